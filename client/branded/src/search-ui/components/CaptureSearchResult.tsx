@@ -0,0 +1,57 @@
+import React from 'react'
+
+import classNames from 'classnames'
+
+import { pluralize } from '@sourcegraph/common'
+import { displayRepoName } from '@sourcegraph/shared/src/components/RepoLink'
+import { CaptureGroupMatch, getRepositoryUrl } from '@sourcegraph/shared/src/search/stream'
+import { Code, Link } from '@sourcegraph/wildcard'
+
+import { ResultContainer } from './ResultContainer'
+
+import styles from './SearchResult.module.scss'
+
+export interface CaptureSearchResultProps {
+    result: CaptureGroupMatch
+    onSelect: () => void
+    containerClassName?: string
+    as?: React.ElementType
+    index: number
+}
+
+export const CaptureSearchResult: React.FunctionComponent<CaptureSearchResultProps> = ({
+    result,
+    onSelect,
+    containerClassName,
+    as,
+    index,
+}) => {
+    const title = (
+        <div className={styles.title}>
+            <span className={classNames('test-search-result-label', styles.titleInner)}>
+                <Link to={getRepositoryUrl(result.repository)} data-selectable-search-result="true">
+                    {displayRepoName(result.repository)}
+                </Link>
+            </span>
+        </div>
+    )
+
+    return (
+        <ResultContainer
+            index={index}
+            title={title}
+            resultType={result.type}
+            onResultClicked={onSelect}
+            repoName={result.repository}
+            className={containerClassName}
+            as={as}
+        >
+            <div className={classNames(styles.searchResultMatch, 'p-2 justify-content-between')}>
+                <Code>{result.value}</Code>
+                <small className="text-muted text-nowrap ml-2">
+                    {result.count} {pluralize('match', result.count, 'matches')}
+                </small>
+            </div>
+        </ResultContainer>
+    )
+}
@@ -35,6 +35,7 @@ const accessibleResultType: Record<SearchMatch['type'], string> = {
     commit: 'commit',
     person: 'person',
     team: 'team',
+    capture: 'capture group value',
 }
 
 /**
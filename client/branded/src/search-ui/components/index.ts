@@ -2,6 +2,7 @@ import FileMatchChildrenStyles from './FileMatchChildren.module.scss'
 import SearchResultStyles from './SearchResult.module.scss'
 import SymbolSearchResultStyles from './SymbolSearchResult.module.scss'
 
+export * from './CaptureSearchResult'
 export * from './CodeExcerpt'
 export * from './CodeHostIcon'
 export * from './CommitSearchResult'
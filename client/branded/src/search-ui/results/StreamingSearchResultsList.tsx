@@ -26,6 +26,7 @@ import {
 import { SettingsCascadeProps } from '@sourcegraph/shared/src/settings/settings'
 import { TelemetryProps } from '@sourcegraph/shared/src/telemetry/telemetryService'
 
+import { CaptureSearchResult } from '../components/CaptureSearchResult'
 import { CommitSearchResult } from '../components/CommitSearchResult'
 import { FileContentSearchResult } from '../components/FileContentSearchResult'
 import { FilePathSearchResult } from '../components/FilePathSearchResult'
@@ -232,6 +233,16 @@ export const StreamingSearchResultsList: React.FunctionComponent<
                                 buildSearchURLQueryFromQueryState={buildSearchURLQueryFromQueryState}
                             />
                         )
+                    case 'capture':
+                        return (
+                            <CaptureSearchResult
+                                index={index}
+                                result={result}
+                                onSelect={() => logSearchResultClicked?.(index, 'capture')}
+                                containerClassName={resultClassName}
+                                as="li"
+                            />
+                        )
                 }
             }
 
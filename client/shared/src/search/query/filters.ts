@@ -12,11 +12,15 @@ export enum FilterType {
     archived = 'archived',
     author = 'author',
     before = 'before',
+    'blame.after' = 'blame.after',
+    'blame.author' = 'blame.author',
+    'blame.before' = 'blame.before',
     case = 'case',
     committer = 'committer',
     content = 'content',
     context = 'context',
     count = 'count',
+    dedupe = 'dedupe',
     file = 'file',
     fork = 'fork',
     lang = 'lang',
@@ -30,6 +34,7 @@ export enum FilterType {
     select = 'select',
     timeout = 'timeout',
     type = 'type',
+    versions = 'versions',
     visibility = 'visibility',
 }
 
@@ -73,6 +78,7 @@ export const filterTypeKeysWithAliases: (FilterType | AliasedFilterType)[] = [
 
 export enum NegatedFilters {
     author = '-author',
+    'blame.author' = '-blame.author',
     committer = '-committer',
     content = '-content',
     f = '-f',
@@ -96,6 +102,7 @@ export type NegatableFilter =
     | FilterType.content
     | FilterType.committer
     | FilterType.author
+    | typeof FilterType['blame.author']
     | FilterType.message
 
 export const isNegatableFilter = (filter: FilterType): filter is NegatableFilter =>
@@ -109,6 +116,7 @@ export const isNegatedFilter = (filter: string): filter is NegatedFilters =>
 
 const negatedFilterToNegatableFilter: { [key: string]: NegatableFilter } = {
     '-author': FilterType.author,
+    '-blame.author': FilterType['blame.author'],
     '-committer': FilterType.committer,
     '-content': FilterType.content,
     '-f': FilterType.file,
@@ -202,6 +210,22 @@ export const FILTERS: Record<NegatableFilter, NegatableFilterDefinition> &
         description: 'Commits made before a certain time, e.g. yesterday, or 12/31/2022',
         placeholder: '"yesterday"',
     },
+    [FilterType['blame.after']]: {
+        description: 'Only include matches on lines last changed after a certain time, e.g. "2 years ago"',
+        placeholder: '"2 years ago"',
+        singular: true,
+    },
+    [FilterType['blame.author']]: {
+        negatable: true,
+        description: negated =>
+            `${negated ? 'Exclude' : 'Include only'} matches on lines last changed by a user, according to git blame.`,
+        placeholder: '"author name/email"',
+    },
+    [FilterType['blame.before']]: {
+        description: 'Only include matches on lines last changed before a certain time, e.g. "2 years ago"',
+        placeholder: '"2 years ago"',
+        singular: true,
+    },
     [FilterType.case]: {
         description: 'Treat the search pattern as case-sensitive.',
         discreteValues: () => ['yes', 'no'].map(value => ({ label: value })),
@@ -231,6 +255,11 @@ export const FILTERS: Record<NegatableFilter, NegatableFilterDefinition> &
         placeholder: 'number',
         singular: true,
     },
+    [FilterType.dedupe]: {
+        description: 'Collapse results with the same file contents at the same path across repositories, such as copies in forks and mirrors.',
+        discreteValues: () => [{ label: 'content' }],
+        singular: true,
+    },
     [FilterType.file]: {
         alias: 'f',
         negatable: true,
@@ -342,6 +371,12 @@ export const FILTERS: Record<NegatableFilter, NegatableFilterDefinition> &
             },
         ],
     },
+    [FilterType.versions]: {
+        description: 'Group results that are identical across the searched revisions and list the versions containing them.',
+        discreteValues: () => ['yes', 'no'].map(value => ({ label: value })),
+        default: 'no',
+        singular: true,
+    },
     [FilterType.visibility]: {
         discreteValues: () => ['any', 'private', 'public'].map(value => ({ label: value })),
         description: 'Include results from repositories with the matching visibility (private, public, any).',
@@ -42,6 +42,7 @@ export const PREDICATES: Access[] = [
                     { name: 'key' },
                     { name: 'meta' },
                     { name: 'topic' },
+                    { name: 'symbol' },
                 ],
             },
         ],
@@ -55,7 +56,7 @@ export const PREDICATES: Access[] = [
             },
             {
                 name: 'has',
-                fields: [{ name: 'content' }, { name: 'owner' }],
+                fields: [{ name: 'content' }, { name: 'owner' }, { name: 'symbol' }],
             },
         ],
     },
@@ -200,6 +201,12 @@ export const predicateCompletion = (field: string): Completion[] => {
                 description: 'Search only inside repositories that have a matching GitHub topic',
                 asSnippet: true,
             },
+            {
+                label: 'has.symbol(...)',
+                insertText: 'has.symbol(name:${1} kind:${2:function})',
+                asSnippet: true,
+                description: 'Search only inside repositories that define a matching symbol',
+            },
             {
                 label: 'has.commit.after(...)',
                 insertText: 'has.commit.after(${1:1 month ago})',
@@ -241,6 +248,12 @@ export const predicateCompletion = (field: string): Completion[] => {
                 asSnippet: true,
                 description: 'Search only inside files that have a contributor that matches a pattern',
             },
+            {
+                label: 'has.symbol(...)',
+                insertText: 'has.symbol(name:${1} kind:${2:function})',
+                asSnippet: true,
+                description: 'Search only inside files that define a matching symbol',
+            },
         ]
     }
     return []
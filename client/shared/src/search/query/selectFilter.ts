@@ -15,6 +15,7 @@ export const SELECTORS: Access[] = [
     },
     {
         name: 'content',
+        fields: [{ name: 'capture' }],
     },
     {
         name: 'symbol',
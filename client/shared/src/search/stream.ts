@@ -29,7 +29,23 @@ export type SearchEvent =
     | { type: 'error'; data: ErrorLike }
     | { type: 'done'; data: {} }
 
-export type SearchMatch = ContentMatch | RepositoryMatch | CommitMatch | SymbolMatch | PathMatch | OwnerMatch
+export type SearchMatch =
+    | ContentMatch
+    | RepositoryMatch
+    | CommitMatch
+    | SymbolMatch
+    | PathMatch
+    | OwnerMatch
+    | CaptureGroupMatch
+
+/**
+ * A contiguous range of searched revisions, in version order, containing the
+ * same contents of a file (`versions:yes`).
+ */
+export interface VersionRange {
+    first: string
+    last: string
+}
 
 export interface PathMatch {
     type: 'path'
@@ -39,7 +55,9 @@ export interface PathMatch {
     repoStars?: number
     repoLastFetched?: string
     branches?: string[]
+    versions?: VersionRange[]
     commit?: string
+    duplicates?: string[]
     debug?: string
 }
 
@@ -51,7 +69,9 @@ export interface ContentMatch {
     repoStars?: number
     repoLastFetched?: string
     branches?: string[]
+    versions?: VersionRange[]
     commit?: string
+    duplicates?: string[]
     lineMatches?: LineMatch[]
     chunkMatches?: ChunkMatch[]
     hunks?: DecoratedHunk[]
@@ -102,7 +122,9 @@ export interface SymbolMatch {
     repoStars?: number
     repoLastFetched?: string
     branches?: string[]
+    versions?: VersionRange[]
     commit?: string
+    duplicates?: string[]
     symbols: MatchedSymbol[]
     debug?: string
 }
@@ -182,6 +204,20 @@ export interface TeamMatch extends BaseOwnerMatch {
     email?: string
 }
 
+/**
+ * A distinct value of a regular expression capture group in a repository, as
+ * selected by `select:content.capture`. Capture matches are streamed as
+ * partial counts: matches with the same repository and value are merged by
+ * summing their counts.
+ */
+export interface CaptureGroupMatch {
+    type: 'capture'
+    value: string
+    count: number
+    repository: string
+    repositoryID?: number
+}
+
 /**
  * An aggregate type representing a progress update.
  * Should be replaced when a new ones come in.
@@ -238,6 +274,7 @@ export interface Skipped {
         | 'backend-missing'
         | 'excluded-fork'
         | 'excluded-archive'
+        | 'duplicate-content'
         | 'display'
         | 'error'
     /**
@@ -318,6 +355,69 @@ export const emptyAggregateResults: AggregateStreamingSearchResults = {
     },
 }
 
+function isFileMatch(match: SearchMatch): match is PathMatch | ContentMatch | SymbolMatch {
+    return match.type === 'path' || match.type === 'content' || match.type === 'symbol'
+}
+
+/**
+ * Returns the key under which matches streamed several times are merged, or
+ * undefined if the match is only ever sent once. Capture matches are sent again
+ * with further counts, and file matches deduplicated with `dedupe:content` are
+ * sent again with further duplicate repositories.
+ */
+function mergeKey(match: SearchMatch): string | undefined {
+    switch (match.type) {
+        case 'capture':
+            return `capture\n${match.repository}\n${match.value}`
+        case 'path':
+        case 'content':
+        case 'symbol':
+            return `file\n${match.repository}\n${match.commit ?? ''}\n${match.path}`
+    }
+    return undefined
+}
+
+/**
+ * Appends newly streamed matches to the existing results. Capture matches for
+ * a repository and value that was already seen update the count of the
+ * existing match, and file matches that were already seen add their duplicate
+ * repositories to the existing match, instead of being appended.
+ */
+function appendMatches(results: SearchMatch[], matches: SearchMatch[]): SearchMatch[] {
+    if (!matches.some(match => match.type === 'capture' || (isFileMatch(match) && match.duplicates))) {
+        return results.concat(matches)
+    }
+
+    const merged = [...results]
+    const indexes = new Map<string, number>()
+    for (const [index, match] of merged.entries()) {
+        const key = mergeKey(match)
+        if (key !== undefined) {
+            indexes.set(key, index)
+        }
+    }
+    for (const match of matches) {
+        const key = mergeKey(match)
+        const index = key === undefined ? undefined : indexes.get(key)
+        if (key === undefined || index === undefined) {
+            if (key !== undefined) {
+                indexes.set(key, merged.length)
+            }
+            merged.push(match)
+            continue
+        }
+        const existing = merged[index]
+        if (existing.type === 'capture' && match.type === 'capture') {
+            merged[index] = { ...existing, count: existing.count + match.count }
+        } else if (isFileMatch(existing) && isFileMatch(match) && match.duplicates) {
+            merged[index] = { ...existing, duplicates: [...(existing.duplicates ?? []), ...match.duplicates] }
+        } else {
+            merged.push(match)
+        }
+    }
+    return merged
+}
+
 /**
  * Converts a stream of SearchEvents into AggregateStreamingSearchResults
  */
@@ -335,7 +435,7 @@ export const switchAggregateSearchResults: OperatorFunction<SearchEvent, Aggrega
                             return {
                                 ...results,
                                 // Matches are additive
-                                results: results.results.concat(newEvent.value.data),
+                                results: appendMatches(results.results, newEvent.value.data),
                             }
 
                         case 'progress':
@@ -628,6 +728,8 @@ export function getMatchUrl(match: SearchMatch): string {
         case 'person':
         case 'team':
             return getOwnerMatchUrl(match)
+        case 'capture':
+            return getRepositoryUrl(match.repository)
     }
 }
 
@@ -6,6 +6,8 @@ import BitbucketIcon from 'mdi-react/BitbucketIcon'
 import GithubIcon from 'mdi-react/GithubIcon'
 import GitIcon from 'mdi-react/GitIcon'
 import GitLabIcon from 'mdi-react/GitlabIcon'
+import HexagonIcon from 'mdi-react/HexagonIcon'
+import LanguageCsharpIcon from 'mdi-react/LanguageCsharpIcon'
 import LanguageGoIcon from 'mdi-react/LanguageGoIcon'
 import LanguageJavaIcon from 'mdi-react/LanguageJavaIcon'
 import LanguagePythonIcon from 'mdi-react/LanguagePythonIcon'
@@ -13,6 +15,8 @@ import LanguageRubyIcon from 'mdi-react/LanguageRubyIcon'
 import LanguageRustIcon from 'mdi-react/LanguageRustIcon'
 import AzureDevOpsIcon from 'mdi-react/MicrosoftAzureDevopsIcon'
 import NpmIcon from 'mdi-react/NpmIcon'
+import PackageVariantIcon from 'mdi-react/PackageVariantIcon'
+import SourceRepositoryIcon from 'mdi-react/SourceRepositoryIcon'
 
 import { PerforceIcon, PhabricatorIcon } from '@sourcegraph/shared/src/components/icons'
 import { Link, Code, Text } from '@sourcegraph/wildcard'
@@ -26,16 +30,21 @@ import githubSchemaJSON from '../../../../../schema/github.schema.json'
 import gitlabSchemaJSON from '../../../../../schema/gitlab.schema.json'
 import gitoliteSchemaJSON from '../../../../../schema/gitolite.schema.json'
 import goModulesSchemaJSON from '../../../../../schema/go-modules.schema.json'
+import hexPackagesSchemaJSON from '../../../../../schema/hex-packages.schema.json'
 import jvmPackagesSchemaJSON from '../../../../../schema/jvm-packages.schema.json'
 import localGitSchemaJSON from '../../../../../schema/localgit.schema.json'
+import mercurialSchemaJSON from '../../../../../schema/mercurial.schema.json'
 import npmPackagesSchemaJSON from '../../../../../schema/npm-packages.schema.json'
+import nugetPackagesSchemaJSON from '../../../../../schema/nuget-packages.schema.json'
 import otherExternalServiceSchemaJSON from '../../../../../schema/other_external_service.schema.json'
 import pagureSchemaJSON from '../../../../../schema/pagure.schema.json'
 import perforceSchemaJSON from '../../../../../schema/perforce.schema.json'
 import phabricatorSchemaJSON from '../../../../../schema/phabricator.schema.json'
+import pubPackagesSchemaJSON from '../../../../../schema/pub-packages.schema.json'
 import pythonPackagesJSON from '../../../../../schema/python-packages.schema.json'
 import rubyPackagesSchemaJSON from '../../../../../schema/ruby-packages.schema.json'
 import rustPackagesJSON from '../../../../../schema/rust-packages.schema.json'
+import subversionSchemaJSON from '../../../../../schema/subversion.schema.json'
 import {
     ExternalRepositoryFields,
     ExternalServiceKind,
@@ -1304,6 +1313,108 @@ const PERFORCE: AddExternalServiceOptions = {
         },
     ],
 }
+const SUBVERSION: AddExternalServiceOptions = {
+    kind: ExternalServiceKind.SUBVERSION,
+    title: 'Subversion',
+    icon: SourceRepositoryIcon,
+    jsonSchema: subversionSchemaJSON,
+    defaultDisplayName: 'Subversion',
+    defaultConfig: `{
+  "url": "https://svn.example.com/repos",
+  "repos": []
+}`,
+    Instructions: () => (
+        <div>
+            <ol>
+                <li>
+                    In the configuration below, set <Field>url</Field> to be the root URL of your Subversion server.
+                </li>
+                <li>
+                    Add the paths of the repositories you wish to index to the <Field>repos</Field> field. These will be
+                    appended to the server URL to obtain the repository URLs.
+                </li>
+                <li>
+                    If the server requires authentication, set the <Field>username</Field> and <Field>password</Field>{' '}
+                    fields.
+                </li>
+            </ol>
+            <Text>
+                See{' '}
+                <Link rel="noopener noreferrer" target="_blank" to="/help/admin/repo/subversion#configuration">
+                    the docs for more options
+                </Link>
+                .
+            </Text>
+        </div>
+    ),
+    editorActions: [
+        {
+            id: 'setLayoutNone',
+            label: 'Import without trunk/branches/tags layout',
+            run: (config: string) => {
+                const value = 'none'
+                const edits = modify(config, ['layout'], value, defaultModificationOptions)
+                return { edits, selectText: value }
+            },
+        },
+    ],
+}
+const MERCURIAL: AddExternalServiceOptions = {
+    kind: ExternalServiceKind.MERCURIAL,
+    title: 'Mercurial',
+    icon: SourceRepositoryIcon,
+    jsonSchema: mercurialSchemaJSON,
+    defaultDisplayName: 'Mercurial',
+    defaultConfig: `{
+  "url": "https://hg.example.com"
+}`,
+    Instructions: () => (
+        <div>
+            <ol>
+                <li>
+                    In the configuration below, set <Field>url</Field> to be the root URL of your hgweb server, or a{' '}
+                    <Code>file://</Code> URL of a directory containing Mercurial repositories.
+                </li>
+                <li>
+                    Repositories are discovered from the URL. To index only some of them, or if they are served over
+                    SSH, add their paths to the <Field>repos</Field> field.
+                </li>
+                <li>
+                    If the server requires authentication, set the <Field>username</Field> and <Field>password</Field>{' '}
+                    fields.
+                </li>
+            </ol>
+            <Text>
+                See{' '}
+                <Link rel="noopener noreferrer" target="_blank" to="/help/admin/repo/mercurial#configuration">
+                    the docs for more options
+                </Link>
+                .
+            </Text>
+        </div>
+    ),
+    editorActions: [
+        {
+            id: 'addRepos',
+            label: 'Add repositories',
+            run: (config: string) => {
+                const value = ['<path to repository>']
+                const edits = modify(config, ['repos'], value, defaultModificationOptions)
+                return { edits, selectText: '<path to repository>' }
+            },
+        },
+        {
+            id: 'excludeRepos',
+            label: 'Exclude repositories',
+            run: (config: string) => {
+                const value = ['<path to repository>']
+                const edits = modify(config, ['exclude'], value, defaultModificationOptions)
+                return { edits, selectText: '<path to repository>' }
+            },
+        },
+    ],
+}
+
 const JVM_PACKAGES: AddExternalServiceOptions = {
     kind: ExternalServiceKind.JVMPACKAGES,
     title: 'JVM Dependencies',
@@ -1583,6 +1694,120 @@ const RUBY_PACKAGES: AddExternalServiceOptions = {
     editorActions: [],
 }
 
+const NUGET_PACKAGES: AddExternalServiceOptions = {
+    kind: ExternalServiceKind.NUGETPACKAGES,
+    title: 'NuGet Dependencies',
+    icon: LanguageCsharpIcon,
+    jsonSchema: nugetPackagesSchemaJSON,
+    defaultDisplayName: 'NuGet Dependencies',
+    defaultConfig: `{
+  "repository": "https://api.nuget.org/v3/index.json",
+  "dependencies": ["Newtonsoft.Json@13.0.3"]
+}`,
+    Instructions: () => (
+        <div>
+            <ol>
+                <li>
+                    The URL https://api.nuget.org/v3/index.json is used if the field
+                    <Code>"repository"</Code> is empty.
+                </li>
+                <li>
+                    Use the syntax <Code>"PACKAGE_ID@VERSION"</Code> to list a dependency for the{' '}
+                    <Code>"dependencies"</Code> field.
+                </li>
+                <li>
+                    The field <Code>"repository"</Code> is redacted because it can include <Code>admin:password</Code>{' '}
+                    credentials.
+                </li>
+                <li>
+                    See the{' '}
+                    <Link to="https://jfrog.com/help/r/jfrog-artifactory-documentation/nuget-repositories">
+                        Artifactory documentation for NuGet repositories
+                    </Link>{' '}
+                    for details on how to configure an internal Artifactory repository.
+                </li>
+            </ol>
+        </div>
+    ),
+    editorActions: [],
+}
+
+const HEX_PACKAGES: AddExternalServiceOptions = {
+    kind: ExternalServiceKind.HEXPACKAGES,
+    title: 'Hex Dependencies',
+    icon: HexagonIcon,
+    jsonSchema: hexPackagesSchemaJSON,
+    defaultDisplayName: 'Hex Dependencies',
+    defaultConfig: `{
+  "repository": "https://repo.hex.pm",
+  "dependencies": ["phoenix@1.7.7"]
+}`,
+    Instructions: () => (
+        <div>
+            <ol>
+                <li>
+                    The URL https://repo.hex.pm is used if the field
+                    <Code>"repository"</Code> is empty.
+                </li>
+                <li>
+                    Use the syntax <Code>"PACKAGE_NAME@VERSION"</Code> to list a dependency for the{' '}
+                    <Code>"dependencies"</Code> field.
+                </li>
+                <li>
+                    The field <Code>"repository"</Code> is redacted because it can include <Code>admin:password</Code>{' '}
+                    credentials.
+                </li>
+                <li>
+                    See the{' '}
+                    <Link to="https://hex.pm/docs/self_hosting">
+                        Hex documentation for self-hosted repositories
+                    </Link>{' '}
+                    for details on how to host an internal Hex repository.
+                </li>
+            </ol>
+        </div>
+    ),
+    editorActions: [],
+}
+
+const PUB_PACKAGES: AddExternalServiceOptions = {
+    kind: ExternalServiceKind.PUBPACKAGES,
+    title: 'Pub Dependencies',
+    icon: PackageVariantIcon,
+    jsonSchema: pubPackagesSchemaJSON,
+    defaultDisplayName: 'Pub Dependencies',
+    defaultConfig: `{
+  "repository": "https://pub.dev",
+  "dependencies": ["http@1.1.0"]
+}`,
+    Instructions: () => (
+        <div>
+            <ol>
+                <li>
+                    The URL https://pub.dev is used if the field
+                    <Code>"repository"</Code> is empty.
+                </li>
+                <li>
+                    Use the syntax <Code>"PACKAGE_NAME@VERSION"</Code> to list a dependency for the{' '}
+                    <Code>"dependencies"</Code> field.
+                </li>
+                <li>
+                    The field <Code>"repository"</Code> is redacted because it can include <Code>admin:password</Code>{' '}
+                    credentials.
+                </li>
+                <li>
+                    See the{' '}
+                    <Link to="https://jfrog.com/help/r/jfrog-artifactory-documentation/pub-repositories">
+                        Artifactory documentation for Pub repositories
+                    </Link>{' '}
+                    for details on how to configure an internal Artifactory repository.
+                </li>
+            </ol>
+        </div>
+    ),
+    editorActions: [],
+}
+
 export const codeHostExternalServices: Record<string, AddExternalServiceOptions> = {
     github: GITHUB_DOTCOM,
     ghe: GITHUB_ENTERPRISE,
@@ -1600,11 +1825,16 @@ export const codeHostExternalServices: Record<string, AddExternalServiceOptions>
     ...(window.context?.experimentalFeatures?.pythonPackages === 'enabled' ? { pythonPackages: PYTHON_PACKAGES } : {}),
     ...(window.context?.experimentalFeatures?.rustPackages === 'enabled' ? { rustPackages: RUST_PACKAGES } : {}),
     ...(window.context?.experimentalFeatures?.rubyPackages === 'enabled' ? { rubyPackages: RUBY_PACKAGES } : {}),
+    ...(window.context?.experimentalFeatures?.nugetPackages === 'enabled' ? { nugetPackages: NUGET_PACKAGES } : {}),
+    ...(window.context?.experimentalFeatures?.hexPackages === 'enabled' ? { hexPackages: HEX_PACKAGES } : {}),
+    ...(window.context?.experimentalFeatures?.pubPackages === 'enabled' ? { pubPackages: PUB_PACKAGES } : {}),
     ...(window.context?.experimentalFeatures?.goPackages === 'enabled' ? { goModules: GO_MODULES } : {}),
     ...(window.context?.experimentalFeatures?.jvmPackages === 'enabled' ? { jvmPackages: JVM_PACKAGES } : {}),
     ...(window.context?.experimentalFeatures?.npmPackages === 'enabled' ? { npmPackages: NPM_PACKAGES } : {}),
     ...(window.context?.experimentalFeatures?.perforce !== 'disabled' ? { perforce: PERFORCE } : {}),
     ...(window.context?.experimentalFeatures?.pagure === 'enabled' ? { pagure: PAGURE } : {}),
+    ...(window.context?.experimentalFeatures?.subversion === 'enabled' ? { subversion: SUBVERSION } : {}),
+    ...(window.context?.experimentalFeatures?.mercurial === 'enabled' ? { mercurial: MERCURIAL } : {}),
 }
 
 export const nonCodeHostExternalServices: Record<string, AddExternalServiceOptions> = {
@@ -1636,6 +1866,11 @@ export const defaultExternalServices: Record<ExternalServiceKind, AddExternalSer
     [ExternalServiceKind.PYTHONPACKAGES]: PYTHON_PACKAGES,
     [ExternalServiceKind.RUSTPACKAGES]: RUST_PACKAGES,
     [ExternalServiceKind.RUBYPACKAGES]: RUBY_PACKAGES,
+    [ExternalServiceKind.SUBVERSION]: SUBVERSION,
+    [ExternalServiceKind.MERCURIAL]: MERCURIAL,
+    [ExternalServiceKind.NUGETPACKAGES]: NUGET_PACKAGES,
+    [ExternalServiceKind.HEXPACKAGES]: HEX_PACKAGES,
+    [ExternalServiceKind.PUBPACKAGES]: PUB_PACKAGES,
 }
 
 export const externalRepoIcon = (
@@ -74,6 +74,11 @@ const scopeRequirements: Record<ExternalServiceKind, JSX.Element> = {
     [ExternalServiceKind.PAGURE]: <span>Unsupported</span>,
     [ExternalServiceKind.OTHER]: <span>Unsupported</span>,
     [ExternalServiceKind.LOCALGIT]: <span>Unsupported</span>,
+    [ExternalServiceKind.SUBVERSION]: <span>Unsupported</span>,
+    [ExternalServiceKind.MERCURIAL]: <span>Unsupported</span>,
+    [ExternalServiceKind.NUGETPACKAGES]: <span>Unsupported</span>,
+    [ExternalServiceKind.HEXPACKAGES]: <span>Unsupported</span>,
+    [ExternalServiceKind.PUBPACKAGES]: <span>Unsupported</span>,
 }
 
 type Step = 'add-token' | 'get-ssh-key'
@@ -26,6 +26,11 @@ const configInstructionLinks: Record<ExternalServiceKind, string> = {
     [ExternalServiceKind.LOCALGIT]: 'unsupported',
     [ExternalServiceKind.PERFORCE]: 'unsupported',
     [ExternalServiceKind.PAGURE]: 'unsupported',
+    [ExternalServiceKind.SUBVERSION]: 'unsupported',
+    [ExternalServiceKind.MERCURIAL]: 'unsupported',
+    [ExternalServiceKind.NUGETPACKAGES]: 'unsupported',
+    [ExternalServiceKind.HEXPACKAGES]: 'unsupported',
+    [ExternalServiceKind.PUBPACKAGES]: 'unsupported',
     [ExternalServiceKind.PHABRICATOR]: 'unsupported',
     [ExternalServiceKind.PYTHONPACKAGES]: 'unsupported',
     [ExternalServiceKind.RUSTPACKAGES]: 'unsupported',
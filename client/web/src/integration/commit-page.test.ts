@@ -31,6 +31,7 @@ describe('RepositoryCommitPage', () => {
                     id: 'R2l0Q29tbWl0OnsiciI6IlVtVndiM05wZEc5eWVUb3pOamd3T1RJMU1BPT0iLCJjIjoiMWU3YmQwMDBlNzhjZjM1YzZlMWJlMWI5ZjE1MTBiNGFhZGZhYTQxNiJ9',
                     oid: '1e7bd000e78cf35c6e1be1b9f1510b4aadfaa416',
                     abbreviatedOID: '1e7bd00',
+                    mercurialChangeset: null,
                     perforceChangelist: null,
                     message: 'Signup copy adjustment (#43435)\n\nCopy adjustment',
                     subject: 'Signup copy adjustment (#43435)',
@@ -70,6 +71,7 @@ describe('RepositoryCommitPage', () => {
                             __typename: 'GitCommit',
                             oid: '56ab377d94fe96c87bc8c5e26675c585f9312e64',
                             abbreviatedOID: '56ab377',
+                            mercurialChangeset: null,
                             perforceChangelist: null,
                             url: '/github.com/sourcegraph/sourcegraph/-/commit/56ab377d94fe96c87bc8c5e26675c585f9312e64',
                         },
@@ -119,6 +119,7 @@ describe('Repository', () => {
                                         id: 'CommitID1',
                                         oid: '15c2290dcb37731cc4ee5a2a1c1e5a25b4c28f81',
                                         abbreviatedOID: '15c2290',
+                                        mercurialChangeset: null,
                                         perforceChangelist: null,
                                         message: 'update LSIF indexing CI workflow\n',
                                         subject: 'update LSIF indexing CI workflow',
@@ -149,12 +150,14 @@ describe('Repository', () => {
                                             {
                                                 oid: '96c4efab7ee28f3d1cf1d248a0139cea37368b18',
                                                 abbreviatedOID: '96c4efa',
+                                                mercurialChangeset: null,
                                                 perforceChangelist: null,
                                                 url: '/github.com/sourcegraph/jsonrpc2/-/commit/96c4efab7ee28f3d1cf1d248a0139cea37368b18',
                                             },
                                             {
                                                 oid: '9e615b1c32cc519130575e8d10d0d0fee8a5eb6c',
                                                 abbreviatedOID: '9e615b1',
+                                                mercurialChangeset: null,
                                                 perforceChangelist: null,
                                                 url: '/github.com/sourcegraph/jsonrpc2/-/commit/9e615b1c32cc519130575e8d10d0d0fee8a5eb6c',
                                             },
@@ -177,6 +180,7 @@ describe('Repository', () => {
                                         id: 'CommitID2',
                                         oid: '9e615b1c32cc519130575e8d10d0d0fee8a5eb6c',
                                         abbreviatedOID: '9e615b1',
+                                        mercurialChangeset: null,
                                         perforceChangelist: null,
                                         message: 'LSIF Indexing Campaign',
                                         subject: 'LSIF Indexing Campaign',
@@ -207,6 +211,7 @@ describe('Repository', () => {
                                             {
                                                 oid: '96c4efab7ee28f3d1cf1d248a0139cea37368b18',
                                                 abbreviatedOID: '96c4efa',
+                                                mercurialChangeset: null,
                                                 perforceChangelist: null,
                                                 url: '/github.com/sourcegraph/jsonrpc2/-/commit/96c4efab7ee28f3d1cf1d248a0139cea37368b18',
                                             },
@@ -230,6 +235,7 @@ describe('Repository', () => {
                                         id: 'CommitID3',
                                         oid: '96c4efab7ee28f3d1cf1d248a0139cea37368b18',
                                         abbreviatedOID: '96c4efa',
+                                        mercurialChangeset: null,
                                         perforceChangelist: null,
                                         message:
                                             'Produce LSIF data for each commit for fast/precise code nav (#35)\n\n* Produce LSIF data for each commit for fast/precise code nav\r\n\r\n* Update lsif.yml\r',
@@ -266,6 +272,7 @@ describe('Repository', () => {
                                             {
                                                 oid: 'cee7209801bf50cee868f8e0696ba0b76ae21792',
                                                 abbreviatedOID: 'cee7209',
+                                                mercurialChangeset: null,
                                                 perforceChangelist: null,
                                                 url: '/github.com/sourcegraph/jsonrpc2/-/commit/cee7209801bf50cee868f8e0696ba0b76ae21792',
                                             },
@@ -299,6 +306,7 @@ describe('Repository', () => {
                             id: 'CommitID1',
                             oid: '15c2290dcb37731cc4ee5a2a1c1e5a25b4c28f81',
                             abbreviatedOID: '15c2290',
+                            mercurialChangeset: null,
                             perforceChangelist: null,
                             message: 'update LSIF indexing CI workflow\n',
                             subject: 'update LSIF indexing CI workflow',
@@ -332,6 +340,7 @@ describe('Repository', () => {
                                     __typename: 'GitCommit',
                                     oid: '96c4efab7ee28f3d1cf1d248a0139cea37368b18',
                                     abbreviatedOID: '96c4efa',
+                                    mercurialChangeset: null,
                                     perforceChangelist: null,
                                     url: '/github.com/sourcegraph/jsonrpc2/-/commit/96c4efab7ee28f3d1cf1d248a0139cea37368b18',
                                 },
@@ -339,6 +348,7 @@ describe('Repository', () => {
                                     __typename: 'GitCommit',
                                     oid: '9e615b1c32cc519130575e8d10d0d0fee8a5eb6c',
                                     abbreviatedOID: '9e615b1',
+                                    mercurialChangeset: null,
                                     perforceChangelist: null,
                                     url: '/github.com/sourcegraph/jsonrpc2/-/commit/9e615b1c32cc519130575e8d10d0d0fee8a5eb6c',
                                 },
@@ -687,6 +697,7 @@ describe('Repository', () => {
                                         id: 'R2l0Q29tbWl0OnsiciI6IlVtVndiM05wZEc5eWVUb3hORGs9IiwiYyI6IjI4NGFiYTAyNGIxYjU1ODU5MGU4ZTJmOTdkYmMzNTUzYTVlMGM3NmIifQ==',
                                         oid: '284aba024b1b558590e8e2f97dbc3553a5e0c76b',
                                         abbreviatedOID: '284aba0',
+                                        mercurialChangeset: null,
                                         perforceChangelist: null,
                                         message: 'sg: create a test command to run e2e tests locally (#34627)\n',
                                         subject: 'sg: create a test command to run e2e tests locally (#34627)',
@@ -719,6 +730,7 @@ describe('Repository', () => {
                                             {
                                                 oid: 'a2d1fd474d79dc29af6c7b4c33f02fe22287bd11',
                                                 abbreviatedOID: 'a2d1fd4',
+                                                mercurialChangeset: null,
                                                 perforceChangelist: null,
                                                 url: '/github.com/sourcegraph/sourcegraph/-/commit/a2d1fd474d79dc29af6c7b4c33f02fe22287bd11',
                                             },
@@ -742,6 +754,7 @@ describe('Repository', () => {
                                         id: 'R2l0Q29tbWl0OnsiciI6IlVtVndiM05wZEc5eWVUb3hORGs9IiwiYyI6ImEyZDFmZDQ3NGQ3OWRjMjlhZjZjN2I0YzMzZjAyZmUyMjI4N2JkMTEifQ==',
                                         oid: 'a2d1fd474d79dc29af6c7b4c33f02fe22287bd11',
                                         abbreviatedOID: 'a2d1fd4',
+                                        mercurialChangeset: null,
                                         perforceChangelist: null,
                                         message:
                                             'Wildcard V2: <Checkbox /> migration (#34324)\n\nCo-authored-by: gitstart-sourcegraph <gitstart@users.noreply.github.com>',
@@ -775,6 +788,7 @@ describe('Repository', () => {
                                             {
                                                 oid: '3a163b92b5c45921fbc730ff2047ff7e60d8689b',
                                                 abbreviatedOID: '3a163b9',
+                                                mercurialChangeset: null,
                                                 perforceChangelist: null,
                                                 url: '/github.com/sourcegraph/sourcegraph/-/commit/3a163b92b5c45921fbc730ff2047ff7e60d8689b',
                                             },
@@ -798,6 +812,7 @@ describe('Repository', () => {
                                         id: 'R2l0Q29tbWl0OnsiciI6IlVtVndiM05wZEc5eWVUb3hORGs9IiwiYyI6IjNhMTYzYjkyYjVjNDU5MjFmYmM3MzBmZjIwNDdmZjdlNjBkODY4OWIifQ==',
                                         oid: '3a163b92b5c45921fbc730ff2047ff7e60d8689b',
                                         abbreviatedOID: '3a163b9',
+                                        mercurialChangeset: null,
                                         perforceChangelist: null,
                                         message: 'web: ban `reactstrap` imports (#34881)\n',
                                         subject: 'web: ban `reactstrap` imports (#34881)',
@@ -830,6 +845,7 @@ describe('Repository', () => {
                                             {
                                                 oid: 'e2e91f0dcdc90811c4f2f4df638bc459b2358e7d',
                                                 abbreviatedOID: 'e2e91f0',
+                                                mercurialChangeset: null,
                                                 perforceChangelist: null,
                                                 url: '/github.com/sourcegraph/sourcegraph/-/commit/e2e91f0dcdc90811c4f2f4df638bc459b2358e7d',
                                             },
@@ -1248,6 +1264,7 @@ describe('Repository', () => {
                                             id: '1'.repeat(70),
                                             oid: '1'.repeat(40),
                                             abbreviatedOID: '1'.repeat(7),
+                                            mercurialChangeset: null,
                                             perforceChangelist: null,
                                             message: 'update README',
                                             subject: 'update README',
@@ -1286,6 +1303,7 @@ describe('Repository', () => {
                                                 {
                                                     oid: '2'.repeat(40),
                                                     abbreviatedOID: '2'.repeat(7),
+                                                    mercurialChangeset: null,
                                                     perforceChangelist: null,
                                                     url: '/github.com/sourcegraph/sourcegraph@2'.repeat(70),
                                                 },
@@ -71,6 +71,7 @@ const barData: FetchOwnersAndHistoryResult = {
                         id: 'R2l0Q29tbWl0OnsiciI6IlVtVndiM05wZEc5eWVUb3hNRGN3Tnc9PSIsImMiOiIxNzQxZmMyYzZlYjhlMTFmNGU3ODZjY2M1YzE5YzBkYTMyNzYzMGE1In0=',
                         oid: '1741fc2c6eb8e11f4e786ccc5c19c0da327630a5',
                         abbreviatedOID: '1741fc2',
+                        mercurialChangeset: null,
                         perforceChangelist: null,
                         message:
                             'build: Drop use of G_DISABLE_DEPRECATED from the build system\n\nIt’s no longer used in any of the headers. See preceding commits.\n\nSigned-off-by: Philip Withnall \u003Cwithnall@endlessm.com\u003E',
@@ -104,6 +105,7 @@ const barData: FetchOwnersAndHistoryResult = {
                             {
                                 oid: '99b412bb192c0062753cbf960169b1f99335080f',
                                 abbreviatedOID: '99b412b',
+                                mercurialChangeset: null,
                                 perforceChangelist: null,
                                 url: '/ghe.sgdev.org/sourcegraph/GNOME-glib/-/commit/99b412bb192c0062753cbf960169b1f99335080f',
                                 __typename: 'GitCommit',
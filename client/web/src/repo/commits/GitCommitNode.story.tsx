@@ -26,6 +26,7 @@ const gitCommitNode: GitCommitFields = {
     id: 'commit123',
     abbreviatedOID: 'abcdefg',
     oid: 'abcdefghijklmnopqrstuvwxyz12345678904321',
+    mercurialChangeset: null,
     perforceChangelist: null,
     author: {
         date: subDays(new Date(), 5).toISOString(),
@@ -62,6 +63,7 @@ const gitCommitNode: GitCommitFields = {
         {
             abbreviatedOID: '987654',
             oid: '98765432101234abcdefghijklmnopqrstuvwxyz',
+            mercurialChangeset: null,
             perforceChangelist: null,
             url: '/commits/987654',
         },
@@ -190,6 +192,7 @@ const perforceChangelistNode: GitCommitFields = {
     id: 'commit123',
     abbreviatedOID: 'abcdefg',
     oid: 'abcdefghijklmnopqrstuvwxyz12345678904321',
+    mercurialChangeset: null,
     perforceChangelist: {
         __typename: 'PerforceChangelist',
         cid: '12345',
@@ -230,6 +233,7 @@ const perforceChangelistNode: GitCommitFields = {
         {
             abbreviatedOID: '987654',
             oid: '98765432101234abcdefghijklmnopqrstuvwxyz',
+            mercurialChangeset: null,
             perforceChangelist: {
                 __typename: 'PerforceChangelist',
                 cid: '12344',
@@ -87,8 +87,9 @@ export const GitCommitNode: React.FunctionComponent<React.PropsWithChildren<GitC
 
     const sourceType = node.perforceChangelist ? RepositoryType.PERFORCE_DEPOT : RepositoryType.GIT_REPOSITORY
     const isPerforceDepot = isPerforceDepotSource(sourceType)
-    const abbreviatedRefID = node.perforceChangelist?.cid ?? node.abbreviatedOID
-    const refID = node.perforceChangelist?.cid ?? node.oid
+    const abbreviatedRefID =
+        node.perforceChangelist?.cid ?? node.mercurialChangeset?.slice(0, 12) ?? node.abbreviatedOID
+    const refID = node.perforceChangelist?.cid ?? node.mercurialChangeset ?? node.oid
     const canonicalURL = getCanonicalURL(sourceType, node)
 
     const toggleShowCommitMessageBody = useCallback((): void => {
@@ -218,13 +219,21 @@ export const GitCommitNode: React.FunctionComponent<React.PropsWithChildren<GitC
                                     className={styles.shaAndParentsParent}
                                     to={parent.perforceChangelist?.canonicalURL ?? parent.url}
                                 >
-                                    <Code>{parent.perforceChangelist?.cid ?? parent.oid}</Code>
+                                    <Code>
+                                        {parent.perforceChangelist?.cid ?? parent.mercurialChangeset ?? parent.oid}
+                                    </Code>
                                 </Link>
                                 <Tooltip content={flashCopiedToClipboardMessage ? 'Copied!' : copyMessage}>
                                     <Button
                                         variant="icon"
                                         className={styles.shaAndParentsCopy}
-                                        onClick={() => copyToClipboard(parent.perforceChangelist?.cid ?? parent.oid)}
+                                        onClick={() =>
+                                            copyToClipboard(
+                                                parent.perforceChangelist?.cid ??
+                                                    parent.mercurialChangeset ??
+                                                    parent.oid
+                                            )
+                                        }
                                         aria-label={copyMessage}
                                     >
                                         <Icon aria-hidden={true} svgPath={mdiContentCopy} />
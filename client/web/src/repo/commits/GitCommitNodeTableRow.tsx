@@ -97,7 +97,9 @@ export const GitCommitNodeTableRow: React.FC<
                 <td className="text-right">
                     <Link to={canonicalURL}>
                         <Code data-testid="git-commit-node-oid">
-                            {node.perforceChangelist?.cid ?? node.abbreviatedOID}
+                            {node.perforceChangelist?.cid ??
+                                node.mercurialChangeset?.slice(0, 12) ??
+                                node.abbreviatedOID}
                         </Code>
                     </Link>
                 </td>
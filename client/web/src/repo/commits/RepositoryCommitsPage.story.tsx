@@ -59,6 +59,7 @@ const mockRepositoryGitCommitsQuery: MockedResponse<RepositoryGitCommitsResult,
                                 id: 'R2l0Q29tbWl0OnsiciI6IlVtVndiM05wZEc5eWVUbzMiLCJjIjoiYjFlMWVjMTZlMmMyZDkzMmNmMjMwYzcxYzc2MDUxNmZiOTY1OGNkYiJ9',
                                 oid: 'b1e1ec16e2c2d932cf230c71c760516fb9658cdb',
                                 abbreviatedOID: 'b1e1ec1',
+                                mercurialChangeset: null,
                                 perforceChangelist: null,
                                 message:
                                     'Document OpenAI API compatibility for completions (#51208)\n\nCo-authored-by: Malo Marrec <malo.marrec@gmail.com>',
@@ -92,6 +93,7 @@ const mockRepositoryGitCommitsQuery: MockedResponse<RepositoryGitCommitsResult,
                                     {
                                         oid: '59ce236cca392975a383cdab14d73244f05d21b6',
                                         abbreviatedOID: '59ce236',
+                                        mercurialChangeset: null,
                                         perforceChangelist: null,
                                         url: '/github.com/sourcegraph/sourcegraph/-/commit/59ce236cca392975a383cdab14d73244f05d21b6',
                                         __typename: 'GitCommit',
@@ -156,6 +158,7 @@ const mockRepositoryPerforceChangelistsQuery: MockedResponse<
                                 id: 'R2l0Q29tbWl0OnsiciI6IlVtVndiM05wZEc5eWVUb3lOak00T1E9PSIsImMiOiJhNzdkMWIzMDliY2U1ZGJiNjM1ODFiY2E4YzJjYWFjMDEzZWM5Mzg3In0=',
                                 oid: 'f27c0f663b36e5294947964d7c25672f6e0e34fe',
                                 abbreviatedOID: 'f27c0f663b',
+                                mercurialChangeset: null,
                                 perforceChangelist: {
                                     __typename: 'PerforceChangelist',
                                     cid: '48485',
@@ -192,6 +195,7 @@ const mockRepositoryPerforceChangelistsQuery: MockedResponse<
                                     {
                                         oid: 'd9994dc548fd79b473ce05198c88282890983fa9',
                                         abbreviatedOID: 'd9994dc',
+                                        mercurialChangeset: null,
                                         perforceChangelist: {
                                             __typename: 'PerforceChangelist',
                                             cid: '48484',
@@ -215,6 +219,7 @@ const mockRepositoryPerforceChangelistsQuery: MockedResponse<
                                 id: 'R2l0Q29tbWl0OnsiciI6IlVtVndiM05wZEc5eWVUb3lOak00T1E9PSIsImMiOiJkOTk5NGRjNTQ4ZmQ3OWI0NzNjZTA1MTk4Yzg4MjgyODkwOTgzZmE5In0=',
                                 oid: '93abc40a6193539d27022a4ce558fcf7d82d3998',
                                 abbreviatedOID: '93abc40a61',
+                                mercurialChangeset: null,
                                 perforceChangelist: {
                                     __typename: 'PerforceChangelist',
                                     cid: '1012',
@@ -251,6 +256,7 @@ const mockRepositoryPerforceChangelistsQuery: MockedResponse<
                                     {
                                         oid: 'd7205ebf51c2c7486ee74ded9f000ddf1b0cca24',
                                         abbreviatedOID: 'd7205eb',
+                                        mercurialChangeset: null,
                                         perforceChangelist: {
                                             __typename: 'PerforceChangelist',
                                             cid: '48485',
@@ -274,6 +280,7 @@ const mockRepositoryPerforceChangelistsQuery: MockedResponse<
                                 id: 'R2l0Q29tbWl0OnsiciI6IlVtVndiM05wZEc5eWVUb3lOak00T1E9PSIsImMiOiJkNzIwNWViZjUxYzJjNzQ4NmVlNzRkZWQ5ZjAwMGRkZjFiMGNjYTI0In0=',
                                 oid: '314a3c2830b6aa500dfbb23ac05aa2304c58f1e1',
                                 abbreviatedOID: '314a3c2830',
+                                mercurialChangeset: null,
                                 perforceChangelist: {
                                     __typename: 'PerforceChangelist',
                                     cid: '1011',
@@ -46,6 +46,7 @@ export const gitCommitFragment = gql`
         perforceChangelist {
             ...PerforceChangelistFieldsWithoutCommit
         }
+        mercurialChangeset
         message
         subject
         body
@@ -61,6 +62,7 @@ export const gitCommitFragment = gql`
             perforceChangelist {
                 ...PerforceChangelistFieldsWithoutCommit
             }
+            mercurialChangeset
             url
         }
         url
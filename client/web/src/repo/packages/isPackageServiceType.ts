@@ -14,6 +14,9 @@ export function isPackageServiceType(serviceType?: string): boolean {
         case 'rubyPackages':
         case 'goModules':
         case 'rustPackages':
+        case 'nugetPackages':
+        case 'hexPackages':
+        case 'pubPackages':
             return true
         default:
             return false
@@ -4,7 +4,7 @@ import { searchResultsToFileContent, buildFileName } from './searchResultsExport
 
 describe('searchResultsToFileContent', () => {
     const sourcegraphURL = 'http://localhost:3443'
-    const data: [SearchType | 'owner' | null, SearchMatch[], string][] = [
+    const data: [SearchType | 'owner' | 'capture' | null, SearchMatch[], string][] = [
         [
             null,
             [
@@ -1393,6 +1393,24 @@ describe('searchResultsToFileContent', () => {
             ],
             'Match type,Handle,Email,User or team name,Display name,Profile URL\nperson,alice,alice@example.com,alice,Alice Example,http://localhost:3443/users/alice\nperson,bob,,,,\nteam,example-team,example-team@example.com,example-team,Example Team,http://localhost:3443/teams/example-team',
         ],
+        [
+            'capture',
+            [
+                {
+                    type: 'capture',
+                    value: 'github.com/sourcegraph/log',
+                    count: 3,
+                    repository: 'github.com/sourcegraph/sourcegraph',
+                },
+                {
+                    type: 'capture',
+                    value: 'say "hi"',
+                    count: 1,
+                    repository: 'github.com/sourcegraph/conc',
+                },
+            ],
+            'Match type,Repository,Repository external URL,Value,Count\ncapture,github.com/sourcegraph/sourcegraph,http://localhost:3443/github.com/sourcegraph/sourcegraph,"github.com/sourcegraph/log",3\ncapture,github.com/sourcegraph/conc,http://localhost:3443/github.com/sourcegraph/conc,"say ""hi""",1',
+        ],
     ]
 
     test.each(data)('returns correct content for searchType "%s"', (searchType, results, content) => {
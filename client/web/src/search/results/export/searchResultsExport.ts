@@ -13,6 +13,7 @@ import {
     PersonMatch,
     TeamMatch,
     getOwnerMatchUrl,
+    CaptureGroupMatch,
     StreamSearchOptions,
     aggregateStreamingSearch,
     AggregateStreamingSearchResults,
@@ -202,6 +203,22 @@ export const searchResultsToFileContent = (
             break
         }
 
+        case 'capture': {
+            content = [
+                [...headers, 'Value', 'Count'],
+                ...searchResults
+                    .filter((result: SearchMatch): result is CaptureGroupMatch => result.type === 'capture')
+                    .map(result => [
+                        result.type,
+                        result.repository,
+                        new URL(getRepositoryUrl(result.repository), sourcegraphURL).toString(),
+                        sanitizeString(result.value),
+                        result.count.toString(),
+                    ]),
+            ]
+            break
+        }
+
         default:
             return ''
     }
@@ -16,16 +16,21 @@ import githubSchemaJSON from '../../../../schema/github.schema.json'
 import gitlabSchemaJSON from '../../../../schema/gitlab.schema.json'
 import gitoliteSchemaJSON from '../../../../schema/gitolite.schema.json'
 import goModulesSchemaJSON from '../../../../schema/go-modules.schema.json'
+import hexPackagesSchemaJSON from '../../../../schema/hex-packages.schema.json'
 import jvmPackagesSchemaJSON from '../../../../schema/jvm-packages.schema.json'
 import localGitSchemaJSON from '../../../../schema/localgit.schema.json'
+import mercurialSchemaJSON from '../../../../schema/mercurial.schema.json'
 import npmPackagesSchemaJSON from '../../../../schema/npm-packages.schema.json'
+import nugetPackagesSchemaJSON from '../../../../schema/nuget-packages.schema.json'
 import otherExternalServiceSchemaJSON from '../../../../schema/other_external_service.schema.json'
 import pagureSchemaJSON from '../../../../schema/pagure.schema.json'
 import perforceSchemaJSON from '../../../../schema/perforce.schema.json'
 import phabricatorSchemaJSON from '../../../../schema/phabricator.schema.json'
+import pubPackagesSchemaJSON from '../../../../schema/pub-packages.schema.json'
 import pythonPackagesSchemaJSON from '../../../../schema/python-packages.schema.json'
 import rubyPackagesSchemaJSON from '../../../../schema/ruby-packages.schema.json'
 import rustPackagesSchemaJSON from '../../../../schema/rust-packages.schema.json'
+import subversionSchemaJSON from '../../../../schema/subversion.schema.json'
 import settingsSchemaJSON from '../../../../schema/settings.schema.json'
 import siteSchemaJSON from '../../../../schema/site.schema.json'
 import { PageTitle } from '../components/PageTitle'
@@ -62,6 +67,11 @@ const externalServices: Record<ExternalServiceKind, JSONSchema> = {
     PHABRICATOR: phabricatorSchemaJSON,
     PAGURE: pagureSchemaJSON,
     LOCALGIT: localGitSchemaJSON,
+    SUBVERSION: subversionSchemaJSON,
+    MERCURIAL: mercurialSchemaJSON,
+    NUGETPACKAGES: nugetPackagesSchemaJSON,
+    HEXPACKAGES: hexPackagesSchemaJSON,
+    PUBPACKAGES: pubPackagesSchemaJSON,
 }
 
 const allConfigSchema = {
@@ -52,6 +52,8 @@ type Services struct {
 
 	PermissionsGitHubWebhook  webhooks.Registerer
 	NewCodeIntelUploadHandler NewCodeIntelUploadHandler
+	CodeIntelSBOMHandler      http.Handler
+	SentinelUploadHandler     http.Handler
 	RankingService            RankingService
 	NewExecutorProxyHandler   NewExecutorProxyHandler
 	NewGitHubAppSetupHandler  NewGitHubAppSetupHandler
@@ -110,6 +112,8 @@ func DefaultServices() Services {
 		BatchesChangesFileUploadHandler: makeNotFoundHandler("batches file upload handler"),
 		SCIMHandler:                     makeNotFoundHandler("SCIM handler"),
 		NewCodeIntelUploadHandler:       func(_ bool) http.Handler { return makeNotFoundHandler("code intel upload") },
+		CodeIntelSBOMHandler:            makeNotFoundHandler("code intel SBOM export"),
+		SentinelUploadHandler:           makeNotFoundHandler("vulnerability bundle upload"),
 		RankingService:                  stubRankingService{},
 		NewExecutorProxyHandler:         func() http.Handler { return makeNotFoundHandler("executor proxy") },
 		NewGitHubAppSetupHandler:        func() http.Handler { return makeNotFoundHandler("Sourcegraph GitHub App setup") },
@@ -0,0 +1,33 @@
+package graphqlbackend
+
+import (
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+// CaptureSearchResultResolver is a resolver for the GraphQL type `CaptureSearchResult`
+type CaptureSearchResultResolver struct {
+	result.CaptureMatch
+
+	RepoResolver *RepositoryResolver
+}
+
+func (r *CaptureSearchResultResolver) Value() string {
+	return r.CaptureMatch.Value
+}
+
+func (r *CaptureSearchResultResolver) Count() int32 {
+	return int32(r.CaptureMatch.Count)
+}
+
+func (r *CaptureSearchResultResolver) Repository() *RepositoryResolver {
+	return r.RepoResolver
+}
+
+func (r *CaptureSearchResultResolver) ToRepository() (*RepositoryResolver, bool) { return nil, false }
+func (r *CaptureSearchResultResolver) ToFileMatch() (*FileMatchResolver, bool)   { return nil, false }
+func (r *CaptureSearchResultResolver) ToCommitSearchResult() (*CommitSearchResultResolver, bool) {
+	return nil, false
+}
+func (r *CaptureSearchResultResolver) ToCaptureSearchResult() (*CaptureSearchResultResolver, bool) {
+	return r, true
+}
@@ -86,6 +86,9 @@ func (fm *FileMatchResolver) ToFileMatch() (*FileMatchResolver, bool)   { return
 func (fm *FileMatchResolver) ToCommitSearchResult() (*CommitSearchResultResolver, bool) {
 	return nil, false
 }
+func (fm *FileMatchResolver) ToCaptureSearchResult() (*CaptureSearchResultResolver, bool) {
+	return nil, false
+}
 
 type lineMatchResolver struct {
 	*result.LineMatch
@@ -19,6 +19,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
@@ -137,6 +138,24 @@ func (r *GitCommitResolver) PerforceChangelist(ctx context.Context) (*PerforceCh
 	return toPerforceChangelistResolver(ctx, r.repoResolver, commit)
 }
 
+func (r *GitCommitResolver) MercurialChangeset(ctx context.Context) (*string, error) {
+	repo, err := r.repoResolver.repo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Only commits converted by gitserver from a Mercurial repository record
+	// their changeset.
+	if repo.ExternalRepo.ServiceType != extsvc.VariantMercurial.AsType() {
+		return nil, nil
+	}
+
+	changeset, err := r.gitserverClient.MercurialChangeset(ctx, authz.DefaultSubRepoPermsChecker, r.gitRepo, api.CommitID(r.oid))
+	if err != nil || changeset == "" {
+		return nil, err
+	}
+	return &changeset, nil
+}
+
 func (r *GitCommitResolver) Author(ctx context.Context) (*signatureResolver, error) {
 	commit, err := r.resolveCommit(ctx)
 	if err != nil {
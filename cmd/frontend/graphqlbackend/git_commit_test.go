@@ -799,3 +799,39 @@ func TestGitCommitPerforceChangelist(t *testing.T) {
 		})
 	})
 }
+
+func TestGitCommitMercurialChangeset(t *testing.T) {
+	ctx := context.Background()
+	db := database.NewMockDB()
+
+	const changeset = "9117c6561b0bd7792fa13b50d28239d51b78e51f"
+	client := gitserver.NewMockClient()
+	client.MercurialChangesetFunc.SetDefaultReturn(changeset, nil)
+
+	t.Run("git repo", func(t *testing.T) {
+		repo := NewRepositoryResolver(db, client, &types.Repo{
+			ID:           1,
+			Name:         "github.com/gorilla/mux",
+			CreatedAt:    time.Now(),
+			ExternalRepo: api.ExternalRepoSpec{ServiceType: extsvc.TypeGitHub},
+		})
+
+		got, err := NewGitCommitResolver(db, client, repo, exampleCommitSHA1, nil).MercurialChangeset(ctx)
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("mercurial repo", func(t *testing.T) {
+		repo := NewRepositoryResolver(db, client, &types.Repo{
+			ID:           2,
+			Name:         "hg.example.com/project-a",
+			CreatedAt:    time.Now(),
+			ExternalRepo: api.ExternalRepoSpec{ServiceType: extsvc.VariantMercurial.AsType()},
+		})
+
+		got, err := NewGitCommitResolver(db, client, repo, exampleCommitSHA1, nil).MercurialChangeset(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		assert.Equal(t, changeset, *got)
+	})
+}
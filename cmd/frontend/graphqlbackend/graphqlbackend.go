@@ -859,6 +859,33 @@ func (r *schemaResolver) DeleteRepositoryFromDisk(ctx context.Context, args *str
 	return &EmptyResponse{}, nil
 }
 
+// TriggerRepositoryMaintenance schedules the maintenance of a repository on
+// gitserver ahead of all other repositories.
+func (r *schemaResolver) TriggerRepositoryMaintenance(ctx context.Context, args *struct {
+	Repo graphql.ID
+},
+) (*repositoryMaintenanceResolver, error) {
+	var repoID api.RepoID
+	if err := relay.UnmarshalSpec(args.Repo, &repoID); err != nil {
+		return nil, err
+	}
+	// 🚨 SECURITY: Only site admins can trigger the maintenance of repositories.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	repo, err := r.db.Repos().Get(ctx, repoID)
+	if err != nil {
+		return nil, err
+	}
+
+	maintenance, err := r.gitserverClient.RepoMaintenance(ctx, repo.Name, true)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("error while triggering maintenance of repository with ID %d", repoID))
+	}
+	return &repositoryMaintenanceResolver{maintenance: maintenance}, nil
+}
+
 func (r *schemaResolver) repositoryByID(ctx context.Context, id graphql.ID) (*RepositoryResolver, error) {
 	var repoID api.RepoID
 	if err := relay.UnmarshalSpec(id, &repoID); err != nil {
@@ -1,6 +1,7 @@
 package graphqlbackend
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"flag"
@@ -22,9 +23,11 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/schema"
@@ -196,6 +199,54 @@ func TestDeleteRepositoryFromDisk(t *testing.T) {
 	assert.True(t, *called)
 }
 
+func TestTriggerRepositoryMaintenance(t *testing.T) {
+	resetMocks()
+	repos := database.NewMockRepoStore()
+	repos.GetFunc.SetDefaultReturn(&types.Repo{ID: 1, Name: "github.com/gorilla/mux"}, nil)
+
+	users := database.NewMockUserStore()
+	users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{ID: 1, SiteAdmin: true}, nil)
+
+	db := database.NewMockDB()
+	db.ReposFunc.SetDefaultReturn(repos)
+	db.UsersFunc.SetDefaultReturn(users)
+
+	gsClient := gitserver.NewMockClient()
+	gsClient.RepoMaintenanceFunc.SetDefaultHook(func(_ context.Context, repo api.RepoName, trigger bool) (*protocol.RepoMaintenance, error) {
+		assert.Equal(t, api.RepoName("github.com/gorilla/mux"), repo)
+		assert.True(t, trigger)
+		return &protocol.RepoMaintenance{Cloned: true, Queued: true}, nil
+	})
+
+	repoID := base64.StdEncoding.EncodeToString([]byte("Repository:1"))
+
+	RunTests(t, []*Test{
+		{
+			Schema: mustParseGraphQLSchemaWithClient(t, db, gsClient),
+			Query: fmt.Sprintf(`
+                mutation {
+                    triggerRepositoryMaintenance(repo: "%s") {
+                        cloned
+                        queued
+                        queuePosition
+                    }
+                }
+            `, repoID),
+			ExpectedResult: `
+                {
+                    "triggerRepositoryMaintenance": {
+                        "cloned": true,
+                        "queued": true,
+                        "queuePosition": 0
+                    }
+                }
+            `,
+		},
+	})
+
+	assert.Len(t, gsClient.RepoMaintenanceFunc.History(), 1)
+}
+
 func TestResolverTo(t *testing.T) {
 	db := database.NewMockDB()
 	// This test exists purely to remove some non determinism in our tests
@@ -33,6 +33,9 @@ var externalServiceToPackageSchemeMap = map[string]string{
 	extsvc.KindPythonPackages: dependencies.PythonPackagesScheme,
 	extsvc.KindRustPackages:   dependencies.RustPackagesScheme,
 	extsvc.KindRubyPackages:   dependencies.RubyPackagesScheme,
+	extsvc.KindNuGetPackages:  dependencies.NuGetPackagesScheme,
+	extsvc.KindHexPackages:    dependencies.HexPackagesScheme,
+	extsvc.KindPubPackages:    dependencies.PubPackagesScheme,
 }
 
 var packageSchemeToExternalServiceMap = map[string]string{
@@ -42,6 +45,9 @@ var packageSchemeToExternalServiceMap = map[string]string{
 	dependencies.PythonPackagesScheme: extsvc.KindPythonPackages,
 	dependencies.RustPackagesScheme:   extsvc.KindRustPackages,
 	dependencies.RubyPackagesScheme:   extsvc.KindRubyPackages,
+	dependencies.NuGetPackagesScheme:  extsvc.KindNuGetPackages,
+	dependencies.HexPackagesScheme:    extsvc.KindHexPackages,
+	dependencies.PubPackagesScheme:    extsvc.KindPubPackages,
 }
 
 func (r *schemaResolver) PackageRepoReferences(ctx context.Context, args *PackageRepoReferenceConnectionArgs) (_ *packageRepoReferenceConnectionResolver, err error) {
@@ -232,6 +238,12 @@ func dependencyRepoToRepoName(dep dependencies.PackageRepoReference) (repoName a
 		repoName = reposource.ParsePythonPackageFromName(dep.Name).RepoName()
 	case "scip-ruby":
 		repoName = reposource.ParseRubyPackageFromName(dep.Name).RepoName()
+	case "scip-dotnet":
+		repoName = reposource.ParseNuGetPackageFromName(dep.Name).RepoName()
+	case "hex":
+		repoName = reposource.ParseHexPackageFromName(dep.Name).RepoName()
+	case "scip-dart":
+		repoName = reposource.ParsePubPackageFromName(dep.Name).RepoName()
 	case "semanticdb":
 		pkg, err := reposource.ParseMavenPackageFromName(dep.Name)
 		if err != nil {
@@ -453,6 +453,9 @@ func (r *RepositoryResolver) ToFileMatch() (*FileMatchResolver, bool)   { return
 func (r *RepositoryResolver) ToCommitSearchResult() (*CommitSearchResultResolver, bool) {
 	return nil, false
 }
+func (r *RepositoryResolver) ToCaptureSearchResult() (*CaptureSearchResultResolver, bool) {
+	return nil, false
+}
 
 func (r *RepositoryResolver) Type(ctx context.Context) (*types.Repo, error) {
 	return r.repo(ctx)
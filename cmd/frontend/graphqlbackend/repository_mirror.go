@@ -14,6 +14,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/featureflag"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
 	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
 	"github.com/sourcegraph/sourcegraph/internal/repoupdater"
@@ -259,6 +260,137 @@ func (r *repositoryMirrorInfoResolver) Shard(ctx context.Context) (*string, erro
 	return &info.ShardID, nil
 }
 
+func (r *repositoryMirrorInfoResolver) SizeBreakdown(ctx context.Context) (*repositorySizeBreakdownResolver, error) {
+	// 🚨 SECURITY: This is a query that reveals internal details of the
+	// instance that only the admin should be able to see.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	breakdown, err := r.gitServerClient.RepoSizeBreakdown(ctx, r.repository.RepoName())
+	if err != nil {
+		return nil, err
+	}
+	return &repositorySizeBreakdownResolver{breakdown: breakdown}, nil
+}
+
+type repositorySizeBreakdownResolver struct {
+	breakdown *protocol.RepoSizeBreakdown
+}
+
+func (r *repositorySizeBreakdownResolver) Cloned() bool {
+	return r.breakdown.Cloned
+}
+
+func (r *repositorySizeBreakdownResolver) TotalBytes() BigInt {
+	return BigInt(r.breakdown.TotalBytes)
+}
+
+func (r *repositorySizeBreakdownResolver) PackfileBytes() BigInt {
+	return BigInt(r.breakdown.PackfileBytes)
+}
+
+func (r *repositorySizeBreakdownResolver) PackfileCount() int32 {
+	return int32(r.breakdown.PackfileCount)
+}
+
+func (r *repositorySizeBreakdownResolver) LooseObjectBytes() BigInt {
+	return BigInt(r.breakdown.LooseObjectBytes)
+}
+
+func (r *repositorySizeBreakdownResolver) LooseObjectCount() int32 {
+	return int32(r.breakdown.LooseObjectCount)
+}
+
+func (r *repositorySizeBreakdownResolver) CommitGraphBytes() BigInt {
+	return BigInt(r.breakdown.CommitGraphBytes)
+}
+
+func (r *repositorySizeBreakdownResolver) OtherBytes() BigInt {
+	return BigInt(r.breakdown.OtherBytes)
+}
+
+func (r *repositorySizeBreakdownResolver) DiskQuotaBytes() *BigInt {
+	if r.breakdown.DiskQuotaBytes == 0 {
+		return nil
+	}
+	quota := BigInt(r.breakdown.DiskQuotaBytes)
+	return &quota
+}
+
+func (r *repositoryMirrorInfoResolver) Maintenance(ctx context.Context) (*repositoryMaintenanceResolver, error) {
+	// 🚨 SECURITY: This is a query that reveals internal details of the
+	// instance that only the admin should be able to see.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	maintenance, err := r.gitServerClient.RepoMaintenance(ctx, r.repository.RepoName(), false)
+	if err != nil {
+		return nil, err
+	}
+	return &repositoryMaintenanceResolver{maintenance: maintenance}, nil
+}
+
+type repositoryMaintenanceResolver struct {
+	maintenance *protocol.RepoMaintenance
+}
+
+func (r *repositoryMaintenanceResolver) Cloned() bool {
+	return r.maintenance.Cloned
+}
+
+func (r *repositoryMaintenanceResolver) Queued() bool {
+	return r.maintenance.Queued
+}
+
+func (r *repositoryMaintenanceResolver) QueuePosition() *int32 {
+	if !r.maintenance.Queued {
+		return nil
+	}
+	position := int32(r.maintenance.QueuePosition)
+	return &position
+}
+
+func (r *repositoryMaintenanceResolver) Running() bool {
+	return r.maintenance.Running
+}
+
+func (r *repositoryMaintenanceResolver) Priority() float64 {
+	return r.maintenance.Priority
+}
+
+func (r *repositoryMaintenanceResolver) Reads() float64 {
+	return r.maintenance.Reads
+}
+
+func (r *repositoryMaintenanceResolver) Fetches() float64 {
+	return r.maintenance.Fetches
+}
+
+func (r *repositoryMaintenanceResolver) LastRunAt() *gqlutil.DateTime {
+	return gqlutil.DateTimeOrNil(r.maintenance.LastRunAt)
+}
+
+func (r *repositoryMaintenanceResolver) LastDurationSeconds() *float64 {
+	if r.maintenance.LastRunAt == nil {
+		return nil
+	}
+	seconds := r.maintenance.LastDuration.Seconds()
+	return &seconds
+}
+
+func (r *repositoryMaintenanceResolver) LastTasks() []string {
+	return r.maintenance.LastTasks
+}
+
+func (r *repositoryMaintenanceResolver) LastError() *string {
+	if r.maintenance.LastError == "" {
+		return nil
+	}
+	return &r.maintenance.LastError
+}
+
 func (r *repositoryMirrorInfoResolver) UpdateSchedule(ctx context.Context) (*updateScheduleResolver, error) {
 	info, err := r.repoUpdateSchedulerInfo(ctx)
 	if err != nil {
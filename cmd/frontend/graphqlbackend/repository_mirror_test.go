@@ -341,3 +341,163 @@ func TestRepositoryMirrorInfoCloneProgressFetchedFromDatabase(t *testing.T) {
 		`,
 	})
 }
+
+func TestRepositoryMirrorInfoSizeBreakdown(t *testing.T) {
+	users := database.NewMockUserStore()
+	users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{SiteAdmin: true}, nil)
+
+	db := database.NewMockDB()
+	db.UsersFunc.SetDefaultReturn(users)
+
+	backend.Mocks.Repos.GetByName = func(ctx context.Context, name api.RepoName) (*types.Repo, error) {
+		return &types.Repo{
+			Name:      name,
+			CreatedAt: time.Now(),
+			Sources:   map[string]*types.SourceInfo{"1": {}},
+		}, nil
+	}
+	t.Cleanup(func() {
+		backend.Mocks = backend.MockServices{}
+	})
+
+	gsClient := gitserver.NewMockClient()
+	gsClient.RepoSizeBreakdownFunc.SetDefaultHook(func(_ context.Context, repo api.RepoName) (*protocol.RepoSizeBreakdown, error) {
+		if repo != "my/repo" {
+			t.Errorf("unexpected repo %q", repo)
+		}
+		return &protocol.RepoSizeBreakdown{
+			Cloned:           true,
+			TotalBytes:       1000,
+			PackfileBytes:    800,
+			PackfileCount:    2,
+			LooseObjectBytes: 100,
+			LooseObjectCount: 5,
+			CommitGraphBytes: 50,
+			OtherBytes:       50,
+		}, nil
+	})
+
+	RunTest(t, &Test{
+		Schema: mustParseGraphQLSchemaWithClient(t, db, gsClient),
+		Query: `
+			{
+				repository(name: "my/repo") {
+					mirrorInfo {
+						sizeBreakdown {
+							cloned
+							totalBytes
+							packfileBytes
+							packfileCount
+							looseObjectBytes
+							looseObjectCount
+							commitGraphBytes
+							otherBytes
+							diskQuotaBytes
+						}
+					}
+				}
+			}
+		`,
+		ExpectedResult: `
+			{
+				"repository": {
+					"mirrorInfo": {
+						"sizeBreakdown": {
+							"cloned": true,
+							"totalBytes": "1000",
+							"packfileBytes": "800",
+							"packfileCount": 2,
+							"looseObjectBytes": "100",
+							"looseObjectCount": 5,
+							"commitGraphBytes": "50",
+							"otherBytes": "50",
+							"diskQuotaBytes": null
+						}
+					}
+				}
+			}
+		`,
+	})
+}
+
+func TestRepositoryMirrorInfoMaintenance(t *testing.T) {
+	users := database.NewMockUserStore()
+	users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{SiteAdmin: true}, nil)
+
+	db := database.NewMockDB()
+	db.UsersFunc.SetDefaultReturn(users)
+
+	backend.Mocks.Repos.GetByName = func(ctx context.Context, name api.RepoName) (*types.Repo, error) {
+		return &types.Repo{
+			Name:      name,
+			CreatedAt: time.Now(),
+			Sources:   map[string]*types.SourceInfo{"1": {}},
+		}, nil
+	}
+	t.Cleanup(func() {
+		backend.Mocks = backend.MockServices{}
+	})
+
+	lastRunAt := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	gsClient := gitserver.NewMockClient()
+	gsClient.RepoMaintenanceFunc.SetDefaultHook(func(_ context.Context, repo api.RepoName, trigger bool) (*protocol.RepoMaintenance, error) {
+		if repo != "my/repo" || trigger {
+			t.Errorf("unexpected request for repo %q (trigger=%t)", repo, trigger)
+		}
+		return &protocol.RepoMaintenance{
+			Cloned:       true,
+			Priority:     12.5,
+			Reads:        2.5,
+			Fetches:      1,
+			LastRunAt:    &lastRunAt,
+			LastDuration: 1500 * time.Millisecond,
+			LastTasks:    []string{"garbage collect", "commit-graph"},
+		}, nil
+	})
+
+	RunTest(t, &Test{
+		Schema: mustParseGraphQLSchemaWithClient(t, db, gsClient),
+		Query: `
+			{
+				repository(name: "my/repo") {
+					mirrorInfo {
+						maintenance {
+							cloned
+							queued
+							queuePosition
+							running
+							priority
+							reads
+							fetches
+							lastRunAt
+							lastDurationSeconds
+							lastTasks
+							lastError
+						}
+					}
+				}
+			}
+		`,
+		ExpectedResult: `
+			{
+				"repository": {
+					"mirrorInfo": {
+						"maintenance": {
+							"cloned": true,
+							"queued": false,
+							"queuePosition": null,
+							"running": false,
+							"priority": 12.5,
+							"reads": 2.5,
+							"fetches": 1,
+							"lastRunAt": "2023-06-01T12:00:00Z",
+							"lastDurationSeconds": 1.5,
+							"lastTasks": ["garbage collect", "commit-graph"],
+							"lastError": null
+						}
+					}
+				}
+			}
+		`,
+	})
+}
@@ -145,6 +145,10 @@ func matchesToResolvers(db database.DB, matches []result.Match) []SearchResultRe
 		return resolver
 	}
 
+	// Capture matches with the same key are merged, since their counts are
+	// streamed as they are found.
+	captureResolvers := make(map[result.Key]*CaptureSearchResultResolver)
+
 	resolvers := make([]SearchResultResolver, 0, len(matches))
 	for _, match := range matches {
 		switch v := match.(type) {
@@ -163,6 +167,17 @@ func matchesToResolvers(db database.DB, matches []result.Match) []SearchResultRe
 			})
 		case *result.OwnerMatch:
 			// todo(own): add OwnerSearchResultResolver
+		case *result.CaptureMatch:
+			if existing, ok := captureResolvers[v.Key()]; ok {
+				existing.CaptureMatch.AppendMatches(v)
+				continue
+			}
+			resolver := &CaptureSearchResultResolver{
+				CaptureMatch: *v,
+				RepoResolver: getRepoResolver(v.Repo, ""),
+			}
+			captureResolvers[v.Key()] = resolver
+			resolvers = append(resolvers, resolver)
 		}
 	}
 	return resolvers
@@ -537,4 +552,5 @@ type SearchResultResolver interface {
 	ToRepository() (*RepositoryResolver, bool)
 	ToFileMatch() (*FileMatchResolver, bool)
 	ToCommitSearchResult() (*CommitSearchResultResolver, bool)
+	ToCaptureSearchResult() (*CaptureSearchResultResolver, bool)
 }
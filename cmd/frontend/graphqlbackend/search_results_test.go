@@ -598,6 +598,25 @@ func TestZeroElapsedMilliseconds(t *testing.T) {
 	}
 }
 
+func TestMatchesToResolversMergesCaptures(t *testing.T) {
+	repoA := types.MinimalRepo{ID: 1, Name: "a"}
+	repoB := types.MinimalRepo{ID: 2, Name: "b"}
+
+	resolvers := matchesToResolvers(database.NewMockDB(), []result.Match{
+		&result.CaptureMatch{Value: "1.2", Repo: repoA, Count: 2},
+		&result.CaptureMatch{Value: "1.2", Repo: repoB, Count: 1},
+		&result.CaptureMatch{Value: "1.2", Repo: repoA, Count: 3},
+	})
+
+	var got []string
+	for _, r := range resolvers {
+		capture, ok := r.ToCaptureSearchResult()
+		require.True(t, ok)
+		got = append(got, fmt.Sprintf("%s %s %d", capture.Repository().Name(), capture.Value(), capture.Count()))
+	}
+	require.Equal(t, []string{"a 1.2 5", "b 1.2 1"}, got)
+}
+
 // Detailed filtering tests are below in TestSubRepoFilterFunc, this test is more
 // of an integration test to ensure that things are threaded through correctly
 // from the resolver
@@ -67,6 +67,8 @@ type Handlers struct {
 
 	// Code intel
 	NewCodeIntelUploadHandler enterprise.NewCodeIntelUploadHandler
+	CodeIntelSBOMHandler      http.Handler
+	SentinelUploadHandler     http.Handler
 
 	// Compute
 	NewComputeStreamHandler enterprise.NewComputeStreamHandler
@@ -154,6 +156,8 @@ func NewHandler(
 	m.Get(apirouter.LSIFUpload).Handler(trace.Route(lsifDeprecationHandler))
 	m.Get(apirouter.SCIPUpload).Handler(trace.Route(handlers.NewCodeIntelUploadHandler(true)))
 	m.Get(apirouter.SCIPUploadExists).Handler(trace.Route(noopHandler))
+	m.Get(apirouter.CodeIntelSBOM).Handler(trace.Route(handlers.CodeIntelSBOMHandler))
+	m.Get(apirouter.SentinelUpload).Handler(trace.Route(handlers.SentinelUploadHandler))
 	m.Get(apirouter.ComputeStream).Handler(trace.Route(handlers.NewComputeStreamHandler()))
 	m.Get(apirouter.ChatCompletionsStream).Handler(trace.Route(handlers.NewChatCompletionsStreamHandler()))
 	m.Get(apirouter.CodeCompletions).Handler(trace.Route(handlers.NewCodeCompletionsHandler()))
@@ -171,6 +175,7 @@ func NewHandler(
 	m.Get(apirouter.GraphQL).Handler(trace.Route(handler(serveGraphQL(logger, schema, rateLimiter, false))))
 
 	m.Get(apirouter.SearchStream).Handler(trace.Route(frontendsearch.StreamHandler(db, enterpriseJobs)))
+	m.Get(apirouter.SearchExport).Handler(trace.Route(frontendsearch.ExportHandler(db, enterpriseJobs)))
 
 	// Return the minimum src-cli version that's compatible with this instance
 	m.Get(apirouter.SrcCli).Handler(trace.Route(newSrcCliVersionHandler(logger)))
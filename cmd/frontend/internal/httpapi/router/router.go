@@ -13,8 +13,11 @@ const (
 	LSIFUpload       = "lsif.upload"
 	SCIPUpload       = "scip.upload"
 	SCIPUploadExists = "scip.upload.exists"
+	CodeIntelSBOM    = "codeintel.sbom"
+	SentinelUpload   = "sentinel.upload"
 
 	SearchStream          = "search.stream"
+	SearchExport          = "search.export"
 	ComputeStream         = "compute.stream"
 	GitBlameStream        = "git.blame.stream"
 	ChatCompletionsStream = "completions.stream"
@@ -80,7 +83,10 @@ func New(base *mux.Router) *mux.Router {
 	base.Path("/lsif/upload").Methods("POST").Name(LSIFUpload)
 	base.Path("/scip/upload").Methods("POST").Name(SCIPUpload)
 	base.Path("/scip/upload").Methods("HEAD").Name(SCIPUploadExists)
+	base.Path("/sbom").Methods("GET").Name(CodeIntelSBOM)
+	base.Path("/vulnerabilities/upload").Methods("POST").Name(SentinelUpload)
 	base.Path("/search/stream").Methods("GET").Name(SearchStream)
+	base.Path("/search/export").Methods("GET").Name(SearchExport)
 	base.Path("/compute/stream").Methods("GET", "POST").Name(ComputeStream)
 	base.Path("/blame/" + routevar.Repo + routevar.RepoRevSuffix + "/stream/{Path:.*}").Methods("GET").Name(GitBlameStream)
 	base.Path("/src-cli/versions/{rest:.*}").Methods("GET", "POST").Name(SrcCliVersionCache)
@@ -0,0 +1,421 @@
+package search
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sourcegraph/log"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/client"
+	"github.com/sourcegraph/sourcegraph/internal/search/job/jobutil"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+// ExportHandler is an http handler which streams back search results as CSV
+// or JSON lines, for consumption by scripts rather than the web UI.
+//
+// Unlike StreamHandler, queries without a count: filter are run with
+// count:all.
+func ExportHandler(db database.DB, enterpriseJobs jobutil.EnterpriseJobs) http.Handler {
+	logger := log.Scoped("searchExportHandler", "")
+	return &exportHandler{
+		logger:       logger,
+		db:           db,
+		searchClient: client.New(logger, db, enterpriseJobs),
+	}
+}
+
+type exportHandler struct {
+	logger       log.Logger
+	db           database.DB
+	searchClient client.SearchClient
+}
+
+// exportFormat is the output format of the export endpoint.
+type exportFormat string
+
+const (
+	exportFormatJSONL exportFormat = "jsonl"
+	exportFormatCSV   exportFormat = "csv"
+)
+
+// exportColumns are the CSV header of an export, in the order of the fields
+// of exportRecord.
+var exportColumns = []string{"type", "repository", "path", "line", "preview", "commit", "author", "count"}
+
+// exportRecord is a single row of an export. A result is exported as one
+// record per matched line or symbol, or as a single record if it has neither.
+type exportRecord struct {
+	Type       string `json:"type"`
+	Repository string `json:"repository"`
+	Path       string `json:"path,omitempty"`
+	Line       int    `json:"line,omitempty"` // 1-based, 0 if the record is not about a line
+	Preview    string `json:"preview,omitempty"`
+	Commit     string `json:"commit,omitempty"`
+	Author     string `json:"author,omitempty"`
+	Count      int    `json:"count,omitempty"` // occurrences of a captured value, 0 for other records
+}
+
+// exportErrorRecord is the last record of an export which failed after the
+// status was sent. In CSV, it is written as a row of type "error" with the
+// message in the preview column.
+type exportErrorRecord struct {
+	Type  string `json:"type"`
+	Error string `json:"error"`
+}
+
+func (r exportRecord) csv() []string {
+	line, count := "", ""
+	if r.Line > 0 {
+		line = strconv.Itoa(r.Line)
+	}
+	if r.Count > 0 {
+		count = strconv.Itoa(r.Count)
+	}
+	return []string{r.Type, r.Repository, r.Path, line, r.Preview, r.Commit, r.Author, count}
+}
+
+func (h *exportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tr, ctx := trace.New(r.Context(), "search.ServeExport")
+	defer tr.Finish()
+
+	format := exportFormat(r.URL.Query().Get("format"))
+	switch format {
+	case "":
+		format = exportFormatJSONL
+	case exportFormatJSONL, exportFormatCSV:
+	default:
+		http.Error(w, errors.Errorf("unsupported format %q, expected jsonl or csv", format).Error(), http.StatusBadRequest)
+		return
+	}
+
+	args, err := parseURLQuery(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	tr.SetAttributes(
+		attribute.String("query", args.Query),
+		attribute.String("format", string(format)),
+	)
+
+	inputs, err := h.searchClient.Plan(
+		ctx,
+		args.Version,
+		pointers.NonZeroPtr(args.PatternType),
+		args.Query,
+		search.Mode(args.SearchMode),
+		search.Streaming,
+	)
+	if err != nil {
+		var queryErr *client.QueryError
+		if errors.As(err, &queryErr) {
+			http.Error(w, queryErr.Error(), http.StatusBadRequest)
+			return
+		}
+		tr.SetError(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if inputs.Query.Count() == nil {
+		countAll := query.Parameter{Field: query.FieldCount, Value: strconv.Itoa(query.CountAllLimit)}
+		for i, b := range inputs.Plan {
+			parameters := append(append([]query.Parameter{}, b.Parameters...), countAll)
+			inputs.Plan[i] = b.MapParameters(parameters)
+		}
+		inputs.Query = inputs.Plan.ToQ()
+	}
+
+	if format == exportFormatCSV {
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	} else {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+	}
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	ew := newExportWriter(ctx, h.logger, h.db, w, format)
+	_, err = h.searchClient.Execute(ctx, ew, inputs)
+	if flushErr := ew.Done(); err == nil {
+		err = flushErr
+	}
+	if err != nil {
+		// The status has already been sent, so the failure is reported with
+		// a final error record that clients can tell apart from a complete
+		// export.
+		tr.SetError(err)
+		if !errors.IsContextCanceled(err) {
+			h.logger.Warn("search export failed", log.String("query", args.Query), log.Error(err))
+			ew.Error(err)
+		}
+	}
+}
+
+// exportFlushInterval is how often buffered export records are flushed to
+// the client.
+const exportFlushInterval = 100 * time.Millisecond
+
+// exportWriter is a streaming.Sender which writes each result it receives to
+// an export. Send blocks until the records are written, so a slow client
+// applies back-pressure to the search.
+type exportWriter struct {
+	ctx    context.Context
+	logger log.Logger
+	db     database.DB
+
+	mu        sync.Mutex
+	flusher   http.Flusher
+	lastFlush time.Time
+	json      *json.Encoder
+	csv       *csv.Writer
+	err       error
+
+	// searchErr is set when results can't be exported, such as when their
+	// access can't be checked. The export ends with it as an error record.
+	searchErr error
+
+	// exportedFiles are the keys of the file matches exported so far. A file
+	// match can be sent again without matches to report more of its duplicate
+	// repos (`dedupe:content`), which doesn't add any records.
+	exportedFiles map[result.Key]struct{}
+}
+
+func newExportWriter(ctx context.Context, logger log.Logger, db database.DB, w io.Writer, format exportFormat) *exportWriter {
+	ew := &exportWriter{
+		ctx:           ctx,
+		logger:        logger,
+		db:            db,
+		lastFlush:     time.Now(),
+		exportedFiles: make(map[result.Key]struct{}),
+	}
+	ew.flusher, _ = w.(http.Flusher)
+	if format == exportFormatCSV {
+		ew.csv = csv.NewWriter(w)
+		ew.err = ew.csv.Write(exportColumns)
+	} else {
+		ew.json = json.NewEncoder(w)
+	}
+	return ew
+}
+
+func (e *exportWriter) Send(event streaming.SearchEvent) {
+	if len(event.Results) == 0 {
+		return
+	}
+
+	repoMetadata, err := getEventRepoMetadata(e.ctx, e.db, event)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.err != nil || e.searchErr != nil {
+		return
+	}
+
+	// Results whose access can't be checked are never exported. Since the
+	// export would be incomplete, it ends with an error record.
+	if err != nil {
+		if !errors.IsContextCanceled(err) {
+			e.logger.Error("failed to get repo metadata", log.Error(err))
+			e.searchErr = errors.Wrap(err, "failed to get repo metadata")
+		}
+		return
+	}
+
+	for _, match := range event.Results {
+		repo := match.RepoName()
+
+		// Don't export matches which we cannot map to a repo the actor has
+		// access to. See eventHandler.Send.
+		if md, ok := repoMetadata[repo.ID]; !ok || md.Name != repo.Name {
+			continue
+		}
+
+		if fm, ok := match.(*result.FileMatch); ok {
+			if _, ok := e.exportedFiles[fm.Key()]; ok && fm.IsPathMatch() {
+				continue
+			}
+			e.exportedFiles[fm.Key()] = struct{}{}
+		}
+
+		for _, record := range toExportRecords(match) {
+			if e.csv != nil {
+				e.err = e.csv.Write(record.csv())
+			} else {
+				e.err = e.json.Encode(record)
+			}
+			if e.err != nil {
+				return
+			}
+		}
+	}
+
+	if time.Since(e.lastFlush) >= exportFlushInterval {
+		e.flush()
+	}
+}
+
+// Error writes a final record reporting err, unless writing the export has
+// already failed.
+func (e *exportWriter) Error(err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.err != nil {
+		return
+	}
+	if e.csv != nil {
+		e.err = e.csv.Write(exportRecord{Type: "error", Preview: err.Error()}.csv())
+	} else {
+		e.err = e.json.Encode(exportErrorRecord{Type: "error", Error: err.Error()})
+	}
+	e.flush()
+}
+
+// Done flushes any buffered records and returns the first error encountered
+// while writing the export, or else the error which stopped results from
+// being exported.
+func (e *exportWriter) Done() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.flush()
+	if e.err != nil {
+		return e.err
+	}
+	return e.searchErr
+}
+
+func (e *exportWriter) flush() {
+	if e.csv != nil {
+		e.csv.Flush()
+		if e.err == nil {
+			e.err = e.csv.Error()
+		}
+	}
+	if e.flusher != nil {
+		e.flusher.Flush()
+	}
+	e.lastFlush = time.Now()
+}
+
+// toExportRecords converts a match to the records it is exported as.
+func toExportRecords(match result.Match) []exportRecord {
+	switch v := match.(type) {
+	case *result.FileMatch:
+		base := exportRecord{
+			Repository: string(v.Repo.Name),
+			Path:       v.Path,
+			Commit:     string(v.CommitID),
+		}
+		if len(v.Symbols) > 0 {
+			records := make([]exportRecord, 0, len(v.Symbols))
+			for _, sym := range v.Symbols {
+				r := base
+				r.Type = "symbol"
+				r.Line = sym.Symbol.Line
+				r.Preview = sym.Symbol.Name
+				records = append(records, r)
+			}
+			return records
+		}
+		if lineMatches := v.ChunkMatches.AsLineMatches(); len(lineMatches) > 0 {
+			records := make([]exportRecord, 0, len(lineMatches))
+			for _, lm := range lineMatches {
+				r := base
+				r.Type = "content"
+				r.Line = int(lm.LineNumber) + 1
+				r.Preview = lm.Preview
+				records = append(records, r)
+			}
+			return records
+		}
+		base.Type = "path"
+		return []exportRecord{base}
+	case *result.RepoMatch:
+		return []exportRecord{{
+			Type:       "repo",
+			Repository: string(v.Name),
+		}}
+	case *result.CommitDiffMatch:
+		return []exportRecord{{
+			Type:       "diff",
+			Repository: string(v.Repo.Name),
+			Path:       v.Path(),
+			Commit:     string(v.Commit.ID),
+			Author:     v.Commit.Author.Name,
+		}}
+	case *result.OwnerMatch:
+		return []exportRecord{{
+			Type:       "owner",
+			Repository: string(v.Repo.Name),
+			Preview:    ownerName(v.ResolvedOwner),
+			Commit:     string(v.CommitID),
+		}}
+	case *result.CaptureMatch:
+		return []exportRecord{{
+			Type:       "capture",
+			Repository: string(v.Repo.Name),
+			Preview:    v.Value,
+			Count:      v.Count,
+		}}
+	case *result.CommitMatch:
+		typ := "commit"
+		if v.DiffPreview != nil {
+			typ = "diff"
+		}
+		return []exportRecord{{
+			Type:       typ,
+			Repository: string(v.Repo.Name),
+			Preview:    v.Commit.Message.Subject(),
+			Commit:     string(v.Commit.ID),
+			Author:     v.Commit.Author.Name,
+		}}
+	default:
+		// Match types without a dedicated record are exported with the
+		// fields of their key, so that no result is missing from an export.
+		key := match.Key()
+		return []exportRecord{{
+			Type:       "match",
+			Repository: string(key.Repo),
+			Path:       key.Path,
+			Commit:     string(key.Commit),
+		}}
+	}
+}
+
+// ownerName returns the handle of an owner, or its email if it has no handle.
+func ownerName(owner result.Owner) string {
+	switch o := owner.(type) {
+	case *result.OwnerPerson:
+		if o.Handle != "" {
+			return o.Handle
+		}
+		return o.Email
+	case *result.OwnerTeam:
+		if o.Handle != "" {
+			return o.Handle
+		}
+		if o.Email != "" {
+			return o.Email
+		}
+		if o.Team != nil {
+			return o.Team.Name
+		}
+	}
+	return ""
+}
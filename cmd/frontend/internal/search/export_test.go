@@ -0,0 +1,254 @@
+package search
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	api2 "github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/client"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestServeExport(t *testing.T) {
+	repo := types.MinimalRepo{ID: 1, Name: "github.com/sourcegraph/sourcegraph"}
+	hidden := types.MinimalRepo{ID: 2, Name: "github.com/sourcegraph/hidden"}
+
+	mock := client.NewMockSearchClient()
+	mock.PlanFunc.SetDefaultHook(func(_ context.Context, _ string, _ *string, q string, _ search.Mode, _ search.Protocol) (*search.Inputs, error) {
+		plan, err := query.Pipeline(query.Init(q, query.SearchTypeLiteral))
+		if err != nil {
+			return nil, err
+		}
+		return &search.Inputs{Plan: plan, Query: plan.ToQ()}, nil
+	})
+	var gotCount *int
+	mock.ExecuteFunc.SetDefaultHook(func(_ context.Context, s streaming.Sender, inputs *search.Inputs) (*search.Alert, error) {
+		gotCount = inputs.Query.Count()
+		s.Send(streaming.SearchEvent{
+			Results: result.Matches{
+				&result.FileMatch{
+					File: result.File{Repo: repo, Path: "main.go", CommitID: "abc"},
+					ChunkMatches: result.ChunkMatches{{
+						Content:      "func main() {\n\tfoo()",
+						ContentStart: result.Location{Line: 9},
+						Ranges: result.Ranges{
+							{Start: result.Location{Line: 9}, End: result.Location{Line: 9, Column: 4}},
+							{Start: result.Location{Line: 10, Column: 1}, End: result.Location{Line: 10, Column: 4}},
+						},
+					}},
+				},
+				&result.FileMatch{
+					File: result.File{Repo: hidden, Path: "secret.go"},
+				},
+				&result.CommitMatch{
+					Repo: repo,
+					Commit: gitdomain.Commit{
+						ID:      "def",
+						Author:  gitdomain.Signature{Name: "Alice"},
+						Message: "Fix foo, again\n\nbody",
+					},
+				},
+			},
+		})
+		// Reporting more duplicate repos of a file doesn't add records.
+		s.Send(streaming.SearchEvent{
+			Results: result.Matches{
+				&result.FileMatch{
+					File:           result.File{Repo: repo, Path: "main.go", CommitID: "abc"},
+					DuplicateRepos: []types.MinimalRepo{hidden},
+				},
+			},
+		})
+		return nil, nil
+	})
+
+	mockRepos := database.NewMockRepoStore()
+	mockRepos.MetadataFunc.SetDefaultHook(func(_ context.Context, ids ...api2.RepoID) ([]*types.SearchedRepo, error) {
+		var out []*types.SearchedRepo
+		for _, id := range ids {
+			if id == repo.ID {
+				out = append(out, &types.SearchedRepo{ID: repo.ID, Name: repo.Name})
+			}
+		}
+		return out, nil
+	})
+	db := database.NewMockDB()
+	db.ReposFunc.SetDefaultReturn(mockRepos)
+
+	ts := httptest.NewServer(&exportHandler{
+		logger:       logtest.Scoped(t),
+		db:           db,
+		searchClient: mock,
+	})
+	defer ts.Close()
+
+	get := func(t *testing.T, params string) (int, string) {
+		t.Helper()
+		res, err := http.Get(ts.URL + "?" + params)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		b, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		return res.StatusCode, string(b)
+	}
+
+	t.Run("jsonl", func(t *testing.T) {
+		status, body := get(t, "q=foo")
+		require.Equal(t, http.StatusOK, status)
+		require.Equal(t, `{"type":"content","repository":"github.com/sourcegraph/sourcegraph","path":"main.go","line":10,"preview":"func main() {","commit":"abc"}
+{"type":"content","repository":"github.com/sourcegraph/sourcegraph","path":"main.go","line":11,"preview":"\tfoo()","commit":"abc"}
+{"type":"commit","repository":"github.com/sourcegraph/sourcegraph","preview":"Fix foo, again","commit":"def","author":"Alice"}
+`, body)
+		require.NotNil(t, gotCount)
+		require.Equal(t, query.CountAllLimit, *gotCount)
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		status, body := get(t, "q=foo+count:10&format=csv")
+		require.Equal(t, http.StatusOK, status)
+		require.Equal(t, `type,repository,path,line,preview,commit,author,count
+content,github.com/sourcegraph/sourcegraph,main.go,10,func main() {,abc,,
+content,github.com/sourcegraph/sourcegraph,main.go,11,"	foo()",abc,,
+commit,github.com/sourcegraph/sourcegraph,,,"Fix foo, again",def,Alice,
+`, body)
+		require.NotNil(t, gotCount)
+		require.Equal(t, 10, *gotCount)
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		status, _ := get(t, "q=foo&format=xml")
+		require.Equal(t, http.StatusBadRequest, status)
+	})
+}
+
+func TestServeExportErrors(t *testing.T) {
+	repo := types.MinimalRepo{ID: 1, Name: "github.com/sourcegraph/sourcegraph"}
+
+	mock := client.NewMockSearchClient()
+	mock.PlanFunc.SetDefaultHook(func(_ context.Context, _ string, _ *string, q string, _ search.Mode, _ search.Protocol) (*search.Inputs, error) {
+		plan, err := query.Pipeline(query.Init(q, query.SearchTypeLiteral))
+		if err != nil {
+			return nil, err
+		}
+		return &search.Inputs{Plan: plan, Query: plan.ToQ()}, nil
+	})
+	mock.ExecuteFunc.SetDefaultHook(func(_ context.Context, s streaming.Sender, _ *search.Inputs) (*search.Alert, error) {
+		s.Send(streaming.SearchEvent{
+			Results: result.Matches{
+				&result.CommitDiffMatch{
+					Repo:     repo,
+					Commit:   gitdomain.Commit{ID: "def", Author: gitdomain.Signature{Name: "Alice"}},
+					DiffFile: &result.DiffFile{OrigName: "main.go", NewName: "main.go"},
+				},
+				&result.OwnerMatch{
+					Repo:          repo,
+					CommitID:      "abc",
+					ResolvedOwner: &result.OwnerPerson{Email: "alice@example.com"},
+				},
+				&result.CaptureMatch{Value: "1.2", Repo: repo, Count: 3},
+			},
+		})
+		return nil, errors.New("zoekt unavailable")
+	})
+
+	mockRepos := database.NewMockRepoStore()
+	mockRepos.MetadataFunc.SetDefaultReturn([]*types.SearchedRepo{{ID: repo.ID, Name: repo.Name}}, nil)
+	db := database.NewMockDB()
+	db.ReposFunc.SetDefaultReturn(mockRepos)
+
+	ts := httptest.NewServer(&exportHandler{
+		logger:       logtest.Scoped(t),
+		db:           db,
+		searchClient: mock,
+	})
+	defer ts.Close()
+
+	get := func(t *testing.T, params string) (int, string) {
+		t.Helper()
+		res, err := http.Get(ts.URL + "?" + params)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		b, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		return res.StatusCode, string(b)
+	}
+
+	t.Run("jsonl", func(t *testing.T) {
+		status, body := get(t, "q=foo")
+		require.Equal(t, http.StatusOK, status)
+		require.Equal(t, `{"type":"diff","repository":"github.com/sourcegraph/sourcegraph","path":"main.go","commit":"def","author":"Alice"}
+{"type":"owner","repository":"github.com/sourcegraph/sourcegraph","preview":"alice@example.com","commit":"abc"}
+{"type":"capture","repository":"github.com/sourcegraph/sourcegraph","preview":"1.2","count":3}
+{"type":"error","error":"zoekt unavailable"}
+`, body)
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		status, body := get(t, "q=foo&format=csv")
+		require.Equal(t, http.StatusOK, status)
+		require.Equal(t, `type,repository,path,line,preview,commit,author,count
+diff,github.com/sourcegraph/sourcegraph,main.go,,,def,Alice,
+owner,github.com/sourcegraph/sourcegraph,,,alice@example.com,abc,,
+capture,github.com/sourcegraph/sourcegraph,,,1.2,,,3
+error,,,,zoekt unavailable,,,
+`, body)
+	})
+}
+
+func TestServeExportRepoMetadataError(t *testing.T) {
+	repo := types.MinimalRepo{ID: 1, Name: "github.com/sourcegraph/sourcegraph"}
+
+	mock := client.NewMockSearchClient()
+	mock.PlanFunc.SetDefaultHook(func(_ context.Context, _ string, _ *string, q string, _ search.Mode, _ search.Protocol) (*search.Inputs, error) {
+		plan, err := query.Pipeline(query.Init(q, query.SearchTypeLiteral))
+		if err != nil {
+			return nil, err
+		}
+		return &search.Inputs{Plan: plan, Query: plan.ToQ()}, nil
+	})
+	mock.ExecuteFunc.SetDefaultHook(func(_ context.Context, s streaming.Sender, _ *search.Inputs) (*search.Alert, error) {
+		s.Send(streaming.SearchEvent{
+			Results: result.Matches{
+				&result.FileMatch{File: result.File{Repo: repo, Path: "main.go", CommitID: "abc"}},
+			},
+		})
+		return nil, nil
+	})
+
+	// Results are never exported without the access check, so the export ends
+	// with an error if the repo metadata can't be fetched.
+	mockRepos := database.NewMockRepoStore()
+	mockRepos.MetadataFunc.SetDefaultReturn(nil, errors.New("database unavailable"))
+	db := database.NewMockDB()
+	db.ReposFunc.SetDefaultReturn(mockRepos)
+
+	ts := httptest.NewServer(&exportHandler{
+		logger:       logtest.Scoped(t),
+		db:           db,
+		searchClient: mock,
+	})
+	defer ts.Close()
+
+	res, err := http.Get(ts.URL + "?q=foo")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	b, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, `{"type":"error","error":"failed to get repo metadata: fetch metadata from db: database unavailable"}
+`, string(b))
+}
@@ -267,6 +267,8 @@ func fromMatch(match result.Match, repoCache map[api.RepoID]*types.SearchedRepo,
 		return fromCommit(v, repoCache)
 	case *result.OwnerMatch:
 		return fromOwner(v)
+	case *result.CaptureMatch:
+		return fromCapture(v)
 	default:
 		panic(fmt.Sprintf("unknown match type %T", v))
 	}
@@ -299,6 +301,8 @@ func fromPathMatch(fm *result.FileMatch, repoCache map[api.RepoID]*types.Searche
 	if fm.InputRev != nil {
 		pathEvent.Branches = []string{*fm.InputRev}
 	}
+	pathEvent.Versions = fromVersionRanges(fm.Versions)
+	pathEvent.Duplicates = fileMatchDuplicates(fm, repoCache)
 
 	if fm.Debug != nil {
 		pathEvent.Debug = *fm.Debug
@@ -307,6 +311,32 @@ func fromPathMatch(fm *result.FileMatch, repoCache map[api.RepoID]*types.Searche
 	return pathEvent
 }
 
+// fromVersionRanges converts the revision ranges of a file match grouped
+// across revisions (`versions:yes`).
+func fromVersionRanges(vrs []result.VersionRange) []streamhttp.VersionRange {
+	if len(vrs) == 0 {
+		return nil
+	}
+	res := make([]streamhttp.VersionRange, 0, len(vrs))
+	for _, vr := range vrs {
+		res = append(res, streamhttp.VersionRange{First: vr.First, Last: vr.Last})
+	}
+	return res
+}
+
+// fileMatchDuplicates returns the names of the other repositories containing
+// a file match, leaving out any we cannot map to a repo the actor has access
+// to.
+func fileMatchDuplicates(fm *result.FileMatch, repoCache map[api.RepoID]*types.SearchedRepo) []string {
+	var names []string
+	for _, repo := range fm.DuplicateRepos {
+		if md, ok := repoCache[repo.ID]; ok && md.Name == repo.Name {
+			names = append(names, string(repo.Name))
+		}
+	}
+	return names
+}
+
 func fromChunkMatches(cms result.ChunkMatches) []streamhttp.ChunkMatch {
 	res := make([]streamhttp.ChunkMatch, 0, len(cms))
 	for _, cm := range cms {
@@ -377,6 +407,8 @@ func fromContentMatch(fm *result.FileMatch, repoCache map[api.RepoID]*types.Sear
 	if fm.InputRev != nil {
 		contentEvent.Branches = []string{*fm.InputRev}
 	}
+	contentEvent.Versions = fromVersionRanges(fm.Versions)
+	contentEvent.Duplicates = fileMatchDuplicates(fm, repoCache)
 
 	if r, ok := repoCache[fm.Repo.ID]; ok {
 		contentEvent.RepoStars = r.Stars
@@ -425,6 +457,8 @@ func fromSymbolMatch(fm *result.FileMatch, repoCache map[api.RepoID]*types.Searc
 	if fm.InputRev != nil {
 		symbolMatch.Branches = []string{*fm.InputRev}
 	}
+	symbolMatch.Versions = fromVersionRanges(fm.Versions)
+	symbolMatch.Duplicates = fileMatchDuplicates(fm, repoCache)
 
 	return symbolMatch
 }
@@ -518,6 +552,16 @@ func fromOwner(owner *result.OwnerMatch) streamhttp.EventMatch {
 	}
 }
 
+func fromCapture(capture *result.CaptureMatch) *streamhttp.EventCaptureMatch {
+	return &streamhttp.EventCaptureMatch{
+		Type:         streamhttp.CaptureMatchType,
+		Value:        capture.Value,
+		Count:        capture.Count,
+		Repository:   string(capture.Repo.Name),
+		RepositoryID: int32(capture.Repo.ID),
+	}
+}
+
 // eventStreamTraceHook returns a StatHook which logs to log.
 func eventStreamTraceHook(addEvent func(string, ...attribute.KeyValue)) func(streamhttp.WriterStat) {
 	return func(stat streamhttp.WriterStat) {
@@ -569,6 +613,11 @@ func repoIDs(results []result.Match) []api.RepoID {
 	ids := make(map[api.RepoID]struct{}, 5)
 	for _, r := range results {
 		ids[r.RepoName().ID] = struct{}{}
+		if fm, ok := r.(*result.FileMatch); ok {
+			for _, repo := range fm.DuplicateRepos {
+				ids[repo.ID] = struct{}{}
+			}
+		}
 	}
 
 	res := make([]api.RepoID, 0, len(ids))
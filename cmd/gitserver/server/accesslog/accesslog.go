@@ -64,6 +64,40 @@ func fromContext(ctx context.Context) *paramsContext {
 	return pc
 }
 
+// accessCounts counts the accesses to each repo recorded with Record, whether
+// or not access logging is enabled.
+var accessCounts = struct {
+	mu     sync.Mutex
+	counts map[string]int64
+}{counts: map[string]int64{}}
+
+// countAccess counts the access recorded in ctx, if any.
+func countAccess(ctx context.Context) {
+	paramsCtx := fromContext(ctx)
+	if paramsCtx == nil {
+		return
+	}
+	repository, _ := paramsCtx.Get()
+	if repository == "" || repository == "<no-repo>" {
+		return
+	}
+
+	accessCounts.mu.Lock()
+	accessCounts.counts[repository]++
+	accessCounts.mu.Unlock()
+}
+
+// TakeAccessCounts returns the number of accesses to each repo recorded with
+// Record since the last call. It is used to rank repos by read traffic.
+func TakeAccessCounts() map[string]int64 {
+	accessCounts.mu.Lock()
+	defer accessCounts.mu.Unlock()
+
+	counts := accessCounts.counts
+	accessCounts.counts = make(map[string]int64, len(counts))
+	return counts
+}
+
 // accessLogger watches the site configuration and logs accesses (if enabled).
 type accessLogger struct {
 	logger log.Logger
@@ -164,7 +198,8 @@ func HTTPMiddleware(logger log.Logger, watcher conftypes.WatchableSiteConfig, ne
 		// Call the next handler in the chain.
 		next(w, r)
 
-		// Log the access
+		// Count and log the access
+		countAccess(ctx)
 		a.maybeLog(ctx)
 	}
 }
@@ -178,6 +213,7 @@ func UnaryServerInterceptor(logger log.Logger, watcher conftypes.WatchableSiteCo
 		ctx = withContext(ctx, &paramsContext{})
 		resp, err = handler(ctx, req)
 
+		countAccess(ctx)
 		a.maybeLog(ctx)
 		return resp, err
 	}
@@ -194,6 +230,7 @@ func StreamServerInterceptor(logger log.Logger, watcher conftypes.WatchableSiteC
 		ss = &wrappedServerStream{ServerStream: ss, ctx: ctx}
 		err := handler(srv, ss)
 
+		countAccess(ctx)
 		a.maybeLog(ctx)
 		return err
 	}
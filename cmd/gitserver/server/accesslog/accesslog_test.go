@@ -145,6 +145,26 @@ func TestHTTPMiddleware(t *testing.T) {
 	})
 }
 
+func TestTakeAccessCounts(t *testing.T) {
+	_ = TakeAccessCounts()
+
+	// Accesses are counted even if access logging is disabled.
+	h := HTTPMiddleware(logtest.Scoped(t), &accessLogConf{disabled: true}, func(w http.ResponseWriter, r *http.Request) {
+		if repo := r.URL.Query().Get("repo"); repo != "" {
+			Record(r.Context(), repo)
+		}
+	})
+	for _, target := range []string{"/?repo=github.com/foo/bar", "/?repo=github.com/foo/bar", "/?repo=github.com/foo/baz", "/?repo=<no-repo>", "/"} {
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", target, nil))
+	}
+
+	assert.Equal(t, map[string]int64{
+		"github.com/foo/bar": 2,
+		"github.com/foo/baz": 1,
+	}, TakeAccessCounts())
+	assert.Empty(t, TakeAccessCounts())
+}
+
 func TestAccessLogGRPC(t *testing.T) {
 	var (
 		fakeIP             = "192.168.1.1"
@@ -0,0 +1,225 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sourcegraph/log"
+	"golang.org/x/exp/slices"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/server/common"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/diskcache"
+	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// BlameCacheDirName is the name of the directory under ReposDir in which the
+// output of git blame is cached.
+const BlameCacheDirName = ".blame-cache"
+
+var blameCacheSizeMB = env.MustGetInt("SRC_GITSERVER_BLAME_CACHE_SIZE_MB", 1000, "the maximum size of the git blame cache in megabytes, or 0 to disable the cache")
+
+var blameCacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "src_gitserver_blame_cache_requests_total",
+	Help: "Number of git blame requests served by the blame cache, by result (hit, miss, error or skipped)",
+}, []string{"result"})
+
+// blameRequest is a git blame request which can be served from the blame
+// cache.
+type blameRequest struct {
+	// flags are the flags of the request, including any -L flag. Requests with
+	// different flags are cached separately.
+	flags  []string
+	commit api.CommitID
+	path   string
+}
+
+// cacheableBlameFlags are the flags clients pass to git blame. Requests with
+// any other flag are not cached.
+var cacheableBlameFlags = []string{"-w", "--porcelain", "--incremental"}
+
+// parseBlameArgs returns the blame request of args, which are the arguments
+// of a git command. ok is false if args are not a git blame of a single path
+// at an absolute commit, with flags the cache knows about.
+func parseBlameArgs(args []string) (req blameRequest, ok bool) {
+	// blame [flags...] <commit> -- <path>
+	if len(args) < 4 || args[0] != "blame" || args[len(args)-2] != "--" {
+		return blameRequest{}, false
+	}
+	for _, arg := range args[1 : len(args)-3] {
+		if !strings.HasPrefix(arg, "-L") && !slices.Contains(cacheableBlameFlags, arg) {
+			return blameRequest{}, false
+		}
+		req.flags = append(req.flags, arg)
+	}
+
+	req.commit = api.CommitID(args[len(args)-3])
+	req.path = args[len(args)-1]
+	if !isAbsoluteRevision(string(req.commit)) || req.path == "" {
+		return blameRequest{}, false
+	}
+	return req, true
+}
+
+// newBlameCache returns the blame cache of the gitserver storing repos in
+// reposDir, or nil if the cache is disabled.
+func newBlameCache(reposDir string) diskcache.Store {
+	if blameCacheSizeMB <= 0 {
+		return nil
+	}
+	return diskcache.NewStore(filepath.Join(reposDir, BlameCacheDirName), "gitserver-blame")
+}
+
+// serveCachedBlame writes the output of the git blame request to w, from the
+// blame cache if possible. served is false if the request was not served, in
+// which case the caller should run git blame itself. On a cache miss, the
+// output of git blame is written to w as it is produced, while it is cached,
+// so that clients of git blame --incremental receive it progressively. If git
+// blame fails after part of its output was written, served is true and err is
+// the error.
+//
+// The output of git blame at a commit only depends on the history of the path
+// up to the last commit which modified it. Entries are keyed by that commit
+// instead of the requested commit, so a cached blame is reused for all later
+// commits which left the file unchanged.
+func (s *Server) serveCachedBlame(ctx context.Context, logger log.Logger, repo api.RepoName, dir common.GitDir, req blameRequest, w io.Writer) (served bool, err error) {
+	if s.blameCache == nil || isPartialClone(dir) {
+		// Blames of partial clones need to fetch missing blobs from the code
+		// host, which is set up by exec.
+		blameCacheRequests.WithLabelValues("skipped").Inc()
+		return false, nil
+	}
+
+	lastModified, err := lastModifyingCommit(ctx, dir, req.commit, req.path)
+	if err != nil || lastModified == "" {
+		// The path doesn't exist, or git log failed. Let git blame report the
+		// error.
+		blameCacheRequests.WithLabelValues("skipped").Inc()
+		return false, nil
+	}
+
+	hit := true
+	stream := &blameStreamWriter{w: w}
+	key := []string{string(repo), string(lastModified), strings.Join(append(req.flags, req.path), "\x00")}
+	f, err := s.blameCache.OpenWithPath(ctx, key, func(ctx context.Context, path string) error {
+		hit = false
+		return runBlame(ctx, dir, req.flags, lastModified, req.path, path, stream)
+	})
+	// The fetch can outlive OpenWithPath if ctx is canceled, stop writing to w
+	// before returning.
+	written, streamErr := stream.stop()
+	if err != nil {
+		blameCacheRequests.WithLabelValues("error").Inc()
+		if written > 0 {
+			// Running git blame again would repeat the output the client
+			// already received.
+			return true, err
+		}
+		logger.Debug("failed to open blame cache entry", log.Error(err))
+		return false, nil
+	}
+	defer f.Close()
+
+	if !hit {
+		blameCacheRequests.WithLabelValues("miss").Inc()
+		if streamErr != nil {
+			logger.Warn("failed to write blame", log.Error(streamErr))
+		}
+		return true, nil
+	}
+
+	blameCacheRequests.WithLabelValues("hit").Inc()
+	if _, err := io.Copy(w, f); err != nil {
+		logger.Warn("failed to write cached blame", log.Error(err))
+	}
+	return true, nil
+}
+
+// blameStreamWriter writes the output of a git blame which is being cached to
+// the client at the same time. It never fails, so that the output is cached
+// even if the client goes away: writes to the client stop at its first error,
+// or once stop is called.
+type blameStreamWriter struct {
+	mu      sync.Mutex
+	w       io.Writer
+	n       int64
+	err     error
+	stopped bool
+}
+
+func (b *blameStreamWriter) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.stopped && b.err == nil {
+		n, err := b.w.Write(p)
+		b.n += int64(n)
+		b.err = err
+	}
+	return len(p), nil
+}
+
+// stop stops writing to the client, and returns the number of bytes written
+// to it and the error which stopped writes to it, if any.
+func (b *blameStreamWriter) stop() (int64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.stopped = true
+	return b.n, b.err
+}
+
+// lastModifyingCommit returns the last commit at or before commit which
+// modified path, or an empty string if path doesn't exist at commit.
+func lastModifyingCommit(ctx context.Context, dir common.GitDir, commit api.CommitID, path string) (api.CommitID, error) {
+	cmd := exec.CommandContext(ctx, "git", "log", "-1", "--format=%H", string(commit), "--", path)
+	dir.Set(cmd)
+	out, err := cmd.Output()
+	if err != nil {
+		return "", errors.Wrap(wrapCmdError(cmd, err), "failed to find last commit modifying path")
+	}
+	return api.CommitID(bytes.TrimSpace(out)), nil
+}
+
+// runBlame writes the output of git blame of path at commit to the file at
+// dst, and to w.
+func runBlame(ctx context.Context, dir common.GitDir, flags []string, commit api.CommitID, path, dst string, w io.Writer) error {
+	f, err := os.OpenFile(dst, os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	args := append([]string{"blame"}, flags...)
+	args = append(args, string(commit), "--", path)
+	cmd := exec.CommandContext(ctx, "git", args...)
+	dir.Set(cmd)
+	cmd.Stdout = io.MultiWriter(f, w)
+	if err := cmd.Run(); err != nil {
+		return errors.Wrap(wrapCmdError(cmd, err), "failed to run git blame")
+	}
+	return f.Close()
+}
+
+// evictBlameCache removes the least recently used entries from the blame cache
+// until it is smaller than SRC_GITSERVER_BLAME_CACHE_SIZE_MB.
+func (s *Server) evictBlameCache(logger log.Logger) {
+	if s.blameCache == nil {
+		return
+	}
+	stats, err := s.blameCache.Evict(int64(blameCacheSizeMB) * 1024 * 1024)
+	if err != nil {
+		logger.Error("failed to evict blame cache", log.Error(err))
+		return
+	}
+	if stats.Evicted > 0 {
+		logger.Debug("evicted blame cache entries", log.Int("evicted", stats.Evicted), log.Int64("cacheSize", stats.CacheSize))
+	}
+}
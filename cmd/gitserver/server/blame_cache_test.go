@@ -0,0 +1,199 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/server/common"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/diskcache"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestParseBlameArgs(t *testing.T) {
+	commit := strings.Repeat("a", 40)
+
+	tests := []struct {
+		name string
+		args []string
+		want blameRequest
+		ok   bool
+	}{
+		{
+			name: "stream blame",
+			args: []string{"blame", "-w", "--porcelain", "--incremental", commit, "--", "dir/file.go"},
+			want: blameRequest{flags: []string{"-w", "--porcelain", "--incremental"}, commit: api.CommitID(commit), path: "dir/file.go"},
+			ok:   true,
+		},
+		{
+			name: "line range",
+			args: []string{"blame", "-w", "--porcelain", "-L1,10", commit, "--", "file.go"},
+			want: blameRequest{flags: []string{"-w", "--porcelain", "-L1,10"}, commit: api.CommitID(commit), path: "file.go"},
+			ok:   true,
+		},
+		{
+			name: "not blame",
+			args: []string{"log", "-w", commit, "--", "file.go"},
+		},
+		{
+			name: "unknown flag",
+			args: []string{"blame", "-M", commit, "--", "file.go"},
+		},
+		{
+			name: "relative revision",
+			args: []string{"blame", "-w", "HEAD", "--", "file.go"},
+		},
+		{
+			name: "no path",
+			args: []string{"blame", "-w", commit, "--"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := parseBlameArgs(tc.args)
+			if ok != tc.ok {
+				t.Fatalf("got ok=%t, want %t", ok, tc.ok)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(blameRequest{})); diff != "" {
+				t.Fatalf("unexpected blame request (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServeCachedBlame(t *testing.T) {
+	root := t.TempDir()
+	repo := filepath.Join(root, "repo")
+	runCmd(t, root, "git", "init", repo)
+	dir := common.GitDir(filepath.Join(repo, ".git"))
+
+	commit := func(file, content string) api.CommitID {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(repo, file), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		runCmd(t, repo, "git", "add", file)
+		runCmd(t, repo, "git", "commit", "-m", "change "+file)
+		return api.CommitID(strings.TrimSpace(runCmd(t, repo, "git", "rev-parse", "HEAD")))
+	}
+	first := commit("a.txt", "one\ntwo\n")
+	second := commit("b.txt", "unrelated\n")
+	third := commit("a.txt", "one\ntwo\nthree\n")
+
+	cacheDir := filepath.Join(root, BlameCacheDirName)
+	s := &Server{
+		Logger:     logtest.Scoped(t),
+		ReposDir:   root,
+		blameCache: diskcache.NewStore(cacheDir, "test"),
+	}
+	logger := logtest.Scoped(t)
+
+	countEntries := func() int {
+		t.Helper()
+		entries, err := filepath.Glob(filepath.Join(cacheDir, "*", "*", "*.zip"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return len(entries)
+	}
+
+	// blame returns the output of the blame served by the cache, and of git
+	// blame itself.
+	blame := func(flags []string, commit api.CommitID, path string) (cached, uncached string) {
+		t.Helper()
+		req := blameRequest{flags: flags, commit: commit, path: path}
+		var buf bytes.Buffer
+		if served, err := s.serveCachedBlame(context.Background(), logger, "repo", dir, req, &buf); !served || err != nil {
+			t.Fatalf("expected blame of %s at %s to be served from the cache, got err=%v", path, commit, err)
+		}
+		args := append(append([]string{"blame"}, flags...), string(commit), "--", path)
+		return buf.String(), runCmd(t, repo, "git", args...)
+	}
+
+	flags := []string{"-w", "--porcelain", "--incremental"}
+	for _, tc := range []struct {
+		name    string
+		flags   []string
+		commit  api.CommitID
+		entries int
+	}{
+		{name: "miss", flags: flags, commit: second, entries: 1},
+		{name: "ancestor with unchanged file", flags: flags, commit: first, entries: 1},
+		{name: "hit", flags: flags, commit: second, entries: 1},
+		{name: "changed file", flags: flags, commit: third, entries: 2},
+		{name: "line range", flags: []string{"-w", "--porcelain", "-L2,3"}, commit: third, entries: 3},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cached, uncached := blame(tc.flags, tc.commit, "a.txt")
+			if diff := cmp.Diff(uncached, cached); diff != "" {
+				t.Fatalf("unexpected blame (-want +got):\n%s", diff)
+			}
+			if got := countEntries(); got != tc.entries {
+				t.Fatalf("got %d cache entries, want %d", got, tc.entries)
+			}
+		})
+	}
+
+	t.Run("missing path", func(t *testing.T) {
+		req := blameRequest{flags: flags, commit: third, path: "missing.txt"}
+		if served, _ := s.serveCachedBlame(context.Background(), logger, "repo", dir, req, &bytes.Buffer{}); served {
+			t.Fatal("expected blame of missing path not to be served from the cache")
+		}
+	})
+
+	t.Run("miss is streamed", func(t *testing.T) {
+		// The output is written before the cache entry is complete.
+		entries := countEntries()
+		var entriesAtFirstWrite []int
+		w := writerFunc(func(p []byte) (int, error) {
+			entriesAtFirstWrite = append(entriesAtFirstWrite, countEntries())
+			return len(p), nil
+		})
+		req := blameRequest{flags: []string{"--incremental"}, commit: third, path: "a.txt"}
+		if served, err := s.serveCachedBlame(context.Background(), logger, "repo", dir, req, w); !served || err != nil {
+			t.Fatalf("expected blame to be served from the cache, got err=%v", err)
+		}
+		if len(entriesAtFirstWrite) == 0 || entriesAtFirstWrite[0] != entries {
+			t.Fatalf("expected output to be written before the cache entry was created, got %v", entriesAtFirstWrite)
+		}
+		if got := countEntries(); got != entries+1 {
+			t.Fatalf("got %d cache entries, want %d", got, entries+1)
+		}
+	})
+
+	t.Run("client errors don't prevent caching", func(t *testing.T) {
+		entries := countEntries()
+		w := writerFunc(func(p []byte) (int, error) {
+			return 0, errors.New("client went away")
+		})
+		req := blameRequest{flags: []string{"-w", "--incremental"}, commit: third, path: "a.txt"}
+		if served, err := s.serveCachedBlame(context.Background(), logger, "repo", dir, req, w); !served || err != nil {
+			t.Fatalf("expected blame to be served from the cache, got err=%v", err)
+		}
+		if got := countEntries(); got != entries+1 {
+			t.Fatalf("got %d cache entries, want %d", got, entries+1)
+		}
+	})
+
+	t.Run("evict", func(t *testing.T) {
+		orig := blameCacheSizeMB
+		blameCacheSizeMB = 0
+		t.Cleanup(func() { blameCacheSizeMB = orig })
+
+		s.evictBlameCache(logger)
+		if got := countEntries(); got != 0 {
+			t.Fatalf("got %d cache entries after eviction, want 0", got)
+		}
+	})
+}
+
+type writerFunc func(p []byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) { return f(p) }
@@ -24,6 +24,7 @@ import (
 
 	"github.com/sourcegraph/log"
 
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/server/accesslog"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/server/common"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
@@ -167,12 +168,11 @@ const reposStatsName = "repos-stats.json"
 // 3. Remove stale lock files.
 // 4. Ensure correct git attributes
 // 5. Ensure gc.auto=0 or unset depending on gitGCMode
-// 6. Perform garbage collection
+// 6. Schedule garbage collection or sg-maintenance, see runMaintenance
 // 7. Re-clone repos after a while. (simulate git gc)
 // 8. Remove repos based on disk pressure.
-// 9. Perform sg-maintenance
-// 10. Git prune
-// 11. Set sizes of repos
+// 9. Set sizes of repos
+// 10. Evict the least recently used git blame cache entries
 func (s *Server) cleanupRepos(ctx context.Context, gitServerAddrs gitserver.GitserverAddresses) {
 	janitorRunning.Set(1)
 	janitorStart := time.Now()
@@ -197,6 +197,11 @@ func (s *Server) cleanupRepos(ctx context.Context, gitServerAddrs gitserver.Gits
 	bCtx, bCancel := s.serverContext()
 	defer bCancel()
 
+	// Update the traffic of the repos which decides the order in which they
+	// are maintained.
+	s.maintenance.decay()
+	s.maintenance.recordReads(accesslog.TakeAccessCounts())
+
 	stats := protocol.ReposStats{
 		UpdatedAt: time.Now(),
 	}
@@ -222,13 +227,19 @@ func (s *Server) cleanupRepos(ctx context.Context, gitServerAddrs gitserver.Gits
 		size := dirSize(dir.Path("."))
 		stats.GitDirBytes += size
 		name := s.name(dir)
-		repoToSize[name] = size
+		addrs := s.addrsForRepo(name, gitServerAddrs)
+		addr := addrs[0]
+
+		// Replicas belong on this instance, but their sizes are recorded by
+		// the primary.
+		replica := s.isReplicaOf(addrs)
+		if !replica {
+			repoToSize[name] = size - dirSize(dir.Path("lfs"))
+		}
 
 		// Record the number and disk usage used of repos that should
 		// not belong on this instance and remove up to SRC_WRONG_SHARD_DELETE_LIMIT in a single Janitor run.
-		addr := s.addrForRepo(name, gitServerAddrs)
-
-		if !s.hostnameMatch(addr) {
+		if !s.hostnameMatch(addr) && !replica {
 			wrongShardRepoCount++
 			wrongShardRepoSize += size
 
@@ -335,10 +346,13 @@ func (s *Server) cleanupRepos(ctx context.Context, gitServerAddrs gitserver.Gits
 			}
 		}
 
-		// We believe converting a Perforce depot to a Git repository is generally a
-		// very expensive operation, therefore we do not try to re-clone/redo the
-		// conversion only because it is old or slow to do "git gc".
-		if repoType == "perforce" && reason != maybeCorrupt {
+		// We believe converting a Perforce depot, a Subversion repository or a
+		// Mercurial repository to a Git repository is generally a very expensive
+		// operation, therefore we do not try to re-clone/redo the conversion only
+		// because it is old or slow to do "git gc". Re-cloning a Mercurial
+		// repository would also discard the mirror and marks its incremental
+		// conversion relies on.
+		if (repoType == "perforce" || repoType == "subversion" || repoType == "mercurial") && reason != maybeCorrupt {
 			reason = ""
 		}
 
@@ -425,16 +439,16 @@ func (s *Server) cleanupRepos(ctx context.Context, gitServerAddrs gitserver.Gits
 		return false, multi
 	}
 
-	performGC := func(dir common.GitDir) (done bool, err error) {
-		return false, gitGC(dir)
-	}
-
-	performSGMaintenance := func(dir common.GitDir) (done bool, err error) {
-		return false, sgMaintenance(logger, dir)
+	var lfsObjects []lfsObjectFile
+	collectLFSObjects := func(dir common.GitDir) (done bool, err error) {
+		objects, err := listLFSObjects(dir)
+		lfsObjects = append(lfsObjects, objects...)
+		return false, err
 	}
 
-	performGitPrune := func(dir common.GitDir) (done bool, err error) {
-		return false, pruneIfNeeded(dir, looseObjectsLimit)
+	scheduleMaintenance := func(dir common.GitDir) (done bool, err error) {
+		s.maintenance.schedule(s.name(dir))
+		return false, nil
 	}
 
 	type cleanupFn struct {
@@ -458,23 +472,17 @@ func (s *Server) cleanupRepos(ctx context.Context, gitServerAddrs gitserver.Gits
 		// happen if several git-gc operations are running at the same time.
 		// We only disable if sg is managing gc.
 		{"auto gc config", ensureAutoGC},
+		// Collect the LFS objects of the repo, which are evicted once the
+		// walk is done if they are too large in total.
+		{"collect LFS objects", collectLFSObjects},
 	}
 
-	if gitGCMode == gitGCModeJanitorAutoGC {
-		// Runs a number of housekeeping tasks within the current repository, such as
-		// compressing file revisions (to reduce disk space and increase performance),
-		// removing unreachable objects which may have been created from prior
-		// invocations of git add, packing refs, pruning reflog, rerere metadata or stale
-		// working trees. May also update ancillary indexes such as the commit-graph.
-		cleanups = append(cleanups, cleanupFn{"garbage collect", performGC})
-	}
-
-	if gitGCMode == gitGCModeMaintenance {
-		// Run tasks to optimize Git repository data, speeding up other Git commands and
-		// reducing storage requirements for the repository. Note: "garbage collect" and
-		// "sg maintenance" must not be enabled at the same time.
-		cleanups = append(cleanups, cleanupFn{"sg maintenance", performSGMaintenance})
-		cleanups = append(cleanups, cleanupFn{"git prune", performGitPrune})
+	if gitGCMode != gitGCModeGitAutoGC {
+		// Garbage collection or sg maintenance, followed by incremental
+		// commit-graph and bitmap updates, run in the background ordered by
+		// the read traffic and fetch frequency of the repos. See
+		// runMaintenance.
+		cleanups = append(cleanups, cleanupFn{"schedule maintenance", scheduleMaintenance})
 	}
 
 	if !conf.Get().DisableAutoGitUpdates {
@@ -505,6 +513,13 @@ func (s *Server) cleanupRepos(ctx context.Context, gitServerAddrs gitserver.Gits
 		// We are sure this is a GIT_DIR after the above check
 		gitDir := common.GitDir(dir)
 
+		// Skip repositories which are being recloned or maintained, since
+		// some cleanups remove or reclone them.
+		if status, locked := s.locker.Status(gitDir); locked {
+			logger.Debug("skipping cleanup of locked repo", log.String("repo", string(gitDir)), log.String("status", status))
+			return filepath.SkipDir
+		}
+
 		for _, cfn := range cleanups {
 			start := time.Now()
 			done, err := cfn.Do(gitDir)
@@ -536,6 +551,11 @@ func (s *Server) cleanupRepos(ctx context.Context, gitServerAddrs gitserver.Gits
 		logger.Error("setting repo sizes", log.Error(err))
 	}
 
+	// Evict LFS objects before freeing up space, which removes whole repos.
+	if evicted := evictLFSObjects(logger, lfsObjects, int64(lfsObjectsSizeMB)*1024*1024); evicted > 0 {
+		logger.Debug("evicted LFS objects", log.Int("evicted", evicted))
+	}
+
 	if s.DiskSizer == nil {
 		s.DiskSizer = &StatDiskSizer{}
 	}
@@ -546,6 +566,8 @@ func (s *Server) cleanupRepos(ctx context.Context, gitServerAddrs gitserver.Gits
 	if err := s.freeUpSpace(logger, b); err != nil {
 		logger.Error("error freeing up space", log.Error(err))
 	}
+
+	s.evictBlameCache(logger)
 }
 
 func checkRepoDirCorrupt(dir common.GitDir) (bool, string, error) {
@@ -644,6 +666,7 @@ func (s *StatDiskSizer) DiskSizeBytes(mountPoint string) (uint64, error) {
 
 // freeUpSpace removes git directories under ReposDir, in order from least
 // recently to most recently used, until it has freed howManyBytesToFree.
+// Locked directories are skipped.
 func (s *Server) freeUpSpace(logger log.Logger, howManyBytesToFree int64) error {
 	if howManyBytesToFree <= 0 {
 		return nil
@@ -680,6 +703,9 @@ func (s *Server) freeUpSpace(logger log.Logger, howManyBytesToFree int64) error
 		if spaceFreed >= howManyBytesToFree {
 			return nil
 		}
+		if _, locked := s.locker.Status(d); locked {
+			continue
+		}
 		delta := dirSize(d.Path("."))
 		if err := s.removeRepoDirectory(d, logger, true); err != nil {
 			return errors.Wrap(err, "removing repo directory")
@@ -1298,14 +1324,20 @@ func hasBitmap(dir common.GitDir) (bool, error) {
 	return len(bitmaps) > 0, nil
 }
 
+// hasCommitGraph reports whether dir has a commit-graph file or a chain of
+// split commit-graph files.
 func hasCommitGraph(dir common.GitDir) (bool, error) {
-	if _, err := os.Stat(dir.Path("objects", "info", "commit-graph")); err == nil {
-		return true, nil
-	} else if errors.Is(err, fs.ErrNotExist) {
-		return false, nil
-	} else {
-		return false, err
+	for _, p := range []string{
+		dir.Path("objects", "info", "commit-graph"),
+		dir.Path("objects", "info", "commit-graphs", "commit-graph-chain"),
+	} {
+		if _, err := os.Stat(p); err == nil {
+			return true, nil
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return false, err
+		}
 	}
+	return false, nil
 }
 
 // tooManyPackfiles counts the packfiles in objects/pack. Packfiles with an
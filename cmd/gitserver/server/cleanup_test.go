@@ -324,6 +324,7 @@ func TestGitGCAuto(t *testing.T) {
 	}
 	s.testSetup(t)
 	s.cleanupRepos(context.Background(), gitserver.GitserverAddresses{Addresses: []string{"gitserver-0"}})
+	drainMaintenance(s)
 
 	// Verify that there are no more GC-able objects in the repository.
 	if !strings.Contains(countObjects(), "count: 0") {
@@ -343,12 +344,14 @@ func TestCleanupExpired(t *testing.T) {
 	repoNonBare := path.Join(root, "repo-non-bare", ".git")
 	repoPerforce := path.Join(root, "repo-perforce", ".git")
 	repoPerforceGCOld := path.Join(root, "repo-perforce-gc-old", ".git")
+	repoMercurial := path.Join(root, "repo-mercurial", ".git")
 	remote := path.Join(root, "remote", ".git")
 	for _, gitDirPath := range []string{
 		repoNew, repoOld,
 		repoGCNew, repoGCOld,
 		repoBoom, repoCorrupt,
 		repoPerforce, repoPerforceGCOld,
+		repoMercurial,
 		remote,
 	} {
 		cmd := exec.Command("git", "--bare", "init", gitDirPath)
@@ -395,6 +398,7 @@ func TestCleanupExpired(t *testing.T) {
 		repoCorrupt:       repoTTLGC / 2, // should only trigger corrupt, not old
 		repoPerforce:      2 * repoTTL,
 		repoPerforceGCOld: 2 * repoTTLGC,
+		repoMercurial:     2 * repoTTL,
 	} {
 		ts := time.Now().Add(-delta)
 		if err := setRecloneTime(common.GitDir(gitDirPath), ts); err != nil {
@@ -413,6 +417,9 @@ func TestCleanupExpired(t *testing.T) {
 	if err := setRepositoryType(common.GitDir(repoPerforceGCOld), "perforce"); err != nil {
 		t.Fatal(err)
 	}
+	if err := setRepositoryType(common.GitDir(repoMercurial), "mercurial"); err != nil {
+		t.Fatal(err)
+	}
 
 	now := time.Now()
 	repoNewTime := modTime(repoNew)
@@ -422,6 +429,7 @@ func TestCleanupExpired(t *testing.T) {
 	repoCorruptTime := modTime(repoBoom)
 	repoPerforceTime := modTime(repoPerforce)
 	repoPerforceGCOldTime := modTime(repoPerforceGCOld)
+	repoMercurialTime := modTime(repoMercurial)
 	repoBoomTime := modTime(repoBoom)
 	repoBoomRecloneTime := recloneTime(repoBoom)
 
@@ -456,6 +464,9 @@ func TestCleanupExpired(t *testing.T) {
 	if repoPerforceGCOldTime.Before(modTime(repoPerforceGCOld)) {
 		t.Error("expected repoPerforceGCOld to not be modified")
 	}
+	if repoMercurialTime.Before(modTime(repoMercurial)) {
+		t.Error("expected repoMercurial to not be modified")
+	}
 
 	// repos that should be recloned
 	if !repoOldTime.Before(modTime(repoOld)) {
@@ -1195,6 +1206,46 @@ func TestFreeUpSpace(t *testing.T) {
 		}
 		require.Equal(t, gr.SetCloneStatusFunc.History()[0].Arg2, types.CloneStatusNotCloned)
 	})
+	t.Run("locked repos are not removed", func(t *testing.T) {
+		rd := t.TempDir()
+		r1 := filepath.Join(rd, "repo1")
+		r2 := filepath.Join(rd, "repo2")
+		if err := makeFakeRepo(r1, 1000); err != nil {
+			t.Fatal(err)
+		}
+		if err := makeFakeRepo(r2, 1000); err != nil {
+			t.Fatal(err)
+		}
+		fi1, err := os.Stat(r1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(r2, time.Now(), fi1.ModTime().Add(time.Second)); err != nil {
+			t.Fatal(err)
+		}
+
+		db := database.NewMockDB()
+		db.GitserverReposFunc.SetDefaultReturn(database.NewMockGitserverRepoStore())
+		s := Server{
+			Logger:         logger,
+			ObservationCtx: observation.TestContextTB(t),
+			ReposDir:       rd,
+			DiskSizer:      &fakeDiskSizer{},
+			DB:             db,
+			locker:         &RepositoryLocker{},
+		}
+
+		// The oldest repo is being maintained, so the next one is removed.
+		lock, _ := s.locker.TryAcquire(common.GitDir(filepath.Join(r1, ".git")), "running maintenance")
+		defer lock.Release()
+		if err := s.freeUpSpace(logger, 1000); err != nil {
+			t.Fatal(err)
+		}
+		assertPaths(t, rd,
+			".tmp",
+			"repo1/.git/HEAD",
+			"repo1/.git/space_eater")
+	})
 }
 
 func makeFakeRepo(d string, sizeBytes int) error {
@@ -1474,6 +1525,24 @@ git commit-graph write --reachable --changed-paths
 			t.Fatalf("expected commit-graph file after running git commit-graph write --reachable --changed-paths")
 		}
 	})
+
+	t.Run("split commit-graph", func(t *testing.T) {
+		if err := os.Remove(gitDir.Path("objects", "info", "commit-graph")); err != nil {
+			t.Fatal(err)
+		}
+		cmd := exec.Command("git", "commit-graph", "write", "--reachable", "--split")
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("out=%s, err=%s", out, err)
+		}
+		hasCg, err := hasCommitGraph(gitDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !hasCg {
+			t.Fatalf("expected commit-graph chain after running git commit-graph write --reachable --split")
+		}
+	})
 }
 
 func TestNeedsMaintenance(t *testing.T) {
@@ -0,0 +1,167 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/server/common"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// diskQuotaSyncer is implemented by VCSSyncers which limit the size of the
+// repositories they sync.
+type diskQuotaSyncer interface {
+	// DiskQuota returns the maximum size of a repository in bytes, or zero if
+	// there is no quota.
+	DiskQuota() int64
+}
+
+// diskQuota returns the disk quota of the repositories synced by syncer, or
+// zero if there is none.
+func diskQuota(syncer VCSSyncer) int64 {
+	if s, ok := syncer.(diskQuotaSyncer); ok {
+		return s.DiskQuota()
+	}
+	return 0
+}
+
+// diskQuotaExceededError is returned when a clone or fetch is refused because
+// the repository is larger than its disk quota.
+type diskQuotaExceededError struct {
+	repo  api.RepoName
+	size  int64
+	quota int64
+}
+
+func (e *diskQuotaExceededError) Error() string {
+	return fmt.Sprintf("repository %s exceeds its disk quota: %s > %s", e.repo, humanize.IBytes(uint64(e.size)), humanize.IBytes(uint64(e.quota)))
+}
+
+// checkDiskQuota returns a *diskQuotaExceededError if the last known size of
+// repo exceeds the disk quota of syncer. This defers clones and fetches of the
+// repository until the quota is raised or the janitor finds the repository
+// has shrunk. typ is "clone" or "fetch".
+func (s *Server) checkDiskQuota(ctx context.Context, repo api.RepoName, syncer VCSSyncer, typ string) error {
+	quota := diskQuota(syncer)
+	if quota <= 0 {
+		return nil
+	}
+
+	gr, err := s.DB.GitserverRepos().GetByName(ctx, repo)
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrap(err, "get last known repo size")
+	}
+	if gr == nil || gr.RepoSizeBytes <= quota {
+		return nil
+	}
+
+	diskQuotaExceededCounter.WithLabelValues(typ).Inc()
+	return &diskQuotaExceededError{repo: repo, size: gr.RepoSizeBytes, quota: quota}
+}
+
+// diskQuotaCheckInterval is how often the size of a running clone is compared
+// to its disk quota.
+var diskQuotaCheckInterval = 10 * time.Second
+
+// watchDiskQuota calls exceeded with the size of dir once it grows larger
+// than quota. It returns after calling exceeded or when ctx is done.
+func watchDiskQuota(ctx context.Context, dir string, quota int64, exceeded func(size int64)) {
+	ticker := time.NewTicker(diskQuotaCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if size := dirSize(dir); size > quota {
+				exceeded(size)
+				return
+			}
+		}
+	}
+}
+
+// repoSize returns the size of the repository in dir, as recorded in the
+// database and compared to its disk quota. It doesn't include the LFS objects
+// of the repository, which are limited by SRC_GITSERVER_LFS_OBJECTS_SIZE_MB
+// instead, so that reading LFS files doesn't stop the repository from being
+// fetched.
+func repoSize(dir common.GitDir) int64 {
+	return dirSize(dir.Path(".")) - dirSize(dir.Path("lfs"))
+}
+
+// gitDirSizeBreakdown adds up the sizes of the files in the .git directory dir
+// by the kind of data they store.
+func gitDirSizeBreakdown(dir common.GitDir) protocol.RepoSizeBreakdown {
+	b := protocol.RepoSizeBreakdown{Cloned: true}
+	root := dir.Path()
+
+	// We don't return an error, so we know that err is always nil and can be
+	// ignored.
+	_ = bestEffortWalk(root, func(path string, d fs.DirEntry) error {
+		if d.IsDir() {
+			return nil
+		}
+		fi, err := d.Info()
+		if err != nil {
+			// We ignore errors for individual files.
+			return nil
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return nil
+		}
+
+		size := fi.Size()
+		b.TotalBytes += size
+		switch rel = filepath.ToSlash(rel); {
+		case strings.HasPrefix(rel, "objects/pack/"):
+			b.PackfileBytes += size
+			if strings.HasSuffix(rel, ".pack") {
+				b.PackfileCount++
+			}
+		case rel == "objects/info/commit-graph" || strings.HasPrefix(rel, "objects/info/commit-graphs/"):
+			b.CommitGraphBytes += size
+		case isLooseObjectPath(rel):
+			b.LooseObjectBytes += size
+			b.LooseObjectCount++
+		default:
+			b.OtherBytes += size
+		}
+		return nil
+	})
+
+	return b
+}
+
+// isLooseObjectPath reports whether rel, relative to the .git directory, is
+// the path of a loose object, e.g. "objects/ab/cdef...".
+func isLooseObjectPath(rel string) bool {
+	parts := strings.Split(rel, "/")
+	if len(parts) != 3 || parts[0] != "objects" || len(parts[1]) != 2 {
+		return false
+	}
+	return isHex(parts[1]) && isHex(parts[2])
+}
+
+func isHex(s string) bool {
+	for _, c := range s {
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f') {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,137 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/server/common"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestCloneRepo_DiskQuota(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	// Create a remote with an incompressible file of 64 KiB.
+	remote := t.TempDir()
+	cmd := func(name string, arg ...string) string {
+		t.Helper()
+		return runCmd(t, remote, name, arg...)
+	}
+	cmd("git", "init", ".")
+	large := make([]byte, 64*1024)
+	_, err := rand.Read(large)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(remote, "large.bin"), large, 0o644))
+	cmd("git", "add", ".")
+	cmd("git", "commit", "-m", "initial commit")
+
+	var knownSize int64
+	gitserverRepos := database.NewMockGitserverRepoStore()
+	gitserverRepos.GetByNameFunc.SetDefaultHook(func(context.Context, api.RepoName) (*types.GitserverRepo, error) {
+		return &types.GitserverRepo{RepoSizeBytes: knownSize}, nil
+	})
+	gitserverRepos.UpdateRepoSizesFunc.SetDefaultHook(func(_ context.Context, _ string, sizes map[api.RepoName]int64) (int, error) {
+		for _, size := range sizes {
+			knownSize = size
+		}
+		return len(sizes), nil
+	})
+	db := database.NewMockDB()
+	db.GitserverReposFunc.SetDefaultReturn(gitserverRepos)
+	db.FeatureFlagsFunc.SetDefaultReturn(database.NewMockFeatureFlagStore())
+
+	reposDir := t.TempDir()
+	repoName := api.RepoName("example.com/foo/bar")
+	s := makeTestServer(ctx, t, reposDir, remote, db)
+
+	var quota int64 = 16 * 1024
+	s.GetVCSSyncer = func(context.Context, api.RepoName) (VCSSyncer, error) {
+		return NewGitRepoSyncerWithCloneOptions(s.RecordingCommandFactory, GitCloneOptions{DiskQuotaBytes: quota}), nil
+	}
+
+	// The clone is refused and its size is recorded.
+	_, err = s.cloneRepo(ctx, repoName, &cloneOptions{Block: true})
+	var quotaErr *diskQuotaExceededError
+	require.True(t, errors.As(err, &quotaErr), "unexpected error: %v", err)
+	assert.False(t, repoCloned(s.dir(repoName)))
+	assert.Greater(t, knownSize, quota)
+
+	// Further clones are deferred without contacting the code host.
+	s.GetRemoteURLFunc = staticGetRemoteURL(filepath.Join(remote, "does-not-exist"))
+	_, err = s.cloneRepo(ctx, repoName, &cloneOptions{Block: true})
+	require.True(t, errors.As(err, &quotaErr), "unexpected error: %v", err)
+	s.GetRemoteURLFunc = staticGetRemoteURL(remote)
+
+	// Raising the quota allows the clone.
+	quota = 1024 * 1024
+	_, err = s.cloneRepo(ctx, repoName, &cloneOptions{Block: true})
+	require.NoError(t, err)
+	assert.True(t, repoCloned(s.dir(repoName)))
+}
+
+func TestRepoSize(t *testing.T) {
+	dir := common.GitDir(t.TempDir())
+	require.NoError(t, os.WriteFile(dir.Path("HEAD"), make([]byte, 10), 0o644))
+	require.NoError(t, os.MkdirAll(dir.Path("objects", "pack"), 0o755))
+	require.NoError(t, os.WriteFile(dir.Path("objects", "pack", "pack-1.pack"), make([]byte, 100), 0o644))
+	require.NoError(t, os.MkdirAll(dir.Path("lfs", "objects", "ab", "cd"), 0o755))
+	require.NoError(t, os.WriteFile(dir.Path("lfs", "objects", "ab", "cd", "abcd"), make([]byte, 1000), 0o644))
+
+	// LFS objects don't count towards the size compared to the disk quota.
+	assert.Equal(t, int64(110), repoSize(dir))
+}
+
+func TestWatchDiskQuota(t *testing.T) {
+	orig := diskQuotaCheckInterval
+	diskQuotaCheckInterval = time.Millisecond
+	t.Cleanup(func() { diskQuotaCheckInterval = orig })
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "small"), make([]byte, 10), 0o644))
+
+	exceeded := make(chan int64, 1)
+	go watchDiskQuota(context.Background(), dir, 100, func(size int64) { exceeded <- size })
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "large"), make([]byte, 200), 0o644))
+	select {
+	case size := <-exceeded:
+		assert.Equal(t, int64(210), size)
+	case <-time.After(10 * time.Second):
+		t.Fatal("quota was not exceeded")
+	}
+}
+
+func TestGitDirSizeBreakdown(t *testing.T) {
+	dir := t.TempDir()
+	cmd := func(name string, arg ...string) string {
+		t.Helper()
+		return runCmd(t, dir, name, arg...)
+	}
+	makeSingleCommitRepo(cmd)
+	cmd("git", "gc", "--quiet")
+	cmd("git", "commit-graph", "write", "--reachable")
+	cmd("sh", "-c", "echo loose | git hash-object -w --stdin")
+
+	gitDir := common.GitDir(filepath.Join(dir, ".git"))
+	b := gitDirSizeBreakdown(gitDir)
+	assert.True(t, b.Cloned)
+	assert.Equal(t, int64(1), b.PackfileCount)
+	assert.Equal(t, int64(1), b.LooseObjectCount)
+	assert.Greater(t, b.PackfileBytes, int64(0))
+	assert.Greater(t, b.LooseObjectBytes, int64(0))
+	assert.Greater(t, b.CommitGraphBytes, int64(0))
+	assert.Greater(t, b.OtherBytes, int64(0))
+	assert.Equal(t, dirSize(string(gitDir)), b.TotalBytes)
+	assert.Equal(t, b.TotalBytes, b.PackfileBytes+b.LooseObjectBytes+b.CommitGraphBytes+b.OtherBytes)
+}
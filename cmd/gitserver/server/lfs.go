@@ -0,0 +1,729 @@
+package server
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/server/common"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// lfsPointerMaxSize is the maximum size of a Git LFS pointer file. Larger
+// blobs are never pointers, see
+// https://github.com/git-lfs/git-lfs/blob/main/docs/spec.md.
+const lfsPointerMaxSize = 1024
+
+// defaultLFSMaxObjectSize is the size limit of the LFS objects which are
+// fetched if the clone options don't set one.
+const defaultLFSMaxObjectSize = 100 * 1024 * 1024
+
+var lfsObjectsSizeMB = env.MustGetInt("SRC_GITSERVER_LFS_OBJECTS_SIZE_MB", 10000, "the maximum total size of the Git LFS objects stored by gitserver in megabytes")
+
+// lfsTmpMaxAge is how old the temporary file of an LFS download must be to be
+// removed by the janitor. Downloads remove their temporary file themselves,
+// unless gitserver is stopped.
+const lfsTmpMaxAge = time.Hour
+
+// lfsHTTPClient is the client used to talk to the LFS servers of code hosts.
+var lfsHTTPClient httpcli.Doer = httpcli.ExternalDoer
+
+var lfsObjectFetches = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "src_gitserver_lfs_object_fetches_total",
+	Help: "Number of Git LFS objects read by clients which were not stored yet, by result (fetched, too_large or error)",
+}, []string{"result"})
+
+var lfsObjectsEvicted = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "src_gitserver_lfs_objects_evicted_total",
+	Help: "Number of Git LFS objects removed by the janitor to keep their total size below SRC_GITSERVER_LFS_OBJECTS_SIZE_MB",
+})
+
+// lfsSyncer is implemented by VCSSyncers which fetch the Git LFS objects of
+// the repositories they sync.
+type lfsSyncer interface {
+	// LFSMaxObjectSize returns the size limit of the LFS objects which are
+	// fetched, or zero if LFS objects are not fetched.
+	LFSMaxObjectSize() int64
+}
+
+// lfsMaxObjectSize returns the size limit of the LFS objects of the
+// repositories synced by syncer, or zero if their LFS objects are not fetched.
+func lfsMaxObjectSize(syncer VCSSyncer) int64 {
+	if s, ok := syncer.(lfsSyncer); ok {
+		return s.LFSMaxObjectSize()
+	}
+	return 0
+}
+
+// lfsMaxObjectSizeTTL is how long the LFS settings of a repository are reused
+// by the commands reading its files before its VCS syncer is requested again.
+const lfsMaxObjectSizeTTL = time.Minute
+
+// lfsMaxObjectSizeCache caches the size limit of the LFS objects of
+// repositories, since getting the VCS syncer of a repository requires a
+// database round trip and every file read asking for LFS content needs it.
+// The zero value is ready to use.
+type lfsMaxObjectSizeCache struct {
+	mu      sync.Mutex
+	entries map[api.RepoName]lfsMaxObjectSizeCacheEntry
+}
+
+type lfsMaxObjectSizeCacheEntry struct {
+	maxObjectSize int64
+	expiresAt     time.Time
+}
+
+// get returns the cached LFS object size limit of repo, or gets the VCS
+// syncer of repo with getVCSSyncer and caches its limit. Errors are not
+// cached.
+func (c *lfsMaxObjectSizeCache) get(ctx context.Context, repo api.RepoName, getVCSSyncer func(context.Context, api.RepoName) (VCSSyncer, error)) (int64, error) {
+	now := time.Now()
+	c.mu.Lock()
+	e, ok := c.entries[repo]
+	c.mu.Unlock()
+	if ok && now.Before(e.expiresAt) {
+		return e.maxObjectSize, nil
+	}
+
+	syncer, err := getVCSSyncer(ctx, repo)
+	if err != nil {
+		return 0, err
+	}
+	maxObjectSize := lfsMaxObjectSize(syncer)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil {
+		c.entries = map[api.RepoName]lfsMaxObjectSizeCacheEntry{}
+	}
+	for name, e := range c.entries {
+		if !now.Before(e.expiresAt) {
+			delete(c.entries, name)
+		}
+	}
+	c.entries[repo] = lfsMaxObjectSizeCacheEntry{maxObjectSize: maxObjectSize, expiresAt: now.Add(lfsMaxObjectSizeTTL)}
+	return maxObjectSize, nil
+}
+
+// lfsPointer is the content of a Git LFS pointer file, which is stored in the
+// repository in place of the content of a file tracked by LFS.
+type lfsPointer struct {
+	// OID is the hex encoded SHA-256 of the content of the file.
+	OID  string `json:"oid"`
+	Size int64  `json:"size"`
+}
+
+// parseLFSPointer parses the content of a blob as an LFS pointer file. ok is
+// false if b is not a pointer file, or uses extensions.
+func parseLFSPointer(b []byte) (p lfsPointer, ok bool) {
+	if len(b) > lfsPointerMaxSize || !bytes.HasPrefix(b, []byte("version https://git-lfs.github.com/spec/v1\n")) {
+		return lfsPointer{}, false
+	}
+
+	var hasSize bool
+	for _, line := range strings.Split(strings.TrimSuffix(string(b), "\n"), "\n")[1:] {
+		key, value, found := strings.Cut(line, " ")
+		if !found {
+			return lfsPointer{}, false
+		}
+		switch key {
+		case "oid":
+			oid, found := strings.CutPrefix(value, "sha256:")
+			if !found || len(oid) != sha256.Size*2 || strings.ToLower(oid) != oid {
+				return lfsPointer{}, false
+			}
+			if _, err := hex.DecodeString(oid); err != nil {
+				return lfsPointer{}, false
+			}
+			p.OID = oid
+		case "size":
+			size, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || size < 0 {
+				return lfsPointer{}, false
+			}
+			p.Size, hasSize = size, true
+		default:
+			// Pointers with extensions need the extensions to be smudged.
+			return lfsPointer{}, false
+		}
+	}
+	if p.OID == "" || !hasSize {
+		return lfsPointer{}, false
+	}
+	return p, true
+}
+
+// lfsStore stores the LFS objects of a repository, and fetches missing objects
+// from the LFS server of the code host when they are read.
+//
+// Objects are stored in the lfs/objects directory of the repository, with the
+// same layout as git-lfs. The modification time of an object is the last time
+// it was read, which the janitor uses to evict the least recently used objects
+// once they grow larger than SRC_GITSERVER_LFS_OBJECTS_SIZE_MB.
+type lfsStore struct {
+	dir common.GitDir
+	// maxObjectSize is the size limit of the objects which are fetched.
+	maxObjectSize int64
+	// remoteURL returns the URL of the remote whose LFS server objects are
+	// fetched from.
+	remoteURL func(context.Context) (*vcs.URL, error)
+}
+
+func (l *lfsStore) objectPath(oid string) string {
+	return l.dir.Path("lfs", "objects", oid[0:2], oid[2:4], oid)
+}
+
+// open returns the content of the LFS object of p. ok is false if the object
+// is not stored, and can't be fetched.
+func (l *lfsStore) open(ctx context.Context, logger log.Logger, p lfsPointer) (_ *os.File, ok bool) {
+	if f, err := os.Open(l.objectPath(p.OID)); err == nil {
+		now := time.Now()
+		if err := os.Chtimes(f.Name(), now, now); err != nil {
+			logger.Warn("failed to record read of LFS object", log.String("oid", p.OID), log.Error(err))
+		}
+		return f, true
+	}
+
+	if p.Size > l.maxObjectSize || p.Size > int64(lfsObjectsSizeMB)*1024*1024 {
+		lfsObjectFetches.WithLabelValues("too_large").Inc()
+		return nil, false
+	}
+	if err := l.fetch(ctx, p); err != nil {
+		lfsObjectFetches.WithLabelValues("error").Inc()
+		logger.Warn("failed to fetch LFS object", log.String("oid", p.OID), log.Error(err))
+		return nil, false
+	}
+	lfsObjectFetches.WithLabelValues("fetched").Inc()
+
+	f, err := os.Open(l.objectPath(p.OID))
+	return f, err == nil
+}
+
+// lfsBatchRequest is a request to the LFS batch API, see
+// https://github.com/git-lfs/git-lfs/blob/main/docs/api/batch.md.
+type lfsBatchRequest struct {
+	Operation string       `json:"operation"`
+	Transfers []string     `json:"transfers"`
+	Objects   []lfsPointer `json:"objects"`
+	HashAlgo  string       `json:"hash_algo"`
+}
+
+type lfsBatchResponse struct {
+	Objects []struct {
+		lfsPointer
+		Actions struct {
+			Download *struct {
+				Href   string            `json:"href"`
+				Header map[string]string `json:"header"`
+			} `json:"download"`
+		} `json:"actions"`
+		Error *struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	} `json:"objects"`
+}
+
+// fetch downloads the LFS object of p from the LFS server of the remote of the
+// repository.
+func (l *lfsStore) fetch(ctx context.Context, p lfsPointer) error {
+	remoteURL, err := l.remoteURL(ctx)
+	if err != nil {
+		return err
+	}
+	endpoint, username, password, err := lfsEndpoint(remoteURL)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(lfsBatchRequest{
+		Operation: "download",
+		Transfers: []string{"basic"},
+		Objects:   []lfsPointer{p},
+		HashAlgo:  "sha256",
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.JoinPath("objects", "batch").String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.git-lfs+json")
+	req.Header.Set("Content-Type", "application/vnd.git-lfs+json")
+	if username != "" || password != "" {
+		req.SetBasicAuth(username, password)
+	}
+
+	resp, err := lfsHTTPClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "LFS batch request failed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Newf("LFS batch request failed with status %d", resp.StatusCode)
+	}
+
+	var batch lfsBatchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&batch); err != nil {
+		return errors.Wrap(err, "failed to decode LFS batch response")
+	}
+	for _, obj := range batch.Objects {
+		if obj.OID != p.OID {
+			continue
+		}
+		if obj.Error != nil {
+			return errors.Newf("LFS server returned error %d: %s", obj.Error.Code, obj.Error.Message)
+		}
+		if obj.Actions.Download == nil {
+			return errors.New("LFS server returned no download action")
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, obj.Actions.Download.Href, nil)
+		if err != nil {
+			return err
+		}
+		for k, v := range obj.Actions.Download.Header {
+			req.Header.Set(k, v)
+		}
+		// Only send the credentials of the remote to the LFS server itself, not
+		// to the storage it redirects downloads to.
+		if req.Header.Get("Authorization") == "" && req.URL.Host == endpoint.Host && (username != "" || password != "") {
+			req.SetBasicAuth(username, password)
+		}
+		return l.download(req, p)
+	}
+	return errors.New("LFS server did not return the object")
+}
+
+// download stores the LFS object of p downloaded by req, after verifying its
+// size and hash.
+func (l *lfsStore) download(req *http.Request, p lfsPointer) error {
+	resp, err := lfsHTTPClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "LFS download failed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Newf("LFS download failed with status %d", resp.StatusCode)
+	}
+
+	tmpDir := l.dir.Path("lfs", "tmp")
+	if err := os.MkdirAll(tmpDir, os.ModePerm); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(tmpDir, p.OID)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	h := sha256.New()
+	n, err := io.Copy(io.MultiWriter(f, h), io.LimitReader(resp.Body, p.Size+1))
+	if err != nil {
+		return errors.Wrap(err, "LFS download failed")
+	}
+	if n != p.Size {
+		return errors.Newf("LFS object has size %d, expected %d", n, p.Size)
+	}
+	if oid := hex.EncodeToString(h.Sum(nil)); oid != p.OID {
+		return errors.Newf("LFS object has oid %s, expected %s", oid, p.OID)
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	dst := l.objectPath(p.OID)
+	if err := os.MkdirAll(filepath.Dir(dst), os.ModePerm); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), dst)
+}
+
+// lfsEndpoint returns the URL of the LFS server of remoteURL, and the
+// credentials it contains, following
+// https://github.com/git-lfs/git-lfs/blob/main/docs/api/server-discovery.md.
+func lfsEndpoint(remoteURL *vcs.URL) (endpoint *url.URL, username, password string, err error) {
+	if remoteURL.Scheme != "http" && remoteURL.Scheme != "https" {
+		return nil, "", "", errors.Newf("fetching LFS objects is not supported for %s remotes", remoteURL.Scheme)
+	}
+
+	u := remoteURL.URL
+	if u.User != nil {
+		username = u.User.Username()
+		password, _ = u.User.Password()
+		u.User = nil
+	}
+	u.RawQuery, u.Fragment = "", ""
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	if !strings.HasSuffix(u.Path, ".git") {
+		u.Path += ".git"
+	}
+	u.RawPath = ""
+	return u.JoinPath("info", "lfs"), username, password, nil
+}
+
+// lfsSmudgeWriter returns a writer which replaces the LFS pointer files in the
+// output of the git command with args by the content of the LFS objects they
+// point to, and writes it to w. The writer must be closed once the command
+// finished to flush the output.
+//
+// It returns nil if the output of the command is not a blob or an archive, or
+// if LFS objects are not fetched for repo.
+func (s *Server) lfsSmudgeWriter(ctx context.Context, logger log.Logger, repo api.RepoName, dir common.GitDir, args []string, w io.Writer) io.WriteCloser {
+	isBlob := (len(args) == 2 && args[0] == "show") || (len(args) == 3 && args[0] == "cat-file" && args[1] == "-p")
+	isArchive := len(args) > 0 && args[0] == "archive"
+	if !isBlob && !isArchive {
+		return nil
+	}
+
+	maxObjectSize, err := s.lfsMaxObjectSizes.get(ctx, repo, s.GetVCSSyncer)
+	if err != nil {
+		logger.Warn("failed to get VCS syncer to smudge LFS pointers", log.Error(err))
+		return nil
+	}
+	if maxObjectSize <= 0 {
+		return nil
+	}
+	store := &lfsStore{
+		dir:           dir,
+		maxObjectSize: maxObjectSize,
+		remoteURL: func(ctx context.Context) (*vcs.URL, error) {
+			return s.getRemoteURL(ctx, repo)
+		},
+	}
+	logger = logger.Scoped("lfs", "smudges LFS pointers")
+
+	if isBlob {
+		return &lfsBlobWriter{ctx: ctx, logger: logger, store: store, w: w}
+	}
+	for _, arg := range args {
+		if arg == "--format="+string(gitserver.ArchiveFormatZip) {
+			tmpDir, err := s.tempDir("lfs-archive-")
+			if err != nil {
+				logger.Warn("failed to create temporary directory to smudge LFS pointers", log.Error(err))
+				return nil
+			}
+			return newLFSZipWriter(ctx, logger, store, w, tmpDir)
+		}
+	}
+	return newLFSTarWriter(ctx, logger, store, w)
+}
+
+// lfsBlobWriter smudges the output of a command printing a blob.
+type lfsBlobWriter struct {
+	ctx    context.Context
+	logger log.Logger
+	store  *lfsStore
+	w      io.Writer
+
+	// buf holds the output until it is known whether it is an LFS pointer.
+	buf bytes.Buffer
+	// passthrough is true once the output is larger than a pointer.
+	passthrough bool
+}
+
+func (b *lfsBlobWriter) Write(p []byte) (int, error) {
+	if b.passthrough {
+		return b.w.Write(p)
+	}
+	b.buf.Write(p)
+	if b.buf.Len() > lfsPointerMaxSize {
+		b.passthrough = true
+		if _, err := b.w.Write(b.buf.Bytes()); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+func (b *lfsBlobWriter) Close() error {
+	if b.passthrough {
+		return nil
+	}
+	if ptr, ok := parseLFSPointer(b.buf.Bytes()); ok {
+		if f, ok := b.store.open(b.ctx, b.logger, ptr); ok {
+			defer f.Close()
+			_, err := io.Copy(b.w, f)
+			return err
+		}
+	}
+	_, err := b.w.Write(b.buf.Bytes())
+	return err
+}
+
+// lfsTarWriter smudges the output of git archive --format=tar. The archive is
+// rewritten as it is written.
+type lfsTarWriter struct {
+	pw   *io.PipeWriter
+	done chan error
+}
+
+func newLFSTarWriter(ctx context.Context, logger log.Logger, store *lfsStore, w io.Writer) *lfsTarWriter {
+	pr, pw := io.Pipe()
+	t := &lfsTarWriter{pw: pw, done: make(chan error, 1)}
+	go func() {
+		err := smudgeLFSTar(ctx, logger, store, pr, w)
+		// Unblock the command if the archive could not be rewritten.
+		pr.CloseWithError(err)
+		t.done <- err
+	}()
+	return t
+}
+
+func (t *lfsTarWriter) Write(p []byte) (int, error) {
+	return t.pw.Write(p)
+}
+
+func (t *lfsTarWriter) Close() error {
+	t.pw.Close()
+	return <-t.done
+}
+
+func smudgeLFSTar(ctx context.Context, logger log.Logger, store *lfsStore, src io.Reader, dst io.Writer) error {
+	tr := tar.NewReader(src)
+	tw := tar.NewWriter(dst)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			// git archive pads the archive after its end.
+			if _, err := io.Copy(io.Discard, src); err != nil {
+				return err
+			}
+			return tw.Close()
+		}
+		if err != nil {
+			return err
+		}
+
+		if hdr.Typeflag != tar.TypeReg || hdr.Size > lfsPointerMaxSize {
+			if err := tw.WriteHeader(hdr); err != nil {
+				return err
+			}
+			if _, err := io.Copy(tw, tr); err != nil {
+				return err
+			}
+			continue
+		}
+
+		b, err := io.ReadAll(tr)
+		if err != nil {
+			return err
+		}
+		if err := writeLFSTarEntry(ctx, logger, store, tw, hdr, b); err != nil {
+			return err
+		}
+	}
+}
+
+// writeLFSTarEntry writes the tar entry with header hdr and content b, smudged
+// if it is an LFS pointer.
+func writeLFSTarEntry(ctx context.Context, logger log.Logger, store *lfsStore, tw *tar.Writer, hdr *tar.Header, b []byte) error {
+	if ptr, ok := parseLFSPointer(b); ok {
+		if f, ok := store.open(ctx, logger, ptr); ok {
+			defer f.Close()
+			hdr.Size = ptr.Size
+			if err := tw.WriteHeader(hdr); err != nil {
+				return err
+			}
+			_, err := io.Copy(tw, f)
+			return err
+		}
+	}
+
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err := tw.Write(b)
+	return err
+}
+
+// lfsZipWriter smudges the output of git archive --format=zip. Zip archives
+// can't be read as a stream, so the archive is written to a temporary file and
+// rewritten once it is complete.
+type lfsZipWriter struct {
+	ctx    context.Context
+	logger log.Logger
+	store  *lfsStore
+	w      io.Writer
+	tmp    *os.File
+	err    error
+}
+
+// newLFSZipWriter returns an lfsZipWriter writing the archive to a temporary
+// file in tmpDir, which is removed when the writer is closed.
+func newLFSZipWriter(ctx context.Context, logger log.Logger, store *lfsStore, w io.Writer, tmpDir string) *lfsZipWriter {
+	z := &lfsZipWriter{ctx: ctx, logger: logger, store: store, w: w}
+	z.tmp, z.err = os.Create(filepath.Join(tmpDir, "archive.zip"))
+	return z
+}
+
+func (z *lfsZipWriter) Write(p []byte) (int, error) {
+	if z.err != nil {
+		return 0, z.err
+	}
+	return z.tmp.Write(p)
+}
+
+func (z *lfsZipWriter) Close() error {
+	if z.err != nil {
+		return z.err
+	}
+	defer os.RemoveAll(filepath.Dir(z.tmp.Name()))
+	defer z.tmp.Close()
+
+	size, err := z.tmp.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	zr, err := zip.NewReader(z.tmp, size)
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(z.w)
+	zw := zip.NewWriter(bw)
+	if err := zw.SetComment(zr.Comment); err != nil {
+		return err
+	}
+	for _, f := range zr.File {
+		if err := z.copyFile(zw, f); err != nil {
+			return err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+func (z *lfsZipWriter) copyFile(zw *zip.Writer, f *zip.File) error {
+	if f.Mode().IsRegular() && f.UncompressedSize64 <= lfsPointerMaxSize {
+		rc, err := f.Open()
+		if err != nil {
+			return err
+		}
+		b, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return err
+		}
+
+		if ptr, ok := parseLFSPointer(b); ok {
+			if obj, ok := z.store.open(z.ctx, z.logger, ptr); ok {
+				defer obj.Close()
+				hdr := f.FileHeader
+				hdr.Method = zip.Store
+				w, err := zw.CreateHeader(&hdr)
+				if err != nil {
+					return err
+				}
+				_, err = io.Copy(w, obj)
+				return err
+			}
+		}
+	}
+	return zw.Copy(f)
+}
+
+// lfsObjectFile is an LFS object stored in a repository.
+type lfsObjectFile struct {
+	path     string
+	size     int64
+	lastRead time.Time
+}
+
+// listLFSObjects returns the LFS objects stored in dir. It also removes the
+// temporary files of LFS downloads which were interrupted.
+func listLFSObjects(dir common.GitDir) ([]lfsObjectFile, error) {
+	tmpEntries, err := os.ReadDir(dir.Path("lfs", "tmp"))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	for _, e := range tmpEntries {
+		if fi, err := e.Info(); err == nil && time.Since(fi.ModTime()) > lfsTmpMaxAge {
+			if err := os.Remove(dir.Path("lfs", "tmp", e.Name())); err != nil && !os.IsNotExist(err) {
+				return nil, err
+			}
+		}
+	}
+
+	var objects []lfsObjectFile
+	err = filepath.WalkDir(dir.Path("lfs", "objects"), func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		fi, err := d.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		objects = append(objects, lfsObjectFile{path: path, size: fi.Size(), lastRead: fi.ModTime()})
+		return nil
+	})
+	return objects, err
+}
+
+// evictLFSObjects removes the least recently read of objects until the others
+// are smaller than maxSize in total. It returns the number of objects removed.
+func evictLFSObjects(logger log.Logger, objects []lfsObjectFile, maxSize int64) int {
+	var size int64
+	for _, o := range objects {
+		size += o.size
+	}
+	if size <= maxSize {
+		return 0
+	}
+
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].lastRead.Before(objects[j].lastRead)
+	})
+	var evicted int
+	for _, o := range objects {
+		if size <= maxSize {
+			break
+		}
+		if err := os.Remove(o.path); err != nil && !os.IsNotExist(err) {
+			logger.Error("failed to evict LFS object", log.String("path", o.path), log.Error(err))
+			continue
+		}
+		size -= o.size
+		evicted++
+	}
+	lfsObjectsEvicted.Add(float64(evicted))
+	return evicted
+}
@@ -50,8 +50,11 @@ func (rl *RepositoryLocker) TryAcquire(dir common.GitDir, initialStatus string)
 }
 
 // Status returns the status of the locked directory dir. If dir is not
-// locked, then locked is false.
+// locked, then locked is false. A nil RepositoryLocker locks no directories.
 func (rl *RepositoryLocker) Status(dir common.GitDir) (status string, locked bool) {
+	if rl == nil {
+		return "", false
+	}
 	rl.mu.RLock()
 	defer rl.mu.RUnlock()
 	status, locked = rl.status[dir]
@@ -0,0 +1,656 @@
+package server
+
+import (
+	"container/heap"
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/server/common"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+var (
+	maintenanceConcurrency = env.MustGetInt("SRC_GITSERVER_MAINTENANCE_CONCURRENCY", 1, "the number of repositories maintained concurrently")
+	maintenanceHalfLife    = env.MustGetDuration("SRC_GITSERVER_MAINTENANCE_TRAFFIC_HALF_LIFE", 24*time.Hour, "the half-life of the reads and fetches of a repository used to prioritize its maintenance")
+)
+
+// maintenanceRetryDelay is how long a repository which is locked, e.g.
+// because it is being recloned, waits before it is queued for maintenance
+// again.
+const maintenanceRetryDelay = time.Minute
+
+// maintenanceLockStatus is the status of the repository lock held while
+// maintenance runs. Maintenance only runs on cloned repositories, so unlike
+// the other holders of the lock it doesn't mean a clone is in progress.
+const maintenanceLockStatus = "running maintenance"
+
+// errMaintenanceLocked is returned by runMaintenanceTasks if the repository
+// is locked.
+var errMaintenanceLocked = errors.New("repository is locked")
+
+// fetchPriorityWeight is the number of reads a fetch of a repository is worth
+// when prioritizing maintenance. Every fetch adds objects which are not
+// covered by the commit-graph and bitmap yet.
+const fetchPriorityWeight = 10
+
+var (
+	maintenanceTaskDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "src_gitserver_maintenance_task_duration_seconds",
+		Help:    "Duration of the maintenance tasks run by the maintenance scheduler",
+		Buckets: []float64{0.1, 1, 10, 60, 300, 3600, 7200},
+	}, []string{"task", "success"})
+	maintenanceQueueSize = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "src_gitserver_maintenance_queue_size",
+		Help: "Number of repositories waiting for maintenance",
+	})
+	maintenanceTriggered = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "src_gitserver_maintenance_triggered_total",
+		Help: "Number of times maintenance of a repository was triggered through the API",
+	})
+)
+
+// maintenanceActivity is the traffic and maintenance history of a repository.
+type maintenanceActivity struct {
+	// reads and fetches are decayed exponentially with maintenanceHalfLife
+	// since decayedAt.
+	reads     float64
+	fetches   float64
+	decayedAt time.Time
+
+	running      bool
+	lastRunAt    time.Time
+	lastDuration time.Duration
+	lastTasks    []string
+	lastError    string
+}
+
+func (a *maintenanceActivity) decay(now time.Time) {
+	if !a.decayedAt.IsZero() && now.After(a.decayedAt) {
+		f := math.Exp2(-float64(now.Sub(a.decayedAt)) / float64(maintenanceHalfLife))
+		a.reads *= f
+		a.fetches *= f
+	}
+	a.decayedAt = now
+}
+
+func (a *maintenanceActivity) priority() float64 {
+	return a.reads + fetchPriorityWeight*a.fetches
+}
+
+// maintenanceItem is a repository waiting for maintenance.
+type maintenanceItem struct {
+	repo     api.RepoName
+	priority float64
+	// triggered items were requested through the API. They are maintained
+	// before all other items, and all tasks run even if they seem unnecessary.
+	triggered bool
+	// seq orders items with the same priority by the time they were queued.
+	seq   uint64
+	index int
+}
+
+// maintenanceQueue is a heap.Interface of maintenance items, ordered by
+// priority.
+type maintenanceQueue []*maintenanceItem
+
+func (q maintenanceQueue) Len() int { return len(q) }
+
+func (q maintenanceQueue) Less(i, j int) bool {
+	return q[i].before(q[j])
+}
+
+func (q maintenanceQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *maintenanceQueue) Push(x any) {
+	item := x.(*maintenanceItem)
+	item.index = len(*q)
+	*q = append(*q, item)
+}
+
+func (q *maintenanceQueue) Pop() any {
+	old := *q
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	item.index = -1
+	*q = old[:n-1]
+	return item
+}
+
+// before reports whether i is maintained before j.
+func (i *maintenanceItem) before(j *maintenanceItem) bool {
+	if i.triggered != j.triggered {
+		return i.triggered
+	}
+	if i.priority != j.priority {
+		return i.priority > j.priority
+	}
+	return i.seq < j.seq
+}
+
+// maintenanceScheduler is a priority queue of repositories waiting for
+// maintenance. Repositories with more read traffic and more frequent fetches
+// are maintained first, so that busy repositories are not starved by the
+// large number of repositories which are rarely used.
+type maintenanceScheduler struct {
+	mu       sync.Mutex
+	queue    maintenanceQueue
+	queued   map[api.RepoName]*maintenanceItem
+	activity map[api.RepoName]*maintenanceActivity
+	seq      uint64
+
+	// wake is signalled when an item is queued.
+	wake chan struct{}
+
+	// now and retryDelay are replaced in tests.
+	now        func() time.Time
+	retryDelay time.Duration
+}
+
+func newMaintenanceScheduler() *maintenanceScheduler {
+	return &maintenanceScheduler{
+		queued:     make(map[api.RepoName]*maintenanceItem),
+		activity:   make(map[api.RepoName]*maintenanceActivity),
+		wake:       make(chan struct{}, 1),
+		now:        time.Now,
+		retryDelay: maintenanceRetryDelay,
+	}
+}
+
+// activityLocked returns the activity of repo, decayed to now. s.mu must be
+// held.
+func (s *maintenanceScheduler) activityLocked(repo api.RepoName, now time.Time) *maintenanceActivity {
+	a, ok := s.activity[repo]
+	if !ok {
+		a = &maintenanceActivity{}
+		s.activity[repo] = a
+	}
+	a.decay(now)
+	return a
+}
+
+// updatePriorityLocked moves repo to its new position in the queue, if it is
+// queued. s.mu must be held.
+func (s *maintenanceScheduler) updatePriorityLocked(repo api.RepoName, a *maintenanceActivity) {
+	if item, ok := s.queued[repo]; ok {
+		item.priority = a.priority()
+		heap.Fix(&s.queue, item.index)
+	}
+}
+
+// recordReads adds the number of reads of each repository since the last
+// call, as returned by accesslog.TakeAccessCounts.
+func (s *maintenanceScheduler) recordReads(counts map[string]int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for name, n := range counts {
+		repo := protocol.NormalizeRepo(api.RepoName(name))
+		a := s.activityLocked(repo, now)
+		a.reads += float64(n)
+		s.updatePriorityLocked(repo, a)
+	}
+}
+
+// recordFetch records a successful fetch of repo.
+func (s *maintenanceScheduler) recordFetch(repo api.RepoName) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a := s.activityLocked(repo, s.now())
+	a.fetches++
+	s.updatePriorityLocked(repo, a)
+}
+
+// decay decays the traffic of all repositories to the current time and
+// reorders the queue accordingly. Repositories without noteworthy traffic
+// which were never maintained are forgotten.
+func (s *maintenanceScheduler) decay() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for repo, a := range s.activity {
+		a.decay(now)
+		_, queued := s.queued[repo]
+		if !queued && !a.running && a.lastRunAt.IsZero() && a.priority() < 0.01 {
+			delete(s.activity, repo)
+		}
+	}
+	for _, item := range s.queue {
+		item.priority = s.activity[item.repo].priority()
+	}
+	heap.Init(&s.queue)
+}
+
+// schedule queues repo for maintenance, unless it is queued already.
+func (s *maintenanceScheduler) schedule(repo api.RepoName) {
+	s.enqueue(repo, false)
+}
+
+// trigger queues repo for maintenance ahead of all repositories which were
+// not triggered. All maintenance tasks run for triggered repositories.
+func (s *maintenanceScheduler) trigger(repo api.RepoName) {
+	maintenanceTriggered.Inc()
+	s.enqueue(repo, true)
+}
+
+func (s *maintenanceScheduler) enqueue(repo api.RepoName, triggered bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if item, ok := s.queued[repo]; ok {
+		if triggered && !item.triggered {
+			item.triggered = true
+			heap.Fix(&s.queue, item.index)
+		}
+		return
+	}
+
+	a := s.activityLocked(repo, s.now())
+	s.seq++
+	item := &maintenanceItem{
+		repo:      repo,
+		priority:  a.priority(),
+		triggered: triggered,
+		seq:       s.seq,
+	}
+	heap.Push(&s.queue, item)
+	s.queued[repo] = item
+	maintenanceQueueSize.Set(float64(len(s.queue)))
+
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// tryNext dequeues the repository with the highest priority. ok is false if
+// the queue is empty. finish must be called once the repository is
+// maintained.
+func (s *maintenanceScheduler) tryNext() (repo api.RepoName, triggered, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.queue) == 0 {
+		return "", false, false
+	}
+	item := heap.Pop(&s.queue).(*maintenanceItem)
+	delete(s.queued, item.repo)
+	maintenanceQueueSize.Set(float64(len(s.queue)))
+
+	s.activityLocked(item.repo, s.now()).running = true
+	return item.repo, item.triggered, true
+}
+
+// next is like tryNext, but waits for a repository to be queued. ok is false
+// if ctx is done.
+func (s *maintenanceScheduler) next(ctx context.Context) (repo api.RepoName, triggered, ok bool) {
+	for {
+		if repo, triggered, ok := s.tryNext(); ok {
+			return repo, triggered, true
+		}
+		select {
+		case <-s.wake:
+		case <-ctx.Done():
+			return "", false, false
+		}
+	}
+}
+
+// finish records the outcome of the maintenance of repo.
+func (s *maintenanceScheduler) finish(repo api.RepoName, duration time.Duration, tasks []string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	a := s.activityLocked(repo, now)
+	a.running = false
+	a.lastRunAt = now
+	a.lastDuration = duration
+	a.lastTasks = tasks
+	a.lastError = ""
+	if err != nil {
+		a.lastError = err.Error()
+	}
+}
+
+// retry queues repo for maintenance again after s.retryDelay, without
+// recording a run, because it could not be maintained yet.
+func (s *maintenanceScheduler) retry(repo api.RepoName, triggered bool) {
+	s.mu.Lock()
+	s.activityLocked(repo, s.now()).running = false
+	s.mu.Unlock()
+
+	time.AfterFunc(s.retryDelay, func() {
+		s.enqueue(repo, triggered)
+	})
+}
+
+// status returns the maintenance status of repo. Cloned is not set.
+func (s *maintenanceScheduler) status(repo api.RepoName) protocol.RepoMaintenance {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var st protocol.RepoMaintenance
+	a, ok := s.activity[repo]
+	if !ok {
+		return st
+	}
+	a.decay(s.now())
+
+	st.Running = a.running
+	st.Reads = a.reads
+	st.Fetches = a.fetches
+	st.Priority = a.priority()
+	if !a.lastRunAt.IsZero() {
+		lastRunAt := a.lastRunAt
+		st.LastRunAt = &lastRunAt
+		st.LastDuration = a.lastDuration
+		st.LastTasks = a.lastTasks
+		st.LastError = a.lastError
+	}
+
+	if item, ok := s.queued[repo]; ok {
+		st.Queued = true
+		for _, other := range s.queue {
+			if other.before(item) {
+				st.QueuePosition++
+			}
+		}
+	}
+	return st
+}
+
+// runMaintenance maintains the repositories queued in the maintenance
+// scheduler until ctx is done.
+func (s *Server) runMaintenance(ctx context.Context) {
+	concurrency := maintenanceConcurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				repo, triggered, ok := s.maintenance.next(ctx)
+				if !ok {
+					return
+				}
+				s.maintainRepo(repo, triggered)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// maintainRepo runs the maintenance tasks of repo and records the outcome in
+// the maintenance scheduler. If force is true, the commit-graph and bitmap are
+// rewritten even if they are up to date.
+func (s *Server) maintainRepo(repo api.RepoName, force bool) {
+	logger := s.Logger.Scoped("maintenance", "repository maintenance").With(log.String("repo", string(repo)))
+
+	start := time.Now()
+	tasks, err := s.runMaintenanceTasks(logger, s.dir(repo), force)
+	if errors.Is(err, errMaintenanceLocked) {
+		logger.Debug("repository is locked, retrying maintenance later")
+		s.maintenance.retry(repo, force)
+		return
+	}
+	if err != nil {
+		logger.Error("error running maintenance", log.Strings("tasks", tasks), log.Error(err))
+	}
+	s.maintenance.finish(repo, time.Since(start), tasks, err)
+}
+
+// runMaintenanceTasks runs the garbage collection configured by gitGCMode,
+// followed by the incremental tasks which keep the commit-graph and bitmap up
+// to date with the latest fetches. It returns the names of the tasks which
+// ran.
+//
+// The repository is locked while the tasks run, so that the janitor doesn't
+// reclone or remove it concurrently. If it is already locked, nothing runs and
+// errMaintenanceLocked is returned.
+func (s *Server) runMaintenanceTasks(logger log.Logger, dir common.GitDir, force bool) (tasks []string, err error) {
+	lock, ok := s.locker.TryAcquire(dir, maintenanceLockStatus)
+	if !ok {
+		return nil, errMaintenanceLocked
+	}
+	defer lock.Release()
+
+	if !repoCloned(dir) {
+		return nil, nil
+	}
+
+	run := func(task string, f func() error) error {
+		start := time.Now()
+		err := f()
+		maintenanceTaskDuration.WithLabelValues(task, strconv.FormatBool(err == nil)).Observe(time.Since(start).Seconds())
+		tasks = append(tasks, task)
+		return err
+	}
+
+	switch gitGCMode {
+	case gitGCModeJanitorAutoGC:
+		// Runs a number of housekeeping tasks within the current repository, such
+		// as compressing file revisions (to reduce disk space and increase
+		// performance), removing unreachable objects which may have been created
+		// from prior invocations of git add, packing refs, pruning reflog, rerere
+		// metadata or stale working trees.
+		if err := run("garbage collect", func() error { return gitGC(dir) }); err != nil {
+			return tasks, err
+		}
+	case gitGCModeMaintenance:
+		// Run tasks to optimize Git repository data, speeding up other Git
+		// commands and reducing storage requirements for the repository.
+		if err := run("sg maintenance", func() error { return sgMaintenance(logger, dir) }); err != nil {
+			return tasks, err
+		}
+		if err := run("git prune", func() error { return pruneIfNeeded(dir, looseObjectsLimit) }); err != nil {
+			return tasks, err
+		}
+	}
+
+	writeCG, err := commitGraphStale(dir)
+	if err != nil {
+		return tasks, err
+	}
+	writeBm, err := bitmapStale(dir, force)
+	if err != nil {
+		return tasks, err
+	}
+	writeCG = writeCG || force
+	if !writeCG && !writeBm {
+		return tasks, nil
+	}
+
+	err, unlock := lockRepoForGC(dir)
+	if unlock == nil {
+		// Another process is running gc. It updates the commit-graph and
+		// bitmap itself.
+		logger.Debug("could not lock repository for incremental maintenance", log.Error(err))
+		return tasks, nil
+	}
+	defer func() {
+		if err := unlock(); err != nil {
+			logger.Warn("failed to unlock repository after incremental maintenance", log.Error(err))
+		}
+	}()
+	if err != nil {
+		return tasks, err
+	}
+
+	if writeCG {
+		if err := run("commit-graph", func() error { return writeCommitGraph(dir) }); err != nil {
+			return tasks, err
+		}
+	}
+	if writeBm {
+		if err := run("bitmap", func() error { return writeMultiPackIndexBitmap(dir) }); err != nil {
+			return tasks, err
+		}
+	}
+	return tasks, nil
+}
+
+// commitGraphPaths are the paths of a commit-graph file and of the chain of a
+// split commit-graph, relative to the objects/info directory.
+var commitGraphPaths = []string{"commit-graph", filepath.Join("commit-graphs", "commit-graph-chain")}
+
+// commitGraphStale reports whether the commit-graph of dir is missing or older
+// than the last fetch.
+func commitGraphStale(dir common.GitDir) (bool, error) {
+	var paths []string
+	for _, p := range commitGraphPaths {
+		paths = append(paths, dir.Path("objects", "info", p))
+	}
+	modTime, err := newestModTime(paths)
+	if err != nil {
+		return false, err
+	}
+	if modTime.IsZero() {
+		return true, nil
+	}
+
+	lastFetched, err := repoLastFetched(dir)
+	if err != nil {
+		return false, err
+	}
+	return lastFetched.After(modTime), nil
+}
+
+// bitmapStale reports whether dir has packfiles which are newer than its
+// newest bitmap, ie. packfiles which are not covered by a bitmap. If force is
+// true, it reports whether dir has any packfiles.
+func bitmapStale(dir common.GitDir, force bool) (bool, error) {
+	packs, err := filepath.Glob(dir.Path("objects", "pack", "*.pack"))
+	if err != nil {
+		return false, err
+	}
+	pack, err := newestModTime(packs)
+	if err != nil || pack.IsZero() {
+		return false, err
+	}
+	if force {
+		return true, nil
+	}
+
+	bitmaps, err := filepath.Glob(dir.Path("objects", "pack", "*.bitmap"))
+	if err != nil {
+		return false, err
+	}
+	bitmap, err := newestModTime(bitmaps)
+	if err != nil {
+		return false, err
+	}
+	return bitmap.IsZero() || pack.After(bitmap), nil
+}
+
+// newestModTime returns the newest modification time of the files at paths
+// which exist, or the zero time if none exists.
+func newestModTime(paths []string) (time.Time, error) {
+	var newest time.Time
+	for _, p := range paths {
+		fi, err := os.Stat(p)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return time.Time{}, err
+		}
+		if fi.ModTime().After(newest) {
+			newest = fi.ModTime()
+		}
+	}
+	return newest, nil
+}
+
+// writeCommitGraph adds the commits which are not in the commit-graph of dir
+// yet as a new layer of a split commit-graph. Git merges small layers, which
+// keeps the cost proportional to the number of new commits.
+func writeCommitGraph(dir common.GitDir) error {
+	cmd := exec.Command("git", "commit-graph", "write", "--reachable", "--split", "--changed-paths")
+	dir.Set(cmd)
+	if err := cmd.Run(); err != nil {
+		return errors.Wrap(wrapCmdError(cmd, err), "failed to write commit-graph")
+	}
+
+	// Git leaves the commit-graph untouched if there are no new commits. We
+	// update its modification time anyway, so that commitGraphStale does not
+	// consider it stale until the next fetch.
+	now := time.Now()
+	for _, p := range commitGraphPaths {
+		if err := os.Chtimes(dir.Path("objects", "info", p), now, now); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeMultiPackIndexBitmap writes a multi-pack-index with a bitmap covering
+// all packfiles of dir. Unlike a full repack, this does not rewrite the
+// packfiles.
+func writeMultiPackIndexBitmap(dir common.GitDir) error {
+	cmd := exec.Command("git", "multi-pack-index", "write", "--bitmap")
+	dir.Set(cmd)
+	if err := cmd.Run(); err != nil {
+		return errors.Wrap(wrapCmdError(cmd, err), "failed to write multi-pack-index bitmap")
+	}
+	return nil
+}
+
+func (s *Server) handleRepoMaintenance(w http.ResponseWriter, r *http.Request) {
+	var req protocol.RepoMaintenanceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if req.Repo == "" {
+		http.Error(w, "no Repo given", http.StatusBadRequest)
+		return
+	}
+	resp := s.repoMaintenance(req.Repo, req.Trigger)
+
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// repoMaintenance returns the maintenance status of repo. If trigger is true,
+// maintenance of repo is scheduled ahead of all other repositories first.
+func (s *Server) repoMaintenance(repo api.RepoName, trigger bool) *protocol.RepoMaintenance {
+	repo = protocol.NormalizeRepo(repo)
+	cloned := repoCloned(s.dir(repo))
+	if trigger && cloned {
+		s.maintenance.trigger(repo)
+	}
+
+	resp := s.maintenance.status(repo)
+	resp.Cloned = cloned
+	return &resp
+}
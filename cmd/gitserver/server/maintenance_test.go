@@ -0,0 +1,299 @@
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/server/common"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+// drainMaintenance maintains all repositories queued in the maintenance
+// scheduler of s.
+func drainMaintenance(s *Server) {
+	for {
+		repo, triggered, ok := s.maintenance.tryNext()
+		if !ok {
+			return
+		}
+		s.maintainRepo(repo, triggered)
+	}
+}
+
+func TestMaintenanceScheduler(t *testing.T) {
+	now := time.Now()
+	s := newMaintenanceScheduler()
+	s.now = func() time.Time { return now }
+
+	dequeueAll := func() []api.RepoName {
+		t.Helper()
+		var repos []api.RepoName
+		for {
+			repo, _, ok := s.tryNext()
+			if !ok {
+				return repos
+			}
+			s.finish(repo, time.Second, nil, nil)
+			repos = append(repos, repo)
+		}
+	}
+
+	s.recordReads(map[string]int64{"github.com/foo/read": 5, "github.com/foo/READ-LESS": 1})
+	s.recordFetch("github.com/foo/fetch")
+	for _, repo := range []api.RepoName{"github.com/foo/cold-1", "github.com/foo/read-less", "github.com/foo/read", "github.com/foo/cold-2", "github.com/foo/fetch"} {
+		s.schedule(repo)
+	}
+	// Scheduling a queued repo does not queue it twice.
+	s.schedule("github.com/foo/read")
+
+	t.Run("status", func(t *testing.T) {
+		got := s.status("github.com/foo/read")
+		if !got.Queued || got.QueuePosition != 1 || got.Reads != 5 || got.Priority != 5 {
+			t.Fatalf("unexpected status %+v", got)
+		}
+	})
+
+	// Traffic recorded while queued moves the repo up.
+	s.recordReads(map[string]int64{"github.com/foo/cold-2": 2})
+
+	want := []api.RepoName{
+		"github.com/foo/fetch",
+		"github.com/foo/read",
+		"github.com/foo/cold-2",
+		"github.com/foo/read-less",
+		"github.com/foo/cold-1",
+	}
+	if diff := cmp.Diff(want, dequeueAll()); diff != "" {
+		t.Fatalf("unexpected maintenance order (-want +got):\n%s", diff)
+	}
+
+	t.Run("trigger", func(t *testing.T) {
+		s.schedule("github.com/foo/read")
+		s.schedule("github.com/foo/cold-1")
+		s.trigger("github.com/foo/cold-1")
+
+		repo, triggered, ok := s.tryNext()
+		if !ok || repo != "github.com/foo/cold-1" || !triggered {
+			t.Fatalf("expected triggered repo first, got %q (triggered=%t, ok=%t)", repo, triggered, ok)
+		}
+		if got := s.status(repo); !got.Running || got.Queued {
+			t.Fatalf("unexpected status %+v", got)
+		}
+		s.finish(repo, time.Minute, []string{"commit-graph"}, nil)
+		dequeueAll()
+
+		got := s.status(repo)
+		if got.Running || got.LastRunAt == nil || got.LastDuration != time.Minute {
+			t.Fatalf("unexpected status %+v", got)
+		}
+		if diff := cmp.Diff([]string{"commit-graph"}, got.LastTasks); diff != "" {
+			t.Fatalf("unexpected last tasks (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("decay", func(t *testing.T) {
+		now = now.Add(maintenanceHalfLife)
+		s.decay()
+
+		if got := s.status("github.com/foo/read"); got.Reads != 2.5 {
+			t.Fatalf("expected reads to be halved after one half-life, got %v", got.Reads)
+		}
+
+		// Traffic of repos which were never maintained is forgotten once it
+		// decayed.
+		s.recordReads(map[string]int64{"github.com/foo/unmaintained": 1})
+		now = now.Add(10 * maintenanceHalfLife)
+		s.decay()
+		if _, ok := s.activity["github.com/foo/unmaintained"]; ok {
+			t.Fatal("expected activity of unmaintained repo to be forgotten")
+		}
+	})
+}
+
+func TestMaintenanceScheduler_Next(t *testing.T) {
+	s := newMaintenanceScheduler()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		s.schedule("github.com/foo/bar")
+	}()
+	repo, _, ok := s.next(ctx)
+	if !ok || repo != "github.com/foo/bar" {
+		t.Fatalf("expected next to wait for queued repo, got %q (ok=%t)", repo, ok)
+	}
+
+	cancel()
+	if _, _, ok := s.next(ctx); ok {
+		t.Fatal("expected next to return once the context is canceled")
+	}
+}
+
+func TestRunMaintenanceTasks(t *testing.T) {
+	// Only run the incremental tasks.
+	origGCMode := gitGCMode
+	gitGCMode = gitGCModeGitAutoGC
+	t.Cleanup(func() { gitGCMode = origGCMode })
+
+	root := t.TempDir()
+	repo := filepath.Join(root, "repo")
+	runCmd(t, root, "git", "init", "--initial-branch", "main", repo)
+	runCmd(t, repo, "git", "commit", "--allow-empty", "-m", "first")
+	runCmd(t, repo, "git", "repack", "-d")
+	dir := common.GitDir(filepath.Join(repo, ".git"))
+
+	s := &Server{
+		ReposDir: root,
+		Logger:   logtest.Scoped(t),
+		locker:   &RepositoryLocker{},
+	}
+	logger := logtest.Scoped(t)
+
+	runTasks := func(force bool) []string {
+		t.Helper()
+		tasks, err := s.runMaintenanceTasks(logger, dir, force)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tasks
+	}
+
+	// Move the modification times of the files in dir into the past, so that
+	// files written later are newer.
+	ageFiles := func() {
+		t.Helper()
+		past := time.Now().Add(-time.Hour)
+		err := filepath.Walk(string(dir), func(path string, _ os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			return os.Chtimes(path, past, past)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if diff := cmp.Diff([]string{"commit-graph", "bitmap"}, runTasks(false)); diff != "" {
+		t.Fatalf("unexpected tasks (-want +got):\n%s", diff)
+	}
+	if has, _ := hasCommitGraph(dir); !has {
+		t.Fatal("expected commit-graph")
+	}
+	if has, _ := hasBitmap(dir); !has {
+		t.Fatal("expected bitmap")
+	}
+	if _, err := os.Stat(dir.Path(gcLockFile)); !os.IsNotExist(err) {
+		t.Fatalf("expected gc lock to be released, got err=%v", err)
+	}
+
+	ageFiles()
+	if tasks := runTasks(false); len(tasks) != 0 {
+		t.Fatalf("expected no tasks for an up to date repo, got %v", tasks)
+	}
+
+	// A fetch makes the commit-graph stale.
+	if err := os.WriteFile(dir.Path("FETCH_HEAD"), nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"commit-graph"}, runTasks(false)); diff != "" {
+		t.Fatalf("unexpected tasks (-want +got):\n%s", diff)
+	}
+
+	// A new packfile makes the bitmap stale.
+	ageFiles()
+	runCmd(t, repo, "git", "commit", "--allow-empty", "-m", "second")
+	runCmd(t, repo, "git", "repack", "-d")
+	if diff := cmp.Diff([]string{"bitmap"}, runTasks(false)); diff != "" {
+		t.Fatalf("unexpected tasks (-want +got):\n%s", diff)
+	}
+
+	// Forced maintenance runs all tasks.
+	ageFiles()
+	if diff := cmp.Diff([]string{"commit-graph", "bitmap"}, runTasks(true)); diff != "" {
+		t.Fatalf("unexpected tasks (-want +got):\n%s", diff)
+	}
+	if _, locked := s.locker.Status(dir); locked {
+		t.Fatal("expected repo lock to be released")
+	}
+
+	// Locked repositories, e.g. while they are recloned, are skipped.
+	lock, _ := s.locker.TryAcquire(dir, "recloning")
+	if tasks, err := s.runMaintenanceTasks(logger, dir, true); len(tasks) != 0 || err != errMaintenanceLocked {
+		t.Fatalf("expected no tasks and errMaintenanceLocked for a locked repo, got %v, %v", tasks, err)
+	}
+	lock.Release()
+}
+
+func TestRepoMaintenance(t *testing.T) {
+	root := t.TempDir()
+	runCmd(t, root, "git", "init", "--bare", filepath.Join(root, "github.com", "foo", "bar", ".git"))
+
+	s := &Server{
+		ReposDir:    root,
+		Logger:      logtest.Scoped(t),
+		locker:      &RepositoryLocker{},
+		maintenance: newMaintenanceScheduler(),
+	}
+
+	got := s.repoMaintenance("github.com/foo/missing", true)
+	if got.Cloned || got.Queued {
+		t.Fatalf("expected missing repo not to be queued, got %+v", got)
+	}
+
+	got = s.repoMaintenance("github.com/foo/bar", true)
+	if !got.Cloned || !got.Queued || got.QueuePosition != 0 {
+		t.Fatalf("expected repo to be queued first, got %+v", got)
+	}
+
+	drainMaintenance(s)
+	got = s.repoMaintenance("github.com/foo/bar", false)
+	if got.Queued || got.LastRunAt == nil || got.LastError != "" {
+		t.Fatalf("expected repo to be maintained, got %+v", got)
+	}
+}
+
+func TestRepoMaintenance_Locked(t *testing.T) {
+	root := t.TempDir()
+	runCmd(t, root, "git", "init", "--bare", filepath.Join(root, "github.com", "foo", "bar", ".git"))
+
+	s := &Server{
+		ReposDir:    root,
+		Logger:      logtest.Scoped(t),
+		locker:      &RepositoryLocker{},
+		maintenance: newMaintenanceScheduler(),
+	}
+	s.maintenance.retryDelay = 0
+	repo := api.RepoName("github.com/foo/bar")
+
+	// A locked repository is queued again instead of being recorded as
+	// maintained.
+	lock, _ := s.locker.TryAcquire(s.dir(repo), "recloning")
+	s.repoMaintenance(repo, true)
+	drainMaintenance(s)
+	lock.Release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	next, triggered, ok := s.maintenance.next(ctx)
+	if !ok || next != repo || !triggered {
+		t.Fatalf("expected triggered repo to be queued again, got %q (triggered=%t, ok=%t)", next, triggered, ok)
+	}
+	if got := s.repoMaintenance(repo, false); !got.Running || got.LastRunAt != nil {
+		t.Fatalf("expected repo not to be recorded as maintained, got %+v", got)
+	}
+
+	// While maintenance holds the lock, the repository is not reported as
+	// cloning.
+	lock, _ = s.locker.TryAcquire(s.dir(repo), maintenanceLockStatus)
+	if progress := s.repoCloneProgress(repo); !progress.Cloned || progress.CloneInProgress {
+		t.Fatalf("expected cloned repo without clone in progress, got %+v", progress)
+	}
+	lock.Release()
+}
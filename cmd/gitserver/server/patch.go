@@ -17,6 +17,7 @@ import (
 	"time"
 
 	"github.com/sourcegraph/log"
+	"golang.org/x/exp/slices"
 
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
@@ -25,6 +26,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/perforce"
 	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/internal/wrexec"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -51,7 +53,73 @@ func (s *Server) handleCreateCommitFromPatchBinary(w http.ResponseWriter, r *htt
 }
 
 func (s *Server) createCommitFromPatch(ctx context.Context, req protocol.CreateCommitFromPatchRequest) (int, protocol.CreateCommitFromPatchResponse) {
-	logger := s.Logger.Scoped("createCommitFromPatch", "").
+	return s.createCommit(ctx, "createCommitFromPatch", req, "<Sourcegraph> Creating commit from patch", func(logger log.Logger, newCmd func(args ...string) *exec.Cmd, cmds stageCommands) (int, error) {
+		cmd := newCmd(append([]string{"apply", "--cached"}, req.GitApplyArgs...)...)
+		cmd.Stdin = bytes.NewReader(req.Patch)
+
+		if out, err := cmds.run(cmd, "applying patch"); err != nil {
+			logger.Error("Failed to apply patch", log.String("output", string(out)))
+			return http.StatusBadRequest, err
+		}
+		return http.StatusOK, nil
+	})
+}
+
+// runCommandFunc runs cmd and records its failure in the response of
+// createCommit.
+type runCommandFunc func(cmd *exec.Cmd, reason string) ([]byte, error)
+
+// stageCommands runs the git commands which stage changes in the temporary
+// repository of createCommit. run returns the combined output of a command,
+// and output only its standard output.
+type stageCommands struct {
+	run    runCommandFunc
+	output runCommandFunc
+}
+
+// stageChangesFunc stages the changes to commit in the index of the temporary
+// repository a commit is created in, which is reset to the base commit. newCmd
+// returns a git command which runs in that repository. The returned status is
+// used as the status of the response when an error is returned.
+type stageChangesFunc func(logger log.Logger, newCmd func(args ...string) *exec.Cmd, cmds stageCommands) (int, error)
+
+// stagingGitCmdAllowlist are the commands and arguments which gitserver runs
+// itself to stage changes in the temporary repository of createCommit, in
+// addition to the commands allowed by gitdomain.IsAllowedGitCmd. They are not
+// allowed in exec requests, since they write to the index or object database.
+var stagingGitCmdAllowlist = map[string][]string{
+	"hash-object":  {"-w", "--stdin"},
+	"ls-files":     {"--stage", "-z", "--"},
+	"update-index": {"-z", "--index-info"},
+}
+
+// isAllowedStagingGitCmd returns true if args are allowed by
+// stagingGitCmdAllowlist. Arguments which are not flags, such as paths, are
+// allowed after "--".
+func isAllowedStagingGitCmd(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+	allowedArgs, ok := stagingGitCmdAllowlist[args[0]]
+	if !ok {
+		return false
+	}
+	for i, arg := range args[1:] {
+		if !slices.Contains(allowedArgs, arg) {
+			return false
+		}
+		if arg == "--" {
+			return i == len(args)-3 // exactly one path
+		}
+	}
+	return true
+}
+
+// createCommit creates a commit on top of req.BaseCommit with the changes
+// staged by stage, and pushes it to req.TargetRef or shelves it as a Perforce
+// changelist if req.Push is set. The patch fields of req are ignored.
+func (s *Server) createCommit(ctx context.Context, name string, req protocol.CreateCommitFromPatchRequest, defaultMessage string, stage stageChangesFunc) (int, protocol.CreateCommitFromPatchResponse) {
+	logger := s.Logger.Scoped(name, "").
 		With(
 			log.String("repo", string(req.Repo)),
 			log.String("baseCommit", string(req.BaseCommit)),
@@ -141,14 +209,15 @@ func (s *Server) createCommitFromPatch(ctx context.Context, req protocol.CreateC
 
 	// Temporary logging command wrapper
 	prefix := fmt.Sprintf("%d %s ", atomic.AddUint64(&patchID, 1), repo)
-	run := func(cmd *exec.Cmd, reason string) ([]byte, error) {
-		if !gitdomain.IsAllowedGitCmd(logger, cmd.Args[1:]) {
+	// runChecked runs cmd with runCmd if it is allowed, and records its
+	// failure in the response.
+	runChecked := func(cmd *exec.Cmd, reason string, runCmd func(cmd wrexec.Cmder) ([]byte, error)) ([]byte, error) {
+		if !isAllowedStagingGitCmd(cmd.Args[1:]) && !gitdomain.IsAllowedGitCmd(logger, cmd.Args[1:]) {
 			return nil, errors.New("command not on allow list")
 		}
 
 		t := time.Now()
-		// runRemoteGitCommand since one of our commands could be git push
-		out, err := runRemoteGitCommand(ctx, s.RecordingCommandFactory.Wrap(ctx, s.Logger, cmd), true, nil)
+		out, err := runCmd(s.RecordingCommandFactory.Wrap(ctx, s.Logger, cmd))
 
 		logger := logger.With(
 			log.String("prefix", prefix),
@@ -166,6 +235,29 @@ func (s *Server) createCommitFromPatch(ctx context.Context, req protocol.CreateC
 		return out, err
 	}
 
+	run := func(cmd *exec.Cmd, reason string) ([]byte, error) {
+		return runChecked(cmd, reason, func(cmd wrexec.Cmder) ([]byte, error) {
+			// runRemoteGitCommand since one of our commands could be git push
+			return runRemoteGitCommand(ctx, cmd, true, nil)
+		})
+	}
+
+	// output is like run, but returns the standard output of the command
+	// only, for commands whose output is parsed.
+	output := func(cmd *exec.Cmd, reason string) ([]byte, error) {
+		var stdout, stderr bytes.Buffer
+		_, err := runChecked(cmd, reason, func(cmd wrexec.Cmder) ([]byte, error) {
+			cmd.Unwrap().Stdout = &stdout
+			cmd.Unwrap().Stderr = &stderr
+			_, err := runCommand(ctx, cmd)
+			return stderr.Bytes(), err
+		})
+		if err != nil {
+			return stderr.Bytes(), err
+		}
+		return stdout.Bytes(), nil
+	}
+
 	tmpGitPathEnv := "GIT_DIR=" + filepath.Join(tmpRepoDir, ".git")
 
 	tmpObjectsDir := filepath.Join(tmpRepoDir, ".git", "objects")
@@ -192,21 +284,23 @@ func (s *Server) createCommitFromPatch(ctx context.Context, req protocol.CreateC
 		return http.StatusInternalServerError, resp
 	}
 
-	applyArgs := append([]string{"apply", "--cached"}, req.GitApplyArgs...)
-
-	cmd = exec.CommandContext(ctx, "git", applyArgs...)
-	cmd.Dir = tmpRepoDir
-	cmd.Env = append(os.Environ(), tmpGitPathEnv, altObjectsEnv)
-	cmd.Stdin = bytes.NewReader(req.Patch)
+	newCmd := func(args ...string) *exec.Cmd {
+		cmd := exec.CommandContext(ctx, "git", args...)
+		cmd.Dir = tmpRepoDir
+		cmd.Env = append(os.Environ(), tmpGitPathEnv, altObjectsEnv)
+		return cmd
+	}
 
-	if out, err := run(cmd, "applying patch"); err != nil {
-		logger.Error("Failed to apply patch", log.String("output", string(out)))
-		return http.StatusBadRequest, resp
+	if status, err := stage(logger, newCmd, stageCommands{run: run, output: output}); err != nil {
+		if resp.Error == nil {
+			resp.SetError(repo, "", "", err)
+		}
+		return status, resp
 	}
 
 	messages := req.CommitInfo.Messages
 	if len(messages) == 0 {
-		messages = []string{"<Sourcegraph> Creating commit from patch"}
+		messages = []string{defaultMessage}
 	}
 	authorName := req.CommitInfo.AuthorName
 	if authorName == "" {
@@ -0,0 +1,178 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"path"
+	"strings"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func (s *Server) handleCreateCommitFromFileOperations(w http.ResponseWriter, r *http.Request) {
+	var req protocol.CreateCommitFromFileOperationsRequest
+	var resp protocol.CreateCommitFromPatchResponse
+	var status int
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		resp.SetError("", "", "", errors.Wrap(err, "decoding CreateCommitFromFileOperationsRequest"))
+		status = http.StatusBadRequest
+	} else {
+		status, resp = s.createCommitFromFileOperations(r.Context(), req)
+	}
+
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// createCommitFromFileOperations creates a commit on top of req.BaseCommit by
+// applying req.FileOperations in order. Pushing the commit or shelving it as a
+// Perforce changelist is the same as in createCommitFromPatch.
+func (s *Server) createCommitFromFileOperations(ctx context.Context, req protocol.CreateCommitFromFileOperationsRequest) (int, protocol.CreateCommitFromPatchResponse) {
+	for _, op := range req.FileOperations {
+		if err := validateFileOperation(op); err != nil {
+			var resp protocol.CreateCommitFromPatchResponse
+			resp.SetError(string(protocol.NormalizeRepo(req.Repo)), "", "", err)
+			return http.StatusBadRequest, resp
+		}
+	}
+
+	commitReq := protocol.CreateCommitFromPatchRequest{
+		Repo:       req.Repo,
+		BaseCommit: req.BaseCommit,
+		TargetRef:  req.TargetRef,
+		UniqueRef:  req.UniqueRef,
+		CommitInfo: req.CommitInfo,
+		Push:       req.Push,
+		PushRef:    req.PushRef,
+	}
+	return s.createCommit(ctx, "createCommitFromFileOperations", commitReq, "<Sourcegraph> Creating commit from file operations", func(logger log.Logger, newCmd func(args ...string) *exec.Cmd, cmds stageCommands) (int, error) {
+		for _, op := range req.FileOperations {
+			if status, err := stageFileOperation(newCmd, cmds, op); err != nil {
+				logger.Error("Failed to stage file operation", log.String("type", string(op.Type)), log.String("path", op.Path), log.Error(err))
+				return status, err
+			}
+		}
+		return http.StatusOK, nil
+	})
+}
+
+// validateFileOperation returns an error if op cannot be applied to any
+// repository, e.g. because its path is outside of the working tree.
+func validateFileOperation(op protocol.FileOperation) error {
+	switch op.Type {
+	case protocol.FileOperationWrite, protocol.FileOperationDelete, protocol.FileOperationChmod:
+	default:
+		return errors.Newf("invalid file operation type %q for %q", op.Type, op.Path)
+	}
+
+	if op.Path == "" {
+		return errors.New("file operation path must not be empty")
+	}
+	if op.Path != path.Clean(op.Path) || path.IsAbs(op.Path) || strings.ContainsRune(op.Path, 0) {
+		return errors.Newf("file operation path %q must be a clean relative path", op.Path)
+	}
+	for _, elem := range strings.Split(op.Path, "/") {
+		if elem == "." || elem == ".." || strings.EqualFold(elem, ".git") {
+			return errors.Newf("file operation path %q must not contain %q", op.Path, elem)
+		}
+	}
+	return nil
+}
+
+// zeroOID is the object ID which removes a path from the index in the input
+// of "git update-index --index-info".
+const zeroOID = "0000000000000000000000000000000000000000"
+
+// stageFileOperation applies op to the index of the temporary repository.
+func stageFileOperation(newCmd func(args ...string) *exec.Cmd, cmds stageCommands, op protocol.FileOperation) (int, error) {
+	var mode, oid string
+
+	switch op.Type {
+	case protocol.FileOperationWrite:
+		cmd := newCmd("hash-object", "-w", "--stdin")
+		cmd.Stdin = bytes.NewReader(op.Contents)
+		out, err := cmds.output(cmd, "writing blob")
+		if err != nil {
+			return http.StatusInternalServerError, err
+		}
+		mode, oid = fileMode(op.Executable), strings.TrimSpace(string(out))
+
+	case protocol.FileOperationDelete:
+		entry, err := indexEntry(newCmd, cmds, op.Path)
+		if err != nil {
+			return http.StatusInternalServerError, err
+		}
+		if entry == nil {
+			return http.StatusBadRequest, errors.Newf("cannot delete %q: no such file", op.Path)
+		}
+		mode, oid = "0", zeroOID
+
+	case protocol.FileOperationChmod:
+		entry, err := indexEntry(newCmd, cmds, op.Path)
+		if err != nil {
+			return http.StatusInternalServerError, err
+		}
+		if entry == nil {
+			return http.StatusBadRequest, errors.Newf("cannot chmod %q: no such file", op.Path)
+		}
+		if entry.mode != fileMode(false) && entry.mode != fileMode(true) {
+			return http.StatusBadRequest, errors.Newf("cannot chmod %q: not a regular file", op.Path)
+		}
+		mode, oid = fileMode(op.Executable), entry.oid
+
+	default:
+		return http.StatusBadRequest, errors.Newf("invalid file operation type %q", op.Type)
+	}
+
+	cmd := newCmd("update-index", "-z", "--index-info")
+	cmd.Stdin = strings.NewReader(fmt.Sprintf("%s %s\t%s\x00", mode, oid, op.Path))
+	if _, err := cmds.run(cmd, "updating index"); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusOK, nil
+}
+
+func fileMode(executable bool) string {
+	if executable {
+		return "100755"
+	}
+	return "100644"
+}
+
+type indexEntryInfo struct {
+	mode string
+	oid  string
+}
+
+// indexEntry returns the entry of the file at p in the index, or nil if there
+// is none. Directories have no entry.
+func indexEntry(newCmd func(args ...string) *exec.Cmd, cmds stageCommands, p string) (*indexEntryInfo, error) {
+	out, err := cmds.output(newCmd("ls-files", "--stage", "-z", "--", ":(literal)"+p), "listing index")
+	if err != nil {
+		return nil, err
+	}
+	// Each entry is "<mode> <oid> <stage>\t<path>\x00".
+	for _, line := range strings.Split(string(out), "\x00") {
+		info, entryPath, ok := strings.Cut(line, "\t")
+		if !ok || entryPath != p {
+			continue
+		}
+		fields := strings.Fields(info)
+		if len(fields) != 3 {
+			return nil, errors.Newf("unexpected index entry %q", line)
+		}
+		return &indexEntryInfo{mode: fields[0], oid: fields[1]}, nil
+	}
+	return nil, nil
+}
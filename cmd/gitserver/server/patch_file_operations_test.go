@@ -0,0 +1,119 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+)
+
+func TestCreateCommitFromFileOperations(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	reposDir := t.TempDir()
+	repoName := api.RepoName("example.com/foo/bar")
+	repoDir := filepath.Join(reposDir, string(repoName))
+	require.NoError(t, os.MkdirAll(repoDir, os.ModePerm))
+
+	cmd := func(name string, arg ...string) string {
+		t.Helper()
+		return runCmd(t, repoDir, name, arg...)
+	}
+	cmd("git", "init", ".")
+	cmd("sh", "-c", "echo hello world > hello.txt")
+	cmd("sh", "-c", "echo 'echo hi' > run.sh")
+	cmd("sh", "-c", "mkdir dir && echo a > dir/a.txt")
+	cmd("git", "add", ".")
+	cmd("git", "commit", "-m", "initial commit")
+	baseCommit := api.CommitID(strings.TrimSpace(cmd("git", "rev-parse", "HEAD")))
+
+	s := makeTestServer(ctx, t, reposDir, repoDir, nil)
+
+	createCommit := func(targetRef string, ops ...protocol.FileOperation) (int, protocol.CreateCommitFromPatchResponse) {
+		return s.createCommitFromFileOperations(ctx, protocol.CreateCommitFromFileOperationsRequest{
+			Repo:           repoName,
+			BaseCommit:     baseCommit,
+			FileOperations: ops,
+			TargetRef:      targetRef,
+			CommitInfo: protocol.PatchCommitInfo{
+				Messages:   []string{"apply file operations"},
+				AuthorName: "alice",
+			},
+		})
+	}
+
+	t.Run("success", func(t *testing.T) {
+		status, resp := createCommit("refs/heads/file-ops",
+			protocol.FileOperation{Type: protocol.FileOperationWrite, Path: "hello.txt", Contents: []byte("goodbye\n")},
+			protocol.FileOperation{Type: protocol.FileOperationWrite, Path: "new/file.txt", Contents: []byte("new\n"), Executable: true},
+			protocol.FileOperation{Type: protocol.FileOperationDelete, Path: "dir/a.txt"},
+			protocol.FileOperation{Type: protocol.FileOperationChmod, Path: "run.sh", Executable: true},
+		)
+		require.Nil(t, resp.Error)
+		require.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "refs/heads/file-ops", resp.Rev)
+
+		var tree []string
+		for _, line := range strings.Split(strings.TrimSpace(cmd("git", "ls-tree", "-r", "file-ops")), "\n") {
+			// Each line is "<mode> <type> <oid>\t<path>".
+			info, path, _ := strings.Cut(line, "\t")
+			tree = append(tree, strings.Fields(info)[0]+" "+path)
+		}
+		assert.Equal(t, []string{"100644 hello.txt", "100755 new/file.txt", "100755 run.sh"}, tree)
+		assert.Equal(t, "goodbye\n", cmd("git", "show", "file-ops:hello.txt"))
+		assert.Equal(t, "new\n", cmd("git", "show", "file-ops:new/file.txt"))
+		assert.Equal(t, stylizeCommitMessage("apply file operations"), strings.TrimSpace(cmd("git", "log", "-1", "--format=%B", "file-ops")))
+		assert.Equal(t, string(baseCommit)+"\n", cmd("git", "rev-parse", "file-ops^"))
+	})
+
+	t.Run("invalid path", func(t *testing.T) {
+		for _, p := range []string{"", "/abs", "../outside", "a/../b", ".git/config", "dir/", "./hello.txt"} {
+			status, resp := createCommit("refs/heads/invalid", protocol.FileOperation{Type: protocol.FileOperationWrite, Path: p})
+			assert.Equal(t, http.StatusBadRequest, status, "path %q", p)
+			assert.NotNil(t, resp.Error, "path %q", p)
+		}
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		status, resp := createCommit("refs/heads/missing", protocol.FileOperation{Type: protocol.FileOperationDelete, Path: "dir"})
+		assert.Equal(t, http.StatusBadRequest, status)
+		require.NotNil(t, resp.Error)
+		assert.Contains(t, resp.Error.InternalError, `cannot delete "dir": no such file`)
+	})
+}
+
+func TestIsAllowedStagingGitCmd(t *testing.T) {
+	for _, tc := range []struct {
+		args []string
+		want bool
+	}{
+		{[]string{"hash-object", "-w", "--stdin"}, true},
+		{[]string{"ls-files", "--stage", "-z", "--", ":(literal)a/b.txt"}, true},
+		{[]string{"update-index", "-z", "--index-info"}, true},
+		{[]string{"hash-object", "-w", "/etc/passwd"}, false},
+		{[]string{"ls-files", "--stage", "-z", "--", "a", "b"}, false},
+		{[]string{"update-index", "--add", "a"}, false},
+		{[]string{"log"}, false},
+		{nil, false},
+	} {
+		if got := isAllowedStagingGitCmd(tc.args); got != tc.want {
+			t.Errorf("isAllowedStagingGitCmd(%q) = %v, want %v", tc.args, got, tc.want)
+		}
+	}
+
+	// The staging commands are not allowed in exec requests.
+	if gitdomain.IsAllowedGitCmd(logtest.Scoped(t), []string{"update-index", "-z", "--index-info"}) {
+		t.Error("update-index must not be allowed in exec requests")
+	}
+}
@@ -45,7 +45,11 @@ func (s *Server) repoCloneProgress(repo api.RepoName) *protocol.RepoCloneProgres
 	resp := protocol.RepoCloneProgress{
 		Cloned: repoCloned(dir),
 	}
-	resp.CloneProgress, resp.CloneInProgress = s.locker.Status(dir)
+	// The repository is also locked while maintenance runs, which doesn't
+	// clone it.
+	if status, locked := s.locker.Status(dir); locked && status != maintenanceLockStatus {
+		resp.CloneProgress, resp.CloneInProgress = status, true
+	}
 	if isAlwaysCloningTest(repo) {
 		resp.CloneInProgress = true
 		resp.CloneProgress = "This will never finish cloning"
@@ -74,6 +78,46 @@ func (s *Server) handleRepoCloneProgress(w http.ResponseWriter, r *http.Request)
 	}
 }
 
+func (s *Server) handleRepoSizeBreakdown(w http.ResponseWriter, r *http.Request) {
+	var req protocol.RepoSizeBreakdownRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if req.Repo == "" {
+		http.Error(w, "no Repo given", http.StatusBadRequest)
+		return
+	}
+	resp, err := s.repoSizeBreakdown(r.Context(), req.Repo)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// repoSizeBreakdown returns the disk usage of repo broken down by the kind of
+// data stored, and its disk quota.
+func (s *Server) repoSizeBreakdown(ctx context.Context, repo api.RepoName) (*protocol.RepoSizeBreakdown, error) {
+	repo = protocol.NormalizeRepo(repo)
+	syncer, err := s.GetVCSSyncer(ctx, repo)
+	if err != nil {
+		return nil, errors.Wrap(err, "get VCS syncer")
+	}
+
+	var resp protocol.RepoSizeBreakdown
+	if dir := s.dir(repo); repoCloned(dir) {
+		resp = gitDirSizeBreakdown(dir)
+	}
+	resp.DiskQuotaBytes = diskQuota(syncer)
+	return &resp, nil
+}
+
 func (s *Server) handleRepoDelete(w http.ResponseWriter, r *http.Request) {
 	var req protocol.RepoDeleteRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
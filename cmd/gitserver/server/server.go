@@ -29,6 +29,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/exp/slices"
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/sync/semaphore"
 	"golang.org/x/time/rate"
@@ -43,6 +44,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/diskcache"
 	"github.com/sourcegraph/sourcegraph/internal/env"
 	"github.com/sourcegraph/sourcegraph/internal/featureflag"
 	"github.com/sourcegraph/sourcegraph/internal/fileutil"
@@ -72,6 +74,14 @@ const tempDirName = ".tmp"
 // and where it will store cache data.
 const P4HomeName = ".p4home"
 
+// SVNHomeName is the name used for the directory that git svn will use as
+// $HOME, where Subversion stores its configuration.
+const SVNHomeName = ".svnhome"
+
+// HgHomeName is the name used for the directory that hg and hg-fast-export
+// will use as $HOME, where Mercurial reads its configuration from.
+const HgHomeName = ".hghome"
+
 // traceLogs is controlled via the env SRC_GITSERVER_TRACE. If true we trace
 // logs to stderr
 var traceLogs bool
@@ -267,6 +277,21 @@ type Server struct {
 
 	locker *RepositoryLocker
 
+	// maintenance schedules the maintenance of repositories by their traffic.
+	maintenance *maintenanceScheduler
+
+	// blameCache caches the output of git blame. It is nil if the cache is
+	// disabled.
+	blameCache diskcache.Store
+
+	// partialCloneRemoteURLs caches the remote URLs passed to the commands run
+	// in partial clones.
+	partialCloneRemoteURLs remoteURLCache
+
+	// lfsMaxObjectSizes caches the size limit of the LFS objects of the repos
+	// whose file reads ask for LFS content.
+	lfsMaxObjectSizes lfsMaxObjectSizeCache
+
 	// cloneLimiter and cloneableLimiter limits the number of concurrent
 	// clones and ls-remotes respectively. Use s.acquireCloneLimiter() and
 	// s.acquireClonableLimiter() instead of using these directly.
@@ -381,6 +406,8 @@ func headerXRequestedWithMiddleware(next http.Handler) http.HandlerFunc {
 func (s *Server) Handler() http.Handler {
 	s.ctx, s.cancel = context.WithCancel(context.Background())
 	s.locker = &RepositoryLocker{}
+	s.maintenance = newMaintenanceScheduler()
+	s.blameCache = newBlameCache(s.ReposDir)
 	s.repoUpdateLocks = make(map[api.RepoName]*locks)
 
 	// GitMaxConcurrentClones controls the maximum number of clones that
@@ -444,10 +471,13 @@ func (s *Server) Handler() http.Handler {
 	mux.HandleFunc("/is-repo-cloneable", trace.WithRouteName("is-repo-cloneable", s.handleIsRepoCloneable))
 	mux.HandleFunc("/repos-stats", trace.WithRouteName("repos-stats", s.handleReposStats))
 	mux.HandleFunc("/repo-clone-progress", trace.WithRouteName("repo-clone-progress", s.handleRepoCloneProgress))
+	mux.HandleFunc("/repo-size-breakdown", trace.WithRouteName("repo-size-breakdown", s.handleRepoSizeBreakdown))
+	mux.HandleFunc("/repo-maintenance", trace.WithRouteName("repo-maintenance", s.handleRepoMaintenance))
 	mux.HandleFunc("/delete", trace.WithRouteName("delete", s.handleRepoDelete))
 	mux.HandleFunc("/repo-update", trace.WithRouteName("repo-update", s.handleRepoUpdate))
 	mux.HandleFunc("/repo-clone", trace.WithRouteName("repo-clone", s.handleRepoClone))
 	mux.HandleFunc("/create-commit-from-patch-binary", trace.WithRouteName("create-commit-from-patch-binary", s.handleCreateCommitFromPatchBinary))
+	mux.HandleFunc("/create-commit-from-file-operations", trace.WithRouteName("create-commit-from-file-operations", s.handleCreateCommitFromFileOperations))
 	mux.HandleFunc("/ping", trace.WithRouteName("ping", func(w http.ResponseWriter, _ *http.Request) {
 		w.WriteHeader(http.StatusOK)
 	}))
@@ -506,6 +536,8 @@ func (s *Server) Janitor(ctx context.Context, interval time.Duration) {
 		return
 	}
 
+	go s.runMaintenance(ctx)
+
 	for {
 		gitserverAddrs := gitserver.NewGitserverAddressesFromConf(conf.Get())
 		s.cleanupRepos(actor.WithInternalActor(ctx), gitserverAddrs)
@@ -552,6 +584,27 @@ func (s *Server) addrForRepo(repoName api.RepoName, gitServerAddrs gitserver.Git
 	return gitServerAddrs.AddrForRepo(filepath.Base(os.Args[0]), repoName)
 }
 
+func (s *Server) addrsForRepo(repoName api.RepoName, gitServerAddrs gitserver.GitserverAddresses) []string {
+	return gitServerAddrs.AddrsForRepo(filepath.Base(os.Args[0]), repoName)
+}
+
+// isReplica reports whether this gitserver stores a replica of a repo whose
+// primary is another gitserver. Only the primary records the state of a repo
+// in the database.
+func (s *Server) isReplica(repoName api.RepoName) bool {
+	gitServerAddrs := gitserver.NewGitserverAddressesFromConf(conf.Get())
+	if gitServerAddrs.ReplicationFactor <= 1 {
+		return false
+	}
+	return s.isReplicaOf(s.addrsForRepo(repoName, gitServerAddrs))
+}
+
+// isReplicaOf is like isReplica, given the addresses of the gitservers which
+// store a replica of a repo.
+func (s *Server) isReplicaOf(addrs []string) bool {
+	return !s.hostnameMatch(addrs[0]) && slices.ContainsFunc(addrs[1:], s.hostnameMatch)
+}
+
 // StartClonePipeline clones repos asynchronously. It creates a producer-consumer
 // pipeline.
 func (s *Server) StartClonePipeline(ctx context.Context) {
@@ -759,10 +812,15 @@ func (s *Server) syncRepoState(gitServerAddrs gitserver.GitserverAddresses, batc
 			// directory.
 			repo.Name = api.UndeletedRepoName(repo.Name)
 
-			// Ensure we're only dealing with repos we are responsible for.
-			addr := s.addrForRepo(repo.Name, gitServerAddrs)
-			if !s.hostnameMatch(addr) {
-				repoSyncStateCounter.WithLabelValues("other_shard").Inc()
+			// Ensure we're only dealing with repos we are responsible for. The
+			// state of replicated repos is synced by their primary.
+			addrs := s.addrsForRepo(repo.Name, gitServerAddrs)
+			if !s.hostnameMatch(addrs[0]) {
+				if s.isReplicaOf(addrs) {
+					repoSyncStateCounter.WithLabelValues("replica").Inc()
+				} else {
+					repoSyncStateCounter.WithLabelValues("other_shard").Inc()
+				}
 				continue
 			}
 			repoSyncStateCounter.WithLabelValues("this_shard").Inc()
@@ -906,12 +964,12 @@ func tempDir(reposDir, prefix string) (name string, err error) {
 }
 
 func (s *Server) ignorePath(path string) bool {
-	// We ignore any path which starts with .tmp or .p4home in ReposDir
+	// We ignore any path which starts with .tmp, .p4home or .svnhome in ReposDir
 	if filepath.Dir(path) != s.ReposDir {
 		return false
 	}
 	base := filepath.Base(path)
-	return strings.HasPrefix(base, tempDirName) || strings.HasPrefix(base, P4HomeName)
+	return strings.HasPrefix(base, tempDirName) || strings.HasPrefix(base, P4HomeName) || strings.HasPrefix(base, SVNHomeName) || strings.HasPrefix(base, HgHomeName) || strings.HasPrefix(base, BlameCacheDirName)
 }
 
 func (s *Server) handleIsRepoCloneable(w http.ResponseWriter, r *http.Request) {
@@ -1088,6 +1146,7 @@ func (s *Server) handleArchive(w http.ResponseWriter, r *http.Request) {
 		repo      = q.Get("repo")
 		format    = q.Get("format")
 		pathspecs = q["path"]
+		smudgeLFS = q.Get("lfs") == "true"
 	)
 
 	// Log which which actor is accessing the repo.
@@ -1123,6 +1182,7 @@ func (s *Server) handleArchive(w http.ResponseWriter, r *http.Request) {
 
 			"--format=" + format,
 		},
+		SmudgeLFS: smudgeLFS,
 	}
 
 	if format == string(gitserver.ArchiveFormatZip) {
@@ -1738,13 +1798,63 @@ func (s *Server) exec(ctx context.Context, logger log.Logger, req *protocol.Exec
 	stderrW := &writeCounter{w: &limitWriter{W: &stderrBuf, N: 1024}}
 
 	cmdStart = time.Now()
+
+	// Special-case `git blame` requests. These are expensive on large files and
+	// are invoked repeatedly for the same files by the blame view and
+	// file:has.contributor() searches.
+	if blameReq, ok := parseBlameArgs(req.Args); ok {
+		if served, err := s.serveCachedBlame(ctx, logger, req.Repo, dir, blameReq, stdoutW); served {
+			stdoutN = stdoutW.n
+			if err != nil {
+				status = "1"
+				return execStatus{Err: err, ExitStatus: 1}, nil
+			}
+			status = "0"
+			return execStatus{}, nil
+		}
+	}
+
 	cmd := s.RecordingCommandFactory.Command(ctx, s.Logger, "git", req.Args...)
 	dir.Set(cmd.Unwrap())
 	cmd.Unwrap().Stdout = stdoutW
 	cmd.Unwrap().Stderr = stderrW
 	cmd.Unwrap().Stdin = bytes.NewReader(req.Stdin)
 
+	// Replace Git LFS pointer files in blobs and archives by the content of the
+	// files, if the client asked for it and LFS objects are fetched for the
+	// repository.
+	var lfsSmudger io.WriteCloser
+	if req.SmudgeLFS {
+		if lfsSmudger = s.lfsSmudgeWriter(ctx, logger, req.Repo, dir, req.Args, stdoutW); lfsSmudger != nil {
+			cmd.Unwrap().Stdout = lfsSmudger
+		}
+	}
+
+	// Commands reading blobs which were not fetched into a partial clone fetch
+	// them lazily from the code host.
+	partialClone := isPartialClone(dir)
+	if partialClone {
+		partialCloneExecCounter.WithLabelValues(req.Args[0]).Inc()
+		if remoteURL, err := s.partialCloneRemoteURLs.get(ctx, req.Repo, s.getRemoteURL); err != nil {
+			// The command still succeeds if it doesn't read missing blobs.
+			logger.Warn("failed to get remote URL of partial clone", log.Error(err))
+		} else {
+			// Inherit the process environment, as runRemoteGitCommand does,
+			// since setting Env replaces it.
+			if cmd.Unwrap().Env == nil {
+				cmd.Unwrap().Env = os.Environ()
+			}
+			cmd.Unwrap().Env = append(cmd.Unwrap().Env, promisorRemoteEnv(remoteURL)...)
+			configureRemoteGitCommand(cmd.Unwrap(), tlsExternal())
+		}
+	}
+
 	exitStatus, execErr = runCommand(ctx, cmd)
+	if lfsSmudger != nil {
+		if err := lfsSmudger.Close(); err != nil && execErr == nil {
+			execErr = errors.Wrap(err, "failed to smudge Git LFS pointers")
+		}
+	}
 
 	status = strconv.Itoa(exitStatus)
 	stdoutN = stdoutW.n
@@ -1752,6 +1862,9 @@ func (s *Server) exec(ctx context.Context, logger log.Logger, req *protocol.Exec
 
 	stderr := stderrBuf.String()
 	s.logIfCorrupt(ctx, req.Repo, dir, stderr)
+	if partialClone && strings.Contains(stderr, "from promisor remote") {
+		lazyFetchFailedCounter.WithLabelValues(req.Args[0]).Inc()
+	}
 
 	return execStatus{
 		Err:        execErr,
@@ -1983,6 +2096,10 @@ func (s *Server) p4Exec(ctx context.Context, logger log.Logger, req *protocol.P4
 }
 
 func (s *Server) setLastFetched(ctx context.Context, name api.RepoName) error {
+	if s.isReplica(name) {
+		return nil
+	}
+
 	dir := s.dir(name)
 
 	lastFetched, err := repoLastFetched(dir)
@@ -2004,6 +2121,10 @@ func (s *Server) setLastFetched(ctx context.Context, name api.RepoName) error {
 
 // setLastErrorNonFatal will set the last_error column for the repo in the gitserver table.
 func (s *Server) setLastErrorNonFatal(ctx context.Context, name api.RepoName, err error) {
+	if s.isReplica(name) {
+		return
+	}
+
 	var errString string
 	if err != nil {
 		errString = err.Error()
@@ -2015,12 +2136,18 @@ func (s *Server) setLastErrorNonFatal(ctx context.Context, name api.RepoName, er
 }
 
 func (s *Server) setLastOutput(ctx context.Context, name api.RepoName, output string) {
+	if s.isReplica(name) {
+		return
+	}
 	if err := s.DB.GitserverRepos().SetLastOutput(ctx, name, output); err != nil {
 		s.Logger.Warn("Setting last output in DB", log.Error(err))
 	}
 }
 
 func (s *Server) setCloneStatus(ctx context.Context, name api.RepoName, status types.CloneStatus) (err error) {
+	if s.isReplica(name) {
+		return nil
+	}
 	return s.DB.GitserverRepos().SetCloneStatus(ctx, name, status, s.Hostname)
 }
 
@@ -2033,11 +2160,14 @@ func (s *Server) setCloneStatusNonFatal(ctx context.Context, name api.RepoName,
 
 // setRepoSize calculates the size of the repo and stores it in the database.
 func (s *Server) setRepoSize(ctx context.Context, name api.RepoName) error {
-	return s.DB.GitserverRepos().SetRepoSize(ctx, name, dirSize(s.dir(name).Path(".")), s.Hostname)
+	if s.isReplica(name) {
+		return nil
+	}
+	return s.DB.GitserverRepos().SetRepoSize(ctx, name, repoSize(s.dir(name)), s.Hostname)
 }
 
 func (s *Server) logIfCorrupt(ctx context.Context, repo api.RepoName, dir common.GitDir, stderr string) {
-	if checkMaybeCorruptRepo(s.Logger, repo, dir, stderr) {
+	if checkMaybeCorruptRepo(s.Logger, repo, dir, stderr) && !s.isReplica(repo) {
 		reason := stderr
 		if err := s.DB.GitserverRepos().LogCorruption(ctx, repo, reason, s.Hostname); err != nil {
 			s.Logger.Warn("failed to log repo corruption", log.String("repo", string(repo)), log.Error(err))
@@ -2114,6 +2244,10 @@ func (s *Server) cloneRepo(ctx context.Context, repo api.RepoName, opts *cloneOp
 		return "", errors.Wrap(err, "get VCS syncer")
 	}
 
+	if err := s.checkDiskQuota(ctx, repo, syncer, "clone"); err != nil {
+		return "", err
+	}
+
 	var remoteURL *vcs.URL
 	if opts != nil && opts.CloneFromShard != "" {
 		// are we cloning from the same gitserver instance?
@@ -2246,7 +2380,20 @@ func (s *Server) doClone(ctx context.Context, repo api.RepoName, dir common.GitD
 		s.setCloneStatusNonFatal(context.Background(), repo, cloneStatus(repoCloned(dir), false))
 	}()
 
-	cmd, err := syncer.CloneCommand(ctx, remoteURL, tmpPath)
+	// We cancel the clone once it grows larger than the disk quota of the
+	// repository, rather than filling the disk with a clone we refuse anyway.
+	quota := diskQuota(syncer)
+	cloneCtx, cancelClone := context.WithCancel(ctx)
+	defer cancelClone()
+	var quotaExceededSize atomic.Int64
+	if quota > 0 {
+		go watchDiskQuota(cloneCtx, tmpPath, quota, func(size int64) {
+			quotaExceededSize.Store(size)
+			cancelClone()
+		})
+	}
+
+	cmd, err := syncer.CloneCommand(cloneCtx, remoteURL, tmpPath)
 	if err != nil {
 		return errors.Wrap(err, "get clone command")
 	}
@@ -2265,11 +2412,30 @@ func (s *Server) doClone(ctx context.Context, repo api.RepoName, dir common.GitD
 
 	go readCloneProgress(s.DB, logger, newURLRedactor(remoteURL), lock, pr, repo)
 
-	output, err := runRemoteGitCommand(ctx, s.RecordingCommandFactory.Wrap(ctx, s.Logger, cmd), true, pw)
+	output, err := runRemoteGitCommand(cloneCtx, s.RecordingCommandFactory.Wrap(ctx, s.Logger, cmd), true, pw)
+	cancelClone()
 
 	// best-effort update the output of the clone
 	go s.setLastOutput(context.Background(), repo, redactor.redact(string(output)))
 
+	if quota > 0 {
+		size := quotaExceededSize.Load()
+		if size == 0 {
+			size = dirSize(tmpPath)
+		}
+		if size > quota {
+			diskQuotaExceededCounter.WithLabelValues("clone").Inc()
+			// Record the size, so that checkDiskQuota defers further clones
+			// until the quota is raised.
+			if !s.isReplica(repo) {
+				if _, err := s.DB.GitserverRepos().UpdateRepoSizes(ctx, s.Hostname, map[api.RepoName]int64{repo: size}); err != nil {
+					logger.Warn("failed to record size of repo exceeding its disk quota", log.Error(err))
+				}
+			}
+			return &diskQuotaExceededError{repo: repo, size: size, quota: quota}
+		}
+	}
+
 	if err != nil {
 		return errors.Wrapf(err, "clone failed. Output: %s", redactor.redact(string(output)))
 	}
@@ -2652,6 +2818,10 @@ func (s *Server) doBackgroundRepoUpdate(repo api.RepoName, revspec string) error
 		return errors.Wrap(err, "get VCS syncer")
 	}
 
+	if err := s.checkDiskQuota(ctx, repo, syncer, "fetch"); err != nil {
+		return err
+	}
+
 	// drop temporary pack files after a fetch. this function won't
 	// return until this fetch has completed or definitely-failed,
 	// either way they can't still be in use. we don't care exactly
@@ -2688,6 +2858,10 @@ func (s *Server) doBackgroundRepoUpdate(repo api.RepoName, revspec string) error
 		logger.Warn("failed to update last changed time", log.Error(err))
 	}
 
+	// Fetches add objects which are not covered by the commit-graph and
+	// bitmap yet, so frequently fetched repos are maintained first.
+	s.maintenance.recordFetch(repo)
+
 	// Successfully updated, best-effort updating of db fetch state based on
 	// disk state.
 	if err := s.setLastFetched(ctx, repo); err != nil {
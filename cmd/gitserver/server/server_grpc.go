@@ -66,6 +66,18 @@ func (gs *GRPCServer) CreateCommitFromPatchBinary(ctx context.Context, req *prot
 
 }
 
+func (gs *GRPCServer) CreateCommitFromFileOperations(ctx context.Context, req *proto.CreateCommitFromFileOperationsRequest) (*proto.CreateCommitFromFileOperationsResponse, error) {
+	var r protocol.CreateCommitFromFileOperationsRequest
+	r.FromProto(req)
+	_, resp := gs.Server.createCommitFromFileOperations(ctx, r)
+
+	if resp.Error != nil {
+		return resp.ToFileOperationsProto(), resp.Error
+	}
+
+	return resp.ToFileOperationsProto(), nil
+}
+
 func (gs *GRPCServer) Exec(req *proto.ExecRequest, ss proto.GitserverService_ExecServer) error {
 	internalReq := protocol.ExecRequest{
 		Repo:           api.RepoName(req.GetRepo()),
@@ -73,6 +85,7 @@ func (gs *GRPCServer) Exec(req *proto.ExecRequest, ss proto.GitserverService_Exe
 		Args:           req.GetArgs(),
 		Stdin:          req.GetStdin(),
 		NoTimeout:      req.GetNoTimeout(),
+		SmudgeLFS:      req.GetSmudgeLfs(),
 	}
 
 	w := streamio.NewWriter(func(p []byte) error {
@@ -121,6 +134,7 @@ func (gs *GRPCServer) Archive(req *proto.ArchiveRequest, ss proto.GitserverServi
 			"--worktree-attributes",
 			"--format=" + req.GetFormat(),
 		},
+		SmudgeLFS: req.GetSmudgeLfs(),
 	}
 
 	if req.GetFormat() == string(gitserver.ArchiveFormatZip) {
@@ -396,6 +410,23 @@ func (gs *GRPCServer) ReposStats(ctx context.Context, req *proto.ReposStatsReque
 	return stats.ToProto(), nil
 }
 
+func (gs *GRPCServer) RepoSizeBreakdown(ctx context.Context, req *proto.RepoSizeBreakdownRequest) (*proto.RepoSizeBreakdownResponse, error) {
+	repo := api.RepoName(req.GetRepo())
+	resp, err := gs.Server.repoSizeBreakdown(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	return resp.ToProto(), nil
+}
+
+func (gs *GRPCServer) RepoMaintenance(ctx context.Context, req *proto.RepoMaintenanceRequest) (*proto.RepoMaintenanceResponse, error) {
+	repo := api.RepoName(req.GetRepo())
+	if repo == "" {
+		return nil, status.Error(codes.InvalidArgument, "no repo given")
+	}
+	return gs.Server.repoMaintenance(repo, req.GetTrigger()).ToProto(), nil
+}
+
 func (gs *GRPCServer) IsRepoCloneable(ctx context.Context, req *proto.IsRepoCloneableRequest) (*proto.IsRepoCloneableResponse, error) {
 	repo := api.RepoName(req.GetRepo())
 	resp, err := gs.Server.IsRepoCloneable(ctx, repo)
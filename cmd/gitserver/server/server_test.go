@@ -1992,6 +1992,8 @@ func TestIgnorePath(t *testing.T) {
 		{path: filepath.Join(reposDir, P4HomeName), shouldIgnore: true},
 		// Double check handling of trailing space
 		{path: filepath.Join(reposDir, P4HomeName+"   "), shouldIgnore: true},
+		{path: filepath.Join(reposDir, SVNHomeName), shouldIgnore: true},
+		{path: filepath.Join(reposDir, HgHomeName), shouldIgnore: true},
 		{path: filepath.Join(reposDir, "sourcegraph/sourcegraph"), shouldIgnore: false},
 	} {
 		t.Run("", func(t *testing.T) {
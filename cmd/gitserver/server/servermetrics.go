@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/ricochet2200/go-disk-usage/du"
 
 	"github.com/sourcegraph/log"
@@ -18,6 +19,25 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 )
 
+var (
+	cloneModeFetchCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "src_gitserver_clone_mode_fetch_total",
+		Help: "Number of clones and fetches of Git repositories by clone mode, which is full or a combination of partial, shallow and refspecs.",
+	}, []string{"type", "mode"})
+	partialCloneExecCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "src_gitserver_partial_clone_exec_total",
+		Help: "Number of exec requests on partial clones, which can lazily fetch missing blobs from the code host.",
+	}, []string{"cmd"})
+	lazyFetchFailedCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "src_gitserver_lazy_fetch_failed_total",
+		Help: "Number of exec requests on partial clones which failed to lazily fetch missing blobs from the code host.",
+	}, []string{"cmd"})
+	diskQuotaExceededCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "src_gitserver_disk_quota_exceeded_total",
+		Help: "Number of clones and fetches refused because the repository exceeds its disk quota.",
+	}, []string{"type"})
+)
+
 func (s *Server) RegisterMetrics(observationCtx *observation.Context, db dbutil.DB) {
 	if runtime.GOOS != "windows" {
 		s.Logger.Info("Enabling 'echo' metric")
@@ -168,7 +168,7 @@ func (s *vcsPackagesSyncer) fetchRevspec(ctx context.Context, name reposource.Pa
 		{
 			Scheme:        dep.Scheme(),
 			Name:          dep.PackageSyntax(),
-			Versions:      []dependencies.MinimalPackageRepoRefVersion{{Version: dep.PackageVersion(), LastCheckedAt: &instant}},
+			Versions:      []dependencies.MinimalPackageRepoRefVersion{{Version: dep.PackageVersion(), LastCheckedAt: &instant, License: packageLicense(dep)}},
 			LastCheckedAt: &instant,
 		},
 	}); err != nil {
@@ -220,6 +220,7 @@ func (s *vcsPackagesSyncer) fetchVersions(ctx context.Context, name reposource.P
 	}
 
 	var cloned []reposource.VersionedPackage
+	var licensed []dependencies.MinimalPackageRepoRef
 	for _, dependency := range cloneable {
 		if _, tagExists := tags[dependency.GitTagFromVersion()]; tagExists {
 			cloned = append(cloned, dependency)
@@ -229,6 +230,22 @@ func (s *vcsPackagesSyncer) fetchVersions(ctx context.Context, name reposource.P
 			errs = errors.Append(errs, errors.Wrapf(err, "error pushing dependency %q", dependency))
 		} else {
 			cloned = append(cloned, dependency)
+
+			if license := packageLicense(dependency); license != nil {
+				licensed = append(licensed, dependencies.MinimalPackageRepoRef{
+					Scheme:   dependency.Scheme(),
+					Name:     dependency.PackageSyntax(),
+					Versions: []dependencies.MinimalPackageRepoRefVersion{{Version: dependency.PackageVersion(), License: license}},
+				})
+			}
+		}
+	}
+
+	// Record the licenses learned while downloading. This is best-effort, as the
+	// versions themselves have already been synced successfully.
+	if len(licensed) > 0 {
+		if _, _, err := s.svc.InsertPackageRepoRefs(ctx, licensed); err != nil {
+			s.logger.Warn("failed to record package licenses", log.String("package", string(name)), log.Error(err))
 		}
 	}
 
@@ -274,6 +291,16 @@ func (s *vcsPackagesSyncer) fetchVersions(ctx context.Context, name reposource.P
 	return nil
 }
 
+// packageLicense returns the license learned while downloading the given dependency, if any.
+func packageLicense(dep reposource.VersionedPackage) *string {
+	if licensed, ok := dep.(reposource.LicensedPackage); ok && licensed.License() != "" {
+		license := licensed.License()
+		return &license
+	}
+
+	return nil
+}
+
 // gitPushDependencyTag downloads the dependency dep and updates
 // bareGitDirectory. If successful, bareGitDirectory will contain a new tag based
 // on dep.
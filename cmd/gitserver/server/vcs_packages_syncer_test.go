@@ -202,6 +202,18 @@ func TestVcsDependenciesSyncer_Fetch(t *testing.T) {
 	})
 }
 
+func TestPackageLicense(t *testing.T) {
+	npmDep, err := reposource.ParseNpmVersionedPackage("left-pad@1.3.0")
+	require.NoError(t, err)
+	require.Nil(t, packageLicense(npmDep))
+
+	npmDep.PackageLicense = "WTFPL"
+	require.Equal(t, "WTFPL", *packageLicense(npmDep))
+
+	fakeDep, _ := parseFakeDependency("foo@0.0.1")
+	require.Nil(t, packageLicense(fakeDep))
+}
+
 type fakeDepsService struct {
 	deps         map[reposource.PackageName]dependencies.PackageRepoReference
 	upsertedDeps []dependencies.MinimalPackageRepoRef
@@ -4,10 +4,15 @@ import (
 	"context"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/sourcegraph/log"
 
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/server/common"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/vcs"
 	"github.com/sourcegraph/sourcegraph/internal/wrexec"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -16,16 +21,137 @@ import (
 // gitRepoSyncer is a syncer for Git repositories.
 type gitRepoSyncer struct {
 	recordingCommandFactory *wrexec.RecordingCommandFactory
+	cloneOptions            GitCloneOptions
 }
 
 func NewGitRepoSyncer(r *wrexec.RecordingCommandFactory) *gitRepoSyncer {
 	return &gitRepoSyncer{recordingCommandFactory: r}
 }
 
+// NewGitRepoSyncerWithCloneOptions returns a syncer for Git repositories which
+// only clones and fetches what opts allow.
+func NewGitRepoSyncerWithCloneOptions(r *wrexec.RecordingCommandFactory, opts GitCloneOptions) *gitRepoSyncer {
+	return &gitRepoSyncer{recordingCommandFactory: r, cloneOptions: opts}
+}
+
+// GitCloneOptions restrict what is cloned and fetched of very large Git
+// repositories. The zero value clones a full mirror.
+type GitCloneOptions struct {
+	// BlobSizeLimit is the size limit of the blobs which are fetched, as in
+	// --filter=blob:limit=<size>. Other blobs are fetched lazily when they are
+	// read, which makes the repository a partial clone. Empty means no limit.
+	BlobSizeLimit string
+	// ShallowSince is the date of the oldest commits fetched, as in
+	// --shallow-since=<date>. Empty means the whole history is fetched.
+	ShallowSince string
+	// Refspecs replace the default refspecs fetched if not empty.
+	Refspecs []string
+	// DiskQuotaBytes is the maximum size of the repository on disk. Clones
+	// and fetches of larger repositories are refused. Zero means no quota.
+	DiskQuotaBytes int64
+	// FetchLFS enables fetching the Git LFS objects of the repository when
+	// clients read the files tracked by LFS.
+	FetchLFS bool
+	// LFSMaxObjectSizeBytes is the size limit of the LFS objects which are
+	// fetched. Zero means defaultLFSMaxObjectSize.
+	LFSMaxObjectSizeBytes int64
+}
+
+// Validate returns an error if the refspecs of o are not of the form
+// [+]<src>:<dst>, where src and dst are references under refs/ which can both
+// contain one "*" wildcard.
+func (o GitCloneOptions) Validate() error {
+	for _, refspec := range o.Refspecs {
+		if err := validateRefspec(refspec); err != nil {
+			return errors.Wrapf(err, "invalid refspec %q", refspec)
+		}
+	}
+	return nil
+}
+
+func validateRefspec(refspec string) error {
+	src, dst, ok := strings.Cut(strings.TrimPrefix(refspec, "+"), ":")
+	if !ok {
+		return errors.New("missing destination")
+	}
+	if strings.Count(src, "*") != strings.Count(dst, "*") {
+		return errors.New("source and destination must both or neither contain a wildcard")
+	}
+	for _, ref := range []string{src, dst} {
+		if !strings.HasPrefix(ref, "refs/") {
+			return errors.Newf("%q is not under refs/", ref)
+		}
+		if strings.Count(ref, "*") > 1 {
+			return errors.Newf("%q contains more than one wildcard", ref)
+		}
+		if strings.Contains(ref, "..") || strings.Contains(ref, "//") || strings.HasSuffix(ref, "/") || strings.HasSuffix(ref, ".lock") {
+			return errors.Newf("%q is not a valid reference name", ref)
+		}
+		for _, r := range ref {
+			if r <= ' ' || r == 0x7f || strings.ContainsRune(":~^?[\\", r) {
+				return errors.Newf("%q contains the invalid character %q", ref, r)
+			}
+		}
+	}
+	return nil
+}
+
+// fetchFlags returns the flags of git fetch which restrict what is fetched.
+func (o GitCloneOptions) fetchFlags() []string {
+	var flags []string
+	if filter := o.filter(); filter != "" {
+		flags = append(flags, "--filter="+filter)
+	}
+	if o.ShallowSince != "" {
+		flags = append(flags, "--shallow-since="+o.ShallowSince)
+	}
+	return flags
+}
+
+// filter returns the object filter of a partial clone, or an empty string.
+func (o GitCloneOptions) filter() string {
+	if o.BlobSizeLimit == "" {
+		return ""
+	}
+	return "blob:limit=" + o.BlobSizeLimit
+}
+
+// mode describes the restrictions of o for metrics, e.g. "partial+shallow".
+func (o GitCloneOptions) mode() string {
+	var restrictions []string
+	if o.BlobSizeLimit != "" {
+		restrictions = append(restrictions, "partial")
+	}
+	if o.ShallowSince != "" {
+		restrictions = append(restrictions, "shallow")
+	}
+	if len(o.Refspecs) > 0 {
+		restrictions = append(restrictions, "refspecs")
+	}
+	if len(restrictions) == 0 {
+		return "full"
+	}
+	return strings.Join(restrictions, "+")
+}
+
 func (s *gitRepoSyncer) Type() string {
 	return "git"
 }
 
+func (s *gitRepoSyncer) DiskQuota() int64 {
+	return s.cloneOptions.DiskQuotaBytes
+}
+
+func (s *gitRepoSyncer) LFSMaxObjectSize() int64 {
+	if !s.cloneOptions.FetchLFS {
+		return 0
+	}
+	if s.cloneOptions.LFSMaxObjectSizeBytes > 0 {
+		return s.cloneOptions.LFSMaxObjectSizeBytes
+	}
+	return defaultLFSMaxObjectSize
+}
+
 // IsCloneable checks to see if the Git remote URL is cloneable.
 func (s *gitRepoSyncer) IsCloneable(ctx context.Context, remoteURL *vcs.URL) error {
 	if isAlwaysCloningTestRemoteURL(remoteURL) {
@@ -65,6 +191,11 @@ func (s *gitRepoSyncer) CloneCommand(ctx context.Context, remoteURL *vcs.URL, tm
 		return nil, errors.Wrapf(&common.GitCommandError{Err: err}, "clone setup failed")
 	}
 
+	if err := configurePartialClone(common.GitDir(tmpPath), s.cloneOptions.filter()); err != nil {
+		return nil, errors.Wrap(err, "clone setup failed")
+	}
+	cloneModeFetchCounter.WithLabelValues("clone", s.cloneOptions.mode()).Inc()
+
 	cmd, _ = s.fetchCommand(ctx, remoteURL)
 	cmd.Dir = tmpPath
 	return cmd, nil
@@ -72,6 +203,12 @@ func (s *gitRepoSyncer) CloneCommand(ctx context.Context, remoteURL *vcs.URL, tm
 
 // Fetch tries to fetch updates of a Git repository.
 func (s *gitRepoSyncer) Fetch(ctx context.Context, remoteURL *vcs.URL, dir common.GitDir, revspec string) ([]byte, error) {
+	// The clone options can have changed since the repository was cloned.
+	if err := configurePartialClone(dir, s.cloneOptions.filter()); err != nil {
+		return nil, err
+	}
+	cloneModeFetchCounter.WithLabelValues("fetch", s.cloneOptions.mode()).Inc()
+
 	cmd, configRemoteOpts := s.fetchCommand(ctx, remoteURL)
 	dir.Set(cmd)
 	if output, err := runRemoteGitCommand(ctx, s.recordingCommandFactory.Wrap(ctx, log.NoOp(), cmd), configRemoteOpts, nil); err != nil {
@@ -85,28 +222,141 @@ func (s *gitRepoSyncer) RemoteShowCommand(ctx context.Context, remoteURL *vcs.UR
 	return exec.CommandContext(ctx, "git", "remote", "show", remoteURL.String()), nil
 }
 
+// fetchCommand returns the command fetching from remoteURL. The flags of the
+// clone options are also passed to custom git fetch commands and to the fetch
+// command of refspec overrides. Custom fetch commands which are not git fetch
+// are run as is.
 func (s *gitRepoSyncer) fetchCommand(ctx context.Context, remoteURL *vcs.URL) (cmd *exec.Cmd, configRemoteOpts bool) {
 	configRemoteOpts = true
+	flags := s.cloneOptions.fetchFlags()
 	if customCmd := customFetchCmd(ctx, remoteURL); customCmd != nil {
 		cmd = customCmd
+		if len(cmd.Args) >= 2 && filepath.Base(cmd.Args[0]) == "git" && cmd.Args[1] == "fetch" {
+			cmd.Args = append(append(cmd.Args[:2:2], flags...), cmd.Args[2:]...)
+		}
 		configRemoteOpts = false
 	} else if useRefspecOverrides() {
-		cmd = refspecOverridesFetchCmd(ctx, remoteURL)
+		cmd = refspecOverridesFetchCmd(ctx, remoteURL, flags)
 	} else {
-		cmd = exec.CommandContext(ctx, "git", "fetch",
-			"--progress", "--prune", remoteURL.String(),
-			// Normal git refs
-			"+refs/heads/*:refs/heads/*", "+refs/tags/*:refs/tags/*",
-			// GitHub pull requests
-			"+refs/pull/*:refs/pull/*",
-			// GitLab merge requests
-			"+refs/merge-requests/*:refs/merge-requests/*",
-			// Bitbucket pull requests
-			"+refs/pull-requests/*:refs/pull-requests/*",
-			// Gerrit changesets
-			"+refs/changes/*:refs/changes/*",
-			// Possibly deprecated refs for sourcegraph zap experiment?
-			"+refs/sourcegraph/*:refs/sourcegraph/*")
+		args := append([]string{"fetch", "--progress", "--prune"}, flags...)
+		args = append(args, remoteURL.String())
+		if len(s.cloneOptions.Refspecs) > 0 {
+			args = append(args, s.cloneOptions.Refspecs...)
+		} else {
+			args = append(args, defaultFetchRefspecs...)
+		}
+		cmd = exec.CommandContext(ctx, "git", args...)
 	}
 	return cmd, configRemoteOpts
 }
+
+// defaultFetchRefspecs are the refspecs fetched unless the clone options
+// restrict them.
+var defaultFetchRefspecs = []string{
+	// Normal git refs
+	"+refs/heads/*:refs/heads/*", "+refs/tags/*:refs/tags/*",
+	// GitHub pull requests
+	"+refs/pull/*:refs/pull/*",
+	// GitLab merge requests
+	"+refs/merge-requests/*:refs/merge-requests/*",
+	// Bitbucket pull requests
+	"+refs/pull-requests/*:refs/pull-requests/*",
+	// Gerrit changesets
+	"+refs/changes/*:refs/changes/*",
+	// Possibly deprecated refs for sourcegraph zap experiment?
+	"+refs/sourcegraph/*:refs/sourcegraph/*",
+}
+
+// configurePartialClone configures the repository at dir as a partial clone
+// with the given object filter, if the filter is not empty.
+//
+// The remote "origin" is the promisor remote which missing objects are fetched
+// from. Its URL is not stored in the repository since it can contain
+// credentials, it's passed to the commands which can fetch objects instead, see
+// promisorRemoteEnv.
+func configurePartialClone(dir common.GitDir, filter string) error {
+	if filter == "" {
+		return nil
+	}
+	current, err := gitConfigGet(dir, "remote.origin.partialclonefilter")
+	if err != nil {
+		return err
+	}
+	if current == filter {
+		return nil
+	}
+	for _, kv := range [][2]string{
+		{"extensions.partialClone", "origin"},
+		{"remote.origin.promisor", "true"},
+		{"remote.origin.partialclonefilter", filter},
+	} {
+		if err := gitConfigSet(dir, kv[0], kv[1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isPartialClone returns true if the repository at dir has objects from a
+// promisor remote, i.e. some of its objects can be missing and fetched lazily.
+func isPartialClone(dir common.GitDir) bool {
+	matches, _ := filepath.Glob(dir.Path("objects", "pack", "*.promisor"))
+	return len(matches) > 0
+}
+
+// promisorRemoteEnv returns the environment which lets git lazily fetch the
+// missing objects of a partial clone from remoteURL.
+func promisorRemoteEnv(remoteURL *vcs.URL) []string {
+	return []string{
+		"GIT_CONFIG_COUNT=1",
+		"GIT_CONFIG_KEY_0=remote.origin.url",
+		"GIT_CONFIG_VALUE_0=" + remoteURL.String(),
+	}
+}
+
+// partialCloneRemoteURLTTL is how long the remote URL of a partial clone is
+// reused by the commands run in it before it is requested again.
+const partialCloneRemoteURLTTL = time.Minute
+
+// remoteURLCache caches the remote URLs of partial clones, which every command
+// run in them needs to lazily fetch missing objects. The zero value is ready
+// to use.
+type remoteURLCache struct {
+	mu      sync.Mutex
+	entries map[api.RepoName]remoteURLCacheEntry
+}
+
+type remoteURLCacheEntry struct {
+	remoteURL *vcs.URL
+	expiresAt time.Time
+}
+
+// get returns the cached remote URL of repo, or calls getRemoteURL and caches
+// its result. Errors are not cached.
+func (c *remoteURLCache) get(ctx context.Context, repo api.RepoName, getRemoteURL func(context.Context, api.RepoName) (*vcs.URL, error)) (*vcs.URL, error) {
+	now := time.Now()
+	c.mu.Lock()
+	e, ok := c.entries[repo]
+	c.mu.Unlock()
+	if ok && now.Before(e.expiresAt) {
+		return e.remoteURL, nil
+	}
+
+	remoteURL, err := getRemoteURL(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil {
+		c.entries = map[api.RepoName]remoteURLCacheEntry{}
+	}
+	for name, e := range c.entries {
+		if !now.Before(e.expiresAt) {
+			delete(c.entries, name)
+		}
+	}
+	c.entries[repo] = remoteURLCacheEntry{remoteURL: remoteURL, expiresAt: now.Add(partialCloneRemoteURLTTL)}
+	return remoteURL, nil
+}
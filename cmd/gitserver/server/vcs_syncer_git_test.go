@@ -0,0 +1,193 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/internal/wrexec"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestGitRepoSyncer_fetchCommand(t *testing.T) {
+	remoteURL, err := vcs.ParseURL("https://example.com/foo/bar.git")
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name     string
+		opts     GitCloneOptions
+		wantArgs []string
+		wantMode string
+	}{
+		{
+			name:     "full",
+			wantArgs: append([]string{"git", "fetch", "--progress", "--prune", remoteURL.String()}, defaultFetchRefspecs...),
+			wantMode: "full",
+		},
+		{
+			name:     "partial and shallow",
+			opts:     GitCloneOptions{BlobSizeLimit: "1m", ShallowSince: "2022-01-01"},
+			wantArgs: append([]string{"git", "fetch", "--progress", "--prune", "--filter=blob:limit=1m", "--shallow-since=2022-01-01", remoteURL.String()}, defaultFetchRefspecs...),
+			wantMode: "partial+shallow",
+		},
+		{
+			name:     "refspecs",
+			opts:     GitCloneOptions{Refspecs: []string{"+refs/heads/main:refs/heads/main"}},
+			wantArgs: []string{"git", "fetch", "--progress", "--prune", remoteURL.String(), "+refs/heads/main:refs/heads/main"},
+			wantMode: "refspecs",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s := NewGitRepoSyncerWithCloneOptions(wrexec.NewNoOpRecordingCommandFactory(), tc.opts)
+			cmd, _ := s.fetchCommand(context.Background(), remoteURL)
+			assert.Equal(t, tc.wantArgs, cmd.Args)
+			assert.Equal(t, tc.wantMode, tc.opts.mode())
+		})
+	}
+}
+
+func TestGitRepoSyncer_partialClone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	// Create a remote with a blob larger than the limit.
+	remote := t.TempDir()
+	cmd := func(name string, arg ...string) string {
+		t.Helper()
+		return runCmd(t, remote, name, arg...)
+	}
+	cmd("git", "init", ".")
+	cmd("git", "config", "uploadpack.allowFilter", "true")
+	require.NoError(t, os.WriteFile(filepath.Join(remote, "small.txt"), []byte("small\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(remote, "large.txt"), bytes.Repeat([]byte("large\n"), 1000), 0o644))
+	cmd("git", "add", ".")
+	cmd("git", "commit", "-m", "initial commit")
+
+	remoteURL, err := vcs.ParseURL("file://" + remote)
+	require.NoError(t, err)
+
+	reposDir := t.TempDir()
+	repoName := api.RepoName("example.com/foo/bar")
+	s := makeTestServer(ctx, t, reposDir, remoteURL.String(), nil)
+	dir := s.dir(repoName)
+
+	syncer := NewGitRepoSyncerWithCloneOptions(s.RecordingCommandFactory, GitCloneOptions{BlobSizeLimit: "1k"})
+	cloneCmd, err := syncer.CloneCommand(ctx, remoteURL, string(dir))
+	require.NoError(t, err)
+	out, err := cloneCmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+	_, err = syncer.Fetch(ctx, remoteURL, dir, "")
+	require.NoError(t, err)
+
+	require.True(t, isPartialClone(dir))
+	missing := runCmd(t, string(dir), "git", "--git-dir", string(dir), "rev-list", "--objects", "--missing=print", "--all")
+	assert.Contains(t, missing, "?", "large blob should not have been fetched")
+
+	// Reading the large blob fetches it from the remote.
+	var stdout bytes.Buffer
+	status, err := s.exec(ctx, logtest.Scoped(t), &protocol.ExecRequest{
+		Repo: repoName,
+		Args: []string{"show", "HEAD:large.txt"},
+	}, "test", &stdout)
+	require.NoError(t, err)
+	require.NoError(t, status.Err, status.Stderr)
+	assert.Equal(t, strings.Repeat("large\n", 1000), stdout.String())
+
+	// Commands run in the partial clone inherit the environment of gitserver.
+	t.Setenv("TZ", "Asia/Tokyo")
+	stdout.Reset()
+	status, err = s.exec(ctx, logtest.Scoped(t), &protocol.ExecRequest{
+		Repo: repoName,
+		Args: []string{"log", "-n1", "--date=format-local:%z", "--format=%cd", "HEAD"},
+	}, "test", &stdout)
+	require.NoError(t, err)
+	require.NoError(t, status.Err, status.Stderr)
+	assert.Equal(t, "+0900\n", stdout.String())
+
+	// The remote URL is not stored in the repository.
+	value, err := gitConfigGet(dir, "remote.origin.url")
+	require.NoError(t, err)
+	assert.Empty(t, value)
+}
+
+func TestGitRepoSyncer_fetchCommandOverrides(t *testing.T) {
+	remoteURL, err := vcs.ParseURL("https://example.com/foo/bar.git")
+	require.NoError(t, err)
+	s := NewGitRepoSyncerWithCloneOptions(wrexec.NewNoOpRecordingCommandFactory(), GitCloneOptions{BlobSizeLimit: "1m", ShallowSince: "2022-01-01"})
+
+	t.Run("refspec overrides", func(t *testing.T) {
+		old := refspecOverrides
+		refspecOverrides = []string{"+refs/heads/main:refs/heads/main"}
+		t.Cleanup(func() { refspecOverrides = old })
+
+		cmd, _ := s.fetchCommand(context.Background(), remoteURL)
+		assert.Equal(t, []string{"git", "fetch", "--progress", "--prune", "--filter=blob:limit=1m", "--shallow-since=2022-01-01", remoteURL.String(), "+refs/heads/main:refs/heads/main"}, cmd.Args)
+	})
+
+	t.Run("custom git fetch", func(t *testing.T) {
+		old := customGitFetch
+		customGitFetch = func() map[string][]string {
+			return map[string][]string{"example.com/foo/bar.git": {"git", "fetch", "--no-tags", "origin"}}
+		}
+		t.Cleanup(func() { customGitFetch = old })
+
+		cmd, configRemoteOpts := s.fetchCommand(context.Background(), remoteURL)
+		assert.False(t, configRemoteOpts)
+		assert.Equal(t, []string{"git", "fetch", "--filter=blob:limit=1m", "--shallow-since=2022-01-01", "--no-tags", "origin"}, cmd.Args)
+	})
+}
+
+func TestGitCloneOptions_Validate(t *testing.T) {
+	for refspec, valid := range map[string]bool{
+		"+refs/heads/main:refs/heads/main":     true,
+		"refs/tags/*:refs/tags/*":              true,
+		"+refs/heads/release/*:refs/heads/r/*": true,
+		"refs/heads/main":                      false,
+		"--upload-pack=touch /tmp/x":           false,
+		"+refs/heads/*:refs/heads/main":        false,
+		"refs/heads/*/*:refs/heads/*/*":        false,
+		"HEAD:refs/heads/main":                 false,
+		"refs/heads/a..b:refs/heads/ab":        false,
+		"refs/heads/a b:refs/heads/ab":         false,
+		"refs/heads/main:refs/heads/main.lock": false,
+	} {
+		err := GitCloneOptions{Refspecs: []string{refspec}}.Validate()
+		assert.Equal(t, valid, err == nil, "refspec %q: %v", refspec, err)
+	}
+}
+
+func TestRemoteURLCache(t *testing.T) {
+	remoteURL, err := vcs.ParseURL("https://example.com/foo/bar.git")
+	require.NoError(t, err)
+
+	calls := 0
+	getRemoteURL := func(context.Context, api.RepoName) (*vcs.URL, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("unavailable")
+		}
+		return remoteURL, nil
+	}
+
+	var c remoteURLCache
+	_, err = c.get(context.Background(), "example.com/foo/bar", getRemoteURL)
+	require.Error(t, err)
+
+	// Errors are not cached, URLs are.
+	for i := 0; i < 2; i++ {
+		got, err := c.get(context.Background(), "example.com/foo/bar", getRemoteURL)
+		require.NoError(t, err)
+		assert.Equal(t, remoteURL, got)
+	}
+	assert.Equal(t, 2, calls)
+}
@@ -0,0 +1,130 @@
+package server
+
+import (
+	"context"
+	"os"
+	"os/exec"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/server/common"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/internal/wrexec"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// mercurialMirrorDir is the directory, relative to the Git directory, of the
+// Mercurial repository which changesets are pulled into before they are
+// converted.
+const mercurialMirrorDir = "hg"
+
+// MercurialRepoSyncer is a syncer for Mercurial repositories, which are
+// converted to Git repositories with hg-fast-export.
+//
+// Changesets are pulled into a Mercurial mirror inside the Git directory and
+// converted incrementally: hg-fast-export keeps the Mercurial revision to Git
+// commit mapping of the previous conversions in the hg2git-* files of the Git
+// directory, and records the changeset hash of each commit as a note in
+// refs/notes/hg.
+type MercurialRepoSyncer struct {
+	// HgHome is a directory we will pass to `hg` and `hg-fast-export`
+	// commands as the $HOME directory, where Mercurial reads its
+	// configuration from.
+	HgHome string
+}
+
+func (s *MercurialRepoSyncer) Type() string {
+	return "mercurial"
+}
+
+// IsCloneable checks to see if the Mercurial remote URL is cloneable.
+func (s *MercurialRepoSyncer) IsCloneable(ctx context.Context, remoteURL *vcs.URL) error {
+	cmd := exec.CommandContext(ctx, "hg", "identify", "--noninteractive", remoteURL.String())
+	cmd.Env = s.hgCommandEnv()
+	if output, err := runCommandCombinedOutput(ctx, wrexec.Wrap(ctx, nil, cmd)); err != nil {
+		return errors.Wrapf(err, "hg identify failed with output %q", newURLRedactor(remoteURL).redact(string(output)))
+	}
+	return nil
+}
+
+// CloneCommand initializes a bare Git repository for a Mercurial repository
+// at tmpPath and converts its history.
+//
+// Like vcsPackagesSyncer, the repository is already cloned when CloneCommand
+// returns, so the command returned is a no-op.
+func (s *MercurialRepoSyncer) CloneCommand(ctx context.Context, remoteURL *vcs.URL, tmpPath string) (*exec.Cmd, error) {
+	if err := os.MkdirAll(tmpPath, 0o755); err != nil {
+		return nil, err
+	}
+	dir := common.GitDir(tmpPath)
+
+	cmd := exec.CommandContext(ctx, "git", "--bare", "init")
+	dir.Set(cmd)
+	if output, err := runCommandCombinedOutput(ctx, wrexec.Wrap(ctx, nil, cmd)); err != nil {
+		return nil, errors.Wrapf(err, "failed to init repository with output %q", string(output))
+	}
+
+	if _, err := s.Fetch(ctx, remoteURL, dir, ""); err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch repo for %s", newURLRedactor(remoteURL).redact(remoteURL.String()))
+	}
+
+	// no-op command to satisfy VCSSyncer interface, see docstring for more details.
+	return exec.CommandContext(ctx, "git", "--version"), nil
+}
+
+// Fetch pulls the new changesets of a Mercurial repository and converts them
+// to Git commits. Mercurial named branches and tags are converted to Git
+// branches and tags, the "default" branch becoming "master".
+func (s *MercurialRepoSyncer) Fetch(ctx context.Context, remoteURL *vcs.URL, dir common.GitDir, _ string) ([]byte, error) {
+	redactor := newURLRedactor(remoteURL)
+	hgDir := dir.Path(mercurialMirrorDir)
+
+	if _, err := os.Stat(dir.Path(mercurialMirrorDir, ".hg")); os.IsNotExist(err) {
+		// The remote URL is not stored in the mirror, since it contains
+		// credentials. It is passed to every pull instead.
+		cmd := exec.CommandContext(ctx, "hg", "init", hgDir)
+		cmd.Env = s.hgCommandEnv()
+		if output, err := runCommandCombinedOutput(ctx, wrexec.Wrap(ctx, nil, cmd)); err != nil {
+			return nil, errors.Wrapf(err, "failed to init mercurial mirror with output %q", string(output))
+		}
+	}
+
+	// Example: hg pull --noninteractive -R $GIT_DIR/hg https://hg.example.com/project-a
+	cmd := exec.CommandContext(ctx, "hg", "pull", "--noninteractive", "-R", hgDir, remoteURL.String())
+	cmd.Env = s.hgCommandEnv()
+	output, err := runCommandCombinedOutput(ctx, wrexec.Wrap(ctx, nil, cmd))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to pull with output %q", redactor.redact(string(output)))
+	}
+
+	// hg-fast-export only converts the revisions which are not in its marks
+	// yet. --force allows branches with several heads, of which only the tip
+	// is converted.
+	//
+	// Example: hg-fast-export.sh -r $GIT_DIR/hg --hg-hash --force
+	cmd = exec.CommandContext(ctx, "hg-fast-export.sh", "-r", hgDir, "--hg-hash", "--force")
+	cmd.Env = s.hgCommandEnv()
+	dir.Set(cmd)
+	exportOutput, err := runCommandCombinedOutput(ctx, wrexec.Wrap(ctx, nil, cmd))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to convert repository with output %q", redactor.redact(string(exportOutput)))
+	}
+
+	return append(output, exportOutput...), nil
+}
+
+// RemoteShowCommand returns the command to be executed for showing Git remote
+// of a Mercurial repository.
+func (s *MercurialRepoSyncer) RemoteShowCommand(ctx context.Context, _ *vcs.URL) (cmd *exec.Cmd, err error) {
+	// Remote info is encoded as in the current repository
+	return exec.CommandContext(ctx, "git", "remote", "show", "./"), nil
+}
+
+func (s *MercurialRepoSyncer) hgCommandEnv() []string {
+	// HGPLAIN makes the output of hg independent of the user configuration.
+	env := append(os.Environ(), "HGPLAIN=1")
+	if s.HgHome != "" {
+		// hg reads its configuration from $HOME/.hgrc, we should pass in a
+		// directory under our control.
+		env = append(env, "HOME="+s.HgHome)
+	}
+	return env
+}
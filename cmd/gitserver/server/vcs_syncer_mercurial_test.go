@@ -0,0 +1,75 @@
+package server
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/server/common"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+)
+
+func TestMercurialRepoSyncer(t *testing.T) {
+	if _, err := exec.LookPath("hg"); err != nil {
+		t.Skip("hg is not installed")
+	}
+	if _, err := exec.LookPath("hg-fast-export.sh"); err != nil {
+		t.Skip("hg-fast-export is not installed")
+	}
+
+	ctx := context.Background()
+	root := t.TempDir()
+
+	output := func(dir, name string, args ...string) string {
+		t.Helper()
+		cmd := exec.Command(name, args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "HGPLAIN=1", "HGUSER=alice <alice@example.com>", "GIT_DIR="+filepath.Join(root, "clone", ".git"))
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("%s %s: %s: %s", name, strings.Join(args, " "), err, out)
+		}
+		return strings.TrimSpace(string(out))
+	}
+
+	// Create a Mercurial repository with a tag and a named branch.
+	hgRepo := filepath.Join(root, "hg")
+	output(root, "hg", "init", hgRepo)
+	require.NoError(t, os.WriteFile(filepath.Join(hgRepo, "README"), []byte("hello\n"), 0o644))
+	output(hgRepo, "hg", "commit", "-A", "-m", "initial commit")
+	firstChangeset := output(hgRepo, "hg", "log", "-r", ".", "--template", "{node}")
+	output(hgRepo, "hg", "tag", "v1")
+	output(hgRepo, "hg", "branch", "feature")
+	output(hgRepo, "hg", "commit", "-m", "start feature")
+
+	remoteURL, err := vcs.ParseURL("file://" + hgRepo)
+	require.NoError(t, err)
+
+	s := &MercurialRepoSyncer{HgHome: t.TempDir()}
+	require.NoError(t, s.IsCloneable(ctx, remoteURL))
+
+	tmpPath := filepath.Join(root, "clone", ".git")
+	cmd, err := s.CloneCommand(ctx, remoteURL, tmpPath)
+	require.NoError(t, err)
+	require.NoError(t, cmd.Run())
+
+	assert.Equal(t, "hello", output(root, "git", "show", "master:README"))
+	assert.Equal(t, "v1", output(root, "git", "tag", "--list"))
+	assert.Equal(t, "start feature", output(root, "git", "log", "-1", "--format=%s", "feature"))
+	assert.Equal(t, firstChangeset, output(root, "git", "log", "-1", "--notes=hg", "--format=%N", "v1"))
+
+	// New changesets are converted incrementally.
+	output(hgRepo, "hg", "update", "default")
+	require.NoError(t, os.WriteFile(filepath.Join(hgRepo, "README"), []byte("hello again\n"), 0o644))
+	output(hgRepo, "hg", "commit", "-m", "second commit")
+
+	_, err = s.Fetch(ctx, remoteURL, common.GitDir(tmpPath), "")
+	require.NoError(t, err)
+	assert.Equal(t, "hello again", output(root, "git", "show", "master:README"))
+}
@@ -88,13 +88,14 @@ func (s npmPackagesSyncer) GetPackage(ctx context.Context, name reposource.Packa
 }
 
 // updateTarballURL sends a GET request to find the URL to download the tarball of this package, and
-// sets the `NpmVersionedPackage.TarballURL` field accordingly.
+// sets the `NpmVersionedPackage.TarballURL` and `NpmVersionedPackage.PackageLicense` fields accordingly.
 func (s *npmPackagesSyncer) updateTarballURL(ctx context.Context, dep *reposource.NpmVersionedPackage) error {
 	f, err := s.client.GetDependencyInfo(ctx, dep)
 	if err != nil {
 		return err
 	}
 	dep.TarballURL = f.Dist.TarballURL
+	dep.PackageLicense = string(f.License)
 	return nil
 }
 
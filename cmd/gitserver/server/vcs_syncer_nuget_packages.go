@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"strings"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/nuget"
+	"github.com/sourcegraph/sourcegraph/internal/unpack"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func NewNuGetPackagesSyncer(
+	connection *schema.NuGetPackagesConnection,
+	svc *dependencies.Service,
+	client *nuget.Client,
+	reposDir string,
+) VCSSyncer {
+	return &vcsPackagesSyncer{
+		logger:      log.Scoped("NuGetPackagesSyncer", "sync NuGet packages"),
+		typ:         "nuget_packages",
+		scheme:      dependencies.NuGetPackagesScheme,
+		placeholder: reposource.NewNuGetVersionedPackage("sourcegraph/placeholder", "0.0.0"),
+		svc:         svc,
+		configDeps:  connection.Dependencies,
+		source:      &nugetDependencySource{client: client, reposDir: reposDir},
+	}
+}
+
+type nugetDependencySource struct {
+	client   *nuget.Client
+	reposDir string
+}
+
+func (nugetDependencySource) ParseVersionedPackageFromNameAndVersion(name reposource.PackageName, version string) (reposource.VersionedPackage, error) {
+	return reposource.ParseNuGetVersionedPackage(string(name) + "@" + version), nil
+}
+
+func (nugetDependencySource) ParseVersionedPackageFromConfiguration(dep string) (reposource.VersionedPackage, error) {
+	return reposource.ParseNuGetVersionedPackage(dep), nil
+}
+
+func (nugetDependencySource) ParsePackageFromName(name reposource.PackageName) (reposource.Package, error) {
+	return reposource.ParseNuGetPackageFromName(name), nil
+}
+
+func (nugetDependencySource) ParsePackageFromRepoName(repoName api.RepoName) (reposource.Package, error) {
+	return reposource.ParseNuGetPackageFromRepoName(repoName)
+}
+
+func (s *nugetDependencySource) Download(ctx context.Context, dir string, dep reposource.VersionedPackage) error {
+	pkgContents, err := s.client.GetPackageContents(ctx, dep)
+	if err != nil {
+		return errors.Wrapf(err, "error downloading NuGet package %q", dep.VersionedPackageSyntax())
+	}
+	defer pkgContents.Close()
+
+	if err = unpackNuGetPackage(pkgContents, s.reposDir, dir); err != nil {
+		return errors.Wrapf(err, "failed to unzip NuGet package %q", dep.VersionedPackageSyntax())
+	}
+
+	return nil
+}
+
+// unpackNuGetPackage unpacks the given .nupkg archive into workDir. The files
+// of the Open Packaging Conventions container, which are not part of the
+// package, are skipped.
+func unpackNuGetPackage(pkg io.Reader, reposDir, workDir string) error {
+	opts := unpack.Opts{
+		SkipInvalid:    true,
+		SkipDuplicates: true,
+		Filter: func(path string, file fs.FileInfo) bool {
+			if path == "[Content_Types].xml" || strings.HasPrefix(path, "_rels/") || strings.HasPrefix(path, "package/") {
+				return false
+			}
+
+			size := file.Size()
+
+			const sizeLimit = 15 * 1024 * 1024
+			if size >= sizeLimit {
+				return false
+			}
+
+			malicious := isPotentiallyMaliciousFilepathInArchive(path, workDir)
+			return !malicious
+		},
+	}
+
+	// A .nupkg is a zip archive, which cannot be unzipped in a streaming
+	// fashion, so we write it to a temporary file first.
+	tmpdir, err := tempDir(reposDir, "nuget-packages")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpdir)
+
+	zip, zipLen, err := writeZipToTemp(tmpdir, pkg)
+	if err != nil {
+		return err
+	}
+	defer zip.Close()
+
+	// Unlike npm and Python packages, the files of a NuGet package are not
+	// nested in a single directory, so they are unpacked as-is.
+	return unpack.Zip(zip, zipLen, workDir, opts)
+}
@@ -0,0 +1,67 @@
+package server
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnpackNuGetPackage(t *testing.T) {
+	var zipBuf bytes.Buffer
+	zw := zip.NewWriter(&zipBuf)
+	for _, f := range []fileInfo{
+		{path: "Newtonsoft.Json.nuspec", contents: []byte("<package/>")},
+		{path: "lib/net6.0/Newtonsoft.Json.xml", contents: []byte("<doc/>")},
+		{path: "README.md", contents: []byte("# Json.NET")},
+		{path: "[Content_Types].xml", contents: []byte("filter me")},
+		{path: "_rels/.rels", contents: []byte("filter me")},
+		{path: "package/services/metadata/core-properties/abc.psmdcp", contents: []byte("filter me")},
+		{path: ".git/index", contents: []byte("filter me")},
+	} {
+		fw, err := zw.Create(f.path)
+		require.NoError(t, err)
+		_, err = fw.Write(f.contents)
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+
+	tmp := t.TempDir()
+	require.NoError(t, unpackNuGetPackage(&zipBuf, t.TempDir(), tmp))
+
+	want := []string{"Newtonsoft.Json.nuspec", "README.md", "lib/net6.0/Newtonsoft.Json.xml"}
+	if d := cmp.Diff(want, listFiles(t, tmp)); d != "" {
+		t.Fatalf("-want,+got\n%s", d)
+	}
+}
+
+// listFiles returns the sorted paths of the regular files in dir, relative to
+// dir.
+func listFiles(t *testing.T, dir string) []string {
+	t.Helper()
+
+	var files []string
+	err := filepath.Walk(dir, func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, filepath.ToSlash(rel))
+		return nil
+	})
+	require.NoError(t, err)
+
+	sort.Strings(files)
+	return files
+}
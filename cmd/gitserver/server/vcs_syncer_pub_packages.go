@@ -0,0 +1,90 @@
+package server
+
+import (
+	"context"
+	"io"
+	"io/fs"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/pub"
+	"github.com/sourcegraph/sourcegraph/internal/unpack"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func NewPubPackagesSyncer(
+	connection *schema.PubPackagesConnection,
+	svc *dependencies.Service,
+	client *pub.Client,
+) VCSSyncer {
+	return &vcsPackagesSyncer{
+		logger:      log.Scoped("PubPackagesSyncer", "sync Pub packages"),
+		typ:         "pub_packages",
+		scheme:      dependencies.PubPackagesScheme,
+		placeholder: reposource.NewPubVersionedPackage("sourcegraph/placeholder", "0.0.0"),
+		svc:         svc,
+		configDeps:  connection.Dependencies,
+		source:      &pubDependencySource{client: client},
+	}
+}
+
+type pubDependencySource struct {
+	client *pub.Client
+}
+
+func (pubDependencySource) ParseVersionedPackageFromNameAndVersion(name reposource.PackageName, version string) (reposource.VersionedPackage, error) {
+	return reposource.ParsePubVersionedPackage(string(name) + "@" + version), nil
+}
+
+func (pubDependencySource) ParseVersionedPackageFromConfiguration(dep string) (reposource.VersionedPackage, error) {
+	return reposource.ParsePubVersionedPackage(dep), nil
+}
+
+func (pubDependencySource) ParsePackageFromName(name reposource.PackageName) (reposource.Package, error) {
+	return reposource.ParsePubPackageFromName(name), nil
+}
+
+func (pubDependencySource) ParsePackageFromRepoName(repoName api.RepoName) (reposource.Package, error) {
+	return reposource.ParsePubPackageFromRepoName(repoName)
+}
+
+func (s *pubDependencySource) Download(ctx context.Context, dir string, dep reposource.VersionedPackage) error {
+	pkgContents, err := s.client.GetPackageContents(ctx, dep)
+	if err != nil {
+		return errors.Wrapf(err, "error downloading Pub package %q", dep.VersionedPackageSyntax())
+	}
+	defer pkgContents.Close()
+
+	if err = unpackPubPackage(pkgContents, dir); err != nil {
+		return errors.Wrapf(err, "failed to unzip Pub package %q", dep.VersionedPackageSyntax())
+	}
+
+	return nil
+}
+
+// unpackPubPackage unpacks the given .tar.gz archive of a Pub package into
+// workDir. Unlike npm packages, the files of a Pub package are not nested in
+// a single directory, so they are unpacked as-is.
+func unpackPubPackage(pkg io.Reader, workDir string) error {
+	opts := unpack.Opts{
+		SkipInvalid:    true,
+		SkipDuplicates: true,
+		Filter: func(path string, file fs.FileInfo) bool {
+			size := file.Size()
+
+			const sizeLimit = 15 * 1024 * 1024
+			if size >= sizeLimit {
+				return false
+			}
+
+			malicious := isPotentiallyMaliciousFilepathInArchive(path, workDir)
+			return !malicious
+		},
+	}
+
+	return unpack.Tgz(pkg, workDir, opts)
+}
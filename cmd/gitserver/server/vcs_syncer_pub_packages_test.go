@@ -0,0 +1,28 @@
+package server
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnpackPubPackage(t *testing.T) {
+	pkg := bytes.NewReader(createTgz(t, []fileInfo{
+		{path: "pubspec.yaml", contents: []byte("name: http")},
+		{path: "lib/http.dart", contents: []byte("library http;")},
+		{path: ".git/index", contents: []byte("filter me")},
+		{path: "/absolute/path/are/filtered", contents: []byte("filter me")},
+	}))
+
+	tmp := t.TempDir()
+	require.NoError(t, unpackPubPackage(pkg, tmp))
+
+	// Files are not moved out of lib/, even though pubspec.yaml is the only
+	// other file left.
+	want := []string{"lib/http.dart", "pubspec.yaml"}
+	if d := cmp.Diff(want, listFiles(t, tmp)); d != "" {
+		t.Fatalf("-want,+got\n%s", d)
+	}
+}
@@ -0,0 +1,247 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/server/common"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/internal/wrexec"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// SubversionLayoutNone is the layout of Subversion repositories which do not
+// follow the trunk/, branches/ and tags/ convention. The whole repository is
+// imported as a single branch.
+const SubversionLayoutNone = "none"
+
+// subversionRemotePrefix is the prefix of the refs git svn fetches into.
+const subversionRemotePrefix = "refs/remotes/svn/"
+
+// subversionAskPassScript answers the credential prompts of git svn, which
+// are sent to $GIT_ASKPASS, from the environment.
+const subversionAskPassScript = `#!/bin/sh
+case "$1" in
+Username*) echo "$SRC_SVN_USERNAME" ;;
+*) echo "$SRC_SVN_PASSWORD" ;;
+esac
+`
+
+// SubversionRepoSyncer is a syncer for Subversion repositories, which are
+// imported as Git repositories with git svn.
+type SubversionRepoSyncer struct {
+	// Layout is the layout of the repositories, "standard" or "none". The
+	// default is the standard layout.
+	Layout string
+
+	// SVNHome is a directory we will pass to `git svn` and `svn` commands as
+	// the $HOME directory, where Subversion stores its configuration.
+	SVNHome string
+}
+
+func (s *SubversionRepoSyncer) Type() string {
+	return "subversion"
+}
+
+// IsCloneable checks to see if the Subversion remote URL is cloneable.
+func (s *SubversionRepoSyncer) IsCloneable(ctx context.Context, remoteURL *vcs.URL) error {
+	username, password, repoURL := decomposeSubversionRemoteURL(remoteURL)
+
+	args := []string{"info", "--non-interactive", "--no-auth-cache"}
+	if username != "" {
+		args = append(args, "--username", username, "--password-from-stdin")
+	}
+	args = append(args, repoURL)
+
+	cmd := exec.CommandContext(ctx, "svn", args...)
+	cmd.Env = s.svnCommandEnv()
+	cmd.Stdin = strings.NewReader(password)
+	if output, err := runCommandCombinedOutput(ctx, wrexec.Wrap(ctx, nil, cmd)); err != nil {
+		return errors.Wrapf(err, "svn info failed with output %q", newURLRedactor(remoteURL).redact(string(output)))
+	}
+	return nil
+}
+
+// CloneCommand initializes a bare Git repository for a Subversion repository
+// at tmpPath and fetches its history.
+//
+// Like vcsPackagesSyncer, the repository is already cloned when CloneCommand
+// returns, so the command returned is a no-op.
+func (s *SubversionRepoSyncer) CloneCommand(ctx context.Context, remoteURL *vcs.URL, tmpPath string) (*exec.Cmd, error) {
+	if err := os.MkdirAll(tmpPath, 0o755); err != nil {
+		return nil, err
+	}
+	dir := common.GitDir(tmpPath)
+
+	cmd := exec.CommandContext(ctx, "git", "--bare", "init")
+	dir.Set(cmd)
+	if output, err := runCommandCombinedOutput(ctx, wrexec.Wrap(ctx, nil, cmd)); err != nil {
+		return nil, errors.Wrapf(err, "failed to init repository with output %q", string(output))
+	}
+
+	// Example: git svn init --prefix=svn/ --stdlayout --username admin https://svn.example.com/repos/project
+	username, _, repoURL := decomposeSubversionRemoteURL(remoteURL)
+	args := []string{"svn", "init", "--prefix=svn/"}
+	if s.Layout != SubversionLayoutNone {
+		args = append(args, "--stdlayout")
+	}
+	if username != "" {
+		args = append(args, "--username", username)
+	}
+	args = append(args, repoURL)
+	cmd = exec.CommandContext(ctx, "git", args...)
+	cmd.Env = s.svnCommandEnv()
+	dir.Set(cmd)
+	if output, err := runCommandCombinedOutput(ctx, wrexec.Wrap(ctx, nil, cmd)); err != nil {
+		return nil, errors.Wrapf(err, "failed to init git svn with output %q", newURLRedactor(remoteURL).redact(string(output)))
+	}
+
+	if _, err := s.Fetch(ctx, remoteURL, dir, ""); err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch repo for %s", newURLRedactor(remoteURL).redact(remoteURL.String()))
+	}
+
+	// no-op command to satisfy VCSSyncer interface, see docstring for more details.
+	return exec.CommandContext(ctx, "git", "--version"), nil
+}
+
+// Fetch tries to fetch updates of a Subversion repository as a Git repository.
+// Subversion branches and tags are mirrored as Git branches and tags, and
+// "master" is updated to the trunk.
+func (s *SubversionRepoSyncer) Fetch(ctx context.Context, remoteURL *vcs.URL, dir common.GitDir, _ string) ([]byte, error) {
+	askPass, err := s.askPassPath()
+	if err != nil {
+		return nil, err
+	}
+	username, password, _ := decomposeSubversionRemoteURL(remoteURL)
+
+	// Example: git svn fetch
+	cmd := wrexec.CommandContext(ctx, nil, "git", "svn", "fetch")
+	cmd.Env = append(s.svnCommandEnv(),
+		"GIT_ASKPASS="+askPass,
+		"SRC_SVN_USERNAME="+username,
+		"SRC_SVN_PASSWORD="+password,
+	)
+	dir.Set(cmd.Cmd)
+	output, err := runCommandCombinedOutput(ctx, cmd)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update with output %q", newURLRedactor(remoteURL).redact(string(output)))
+	}
+
+	if err := s.updateRefs(ctx, dir); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// updateRefs force updates the Git branches and tags from the refs fetched by
+// git svn.
+func (s *SubversionRepoSyncer) updateRefs(ctx context.Context, dir common.GitDir) error {
+	cmd := exec.CommandContext(ctx, "git", "for-each-ref", "--format=%(refname) %(objectname)", subversionRemotePrefix)
+	dir.Set(cmd)
+	output, err := runCommandCombinedOutput(ctx, wrexec.Wrap(ctx, nil, cmd))
+	if err != nil {
+		return errors.Wrapf(err, "failed to list refs with output %q", string(output))
+	}
+
+	var updates bytes.Buffer
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		refname, oid, ok := strings.Cut(line, " ")
+		if !ok {
+			continue
+		}
+		if ref := subversionGitRef(s.Layout, strings.TrimPrefix(refname, subversionRemotePrefix)); ref != "" {
+			fmt.Fprintf(&updates, "update %s %s\n", ref, oid)
+		}
+	}
+	if updates.Len() == 0 {
+		return nil
+	}
+
+	cmd = exec.CommandContext(ctx, "git", "update-ref", "--stdin")
+	dir.Set(cmd)
+	cmd.Stdin = &updates
+	if output, err := runCommandCombinedOutput(ctx, wrexec.Wrap(ctx, nil, cmd)); err != nil {
+		return errors.Wrapf(err, "failed to update refs with output %q", string(output))
+	}
+	return nil
+}
+
+// subversionGitRef returns the Git ref to mirror a ref fetched by git svn to,
+// given its name below refs/remotes/svn/, or "" if it should not be mirrored.
+func subversionGitRef(layout, name string) string {
+	if layout == SubversionLayoutNone {
+		if name == "git-svn" {
+			return "refs/heads/master"
+		}
+		return ""
+	}
+
+	switch {
+	case name == "trunk":
+		return "refs/heads/master"
+	case strings.HasPrefix(name, "tags/"):
+		// git svn keeps the history of a tag, so a tag which was changed
+		// after it was created has refs like tags/v1@42 for its older
+		// versions.
+		if strings.Contains(name, "@") {
+			return ""
+		}
+		return "refs/tags/" + strings.TrimPrefix(name, "tags/")
+	case strings.Contains(name, "@"):
+		return ""
+	default:
+		return "refs/heads/" + name
+	}
+}
+
+// RemoteShowCommand returns the command to be executed for showing Git remote
+// of a Subversion repository.
+func (s *SubversionRepoSyncer) RemoteShowCommand(ctx context.Context, _ *vcs.URL) (cmd *exec.Cmd, err error) {
+	// Remote info is encoded as in the current repository
+	return exec.CommandContext(ctx, "git", "remote", "show", "./"), nil
+}
+
+func (s *SubversionRepoSyncer) svnCommandEnv() []string {
+	env := os.Environ()
+	if s.SVNHome != "" {
+		// svn stores its configuration and credential cache in
+		// $HOME/.subversion, we should pass in a directory under our control
+		// and ensure that it is writeable.
+		env = append(env, "HOME="+s.SVNHome)
+	}
+	return env
+}
+
+// askPassPath returns the path of the script answering the credential prompts
+// of git svn, writing it if necessary.
+func (s *SubversionRepoSyncer) askPassPath() (string, error) {
+	dir := s.SVNHome
+	if dir == "" {
+		dir = os.TempDir()
+	}
+	path := filepath.Join(dir, "svn-askpass")
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	}
+	if err := os.WriteFile(path, []byte(subversionAskPassScript), 0o700); err != nil {
+		return "", errors.Wrap(err, "writing askpass script")
+	}
+	return path, nil
+}
+
+// decomposeSubversionRemoteURL decomposes the credentials from a clone URL for
+// a Subversion repository, and returns the URL without them.
+func decomposeSubversionRemoteURL(remoteURL *vcs.URL) (username, password, repoURL string) {
+	u := *remoteURL
+	if u.User != nil {
+		username = u.User.Username()
+		password, _ = u.User.Password()
+		u.User = nil
+	}
+	return username, password, u.String()
+}
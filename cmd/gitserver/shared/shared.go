@@ -15,6 +15,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/grafana/regexp"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/sourcegraph/log"
 	"golang.org/x/sync/semaphore"
@@ -37,7 +38,10 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/crates"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gomodproxy"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/hex"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/npm"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/nuget"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/pub"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/pypi"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/rubygems"
 	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
@@ -446,6 +450,30 @@ func getVCSSyncer(ctx context.Context, opts *newVCSSyncerOpts) (server.VCSSyncer
 			FusionConfig: configureFusionClient(c),
 			P4Home:       p4Home,
 		}, nil
+	case extsvc.VariantSubversion.AsType():
+		var c schema.SubversionConnection
+		if _, err := extractOptions(&c); err != nil {
+			return nil, err
+		}
+
+		svnHome := filepath.Join(opts.reposDir, server.SVNHomeName)
+		// Ensure the directory exists
+		if err := os.MkdirAll(svnHome, os.ModePerm); err != nil {
+			return nil, errors.Wrapf(err, "ensuring svnHome exists: %q", svnHome)
+		}
+
+		return &server.SubversionRepoSyncer{
+			Layout:  c.Layout,
+			SVNHome: svnHome,
+		}, nil
+	case extsvc.VariantMercurial.AsType():
+		hgHome := filepath.Join(opts.reposDir, server.HgHomeName)
+		// Ensure the directory exists
+		if err := os.MkdirAll(hgHome, os.ModePerm); err != nil {
+			return nil, errors.Wrapf(err, "ensuring hgHome exists: %q", hgHome)
+		}
+
+		return &server.MercurialRepoSyncer{HgHome: hgHome}, nil
 	case extsvc.TypeJVMPackages:
 		var c schema.JVMPackagesConnection
 		if _, err := extractOptions(&c); err != nil {
@@ -504,8 +532,97 @@ func getVCSSyncer(ctx context.Context, opts *newVCSSyncerOpts) (server.VCSSyncer
 			return nil, err
 		}
 		return server.NewRubyPackagesSyncer(&c, opts.depsSvc, cli), nil
+	case extsvc.TypeNuGetPackages:
+		var c schema.NuGetPackagesConnection
+		urn, err := extractOptions(&c)
+		if err != nil {
+			return nil, err
+		}
+		cli, err := nuget.NewClient(urn, c.Repository, httpcli.ExternalClientFactory)
+		if err != nil {
+			return nil, err
+		}
+		return server.NewNuGetPackagesSyncer(&c, opts.depsSvc, cli, opts.reposDir), nil
+	case extsvc.TypeHexPackages:
+		var c schema.HexPackagesConnection
+		urn, err := extractOptions(&c)
+		if err != nil {
+			return nil, err
+		}
+		cli, err := hex.NewClient(urn, c.Repository, httpcli.ExternalClientFactory)
+		if err != nil {
+			return nil, err
+		}
+		return server.NewHexPackagesSyncer(&c, opts.depsSvc, cli, opts.reposDir), nil
+	case extsvc.TypePubPackages:
+		var c schema.PubPackagesConnection
+		urn, err := extractOptions(&c)
+		if err != nil {
+			return nil, err
+		}
+		cli, err := pub.NewClient(urn, c.Repository, httpcli.ExternalClientFactory)
+		if err != nil {
+			return nil, err
+		}
+		return server.NewPubPackagesSyncer(&c, opts.depsSvc, cli), nil
+	}
+
+	if len(r.Sources) == 0 {
+		return server.NewGitRepoSyncer(opts.recordingCommandFactory), nil
+	}
+	var c gitCloneOptionsConnection
+	if _, err := extractOptions(&c); err != nil {
+		return nil, err
+	}
+	cloneOptions, err := c.cloneOptionsFor(r.Name)
+	if err != nil {
+		return nil, err
+	}
+	return server.NewGitRepoSyncerWithCloneOptions(opts.recordingCommandFactory, cloneOptions), nil
+}
+
+// gitCloneOptionsConnection is the part of the configuration of the
+// connections to Git code hosts, such as schema.GitHubConnection, which
+// restricts what gitserver clones.
+type gitCloneOptionsConnection struct {
+	CloneOptions []struct {
+		Pattern               string   `json:"pattern"`
+		BlobSizeLimit         string   `json:"blobSizeLimit"`
+		ShallowSince          string   `json:"shallowSince"`
+		Refspecs              []string `json:"refspecs"`
+		DiskQuotaBytes        int64    `json:"diskQuotaBytes"`
+		FetchLFS              bool     `json:"fetchLFS"`
+		LFSMaxObjectSizeBytes int64    `json:"lfsMaxObjectSizeBytes"`
+	} `json:"cloneOptions"`
+}
+
+// cloneOptionsFor returns the options of the first entry of cloneOptions whose
+// pattern matches the name of repo.
+func (c *gitCloneOptionsConnection) cloneOptionsFor(repo api.RepoName) (server.GitCloneOptions, error) {
+	for _, o := range c.CloneOptions {
+		if o.Pattern != "" {
+			re, err := regexp.Compile(o.Pattern)
+			if err != nil {
+				return server.GitCloneOptions{}, errors.Wrapf(err, "invalid cloneOptions pattern %q", o.Pattern)
+			}
+			if !re.MatchString(string(repo)) {
+				continue
+			}
+		}
+		opts := server.GitCloneOptions{
+			BlobSizeLimit:         o.BlobSizeLimit,
+			ShallowSince:          o.ShallowSince,
+			Refspecs:              o.Refspecs,
+			DiskQuotaBytes:        o.DiskQuotaBytes,
+			FetchLFS:              o.FetchLFS,
+			LFSMaxObjectSizeBytes: o.LFSMaxObjectSizeBytes,
+		}
+		if err := opts.Validate(); err != nil {
+			return server.GitCloneOptions{}, errors.Wrapf(err, "invalid cloneOptions for pattern %q", o.Pattern)
+		}
+		return opts, nil
 	}
-	return server.NewGitRepoSyncer(opts.recordingCommandFactory), nil
+	return server.GitCloneOptions{}, nil
 }
 
 func syncExternalServiceRateLimiters(ctx context.Context, store database.ExternalServiceStore) error {
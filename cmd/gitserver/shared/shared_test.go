@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	jsoniter "github.com/json-iterator/go"
 	"github.com/sourcegraph/log"
 	"github.com/sourcegraph/log/logtest"
 	"google.golang.org/grpc"
@@ -121,6 +122,40 @@ func TestGetVCSSyncer(t *testing.T) {
 	}
 }
 
+func TestGitCloneOptionsConnection(t *testing.T) {
+	var c gitCloneOptionsConnection
+	if err := jsoniter.Unmarshal([]byte(`{
+		"cloneOptions": [
+			{"pattern": "^example\\.com/acme/monorepo$", "blobSizeLimit": "1m", "refspecs": ["+refs/heads/main:refs/heads/main"], "fetchLFS": true, "lfsMaxObjectSizeBytes": 1048576},
+			{"shallowSince": "2022-01-01", "diskQuotaBytes": 1073741824}
+		]
+	}`), &c); err != nil {
+		t.Fatal(err)
+	}
+
+	for repo, want := range map[api.RepoName]server.GitCloneOptions{
+		"example.com/acme/monorepo": {BlobSizeLimit: "1m", Refspecs: []string{"+refs/heads/main:refs/heads/main"}, FetchLFS: true, LFSMaxObjectSizeBytes: 1 << 20},
+		"example.com/acme/other":    {ShallowSince: "2022-01-01", DiskQuotaBytes: 1 << 30},
+	} {
+		got, err := c.cloneOptionsFor(repo)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("unexpected clone options for %s (-want +got):\n%s", repo, diff)
+		}
+	}
+
+	// Without cloneOptions, repositories are fully cloned.
+	got, err := (&gitCloneOptionsConnection{}).cloneOptionsFor("example.com/acme/monorepo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(server.GitCloneOptions{}, got); diff != "" {
+		t.Errorf("unexpected clone options (-want +got):\n%s", diff)
+	}
+}
+
 func TestMethodSpecificStreamInterceptor(t *testing.T) {
 	tests := []struct {
 		name string
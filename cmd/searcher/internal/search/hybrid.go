@@ -195,8 +195,9 @@ func zoektSearchIgnorePaths(ctx context.Context, client zoekt.Streamer, p *proto
 			foundResults = true
 
 			sender.Send(protocol.FileMatch{
-				Path:         fm.FileName,
-				ChunkMatches: zoektChunkMatches(fm.ChunkMatches),
+				Path:            fm.FileName,
+				ChunkMatches:    zoektChunkMatches(fm.ChunkMatches),
+				ContentChecksum: fm.Checksum,
 			})
 		}
 	}))
@@ -3,6 +3,8 @@ package search
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
+	"hash/crc64"
 	"io"
 	"regexp/syntax" //nolint:depguard // using the grafana fork of regexp clashes with zoekt, which uses the std regexp/syntax.
 	"strings"
@@ -242,6 +244,20 @@ func locsToRanges(buf []byte, locs [][]int) []protocol.Range {
 	return ranges
 }
 
+// zoektChecksumTable is the table Zoekt computes the checksums of file
+// contents with.
+var zoektChecksumTable = crc64.MakeTable(crc64.ISO)
+
+// contentChecksum returns the checksum of the contents of a file, computed the
+// same way Zoekt does when indexing. It is nil for empty contents, which are
+// also stored for large and binary files.
+func contentChecksum(content []byte) []byte {
+	if len(content) == 0 {
+		return nil
+	}
+	return binary.BigEndian.AppendUint64(nil, crc64.Checksum(content, zoektChecksumTable))
+}
+
 // FindZip is a convenience function to run Find on f.
 func (rg *readerGrep) FindZip(zf *zipFile, f *srcFile, limit int) (protocol.FileMatch, error) {
 	cms, err := rg.Find(zf, f, limit)
@@ -297,7 +313,7 @@ func regexSearch(ctx context.Context, rg *readerGrep, zf *zipFile, patternMatche
 				if ctx.Err() != nil {
 					return ctx.Err()
 				}
-				fm := protocol.FileMatch{Path: f.Name}
+				fm := protocol.FileMatch{Path: f.Name, ContentChecksum: contentChecksum(zf.DataFor(&f))}
 				sender.Send(fm)
 			}
 		}
@@ -354,6 +370,7 @@ func regexSearch(ctx context.Context, rg *readerGrep, zf *zipFile, patternMatche
 					}
 				}
 				if match == !isPatternNegated {
+					fm.ContentChecksum = contentChecksum(zf.DataFor(f))
 					sender.Send(fm)
 				}
 			}
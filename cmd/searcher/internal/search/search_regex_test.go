@@ -6,6 +6,7 @@ import (
 	"context"
 	"io"
 	"os"
+	"path/filepath"
 	"reflect"
 	"regexp/syntax" //nolint:depguard // using the grafana fork of regexp clashes with zoekt, which uses the std regexp/syntax.
 	"sort"
@@ -14,6 +15,8 @@ import (
 	"testing/iotest"
 
 	"github.com/grafana/regexp"
+	"github.com/sourcegraph/zoekt"
+	"github.com/sourcegraph/zoekt/query"
 	"github.com/stretchr/testify/require"
 
 	"github.com/sourcegraph/sourcegraph/cmd/searcher/protocol"
@@ -499,6 +502,31 @@ func TestRegexSearch(t *testing.T) {
 	}
 }
 
+func TestContentChecksum(t *testing.T) {
+	content := []byte("package main\n\nfunc main() {}\n")
+
+	// Checksums of unindexed files must be comparable to the ones Zoekt
+	// returns for indexed files.
+	b, err := zoekt.NewIndexBuilder(&zoekt.Repository{Name: "foo"})
+	require.NoError(t, err)
+	require.NoError(t, b.Add(zoekt.Document{Name: "main.go", Content: content}))
+	f, err := os.Create(filepath.Join(t.TempDir(), "foo.zoekt"))
+	require.NoError(t, err)
+	require.NoError(t, b.Write(f))
+	indexFile, err := zoekt.NewIndexFile(f)
+	require.NoError(t, err)
+	searcher, err := zoekt.NewSearcher(indexFile)
+	require.NoError(t, err)
+	defer searcher.Close()
+
+	res, err := searcher.Search(context.Background(), &query.Const{Value: true}, &zoekt.SearchOptions{})
+	require.NoError(t, err)
+	require.Len(t, res.Files, 1)
+
+	require.Equal(t, res.Files[0].Checksum, contentChecksum(content))
+	require.Nil(t, contentChecksum(nil))
+}
+
 func Test_locsToRanges(t *testing.T) {
 	cases := []struct {
 		buf    string
@@ -68,9 +68,10 @@ func toFileMatch(zipReader *zip.Reader, combyMatch *comby.FileMatch) (protocol.F
 	chunks := chunkRanges(ranges, 0)
 	chunkMatches := chunksToMatches(fileBuf, chunks)
 	return protocol.FileMatch{
-		Path:         combyMatch.URI,
-		ChunkMatches: chunkMatches,
-		LimitHit:     false,
+		Path:            combyMatch.URI,
+		ChunkMatches:    chunkMatches,
+		LimitHit:        false,
+		ContentChecksum: contentChecksum(fileBuf),
 	}, nil
 }
 
@@ -256,6 +256,11 @@ type FileMatch struct {
 
 	// LimitHit is true if LineMatches may not include all LineMatches.
 	LimitHit bool
+
+	// ContentChecksum is the checksum of the file's contents, computed the
+	// same way Zoekt does for indexed files. It is empty if the contents were
+	// not read.
+	ContentChecksum []byte
 }
 
 func (fm *FileMatch) ToProto() *proto.FileMatch {
@@ -264,9 +269,10 @@ func (fm *FileMatch) ToProto() *proto.FileMatch {
 		chunkMatches[i] = cm.ToProto()
 	}
 	return &proto.FileMatch{
-		Path:         fm.Path,
-		ChunkMatches: chunkMatches,
-		LimitHit:     fm.LimitHit,
+		Path:            fm.Path,
+		ChunkMatches:    chunkMatches,
+		LimitHit:        fm.LimitHit,
+		ContentChecksum: fm.ContentChecksum,
 	}
 }
 
@@ -276,9 +282,10 @@ func (fm *FileMatch) FromProto(pm *proto.FileMatch) {
 		chunkMatches[i].FromProto(cm)
 	}
 	*fm = FileMatch{
-		Path:         pm.Path,
-		ChunkMatches: chunkMatches,
-		LimitHit:     pm.LimitHit,
+		Path:            pm.Path,
+		ChunkMatches:    chunkMatches,
+		LimitHit:        pm.LimitHit,
+		ContentChecksum: pm.ContentChecksum,
 	}
 }
 
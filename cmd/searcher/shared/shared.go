@@ -135,8 +135,9 @@ func Start(ctx context.Context, observationCtx *observation.Context, ready servi
 				// searcher needs access to all data in the archive.
 				ctx = actor.WithInternalActor(ctx)
 				return git.ArchiveReader(ctx, nil, repo, gitserver.ArchiveOptions{
-					Treeish: string(commit),
-					Format:  gitserver.ArchiveFormatTar,
+					Treeish:   string(commit),
+					Format:    gitserver.ArchiveFormatTar,
+					SmudgeLFS: true,
 				})
 			},
 			FetchTarPaths: func(ctx context.Context, repo api.RepoName, commit api.CommitID, paths []string) (io.ReadCloser, error) {
@@ -151,6 +152,7 @@ func Start(ctx context.Context, observationCtx *observation.Context, ready servi
 					Treeish:   string(commit),
 					Format:    gitserver.ArchiveFormatTar,
 					Pathspecs: pathspecs,
+					SmudgeLFS: true,
 				})
 			},
 			FilterTar:         search.NewFilter,
@@ -71,6 +71,7 @@ func (c *gitserverClient) FetchTar(ctx context.Context, repo api.RepoName, commi
 		Treeish:   string(commit),
 		Format:    gitserver.ArchiveFormatTar,
 		Pathspecs: pathSpecs,
+		SmudgeLFS: true,
 	}
 
 	// Note: the sub-repo perms checker is nil here because we do the sub-repo filtering at a higher level
@@ -96,7 +97,7 @@ func (c *gitserverClient) GitDiff(ctx context.Context, repo api.RepoName, commit
 }
 
 func (c *gitserverClient) ReadFile(ctx context.Context, repoCommitPath types.RepoCommitPath) ([]byte, error) {
-	data, err := c.innerClient.ReadFile(ctx, nil, api.RepoName(repoCommitPath.Repo), api.CommitID(repoCommitPath.Commit), repoCommitPath.Path)
+	data, err := c.innerClient.ReadFileWithOptions(ctx, nil, api.RepoName(repoCommitPath.Repo), api.CommitID(repoCommitPath.Commit), repoCommitPath.Path, gitserver.FileReaderOptions{SmudgeLFS: true})
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get file contents")
 	}
@@ -36,6 +36,7 @@ func MakeSqliteSearchFunc(observationCtx *observation.Context, cachedDatabaseWri
 			attribute.Int("numIncludePatterns", len(args.IncludePatterns)),
 			attribute.String("includePatterns", strings.Join(args.IncludePatterns, ":")),
 			attribute.String("excludePattern", args.ExcludePattern),
+			attribute.StringSlice("includeKinds", args.IncludeKinds),
 			attribute.Int("first", args.First),
 			attribute.Float64("timeoutSeconds", args.Timeout.Seconds()),
 		}})
@@ -74,6 +74,7 @@ func makeSearchConditions(args search.SymbolsParameters) []*sqlf.Query {
 	for _, includePattern := range args.IncludePatterns {
 		conditions = append(conditions, makeSearchCondition("path", includePattern, args.IsCaseSensitive))
 	}
+	conditions = append(conditions, makeKindCondition(args.IncludeKinds))
 
 	filtered := conditions[:0]
 	for _, condition := range conditions {
@@ -90,6 +91,22 @@ func makeSearchConditions(args search.SymbolsParameters) []*sqlf.Query {
 	return filtered
 }
 
+// makeKindCondition returns a condition matching the symbols whose kind is one
+// of the given select:symbol.<kind> kinds, or nil if kinds is empty.
+func makeKindCondition(kinds []string) *sqlf.Query {
+	if len(kinds) == 0 {
+		return nil
+	}
+	values := []*sqlf.Query{}
+	for _, kind := range result.KindsOfSelectKinds(kinds) {
+		values = append(values, sqlf.Sprintf("%s", kind))
+	}
+	if len(values) == 0 {
+		return sqlf.Sprintf("FALSE")
+	}
+	return sqlf.Sprintf("lower(kind) IN (%s)", sqlf.Join(values, ","))
+}
+
 func makeSearchCondition(column string, regex string, isCaseSensitive bool) *sqlf.Query {
 	if regex == "" {
 		return nil
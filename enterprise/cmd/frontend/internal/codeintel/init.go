@@ -14,7 +14,9 @@ import (
 	policiesgraphql "github.com/sourcegraph/sourcegraph/internal/codeintel/policies/transport/graphql"
 	rankinggraphql "github.com/sourcegraph/sourcegraph/internal/codeintel/ranking/transport/graphql"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/resolvers"
+	sbomhttp "github.com/sourcegraph/sourcegraph/internal/codeintel/sbom/transport/http"
 	sentinelgraphql "github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/transport/graphql"
+	sentinelhttp "github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/transport/http"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/shared/lsifuploadstore"
 	sharedresolvers "github.com/sourcegraph/sourcegraph/internal/codeintel/shared/resolvers"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/shared/resolvers/gitresolvers"
@@ -112,6 +114,7 @@ func Init(
 	sentinelRootResolver := sentinelgraphql.NewRootResolver(
 		scopedContext("sentinel"),
 		codeIntelServices.SentinelService,
+		siteAdminChecker,
 		uploadLoaderFactory,
 		indexLoaderFactory,
 		locationResolverFactory,
@@ -133,6 +136,8 @@ func Init(
 		rankingRootResolver,
 	))
 	enterpriseServices.NewCodeIntelUploadHandler = newUploadHandler
+	enterpriseServices.CodeIntelSBOMHandler = sbomhttp.NewHandler(codeIntelServices.SBOMService, db, codeIntelServices.GitserverClient)
+	enterpriseServices.SentinelUploadHandler = sentinelhttp.NewUploadHandler(codeIntelServices.SentinelService, db)
 	enterpriseServices.RankingService = codeIntelServices.RankingService
 	return nil
 }
@@ -32,5 +32,7 @@ func (j *codeMonitorJob) Routines(_ context.Context, observationCtx *observation
 		return nil, err
 	}
 
-	return background.NewBackgroundJobs(observationCtx, db, search.NewEnterpriseSearchJobs()), nil
+	// Code monitors only notify about new results, so they don't need
+	// results to be ordered by document rank.
+	return background.NewBackgroundJobs(observationCtx, db, search.NewEnterpriseSearchJobs(nil)), nil
 }
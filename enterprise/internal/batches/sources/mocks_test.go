@@ -10657,6 +10657,10 @@ type MockGitserverClient struct {
 	// ContributorCountFunc is an instance of a mock function object
 	// controlling the behavior of the method ContributorCount.
 	ContributorCountFunc *GitserverClientContributorCountFunc
+	// CreateCommitFromFileOperationsFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// CreateCommitFromFileOperations.
+	CreateCommitFromFileOperationsFunc *GitserverClientCreateCommitFromFileOperationsFunc
 	// CreateCommitFromPatchFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateCommitFromPatch.
 	CreateCommitFromPatchFunc *GitserverClientCreateCommitFromPatchFunc
@@ -10717,6 +10721,9 @@ type MockGitserverClient struct {
 	// LsFilesFunc is an instance of a mock function object controlling the
 	// behavior of the method LsFiles.
 	LsFilesFunc *GitserverClientLsFilesFunc
+	// MercurialChangesetFunc is an instance of a mock function object
+	// controlling the behavior of the method MercurialChangeset.
+	MercurialChangesetFunc *GitserverClientMercurialChangesetFunc
 	// MergeBaseFunc is an instance of a mock function object controlling
 	// the behavior of the method MergeBase.
 	MergeBaseFunc *GitserverClientMergeBaseFunc
@@ -10735,6 +10742,9 @@ type MockGitserverClient struct {
 	// ReadFileFunc is an instance of a mock function object controlling the
 	// behavior of the method ReadFile.
 	ReadFileFunc *GitserverClientReadFileFunc
+	// ReadFileWithOptionsFunc is an instance of a mock function object
+	// controlling the behavior of the method ReadFileWithOptions.
+	ReadFileWithOptionsFunc *GitserverClientReadFileWithOptionsFunc
 	// RefDescriptionsFunc is an instance of a mock function object
 	// controlling the behavior of the method RefDescriptions.
 	RefDescriptionsFunc *GitserverClientRefDescriptionsFunc
@@ -10747,6 +10757,12 @@ type MockGitserverClient struct {
 	// RepoCloneProgressFunc is an instance of a mock function object
 	// controlling the behavior of the method RepoCloneProgress.
 	RepoCloneProgressFunc *GitserverClientRepoCloneProgressFunc
+	// RepoMaintenanceFunc is an instance of a mock function object
+	// controlling the behavior of the method RepoMaintenance.
+	RepoMaintenanceFunc *GitserverClientRepoMaintenanceFunc
+	// RepoSizeBreakdownFunc is an instance of a mock function object
+	// controlling the behavior of the method RepoSizeBreakdown.
+	RepoSizeBreakdownFunc *GitserverClientRepoSizeBreakdownFunc
 	// ReposStatsFunc is an instance of a mock function object controlling
 	// the behavior of the method ReposStats.
 	ReposStatsFunc *GitserverClientReposStatsFunc
@@ -10850,6 +10866,11 @@ func NewMockGitserverClient() *MockGitserverClient {
 				return
 			},
 		},
+		CreateCommitFromFileOperationsFunc: &GitserverClientCreateCommitFromFileOperationsFunc{
+			defaultHook: func(context.Context, protocol.CreateCommitFromFileOperationsRequest) (r0 *protocol.CreateCommitFromPatchResponse, r1 error) {
+				return
+			},
+		},
 		CreateCommitFromPatchFunc: &GitserverClientCreateCommitFromPatchFunc{
 			defaultHook: func(context.Context, protocol.CreateCommitFromPatchRequest) (r0 *protocol.CreateCommitFromPatchResponse, r1 error) {
 				return
@@ -10950,6 +10971,11 @@ func NewMockGitserverClient() *MockGitserverClient {
 				return
 			},
 		},
+		MercurialChangesetFunc: &GitserverClientMercurialChangesetFunc{
+			defaultHook: func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID) (r0 string, r1 error) {
+				return
+			},
+		},
 		MergeBaseFunc: &GitserverClientMergeBaseFunc{
 			defaultHook: func(context.Context, api.RepoName, api.CommitID, api.CommitID) (r0 api.CommitID, r1 error) {
 				return
@@ -10980,6 +11006,11 @@ func NewMockGitserverClient() *MockGitserverClient {
 				return
 			},
 		},
+		ReadFileWithOptionsFunc: &GitserverClientReadFileWithOptionsFunc{
+			defaultHook: func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID, string, gitserver.FileReaderOptions) (r0 []byte, r1 error) {
+				return
+			},
+		},
 		RefDescriptionsFunc: &GitserverClientRefDescriptionsFunc{
 			defaultHook: func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, ...string) (r0 map[string][]gitdomain.RefDescription, r1 error) {
 				return
@@ -11000,6 +11031,16 @@ func NewMockGitserverClient() *MockGitserverClient {
 				return
 			},
 		},
+		RepoMaintenanceFunc: &GitserverClientRepoMaintenanceFunc{
+			defaultHook: func(context.Context, api.RepoName, bool) (r0 *protocol.RepoMaintenance, r1 error) {
+				return
+			},
+		},
+		RepoSizeBreakdownFunc: &GitserverClientRepoSizeBreakdownFunc{
+			defaultHook: func(context.Context, api.RepoName) (r0 *protocol.RepoSizeBreakdown, r1 error) {
+				return
+			},
+		},
 		ReposStatsFunc: &GitserverClientReposStatsFunc{
 			defaultHook: func(context.Context) (r0 map[string]*protocol.ReposStats, r1 error) {
 				return
@@ -11122,6 +11163,11 @@ func NewStrictMockGitserverClient() *MockGitserverClient {
 				panic("unexpected invocation of MockGitserverClient.ContributorCount")
 			},
 		},
+		CreateCommitFromFileOperationsFunc: &GitserverClientCreateCommitFromFileOperationsFunc{
+			defaultHook: func(context.Context, protocol.CreateCommitFromFileOperationsRequest) (*protocol.CreateCommitFromPatchResponse, error) {
+				panic("unexpected invocation of MockGitserverClient.CreateCommitFromFileOperations")
+			},
+		},
 		CreateCommitFromPatchFunc: &GitserverClientCreateCommitFromPatchFunc{
 			defaultHook: func(context.Context, protocol.CreateCommitFromPatchRequest) (*protocol.CreateCommitFromPatchResponse, error) {
 				panic("unexpected invocation of MockGitserverClient.CreateCommitFromPatch")
@@ -11222,6 +11268,11 @@ func NewStrictMockGitserverClient() *MockGitserverClient {
 				panic("unexpected invocation of MockGitserverClient.LsFiles")
 			},
 		},
+		MercurialChangesetFunc: &GitserverClientMercurialChangesetFunc{
+			defaultHook: func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID) (string, error) {
+				panic("unexpected invocation of MockGitserverClient.MercurialChangeset")
+			},
+		},
 		MergeBaseFunc: &GitserverClientMergeBaseFunc{
 			defaultHook: func(context.Context, api.RepoName, api.CommitID, api.CommitID) (api.CommitID, error) {
 				panic("unexpected invocation of MockGitserverClient.MergeBase")
@@ -11252,6 +11303,11 @@ func NewStrictMockGitserverClient() *MockGitserverClient {
 				panic("unexpected invocation of MockGitserverClient.ReadFile")
 			},
 		},
+		ReadFileWithOptionsFunc: &GitserverClientReadFileWithOptionsFunc{
+			defaultHook: func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID, string, gitserver.FileReaderOptions) ([]byte, error) {
+				panic("unexpected invocation of MockGitserverClient.ReadFileWithOptions")
+			},
+		},
 		RefDescriptionsFunc: &GitserverClientRefDescriptionsFunc{
 			defaultHook: func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, ...string) (map[string][]gitdomain.RefDescription, error) {
 				panic("unexpected invocation of MockGitserverClient.RefDescriptions")
@@ -11272,6 +11328,16 @@ func NewStrictMockGitserverClient() *MockGitserverClient {
 				panic("unexpected invocation of MockGitserverClient.RepoCloneProgress")
 			},
 		},
+		RepoMaintenanceFunc: &GitserverClientRepoMaintenanceFunc{
+			defaultHook: func(context.Context, api.RepoName, bool) (*protocol.RepoMaintenance, error) {
+				panic("unexpected invocation of MockGitserverClient.RepoMaintenance")
+			},
+		},
+		RepoSizeBreakdownFunc: &GitserverClientRepoSizeBreakdownFunc{
+			defaultHook: func(context.Context, api.RepoName) (*protocol.RepoSizeBreakdown, error) {
+				panic("unexpected invocation of MockGitserverClient.RepoSizeBreakdown")
+			},
+		},
 		ReposStatsFunc: &GitserverClientReposStatsFunc{
 			defaultHook: func(context.Context) (map[string]*protocol.ReposStats, error) {
 				panic("unexpected invocation of MockGitserverClient.ReposStats")
@@ -11367,6 +11433,9 @@ func NewMockGitserverClientFrom(i gitserver.Client) *MockGitserverClient {
 		ContributorCountFunc: &GitserverClientContributorCountFunc{
 			defaultHook: i.ContributorCount,
 		},
+		CreateCommitFromFileOperationsFunc: &GitserverClientCreateCommitFromFileOperationsFunc{
+			defaultHook: i.CreateCommitFromFileOperations,
+		},
 		CreateCommitFromPatchFunc: &GitserverClientCreateCommitFromPatchFunc{
 			defaultHook: i.CreateCommitFromPatch,
 		},
@@ -11427,6 +11496,9 @@ func NewMockGitserverClientFrom(i gitserver.Client) *MockGitserverClient {
 		LsFilesFunc: &GitserverClientLsFilesFunc{
 			defaultHook: i.LsFiles,
 		},
+		MercurialChangesetFunc: &GitserverClientMercurialChangesetFunc{
+			defaultHook: i.MercurialChangeset,
+		},
 		MergeBaseFunc: &GitserverClientMergeBaseFunc{
 			defaultHook: i.MergeBase,
 		},
@@ -11445,6 +11517,9 @@ func NewMockGitserverClientFrom(i gitserver.Client) *MockGitserverClient {
 		ReadFileFunc: &GitserverClientReadFileFunc{
 			defaultHook: i.ReadFile,
 		},
+		ReadFileWithOptionsFunc: &GitserverClientReadFileWithOptionsFunc{
+			defaultHook: i.ReadFileWithOptions,
+		},
 		RefDescriptionsFunc: &GitserverClientRefDescriptionsFunc{
 			defaultHook: i.RefDescriptions,
 		},
@@ -11457,6 +11532,12 @@ func NewMockGitserverClientFrom(i gitserver.Client) *MockGitserverClient {
 		RepoCloneProgressFunc: &GitserverClientRepoCloneProgressFunc{
 			defaultHook: i.RepoCloneProgress,
 		},
+		RepoMaintenanceFunc: &GitserverClientRepoMaintenanceFunc{
+			defaultHook: i.RepoMaintenance,
+		},
+		RepoSizeBreakdownFunc: &GitserverClientRepoSizeBreakdownFunc{
+			defaultHook: i.RepoSizeBreakdown,
+		},
 		ReposStatsFunc: &GitserverClientReposStatsFunc{
 			defaultHook: i.ReposStats,
 		},
@@ -13066,6 +13147,118 @@ func (c GitserverClientContributorCountFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// GitserverClientCreateCommitFromFileOperationsFunc describes the behavior
+// when the CreateCommitFromFileOperations method of the parent
+// MockGitserverClient instance is invoked.
+type GitserverClientCreateCommitFromFileOperationsFunc struct {
+	defaultHook func(context.Context, protocol.CreateCommitFromFileOperationsRequest) (*protocol.CreateCommitFromPatchResponse, error)
+	hooks       []func(context.Context, protocol.CreateCommitFromFileOperationsRequest) (*protocol.CreateCommitFromPatchResponse, error)
+	history     []GitserverClientCreateCommitFromFileOperationsFuncCall
+	mutex       sync.Mutex
+}
+
+// CreateCommitFromFileOperations delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockGitserverClient) CreateCommitFromFileOperations(v0 context.Context, v1 protocol.CreateCommitFromFileOperationsRequest) (*protocol.CreateCommitFromPatchResponse, error) {
+	r0, r1 := m.CreateCommitFromFileOperationsFunc.nextHook()(v0, v1)
+	m.CreateCommitFromFileOperationsFunc.appendCall(GitserverClientCreateCommitFromFileOperationsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// CreateCommitFromFileOperations method of the parent MockGitserverClient
+// instance is invoked and the hook queue is empty.
+func (f *GitserverClientCreateCommitFromFileOperationsFunc) SetDefaultHook(hook func(context.Context, protocol.CreateCommitFromFileOperationsRequest) (*protocol.CreateCommitFromPatchResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateCommitFromFileOperations method of the parent MockGitserverClient
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *GitserverClientCreateCommitFromFileOperationsFunc) PushHook(hook func(context.Context, protocol.CreateCommitFromFileOperationsRequest) (*protocol.CreateCommitFromPatchResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverClientCreateCommitFromFileOperationsFunc) SetDefaultReturn(r0 *protocol.CreateCommitFromPatchResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, protocol.CreateCommitFromFileOperationsRequest) (*protocol.CreateCommitFromPatchResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverClientCreateCommitFromFileOperationsFunc) PushReturn(r0 *protocol.CreateCommitFromPatchResponse, r1 error) {
+	f.PushHook(func(context.Context, protocol.CreateCommitFromFileOperationsRequest) (*protocol.CreateCommitFromPatchResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverClientCreateCommitFromFileOperationsFunc) nextHook() func(context.Context, protocol.CreateCommitFromFileOperationsRequest) (*protocol.CreateCommitFromPatchResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverClientCreateCommitFromFileOperationsFunc) appendCall(r0 GitserverClientCreateCommitFromFileOperationsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// GitserverClientCreateCommitFromFileOperationsFuncCall objects describing
+// the invocations of this function.
+func (f *GitserverClientCreateCommitFromFileOperationsFunc) History() []GitserverClientCreateCommitFromFileOperationsFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverClientCreateCommitFromFileOperationsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverClientCreateCommitFromFileOperationsFuncCall is an object that
+// describes an invocation of method CreateCommitFromFileOperations on an
+// instance of MockGitserverClient.
+type GitserverClientCreateCommitFromFileOperationsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 protocol.CreateCommitFromFileOperationsRequest
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *protocol.CreateCommitFromPatchResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverClientCreateCommitFromFileOperationsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverClientCreateCommitFromFileOperationsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // GitserverClientCreateCommitFromPatchFunc describes the behavior when the
 // CreateCommitFromPatch method of the parent MockGitserverClient instance
 // is invoked.
@@ -15367,6 +15560,123 @@ func (c GitserverClientLsFilesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// GitserverClientMercurialChangesetFunc describes the behavior when the
+// MercurialChangeset method of the parent MockGitserverClient instance is
+// invoked.
+type GitserverClientMercurialChangesetFunc struct {
+	defaultHook func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID) (string, error)
+	hooks       []func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID) (string, error)
+	history     []GitserverClientMercurialChangesetFuncCall
+	mutex       sync.Mutex
+}
+
+// MercurialChangeset delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGitserverClient) MercurialChangeset(v0 context.Context, v1 authz.SubRepoPermissionChecker, v2 api.RepoName, v3 api.CommitID) (string, error) {
+	r0, r1 := m.MercurialChangesetFunc.nextHook()(v0, v1, v2, v3)
+	m.MercurialChangesetFunc.appendCall(GitserverClientMercurialChangesetFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the MercurialChangeset
+// method of the parent MockGitserverClient instance is invoked and the hook
+// queue is empty.
+func (f *GitserverClientMercurialChangesetFunc) SetDefaultHook(hook func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID) (string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// MercurialChangeset method of the parent MockGitserverClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GitserverClientMercurialChangesetFunc) PushHook(hook func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID) (string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverClientMercurialChangesetFunc) SetDefaultReturn(r0 string, r1 error) {
+	f.SetDefaultHook(func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID) (string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverClientMercurialChangesetFunc) PushReturn(r0 string, r1 error) {
+	f.PushHook(func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID) (string, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverClientMercurialChangesetFunc) nextHook() func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID) (string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverClientMercurialChangesetFunc) appendCall(r0 GitserverClientMercurialChangesetFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverClientMercurialChangesetFuncCall
+// objects describing the invocations of this function.
+func (f *GitserverClientMercurialChangesetFunc) History() []GitserverClientMercurialChangesetFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverClientMercurialChangesetFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverClientMercurialChangesetFuncCall is an object that describes an
+// invocation of method MercurialChangeset on an instance of
+// MockGitserverClient.
+type GitserverClientMercurialChangesetFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 authz.SubRepoPermissionChecker
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.RepoName
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 api.CommitID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverClientMercurialChangesetFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverClientMercurialChangesetFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // GitserverClientMergeBaseFunc describes the behavior when the MergeBase
 // method of the parent MockGitserverClient instance is invoked.
 type GitserverClientMergeBaseFunc struct {
@@ -16076,6 +16386,129 @@ func (c GitserverClientReadFileFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// GitserverClientReadFileWithOptionsFunc describes the behavior when the
+// ReadFileWithOptions method of the parent MockGitserverClient instance is
+// invoked.
+type GitserverClientReadFileWithOptionsFunc struct {
+	defaultHook func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID, string, gitserver.FileReaderOptions) ([]byte, error)
+	hooks       []func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID, string, gitserver.FileReaderOptions) ([]byte, error)
+	history     []GitserverClientReadFileWithOptionsFuncCall
+	mutex       sync.Mutex
+}
+
+// ReadFileWithOptions delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGitserverClient) ReadFileWithOptions(v0 context.Context, v1 authz.SubRepoPermissionChecker, v2 api.RepoName, v3 api.CommitID, v4 string, v5 gitserver.FileReaderOptions) ([]byte, error) {
+	r0, r1 := m.ReadFileWithOptionsFunc.nextHook()(v0, v1, v2, v3, v4, v5)
+	m.ReadFileWithOptionsFunc.appendCall(GitserverClientReadFileWithOptionsFuncCall{v0, v1, v2, v3, v4, v5, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ReadFileWithOptions
+// method of the parent MockGitserverClient instance is invoked and the hook
+// queue is empty.
+func (f *GitserverClientReadFileWithOptionsFunc) SetDefaultHook(hook func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID, string, gitserver.FileReaderOptions) ([]byte, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ReadFileWithOptions method of the parent MockGitserverClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GitserverClientReadFileWithOptionsFunc) PushHook(hook func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID, string, gitserver.FileReaderOptions) ([]byte, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverClientReadFileWithOptionsFunc) SetDefaultReturn(r0 []byte, r1 error) {
+	f.SetDefaultHook(func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID, string, gitserver.FileReaderOptions) ([]byte, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverClientReadFileWithOptionsFunc) PushReturn(r0 []byte, r1 error) {
+	f.PushHook(func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID, string, gitserver.FileReaderOptions) ([]byte, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverClientReadFileWithOptionsFunc) nextHook() func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID, string, gitserver.FileReaderOptions) ([]byte, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverClientReadFileWithOptionsFunc) appendCall(r0 GitserverClientReadFileWithOptionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverClientReadFileWithOptionsFuncCall
+// objects describing the invocations of this function.
+func (f *GitserverClientReadFileWithOptionsFunc) History() []GitserverClientReadFileWithOptionsFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverClientReadFileWithOptionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverClientReadFileWithOptionsFuncCall is an object that describes an
+// invocation of method ReadFileWithOptions on an instance of
+// MockGitserverClient.
+type GitserverClientReadFileWithOptionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 authz.SubRepoPermissionChecker
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.RepoName
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 api.CommitID
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 string
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 gitserver.FileReaderOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []byte
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverClientReadFileWithOptionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverClientReadFileWithOptionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // GitserverClientRefDescriptionsFunc describes the behavior when the
 // RefDescriptions method of the parent MockGitserverClient instance is
 // invoked.
@@ -16530,6 +16963,230 @@ func (c GitserverClientRepoCloneProgressFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// GitserverClientRepoMaintenanceFunc describes the behavior when the
+// RepoMaintenance method of the parent MockGitserverClient instance is
+// invoked.
+type GitserverClientRepoMaintenanceFunc struct {
+	defaultHook func(context.Context, api.RepoName, bool) (*protocol.RepoMaintenance, error)
+	hooks       []func(context.Context, api.RepoName, bool) (*protocol.RepoMaintenance, error)
+	history     []GitserverClientRepoMaintenanceFuncCall
+	mutex       sync.Mutex
+}
+
+// RepoMaintenance delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGitserverClient) RepoMaintenance(v0 context.Context, v1 api.RepoName, v2 bool) (*protocol.RepoMaintenance, error) {
+	r0, r1 := m.RepoMaintenanceFunc.nextHook()(v0, v1, v2)
+	m.RepoMaintenanceFunc.appendCall(GitserverClientRepoMaintenanceFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the RepoMaintenance
+// method of the parent MockGitserverClient instance is invoked and the hook
+// queue is empty.
+func (f *GitserverClientRepoMaintenanceFunc) SetDefaultHook(hook func(context.Context, api.RepoName, bool) (*protocol.RepoMaintenance, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RepoMaintenance method of the parent MockGitserverClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GitserverClientRepoMaintenanceFunc) PushHook(hook func(context.Context, api.RepoName, bool) (*protocol.RepoMaintenance, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverClientRepoMaintenanceFunc) SetDefaultReturn(r0 *protocol.RepoMaintenance, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, bool) (*protocol.RepoMaintenance, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverClientRepoMaintenanceFunc) PushReturn(r0 *protocol.RepoMaintenance, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, bool) (*protocol.RepoMaintenance, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverClientRepoMaintenanceFunc) nextHook() func(context.Context, api.RepoName, bool) (*protocol.RepoMaintenance, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverClientRepoMaintenanceFunc) appendCall(r0 GitserverClientRepoMaintenanceFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverClientRepoMaintenanceFuncCall
+// objects describing the invocations of this function.
+func (f *GitserverClientRepoMaintenanceFunc) History() []GitserverClientRepoMaintenanceFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverClientRepoMaintenanceFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverClientRepoMaintenanceFuncCall is an object that describes an
+// invocation of method RepoMaintenance on an instance of
+// MockGitserverClient.
+type GitserverClientRepoMaintenanceFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 bool
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *protocol.RepoMaintenance
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverClientRepoMaintenanceFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverClientRepoMaintenanceFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitserverClientRepoSizeBreakdownFunc describes the behavior when the
+// RepoSizeBreakdown method of the parent MockGitserverClient instance is
+// invoked.
+type GitserverClientRepoSizeBreakdownFunc struct {
+	defaultHook func(context.Context, api.RepoName) (*protocol.RepoSizeBreakdown, error)
+	hooks       []func(context.Context, api.RepoName) (*protocol.RepoSizeBreakdown, error)
+	history     []GitserverClientRepoSizeBreakdownFuncCall
+	mutex       sync.Mutex
+}
+
+// RepoSizeBreakdown delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGitserverClient) RepoSizeBreakdown(v0 context.Context, v1 api.RepoName) (*protocol.RepoSizeBreakdown, error) {
+	r0, r1 := m.RepoSizeBreakdownFunc.nextHook()(v0, v1)
+	m.RepoSizeBreakdownFunc.appendCall(GitserverClientRepoSizeBreakdownFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the RepoSizeBreakdown
+// method of the parent MockGitserverClient instance is invoked and the hook
+// queue is empty.
+func (f *GitserverClientRepoSizeBreakdownFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (*protocol.RepoSizeBreakdown, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RepoSizeBreakdown method of the parent MockGitserverClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GitserverClientRepoSizeBreakdownFunc) PushHook(hook func(context.Context, api.RepoName) (*protocol.RepoSizeBreakdown, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverClientRepoSizeBreakdownFunc) SetDefaultReturn(r0 *protocol.RepoSizeBreakdown, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) (*protocol.RepoSizeBreakdown, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverClientRepoSizeBreakdownFunc) PushReturn(r0 *protocol.RepoSizeBreakdown, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName) (*protocol.RepoSizeBreakdown, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverClientRepoSizeBreakdownFunc) nextHook() func(context.Context, api.RepoName) (*protocol.RepoSizeBreakdown, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverClientRepoSizeBreakdownFunc) appendCall(r0 GitserverClientRepoSizeBreakdownFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverClientRepoSizeBreakdownFuncCall
+// objects describing the invocations of this function.
+func (f *GitserverClientRepoSizeBreakdownFunc) History() []GitserverClientRepoSizeBreakdownFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverClientRepoSizeBreakdownFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverClientRepoSizeBreakdownFuncCall is an object that describes an
+// invocation of method RepoSizeBreakdown on an instance of
+// MockGitserverClient.
+type GitserverClientRepoSizeBreakdownFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *protocol.RepoSizeBreakdown
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverClientRepoSizeBreakdownFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverClientRepoSizeBreakdownFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // GitserverClientReposStatsFunc describes the behavior when the ReposStats
 // method of the parent MockGitserverClient instance is invoked.
 type GitserverClientReposStatsFunc struct {
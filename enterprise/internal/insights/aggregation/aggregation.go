@@ -103,16 +103,12 @@ func countCaptureGroupsFunc(querystring string) (AggregationCountFunc, error) {
 		if len(content) != 0 {
 			matches := map[MatchKey]int{}
 			for _, contentPiece := range content {
-				for _, submatches := range regex.FindAllStringSubmatchIndex(contentPiece, -1) {
-					contentMatches := fromRegexpMatches(submatches, contentPiece)
-					for value, count := range contentMatches {
-						key := MatchKey{Repo: string(r.RepoName().Name), RepoID: int32(r.RepoName().ID), Group: value}
-						if len(key.Group) > 100 {
-							key.Group = key.Group[:100]
-						}
-						current := matches[key]
-						matches[key] = current + count
+				for _, value := range result.CaptureGroupValues(regex, contentPiece) {
+					key := MatchKey{Repo: string(r.RepoName().Name), RepoID: int32(r.RepoName().ID), Group: value}
+					if len(key.Group) > 100 {
+						key.Group = key.Group[:100]
 					}
+					matches[key]++
 				}
 			}
 			return matches, nil
@@ -128,9 +124,7 @@ func matchContent(event result.Match) []string {
 		var content = make([]string, 0, capacity)
 		if len(match.ChunkMatches) > 0 { // This File match with the subtype of text results
 			for _, cm := range match.ChunkMatches {
-				for _, range_ := range cm.Ranges {
-					content = append(content, chunkContent(cm, range_))
-				}
+				content = append(content, cm.MatchedContent()...)
 			}
 			return content
 		} else if len(match.Symbols) > 0 { // This File match with the subtype of symbol results
@@ -7,7 +7,6 @@ import (
 	"github.com/grafana/regexp"
 
 	"github.com/sourcegraph/sourcegraph/internal/search/query"
-	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -35,12 +34,6 @@ func (p Comby) String() string {
 	return p.Value
 }
 
-func chunkContent(c result.ChunkMatch, r result.Range) string {
-	// Set range relative to the start of the content.
-	rr := r.Sub(c.ContentStart)
-	return c.Content[rr.Start.Offset:rr.End.Offset]
-}
-
 func toRegexpPattern(value string) (MatchPattern, error) {
 	rp, err := regexp.Compile(value)
 	if err != nil {
@@ -76,19 +69,3 @@ func extractPattern(basic *query.Basic) (*query.Pattern, error) {
 	}
 	return pattern, nil
 }
-
-func fromRegexpMatches(submatches []int, content string) map[string]int {
-	counts := map[string]int{}
-
-	if len(submatches) >= 4 {
-		start := submatches[2]
-		end := submatches[3]
-		if start != -1 && end != -1 {
-			value := content[start:end]
-			counts[value] = 1
-		}
-
-	}
-
-	return counts
-}
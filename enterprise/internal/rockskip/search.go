@@ -167,6 +167,23 @@ func mkIsMatch(args search.SymbolsParameters) (func(string) bool, error) {
 	}
 }
 
+// mkIsKindMatch returns a function which reports whether a symbol of the given
+// kind is one of args.IncludeKinds.
+func mkIsKindMatch(args search.SymbolsParameters) func(string) bool {
+	if len(args.IncludeKinds) == 0 {
+		return func(string) bool { return true }
+	}
+	return func(kind string) bool {
+		selectKind := result.Symbol{Kind: kind}.SelectKind()
+		for _, k := range args.IncludeKinds {
+			if k == selectKind {
+				return true
+			}
+		}
+		return false
+	}
+}
+
 func (s *Service) emitIndexRequest(rc repoCommit) (chan struct{}, error) {
 	key := fmt.Sprintf("%s@%s", rc.repo, rc.commit)
 
@@ -253,6 +270,7 @@ func (s *Service) querySymbols(ctx context.Context, args search.SymbolsParameter
 	if err != nil {
 		return nil, err
 	}
+	isKindMatch := mkIsKindMatch(args)
 
 	paths := goset.NewSet[string]()
 	for rows.Next() {
@@ -287,7 +305,7 @@ func (s *Service) querySymbols(ctx context.Context, args search.SymbolsParameter
 		lines := strings.Split(string(contents), "\n")
 
 		for _, symbol := range allSymbols {
-			if isMatch(symbol.Name) {
+			if isMatch(symbol.Name) && isKindMatch(symbol.Kind) {
 				if symbol.Line < 1 || symbol.Line > len(lines) {
 					log15.Warn("ctags returned an invalid line number", "path", path, "line", symbol.Line, "len(lines)", len(lines), "symbol", symbol.Name)
 					continue
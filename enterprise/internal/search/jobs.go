@@ -4,13 +4,18 @@ import (
 	ownsearch "github.com/sourcegraph/sourcegraph/internal/own/search"
 	"github.com/sourcegraph/sourcegraph/internal/search/job"
 	"github.com/sourcegraph/sourcegraph/internal/search/job/jobutil"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
 )
 
-func NewEnterpriseSearchJobs() jobutil.EnterpriseJobs {
-	return &enterpriseJobs{}
+// NewEnterpriseSearchJobs returns the enterprise search jobs. ranker may be
+// nil, in which case results are not ordered by document rank.
+func NewEnterpriseSearchJobs(ranker streaming.DocumentRanker) jobutil.EnterpriseJobs {
+	return &enterpriseJobs{ranker: ranker}
 }
 
-type enterpriseJobs struct{}
+type enterpriseJobs struct {
+	ranker streaming.DocumentRanker
+}
 
 func (e *enterpriseJobs) FileHasOwnerJob(child job.Job, includeOwners, excludeOwners []string) job.Job {
 	return ownsearch.NewFileHasOwnersJob(child, includeOwners, excludeOwners)
@@ -19,3 +24,10 @@ func (e *enterpriseJobs) FileHasOwnerJob(child job.Job, includeOwners, excludeOw
 func (e *enterpriseJobs) SelectFileOwnerJob(child job.Job) job.Job {
 	return ownsearch.NewSelectOwnersJob(child)
 }
+
+func (e *enterpriseJobs) DocumentRankJob(child job.Job) job.Job {
+	if e.ranker == nil {
+		return child
+	}
+	return jobutil.NewDocumentRankJob(child, e.ranker)
+}
@@ -19,6 +19,9 @@ func InferRepositoryAndRevision(pkg dependencies.MinimialVersionedPackageRepo) (
 		inferRustRepositoryAndRevision,
 		inferPythonRepositoryAndRevision,
 		inferRubyRepositoryAndRevision,
+		inferNuGetRepositoryAndRevision,
+		inferHexRepositoryAndRevision,
+		inferPubRepositoryAndRevision,
 	} {
 		if repoName, gitTagOrCommit, ok := fn(pkg); ok {
 			return repoName, gitTagOrCommit, true
@@ -99,3 +102,30 @@ func inferRubyRepositoryAndRevision(pkg dependencies.MinimialVersionedPackageRep
 
 	return rubyPkg.RepoName(), pkg.Version, true
 }
+
+func inferNuGetRepositoryAndRevision(pkg dependencies.MinimialVersionedPackageRepo) (api.RepoName, string, bool) {
+	if pkg.Scheme != dependencies.NuGetPackagesScheme {
+		return "", "", false
+	}
+
+	nugetPkg := reposource.NewNuGetVersionedPackage(pkg.Name, pkg.Version)
+	return nugetPkg.RepoName(), nugetPkg.GitTagFromVersion(), true
+}
+
+func inferHexRepositoryAndRevision(pkg dependencies.MinimialVersionedPackageRepo) (api.RepoName, string, bool) {
+	if pkg.Scheme != dependencies.HexPackagesScheme {
+		return "", "", false
+	}
+
+	hexPkg := reposource.NewHexVersionedPackage(pkg.Name, pkg.Version)
+	return hexPkg.RepoName(), hexPkg.GitTagFromVersion(), true
+}
+
+func inferPubRepositoryAndRevision(pkg dependencies.MinimialVersionedPackageRepo) (api.RepoName, string, bool) {
+	if pkg.Scheme != dependencies.PubPackagesScheme {
+		return "", "", false
+	}
+
+	pubPkg := reposource.NewPubVersionedPackage(pkg.Name, pkg.Version)
+	return pubPkg.RepoName(), pubPkg.GitTagFromVersion(), true
+}
@@ -58,6 +58,33 @@ func TestInferRepositoryAndRevision(t *testing.T) {
 				repoName: "npm/myscope/mypackage",
 				revision: "v1.0.0",
 			},
+			{
+				pkg: dependencies.MinimialVersionedPackageRepo{
+					Scheme:  "scip-dotnet",
+					Name:    "Newtonsoft.Json",
+					Version: "13.0.3",
+				},
+				repoName: "nuget/Newtonsoft.Json",
+				revision: "v13.0.3",
+			},
+			{
+				pkg: dependencies.MinimialVersionedPackageRepo{
+					Scheme:  "hex",
+					Name:    "phoenix",
+					Version: "1.7.7",
+				},
+				repoName: "hex/phoenix",
+				revision: "v1.7.7",
+			},
+			{
+				pkg: dependencies.MinimialVersionedPackageRepo{
+					Scheme:  "scip-dart",
+					Name:    "http",
+					Version: "1.1.0",
+				},
+				repoName: "pub/http",
+				revision: "v1.1.0",
+			},
 		}
 
 		for _, testCase := range testCases {
@@ -0,0 +1,557 @@
+package codenav
+
+import (
+	"context"
+	"strings"
+
+	"github.com/sourcegraph/scip/bindings/go/scip"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/internal/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/collections"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// MaxCallHierarchyDepth is the maximum depth of a call hierarchy.
+const MaxCallHierarchyDepth = 10
+
+const (
+	// maxCallHierarchyCallsPerFunction is the maximum number of calls to or from a single function
+	// considered by a call hierarchy, which bounds the fan-out of each step of the traversal.
+	maxCallHierarchyCallsPerFunction = 100
+
+	// maxCallHierarchyFunctions is the maximum number of functions visited by a call hierarchy,
+	// which also bounds the size of its cursor.
+	maxCallHierarchyFunctions = 100
+)
+
+// GetIncomingCalls returns the calls to the function at the given position, followed by the calls to
+// its callers, and so on, up to the given depth. The caller of a call is the innermost function whose
+// definition encloses the reference, so indexers which don't emit enclosing ranges yield no calls.
+func (s *Service) GetIncomingCalls(ctx context.Context, args PositionalRequestArgs, requestState RequestState, depth int, cursor CallHierarchyCursor) (_ []CallHierarchyCall, _ CallHierarchyCursor, err error) {
+	return s.gatherCalls(
+		ctx, args, requestState, depth, cursor,
+
+		s.operations.getIncomingCalls, // operation
+		false,                         // requireDefinition
+		s.getIncomingCalls,
+	)
+}
+
+// GetOutgoingCalls returns the calls from the function at the given position, followed by the calls
+// from its callees, and so on, up to the given depth. The calls from a function are the references to
+// functions within the enclosing range of its definition.
+func (s *Service) GetOutgoingCalls(ctx context.Context, args PositionalRequestArgs, requestState RequestState, depth int, cursor CallHierarchyCursor) (_ []CallHierarchyCall, _ CallHierarchyCursor, err error) {
+	return s.gatherCalls(
+		ctx, args, requestState, depth, cursor,
+
+		s.operations.getOutgoingCalls, // operation
+		true,                          // requireDefinition
+		s.getOutgoingCalls,
+	)
+}
+
+// getCallsFunc returns the calls to or from the given function, along with the functions on the
+// other end of these calls.
+type getCallsFunc func(ctx context.Context, args RequestArgs, requestState RequestState, node CallHierarchyNode) ([]CallHierarchyCall, []CallHierarchyNode, error)
+
+// gatherCalls returns a page of the calls of a breadth-first call hierarchy traversal starting at
+// the function at the given position.
+func (s *Service) gatherCalls(
+	ctx context.Context,
+	args PositionalRequestArgs,
+	requestState RequestState,
+	depth int,
+	cursor CallHierarchyCursor,
+	operation *observation.Operation,
+	requireDefinition bool,
+	getCalls getCallsFunc,
+) (allCalls []CallHierarchyCall, _ CallHierarchyCursor, err error) {
+	ctx, trace, endObservation := observeResolver(ctx, &err, operation, serviceObserverThreshold, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", args.RepositoryID),
+		attribute.String("commit", args.Commit),
+		attribute.String("path", args.Path),
+		attribute.Int("numUploads", len(requestState.GetCacheUploads())),
+		attribute.String("uploads", uploadIDsToString(requestState.GetCacheUploads())),
+		attribute.Int("line", args.Line),
+		attribute.Int("character", args.Character),
+		attribute.Int("depth", depth),
+	}})
+	defer endObservation()
+
+	if cursor.Phase == "" {
+		roots, err := s.getCallHierarchyRoots(ctx, args, requestState, requireDefinition)
+		if err != nil {
+			return nil, CallHierarchyCursor{}, err
+		}
+
+		cursor.Phase = "calls"
+		cursor.Queue = roots
+		for _, root := range roots {
+			cursor.Visited = append(cursor.Visited, root.Symbol)
+		}
+	}
+	visited := collections.NewSet(cursor.Visited...)
+
+	for len(allCalls) < args.Limit && len(cursor.Queue) > 0 {
+		node := cursor.Queue[0]
+
+		calls, next, err := getCalls(ctx, args.RequestArgs, requestState, node)
+		if err != nil {
+			return nil, CallHierarchyCursor{}, err
+		}
+		trace.AddEvent("Calls",
+			attribute.String("symbol", node.Symbol),
+			attribute.Int("depth", node.Depth),
+			attribute.Int("numCalls", len(calls)))
+
+		page := pageSlice(calls, args.Limit-len(allCalls), cursor.Offset)
+		allCalls = append(allCalls, page...)
+		cursor.Offset += len(page)
+
+		if cursor.Offset < len(calls) {
+			// we've filled our page within the calls of this function
+			break
+		}
+
+		// We've returned all calls of this function. Queue the functions on the other end of
+		// these calls, unless the calls of those functions would be deeper than requested. The
+		// visited set prevents cycles and bounds the total work of the traversal.
+		cursor.Queue = cursor.Queue[1:]
+		cursor.Offset = 0
+
+		if node.Depth+1 >= depth {
+			continue
+		}
+		for _, n := range next {
+			if len(visited) >= maxCallHierarchyFunctions {
+				break
+			}
+			if visited.Has(n.Symbol) {
+				continue
+			}
+
+			visited.Add(n.Symbol)
+			n.Depth = node.Depth + 1
+			cursor.Queue = append(cursor.Queue, n)
+		}
+	}
+
+	if len(cursor.Queue) == 0 {
+		return allCalls, CallHierarchyCursor{Phase: "done"}, nil
+	}
+
+	cursor.Visited = visited.Sorted(compareStrings)
+	return allCalls, cursor, nil
+}
+
+// getCallHierarchyRoots returns the symbol at the given position within each visible upload, at
+// the location of its definition if there is one. If requireDefinition is true, then symbols
+// without a definition are skipped.
+func (s *Service) getCallHierarchyRoots(ctx context.Context, args PositionalRequestArgs, requestState RequestState, requireDefinition bool) ([]CallHierarchyNode, error) {
+	visibleUploads, err := s.getVisibleUploads(ctx, args.Line, args.Character, requestState)
+	if err != nil {
+		return nil, err
+	}
+
+	var roots []CallHierarchyNode
+	seen := collections.NewSet[string]()
+
+	for _, upload := range visibleUploads {
+		document, err := s.lsifstore.SCIPDocument(ctx, upload.Upload.ID, upload.TargetPathWithoutRoot)
+		if err != nil {
+			return nil, errors.Wrap(err, "lsifStore.SCIPDocument")
+		}
+		if document == nil {
+			continue
+		}
+
+		for _, occurrence := range scip.FindOccurrences(document.Occurrences, int32(upload.TargetPosition.Line), int32(upload.TargetPosition.Character)) {
+			if occurrence.Symbol == "" {
+				continue
+			}
+			if seen.Has(occurrence.Symbol) {
+				break
+			}
+			seen.Add(occurrence.Symbol)
+
+			root := CallHierarchyNode{
+				UploadID: upload.Upload.ID,
+				Path:     upload.TargetPathWithoutRoot,
+				Position: convertSCIPRange(occurrence.Range).Start,
+				Symbol:   occurrence.Symbol,
+			}
+
+			definition, ok, err := s.getCallHierarchyDefinition(ctx, requestState, root.UploadID, root.Path, document, root.Symbol)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				root = newCallHierarchyNode(definition, root.Symbol)
+			} else if requireDefinition {
+				break
+			}
+
+			roots = append(roots, root)
+			break
+		}
+	}
+
+	return roots, nil
+}
+
+// getIncomingCalls returns the calls to the given function, and their callers. References are read
+// from the document of the function, then from the indexes defining the function and a single batch
+// of indexes referencing it.
+func (s *Service) getIncomingCalls(ctx context.Context, args RequestArgs, requestState RequestState, node CallHierarchyNode) ([]CallHierarchyCall, []CallHierarchyNode, error) {
+	locations, symbolNames, err := s.lsifstore.ExtractReferenceLocationsFromPosition(ctx, lsifstore.LocationKey{
+		UploadID:  node.UploadID,
+		Path:      node.Path,
+		Line:      node.Position.Line,
+		Character: node.Position.Character,
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "lsifStore.ExtractReferenceLocationsFromPosition")
+	}
+
+	if len(locations) < maxCallHierarchyCallsPerFunction {
+		remoteLocations, err := s.getCallHierarchyRemoteReferences(ctx, args, requestState, node, symbolNames, maxCallHierarchyCallsPerFunction-len(locations))
+		if err != nil {
+			return nil, nil, err
+		}
+		locations = append(locations, remoteLocations...)
+	}
+	locations = pageSlice(locations, maxCallHierarchyCallsPerFunction, 0)
+
+	// Hydrate the uploads of the references into the request state data loader, as the function
+	// may come from the cursor of a previous request.
+	uploadIDs := collections.NewSet[int]()
+	for _, location := range locations {
+		uploadIDs.Add(location.DumpID)
+	}
+	if _, err := s.getUploadsByIDs(ctx, uploadIDs.Values(), requestState); err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		calls     []CallHierarchyCall
+		callers   []CallHierarchyNode
+		documents = map[shared.Location]*scip.Document{}
+	)
+
+	for _, location := range locations {
+		documentKey := shared.Location{DumpID: location.DumpID, Path: location.Path}
+		document, ok := documents[documentKey]
+		if !ok {
+			if document, err = s.lsifstore.SCIPDocument(ctx, location.DumpID, location.Path); err != nil {
+				return nil, nil, errors.Wrap(err, "lsifStore.SCIPDocument")
+			}
+			documents[documentKey] = document
+		}
+		if document == nil {
+			continue
+		}
+
+		caller := enclosingFunction(document, location.Range)
+		if caller == nil {
+			// e.g. a reference at the top level of a file
+			continue
+		}
+		callerDefinition := shared.Location{
+			DumpID: location.DumpID,
+			Path:   location.Path,
+			Range:  convertSCIPRange(caller.Range),
+		}
+
+		call, ok, err := s.newCallHierarchyCall(ctx, args, requestState, node.Depth+1, caller.Symbol, &callerDefinition, location)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		calls = append(calls, call)
+		callers = append(callers, newCallHierarchyNode(callerDefinition, caller.Symbol))
+	}
+
+	return calls, callers, nil
+}
+
+// getCallHierarchyRemoteReferences returns references to the given symbols outside of the document
+// of the given function, within the uploads defining one of the symbols and a single batch of the
+// uploads referencing them.
+func (s *Service) getCallHierarchyRemoteReferences(ctx context.Context, args RequestArgs, requestState RequestState, node CallHierarchyNode, symbolNames []string, limit int) ([]shared.Location, error) {
+	return s.getRemoteMonikerLocations(ctx, args, requestState, "references", node, symbolNames, limit)
+}
+
+// getRemoteMonikerLocations returns the locations of the given table (e.g. references) of the given
+// symbols outside of the document of the given node, within the uploads defining one of the symbols
+// and a single batch of the uploads referencing them.
+func (s *Service) getRemoteMonikerLocations(ctx context.Context, args RequestArgs, requestState RequestState, tableName string, node CallHierarchyNode, symbolNames []string, limit int) ([]shared.Location, error) {
+	var globalSymbolNames []string
+	for _, symbolName := range symbolNames {
+		if !strings.HasPrefix(symbolName, skipPrefix) {
+			globalSymbolNames = append(globalSymbolNames, symbolName)
+		}
+	}
+
+	monikers, err := symbolsToMonikers(globalSymbolNames)
+	if err != nil {
+		return nil, err
+	}
+	if len(monikers) == 0 {
+		return nil, nil
+	}
+
+	definitionUploads, err := s.getUploadsWithDefinitionsForMonikers(ctx, monikers, requestState)
+	if err != nil {
+		return nil, err
+	}
+	definitionIDs := make([]int, 0, len(definitionUploads))
+	for _, upload := range definitionUploads {
+		definitionIDs = append(definitionIDs, upload.ID)
+	}
+
+	referenceIDs, _, _, err := s.uploadSvc.GetUploadIDsWithReferences(
+		ctx,
+		monikers,
+		definitionIDs,
+		args.RepositoryID,
+		args.Commit,
+		requestState.maximumIndexesPerMonikerSearch, // limit
+		0, // offset
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "uploadSvc.GetUploadIDsWithReferences")
+	}
+
+	uploadIDs := append(definitionIDs, referenceIDs...)
+	if len(uploadIDs) == 0 {
+		return nil, nil
+	}
+
+	monikerArgs := make([]precise.MonikerData, 0, len(monikers))
+	for _, moniker := range monikers {
+		monikerArgs = append(monikerArgs, moniker.MonikerData)
+	}
+
+	locations, _, err := s.lsifstore.GetMinimalBulkMonikerLocations(
+		ctx,
+		tableName,
+		uploadIDs,
+		map[int]string{node.UploadID: node.Path},
+		monikerArgs,
+		limit,
+		0, // offset
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "lsifStore.GetMinimalBulkMonikerLocations")
+	}
+
+	return locations, nil
+}
+
+// getOutgoingCalls returns the calls from the given function, and their callees. The callees are
+// returned only if they have a definition, as the calls from a function are read from the document
+// defining it.
+func (s *Service) getOutgoingCalls(ctx context.Context, args RequestArgs, requestState RequestState, node CallHierarchyNode) ([]CallHierarchyCall, []CallHierarchyNode, error) {
+	document, err := s.lsifstore.SCIPDocument(ctx, node.UploadID, node.Path)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "lsifStore.SCIPDocument")
+	}
+	if document == nil {
+		return nil, nil, nil
+	}
+
+	var enclosingRange []int32
+	for _, occurrence := range document.Occurrences {
+		if occurrence.Symbol == node.Symbol && scip.SymbolRole_Definition.Matches(occurrence) && len(occurrence.EnclosingRange) > 0 {
+			enclosingRange = occurrence.EnclosingRange
+			break
+		}
+	}
+	if enclosingRange == nil {
+		// The indexer did not emit the range of the function's body
+		return nil, nil, nil
+	}
+	body := convertSCIPRange(enclosingRange)
+
+	if _, err := s.getUploadsByIDs(ctx, []int{node.UploadID}, requestState); err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		calls       []CallHierarchyCall
+		callees     []CallHierarchyNode
+		definitions = map[string]*shared.Location{}
+	)
+
+	for _, occurrence := range document.Occurrences {
+		if len(calls) >= maxCallHierarchyCallsPerFunction {
+			break
+		}
+
+		callSite := shared.Location{DumpID: node.UploadID, Path: node.Path, Range: convertSCIPRange(occurrence.Range)}
+		if !rangeContains(body, callSite.Range) || scip.SymbolRole_Definition.Matches(occurrence) || !isFunctionSymbol(occurrence.Symbol) {
+			continue
+		}
+
+		definition, ok := definitions[occurrence.Symbol]
+		if !ok {
+			location, found, err := s.getCallHierarchyDefinition(ctx, requestState, node.UploadID, node.Path, document, occurrence.Symbol)
+			if err != nil {
+				return nil, nil, err
+			}
+			if found {
+				definition = &location
+				callees = append(callees, newCallHierarchyNode(location, occurrence.Symbol))
+			}
+			definitions[occurrence.Symbol] = definition
+		}
+
+		call, ok, err := s.newCallHierarchyCall(ctx, args, requestState, node.Depth+1, occurrence.Symbol, definition, callSite)
+		if err != nil {
+			return nil, nil, err
+		}
+		if ok {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls, callees, nil
+}
+
+// getCallHierarchyDefinition returns the location of a definition of the given symbol. Definitions
+// within the given document, which belongs to the given upload, are preferred over definitions in
+// the uploads defining one of the symbol's monikers.
+func (s *Service) getCallHierarchyDefinition(ctx context.Context, requestState RequestState, uploadID int, path string, document *scip.Document, symbolName string) (shared.Location, bool, error) {
+	for _, occurrence := range document.Occurrences {
+		if occurrence.Symbol == symbolName && scip.SymbolRole_Definition.Matches(occurrence) {
+			return shared.Location{DumpID: uploadID, Path: path, Range: convertSCIPRange(occurrence.Range)}, true, nil
+		}
+	}
+
+	monikers, err := symbolsToMonikers([]string{symbolName})
+	if err != nil || len(monikers) == 0 {
+		return shared.Location{}, false, err
+	}
+
+	uploads, err := s.getUploadsWithDefinitionsForMonikers(ctx, monikers, requestState)
+	if err != nil || len(uploads) == 0 {
+		return shared.Location{}, false, err
+	}
+	ids := make([]int, 0, len(uploads))
+	for _, upload := range uploads {
+		ids = append(ids, upload.ID)
+	}
+
+	locations, _, err := s.lsifstore.GetMinimalBulkMonikerLocations(ctx, "definitions", ids, nil, []precise.MonikerData{monikers[0].MonikerData}, 1, 0)
+	if err != nil {
+		return shared.Location{}, false, errors.Wrap(err, "lsifStore.GetMinimalBulkMonikerLocations")
+	}
+	if len(locations) == 0 {
+		return shared.Location{}, false, nil
+	}
+
+	return locations[0], true, nil
+}
+
+// newCallHierarchyCall adjusts the locations of a call to the requested commit. A false-valued flag is
+// returned if the upload of the call site is not known.
+func (s *Service) newCallHierarchyCall(ctx context.Context, args RequestArgs, requestState RequestState, depth int, symbolName string, definition *shared.Location, callSite shared.Location) (CallHierarchyCall, bool, error) {
+	adjustedCallSites, err := s.getUploadLocations(ctx, args, requestState, []shared.Location{callSite}, true)
+	if err != nil || len(adjustedCallSites) == 0 {
+		return CallHierarchyCall{}, false, err
+	}
+
+	call := CallHierarchyCall{
+		Symbol:   symbolName,
+		CallSite: adjustedCallSites[0],
+		Depth:    depth,
+	}
+
+	if definition != nil {
+		adjustedDefinitions, err := s.getUploadLocations(ctx, args, requestState, []shared.Location{*definition}, true)
+		if err != nil {
+			return CallHierarchyCall{}, false, err
+		}
+		if len(adjustedDefinitions) > 0 {
+			call.Definition = &adjustedDefinitions[0]
+		}
+	}
+
+	return call, true, nil
+}
+
+func newCallHierarchyNode(definition shared.Location, symbolName string) CallHierarchyNode {
+	return CallHierarchyNode{
+		UploadID: definition.DumpID,
+		Path:     definition.Path,
+		Position: definition.Range.Start,
+		Symbol:   symbolName,
+	}
+}
+
+// enclosingFunction returns the definition of the innermost function whose enclosing range contains
+// the given range, if any.
+func enclosingFunction(document *scip.Document, r shared.Range) *scip.Occurrence {
+	var (
+		function      *scip.Occurrence
+		functionRange shared.Range
+	)
+
+	for _, occurrence := range document.Occurrences {
+		if len(occurrence.EnclosingRange) == 0 || !scip.SymbolRole_Definition.Matches(occurrence) {
+			continue
+		}
+
+		enclosingRange := convertSCIPRange(occurrence.EnclosingRange)
+		if !rangeContains(enclosingRange, r) || (function != nil && !rangeContains(functionRange, enclosingRange)) {
+			continue
+		}
+		if !isFunctionSymbol(occurrence.Symbol) {
+			continue
+		}
+
+		function, functionRange = occurrence, enclosingRange
+	}
+
+	return function
+}
+
+// isFunctionSymbol returns true if the given symbol is a global symbol with a method descriptor,
+// which SCIP uses for both functions and methods (e.g. `scip-go gomod example v1 pkg/Func().`).
+func isFunctionSymbol(symbolName string) bool {
+	if symbolName == "" || scip.IsLocalSymbol(symbolName) {
+		return false
+	}
+
+	symbol, err := scip.ParseSymbol(symbolName)
+	if err != nil || len(symbol.Descriptors) == 0 {
+		return false
+	}
+
+	return symbol.Descriptors[len(symbol.Descriptors)-1].Suffix == scip.Descriptor_Method
+}
+
+func convertSCIPRange(scipRange []int32) shared.Range {
+	r := scip.NewRange(scipRange)
+
+	return shared.Range{
+		Start: shared.Position{Line: int(r.Start.Line), Character: int(r.Start.Character)},
+		End:   shared.Position{Line: int(r.End.Line), Character: int(r.End.Character)},
+	}
+}
+
+// rangeContains returns true if the outer range contains the inner range.
+func rangeContains(outer, inner shared.Range) bool {
+	return !positionBefore(inner.Start, outer.Start) && !positionBefore(outer.End, inner.End)
+}
+
+func positionBefore(a, b shared.Position) bool {
+	return a.Line < b.Line || (a.Line == b.Line && a.Character < b.Character)
+}
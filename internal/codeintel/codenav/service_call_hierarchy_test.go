@@ -0,0 +1,281 @@
+package codenav
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/scip/bindings/go/scip"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/internal/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	sgtypes "github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
+
+const (
+	testSymbolA       = "scip-go gomod example v1 `example`/a()."
+	testSymbolB       = "scip-go gomod example v1 `example`/b()."
+	testSymbolC       = "scip-go gomod example v1 `example`/c()."
+	testSymbolD       = "scip-go gomod lib v2 `lib`/d()."
+	testSymbolX       = "scip-go gomod example v1 `example`/x."
+	testSymbolPrintln = "scip-go gomod fmt v1 `fmt`/Println()."
+)
+
+func TestGetIncomingCalls(t *testing.T) {
+	svc, requestState := setupCallHierarchyTest(t)
+
+	// a() is called by b() and c(), and b() is called by c(). The call to a() in
+	// d() is in another repository.
+	want := []string{
+		"1 b() sub1/main.go:5:1 -> sub1/main.go:4:5",
+		"1 c() sub1/main.go:11:1 -> sub1/main.go:9:5",
+		"1 d() lib/lib.go:1:1 -> lib/lib.go:0:5",
+		"2 c() sub1/main.go:10:1 -> sub1/main.go:9:5",
+	}
+	testCallHierarchy(t, want, func(limit int, cursor CallHierarchyCursor) ([]CallHierarchyCall, CallHierarchyCursor, error) {
+		return svc.GetIncomingCalls(context.Background(), callHierarchyRequest(0, 5, limit), requestState, 2, cursor)
+	})
+
+	t.Run("depth", func(t *testing.T) {
+		testCallHierarchy(t, want[:3], func(limit int, cursor CallHierarchyCursor) ([]CallHierarchyCall, CallHierarchyCursor, error) {
+			return svc.GetIncomingCalls(context.Background(), callHierarchyRequest(0, 5, limit), requestState, 1, cursor)
+		})
+	})
+}
+
+func TestGetOutgoingCalls(t *testing.T) {
+	svc, requestState := setupCallHierarchyTest(t)
+
+	// c() calls b(), a() and fmt.Println(), which is not indexed, and b() calls
+	// a(). The reference to the variable x in c() is not a call.
+	want := []string{
+		"1 b() sub1/main.go:10:1 -> sub1/main.go:4:5",
+		"1 a() sub1/main.go:11:1 -> sub1/main.go:0:5",
+		"1 Println() sub1/main.go:12:1 -> <none>",
+		"2 a() sub1/main.go:5:1 -> sub1/main.go:0:5",
+	}
+	testCallHierarchy(t, want, func(limit int, cursor CallHierarchyCursor) ([]CallHierarchyCall, CallHierarchyCursor, error) {
+		return svc.GetOutgoingCalls(context.Background(), callHierarchyRequest(9, 5, limit), requestState, 3, cursor)
+	})
+}
+
+func TestIsFunctionSymbol(t *testing.T) {
+	for symbol, want := range map[string]bool{
+		testSymbolA:       true,
+		testSymbolPrintln: true,
+		testSymbolX:       false,
+		"scip-java maven example 1.0 com/example/Foo#bar().": true,
+		"scip-java maven example 1.0 com/example/Foo#":       false,
+		"local 1":  false,
+		"":         false,
+		"invalid(": false,
+	} {
+		if got := isFunctionSymbol(symbol); got != want {
+			t.Errorf("unexpected result for %q. want=%v have=%v", symbol, want, got)
+		}
+	}
+}
+
+// testCallHierarchy checks that the given call hierarchy returns the wanted calls, both in a single page
+// and one call per page.
+func testCallHierarchy(t *testing.T, want []string, getCalls func(limit int, cursor CallHierarchyCursor) ([]CallHierarchyCall, CallHierarchyCursor, error)) {
+	t.Helper()
+
+	calls, cursor, err := getCalls(50, CallHierarchyCursor{})
+	if err != nil {
+		t.Fatalf("unexpected error querying calls: %s", err)
+	}
+	if diff := cmp.Diff(want, formatCalls(calls)); diff != "" {
+		t.Errorf("unexpected calls (-want +got):\n%s", diff)
+	}
+	if cursor.Phase != "done" {
+		t.Errorf("unexpected cursor phase. want=%q have=%q", "done", cursor.Phase)
+	}
+
+	var paged []CallHierarchyCall
+	for i, cursor := 0, (CallHierarchyCursor{}); i == 0 || cursor.Phase != "done"; i++ {
+		if i > len(want) {
+			t.Fatalf("call hierarchy did not terminate")
+		}
+
+		// round-trip the cursor as the resolver does
+		raw, _ := json.Marshal(cursor)
+		cursor = CallHierarchyCursor{}
+		if err := json.Unmarshal(raw, &cursor); err != nil {
+			t.Fatalf("unexpected error decoding cursor: %s", err)
+		}
+
+		calls, cursor, err = getCalls(1, cursor)
+		if err != nil {
+			t.Fatalf("unexpected error querying calls: %s", err)
+		}
+		paged = append(paged, calls...)
+	}
+	if diff := cmp.Diff(want, formatCalls(paged)); diff != "" {
+		t.Errorf("unexpected paged calls (-want +got):\n%s", diff)
+	}
+}
+
+func formatCalls(calls []CallHierarchyCall) []string {
+	formatLocation := func(location *shared.UploadLocation) string {
+		if location == nil {
+			return "<none>"
+		}
+		return fmt.Sprintf("%s:%d:%d", location.Path, location.TargetRange.Start.Line, location.TargetRange.Start.Character)
+	}
+
+	formatted := make([]string, 0, len(calls))
+	for _, call := range calls {
+		symbol, _ := scip.ParseSymbol(call.Symbol)
+		name := symbol.Descriptors[len(symbol.Descriptors)-1].Name
+		formatted = append(formatted, fmt.Sprintf("%d %s() %s -> %s", call.Depth, name, formatLocation(&call.CallSite), formatLocation(call.Definition)))
+	}
+
+	return formatted
+}
+
+func callHierarchyRequest(line, character, limit int) PositionalRequestArgs {
+	return PositionalRequestArgs{
+		RequestArgs: RequestArgs{
+			RepositoryID: 42,
+			Commit:       mockCommit,
+			Limit:        limit,
+		},
+		Path:      "sub1/main.go",
+		Line:      line,
+		Character: character,
+	}
+}
+
+// setupCallHierarchyTest returns a service backed by an upload of repository 42 with the following
+// document, and an upload of repository 43 with a function d() calling a().
+//
+//	0  func a() {
+//	1  }
+//	2
+//	3
+//	4  func b() {
+//	5  	a()
+//	6  }
+//	7
+//	8
+//	9  func c() {
+//	10 	b()
+//	11 	a()
+//	12 	fmt.Println(x)
+//	13 }
+//	14 var y = a
+func setupCallHierarchyTest(t *testing.T) (*Service, RequestState) {
+	mockRepoStore := defaultMockRepoStore()
+	mockLsifStore := NewMockLsifStore()
+	mockUploadSvc := NewMockUploadService()
+	mockGitserverClient := gitserver.NewMockClient()
+	hunkCache, _ := NewHunkCache(50)
+
+	svc := newService(&observation.TestContext, mockRepoStore, mockLsifStore, mockUploadSvc, mockGitserverClient)
+
+	requestState := RequestState{}
+	requestState.SetLocalCommitCache(mockRepoStore, mockGitserverClient)
+	if err := requestState.SetLocalGitTreeTranslator(mockGitserverClient, &sgtypes.Repo{ID: 42}, mockCommit, "sub1/main.go", hunkCache); err != nil {
+		t.Fatalf("unexpected error setting local git tree translator: %s", err)
+	}
+	gitTreeTranslator := NewMockGitTreeTranslator()
+	gitTreeTranslator.GetTargetCommitPositionFromSourcePositionFunc.SetDefaultHook(func(_ context.Context, _ string, pos shared.Position, _ bool) (string, shared.Position, bool, error) {
+		return "sub1/main.go", pos, true, nil
+	})
+	gitTreeTranslator.GetTargetCommitRangeFromSourceRangeFunc.SetDefaultHook(func(_ context.Context, commit, _ string, rx shared.Range, _ bool) (string, shared.Range, bool, error) {
+		return commit, rx, true, nil
+	})
+	requestState.GitTreeTranslator = gitTreeTranslator
+
+	upload := uploadsshared.Dump{ID: 50, RepositoryID: 42, Commit: mockCommit, Root: "sub1/"}
+	remoteUpload := uploadsshared.Dump{ID: 60, RepositoryID: 43, Commit: "cafebabe", Root: "lib/"}
+	requestState.SetUploadsDataLoader([]uploadsshared.Dump{upload})
+
+	mockGitserverClient.CommitsExistFunc.SetDefaultHook(func(_ context.Context, _ authz.SubRepoPermissionChecker, rcs []api.RepoCommit) (exists []bool, _ error) {
+		for range rcs {
+			exists = append(exists, true)
+		}
+		return exists, nil
+	})
+	mockUploadSvc.GetDumpsByIDsFunc.SetDefaultHook(func(_ context.Context, ids []int) (dumps []uploadsshared.Dump, _ error) {
+		for _, id := range ids {
+			if id == remoteUpload.ID {
+				dumps = append(dumps, remoteUpload)
+			}
+		}
+		return dumps, nil
+	})
+	mockUploadSvc.GetUploadIDsWithReferencesFunc.SetDefaultHook(func(_ context.Context, monikers []precise.QualifiedMonikerData, _ []int, _ int, _ string, _, _ int) ([]int, int, int, error) {
+		if len(monikers) == 1 && monikers[0].Identifier == testSymbolA {
+			return []int{remoteUpload.ID}, 1, 1, nil
+		}
+		return nil, 0, 0, nil
+	})
+
+	definition := int32(scip.SymbolRole_Definition)
+	documents := map[string]*scip.Document{
+		"50:main.go": {
+			Occurrences: []*scip.Occurrence{
+				{Range: []int32{0, 5, 6}, Symbol: testSymbolA, SymbolRoles: definition, EnclosingRange: []int32{0, 0, 1, 1}},
+				{Range: []int32{4, 5, 6}, Symbol: testSymbolB, SymbolRoles: definition, EnclosingRange: []int32{4, 0, 6, 1}},
+				{Range: []int32{5, 1, 2}, Symbol: testSymbolA},
+				{Range: []int32{9, 5, 6}, Symbol: testSymbolC, SymbolRoles: definition, EnclosingRange: []int32{9, 0, 13, 1}},
+				{Range: []int32{10, 1, 2}, Symbol: testSymbolB},
+				{Range: []int32{11, 1, 2}, Symbol: testSymbolA},
+				{Range: []int32{12, 1, 8}, Symbol: testSymbolPrintln},
+				{Range: []int32{12, 9, 10}, Symbol: testSymbolX},
+				{Range: []int32{14, 10, 11}, Symbol: testSymbolA},
+			},
+		},
+		"60:lib.go": {
+			Occurrences: []*scip.Occurrence{
+				{Range: []int32{0, 5, 6}, Symbol: testSymbolD, SymbolRoles: definition, EnclosingRange: []int32{0, 0, 2, 1}},
+				{Range: []int32{1, 1, 2}, Symbol: testSymbolA},
+			},
+		},
+	}
+	mockLsifStore.SCIPDocumentFunc.SetDefaultHook(func(_ context.Context, id int, path string) (*scip.Document, error) {
+		return documents[fmt.Sprintf("%d:%s", id, path)], nil
+	})
+
+	// References within the document of each function
+	mockLsifStore.ExtractReferenceLocationsFromPositionFunc.SetDefaultHook(func(_ context.Context, key lsifstore.LocationKey) ([]shared.Location, []string, error) {
+		document := documents[fmt.Sprintf("%d:%s", key.UploadID, key.Path)]
+		if document == nil {
+			return nil, nil, nil
+		}
+
+		var symbolName string
+		for _, occurrence := range scip.FindOccurrences(document.Occurrences, int32(key.Line), int32(key.Character)) {
+			symbolName = occurrence.Symbol
+		}
+
+		var locations []shared.Location
+		for _, occurrence := range document.Occurrences {
+			if occurrence.Symbol == symbolName && !scip.SymbolRole_Definition.Matches(occurrence) {
+				locations = append(locations, shared.Location{DumpID: key.UploadID, Path: key.Path, Range: convertSCIPRange(occurrence.Range)})
+			}
+		}
+		return locations, []string{symbolName}, nil
+	})
+
+	// References to a() from the remote upload
+	mockLsifStore.GetMinimalBulkMonikerLocationsFunc.SetDefaultHook(func(_ context.Context, tableName string, uploadIDs []int, _ map[int]string, monikers []precise.MonikerData, _, _ int) ([]shared.Location, int, error) {
+		if tableName == "references" && len(uploadIDs) == 1 && uploadIDs[0] == remoteUpload.ID && strings.HasSuffix(monikers[0].Identifier, "/a().") {
+			return []shared.Location{{DumpID: remoteUpload.ID, Path: "lib.go", Range: convertSCIPRange([]int32{1, 1, 2})}}, 1, nil
+		}
+		return nil, 0, nil
+	})
+
+	return svc, requestState
+}
@@ -0,0 +1,328 @@
+package codenav
+
+import (
+	"context"
+
+	"github.com/sourcegraph/scip/bindings/go/scip"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/collections"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// MaxTypeHierarchyDepth is the maximum depth of a type hierarchy.
+const MaxTypeHierarchyDepth = 10
+
+const (
+	// maxTypeHierarchyTypesPerType is the maximum number of supertypes or subtypes of a single type
+	// considered by a type hierarchy, which bounds the fan-out of each step of the traversal.
+	maxTypeHierarchyTypesPerType = 100
+
+	// maxTypeHierarchyTypes is the maximum number of types visited by a type hierarchy.
+	maxTypeHierarchyTypes = 100
+)
+
+// GetSupertypes returns the types implemented or extended by the type at the given position, followed
+// by their supertypes, and so on, up to the given depth. The supertypes of a type are the targets of the
+// implementation relationships of its symbol, so the hierarchy of a method is made of the methods it
+// overrides or implements.
+func (s *Service) GetSupertypes(ctx context.Context, args PositionalRequestArgs, requestState RequestState, depth int) (_ []TypeHierarchyType, err error) {
+	return s.gatherTypes(
+		ctx, args, requestState, depth,
+
+		s.operations.getSupertypes, // operation
+		true,                       // requireDefinition
+		s.getSupertypes,
+	)
+}
+
+// GetSubtypes returns the types implementing or extending the type at the given position, followed
+// by their subtypes, and so on, up to the given depth. The subtypes of a type are the symbols with an
+// implementation relationship targeting it, within any index referencing it.
+func (s *Service) GetSubtypes(ctx context.Context, args PositionalRequestArgs, requestState RequestState, depth int) (_ []TypeHierarchyType, err error) {
+	return s.gatherTypes(
+		ctx, args, requestState, depth,
+
+		s.operations.getSubtypes, // operation
+		false,                    // requireDefinition
+		s.getSubtypes,
+	)
+}
+
+// getTypesFunc returns the supertypes or subtypes of the given type, along with the nodes of these
+// types which can be traversed further. Type hierarchy traversals identify types the same way call
+// hierarchy traversals identify functions, so they share CallHierarchyNode and its helpers.
+type getTypesFunc func(ctx context.Context, args RequestArgs, requestState RequestState, node CallHierarchyNode) ([]TypeHierarchyType, []CallHierarchyNode, error)
+
+// gatherTypes returns the types of a breadth-first type hierarchy traversal starting at the type at
+// the given position. A type reachable through several paths, or through a cycle of relationships,
+// is returned once at its smallest depth.
+func (s *Service) gatherTypes(
+	ctx context.Context,
+	args PositionalRequestArgs,
+	requestState RequestState,
+	depth int,
+	operation *observation.Operation,
+	requireDefinition bool,
+	getTypes getTypesFunc,
+) (allTypes []TypeHierarchyType, err error) {
+	ctx, trace, endObservation := observeResolver(ctx, &err, operation, serviceObserverThreshold, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", args.RepositoryID),
+		attribute.String("commit", args.Commit),
+		attribute.String("path", args.Path),
+		attribute.Int("numUploads", len(requestState.GetCacheUploads())),
+		attribute.String("uploads", uploadIDsToString(requestState.GetCacheUploads())),
+		attribute.Int("line", args.Line),
+		attribute.Int("character", args.Character),
+		attribute.Int("depth", depth),
+	}})
+	defer endObservation()
+
+	queue, err := s.getCallHierarchyRoots(ctx, args, requestState, requireDefinition)
+	if err != nil {
+		return nil, err
+	}
+
+	visited := collections.NewSet[string]()
+	for _, root := range queue {
+		visited.Add(root.Symbol)
+	}
+
+	for len(queue) > 0 && len(visited) < maxTypeHierarchyTypes {
+		node := queue[0]
+		queue = queue[1:]
+
+		types, next, err := getTypes(ctx, args.RequestArgs, requestState, node)
+		if err != nil {
+			return nil, err
+		}
+		trace.AddEvent("Types",
+			attribute.String("symbol", node.Symbol),
+			attribute.Int("depth", node.Depth),
+			attribute.Int("numTypes", len(types)))
+
+		nextBySymbol := make(map[string]CallHierarchyNode, len(next))
+		for _, n := range next {
+			nextBySymbol[n.Symbol] = n
+		}
+
+		for _, t := range types {
+			if len(visited) >= maxTypeHierarchyTypes {
+				break
+			}
+			if visited.Has(t.Symbol) {
+				continue
+			}
+
+			visited.Add(t.Symbol)
+			allTypes = append(allTypes, t)
+
+			if n, ok := nextBySymbol[t.Symbol]; ok && t.Depth < depth {
+				n.Depth = t.Depth
+				queue = append(queue, n)
+			}
+		}
+	}
+
+	return allTypes, nil
+}
+
+// getSupertypes returns the supertypes of the given type, read from the symbol information of the
+// document defining the type. Supertypes are returned even if they have no visible definition, as
+// their symbols come from the document of the given type.
+func (s *Service) getSupertypes(ctx context.Context, args RequestArgs, requestState RequestState, node CallHierarchyNode) ([]TypeHierarchyType, []CallHierarchyNode, error) {
+	document, err := s.lsifstore.SCIPDocument(ctx, node.UploadID, node.Path)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "lsifStore.SCIPDocument")
+	}
+	if document == nil {
+		return nil, nil, nil
+	}
+	symbol := scip.FindSymbol(document, node.Symbol)
+	if symbol == nil {
+		return nil, nil, nil
+	}
+
+	if _, err := s.getUploadsByIDs(ctx, []int{node.UploadID}, requestState); err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		types      []TypeHierarchyType
+		supertypes []CallHierarchyNode
+	)
+
+	for _, relationship := range symbol.Relationships {
+		if len(types) >= maxTypeHierarchyTypesPerType {
+			break
+		}
+		if !relationship.IsImplementation || relationship.Symbol == node.Symbol {
+			continue
+		}
+
+		var definition *shared.Location
+		location, ok, err := s.getCallHierarchyDefinition(ctx, requestState, node.UploadID, node.Path, document, relationship.Symbol)
+		if err != nil {
+			return nil, nil, err
+		}
+		if ok {
+			definition = &location
+		}
+
+		t, _, err := s.newTypeHierarchyType(ctx, args, requestState, node, relationship.Symbol, definition, false)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		types = append(types, t)
+		if t.Definition != nil {
+			supertypes = append(supertypes, newCallHierarchyNode(location, relationship.Symbol))
+		}
+	}
+
+	return types, supertypes, nil
+}
+
+// getSubtypes returns the subtypes of the given type. Subtypes are read from the symbol information
+// of the document of the given type, then from the implementation ranges of the type within the indexes
+// defining the type and a single batch of indexes referencing it. Subtypes from other indexes are returned
+// only if their definition is visible.
+func (s *Service) getSubtypes(ctx context.Context, args RequestArgs, requestState RequestState, node CallHierarchyNode) ([]TypeHierarchyType, []CallHierarchyNode, error) {
+	document, err := s.lsifstore.SCIPDocument(ctx, node.UploadID, node.Path)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "lsifStore.SCIPDocument")
+	}
+
+	if _, err := s.getUploadsByIDs(ctx, []int{node.UploadID}, requestState); err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		types    []TypeHierarchyType
+		subtypes []CallHierarchyNode
+	)
+
+	addSubtype := func(symbolName string, definition shared.Location) error {
+		t, ok, err := s.newTypeHierarchyType(ctx, args, requestState, node, symbolName, &definition, true)
+		if err != nil || !ok {
+			return err
+		}
+
+		types = append(types, t)
+		subtypes = append(subtypes, newCallHierarchyNode(definition, symbolName))
+		return nil
+	}
+
+	if document != nil {
+		for _, symbol := range document.Symbols {
+			if len(types) >= maxTypeHierarchyTypesPerType {
+				break
+			}
+			if symbol.Symbol == node.Symbol || !implements(symbol, node.Symbol) {
+				continue
+			}
+
+			definition, ok, err := s.getCallHierarchyDefinition(ctx, requestState, node.UploadID, node.Path, document, symbol.Symbol)
+			if err != nil {
+				return nil, nil, err
+			}
+			if !ok {
+				continue
+			}
+			if err := addSubtype(symbol.Symbol, definition); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	if len(types) >= maxTypeHierarchyTypesPerType || scip.IsLocalSymbol(node.Symbol) {
+		return types, subtypes, nil
+	}
+
+	locations, err := s.getRemoteMonikerLocations(ctx, args, requestState, "implementations", node, []string{node.Symbol}, maxTypeHierarchyTypesPerType-len(types))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Hydrate the uploads of the subtypes into the request state data loader, as the type may come
+	// from another index.
+	uploadIDs := collections.NewSet[int]()
+	for _, location := range locations {
+		uploadIDs.Add(location.DumpID)
+	}
+	if _, err := s.getUploadsByIDs(ctx, uploadIDs.Values(), requestState); err != nil {
+		return nil, nil, err
+	}
+
+	documents := map[shared.Location]*scip.Document{}
+	for _, location := range locations {
+		documentKey := shared.Location{DumpID: location.DumpID, Path: location.Path}
+		document, ok := documents[documentKey]
+		if !ok {
+			if document, err = s.lsifstore.SCIPDocument(ctx, location.DumpID, location.Path); err != nil {
+				return nil, nil, errors.Wrap(err, "lsifStore.SCIPDocument")
+			}
+			documents[documentKey] = document
+		}
+		if document == nil {
+			continue
+		}
+
+		// Implementation ranges are the ranges of the definitions of the implementing symbols
+		for _, occurrence := range document.Occurrences {
+			if !scip.SymbolRole_Definition.Matches(occurrence) || convertSCIPRange(occurrence.Range) != location.Range {
+				continue
+			}
+			if symbol := scip.FindSymbol(document, occurrence.Symbol); symbol == nil || !implements(symbol, node.Symbol) {
+				continue
+			}
+
+			if err := addSubtype(occurrence.Symbol, location); err != nil {
+				return nil, nil, err
+			}
+			break
+		}
+	}
+
+	return types, subtypes, nil
+}
+
+// newTypeHierarchyType adjusts the definition of a type to the requested commit. If requireDefinition
+// is true, then a false-valued flag is returned if the definition is missing or its upload is not known.
+func (s *Service) newTypeHierarchyType(ctx context.Context, args RequestArgs, requestState RequestState, parent CallHierarchyNode, symbolName string, definition *shared.Location, requireDefinition bool) (TypeHierarchyType, bool, error) {
+	t := TypeHierarchyType{
+		Symbol: symbolName,
+		Parent: parent.Symbol,
+		Depth:  parent.Depth + 1,
+	}
+
+	if definition != nil {
+		adjustedDefinitions, err := s.getUploadLocations(ctx, args, requestState, []shared.Location{*definition}, true)
+		if err != nil {
+			return TypeHierarchyType{}, false, err
+		}
+		if len(adjustedDefinitions) > 0 {
+			t.Definition = &adjustedDefinitions[0]
+		}
+	}
+
+	if t.Definition == nil && requireDefinition {
+		return TypeHierarchyType{}, false, nil
+	}
+
+	return t, true, nil
+}
+
+// implements returns true if the given symbol has an implementation relationship with the given
+// symbol name.
+func implements(symbol *scip.SymbolInformation, symbolName string) bool {
+	for _, relationship := range symbol.Relationships {
+		if relationship.IsImplementation && relationship.Symbol == symbolName {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,217 @@
+package codenav
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/scip/bindings/go/scip"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	sgtypes "github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
+
+const (
+	testTypeI            = "scip-java maven example 1.0 com/example/I#"
+	testTypeJ            = "scip-java maven example 1.0 com/example/J#"
+	testTypeS            = "scip-java maven example 1.0 com/example/S#"
+	testTypeV            = "scip-java maven example 1.0 com/example/V#"
+	testTypeR            = "scip-java maven lib 2.0 com/lib/R#"
+	testTypeSerializable = "scip-java maven jdk 11 java/io/Serializable#"
+)
+
+func TestGetSupertypes(t *testing.T) {
+	svc, requestState := setupTypeHierarchyTest(t)
+
+	// S implements J, I and Serializable, which is not indexed, and J extends I.
+	// The cycle between S and I is not followed.
+	want := []string{
+		"1 S -> J sub1/main.java:1:10",
+		"1 S -> I sub1/main.java:0:10",
+		"1 S -> Serializable <none>",
+	}
+	types, err := svc.GetSupertypes(context.Background(), typeHierarchyRequest(2, 6), requestState, 3)
+	if err != nil {
+		t.Fatalf("unexpected error querying supertypes: %s", err)
+	}
+	if diff := cmp.Diff(want, formatTypes(types)); diff != "" {
+		t.Errorf("unexpected supertypes (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetSubtypes(t *testing.T) {
+	svc, requestState := setupTypeHierarchyTest(t)
+
+	// J and S implement I, as does R in another repository, and V extends S.
+	want := []string{
+		"1 I -> J sub1/main.java:1:10",
+		"1 I -> S sub1/main.java:2:6",
+		"1 I -> R lib/R.java:0:6",
+		"2 S -> V sub1/main.java:3:6",
+	}
+	types, err := svc.GetSubtypes(context.Background(), typeHierarchyRequest(0, 10), requestState, 2)
+	if err != nil {
+		t.Fatalf("unexpected error querying subtypes: %s", err)
+	}
+	if diff := cmp.Diff(want, formatTypes(types)); diff != "" {
+		t.Errorf("unexpected subtypes (-want +got):\n%s", diff)
+	}
+
+	t.Run("depth", func(t *testing.T) {
+		types, err := svc.GetSubtypes(context.Background(), typeHierarchyRequest(0, 10), requestState, 1)
+		if err != nil {
+			t.Fatalf("unexpected error querying subtypes: %s", err)
+		}
+		if diff := cmp.Diff(want[:3], formatTypes(types)); diff != "" {
+			t.Errorf("unexpected subtypes (-want +got):\n%s", diff)
+		}
+	})
+}
+
+func formatTypes(types []TypeHierarchyType) []string {
+	name := func(symbolName string) string {
+		symbol, _ := scip.ParseSymbol(symbolName)
+		return symbol.Descriptors[len(symbol.Descriptors)-1].Name
+	}
+
+	formatted := make([]string, 0, len(types))
+	for _, t := range types {
+		definition := "<none>"
+		if t.Definition != nil {
+			definition = fmt.Sprintf("%s:%d:%d", t.Definition.Path, t.Definition.TargetRange.Start.Line, t.Definition.TargetRange.Start.Character)
+		}
+		formatted = append(formatted, fmt.Sprintf("%d %s -> %s %s", t.Depth, name(t.Parent), name(t.Symbol), definition))
+	}
+
+	return formatted
+}
+
+func typeHierarchyRequest(line, character int) PositionalRequestArgs {
+	return PositionalRequestArgs{
+		RequestArgs: RequestArgs{
+			RepositoryID: 42,
+			Commit:       mockCommit,
+		},
+		Path:      "sub1/main.java",
+		Line:      line,
+		Character: character,
+	}
+}
+
+// setupTypeHierarchyTest returns a service backed by an upload of repository 42 with the following
+// document, and an upload of repository 43 with a class R implementing I.
+//
+//	0  interface I extends S {} // not valid Java, but a valid SCIP relationship
+//	1  interface J extends I {}
+//	2  class S implements J, I, Serializable {}
+//	3  class V extends S {}
+func setupTypeHierarchyTest(t *testing.T) (*Service, RequestState) {
+	mockRepoStore := defaultMockRepoStore()
+	mockLsifStore := NewMockLsifStore()
+	mockUploadSvc := NewMockUploadService()
+	mockGitserverClient := gitserver.NewMockClient()
+	hunkCache, _ := NewHunkCache(50)
+
+	svc := newService(&observation.TestContext, mockRepoStore, mockLsifStore, mockUploadSvc, mockGitserverClient)
+
+	requestState := RequestState{}
+	requestState.SetLocalCommitCache(mockRepoStore, mockGitserverClient)
+	if err := requestState.SetLocalGitTreeTranslator(mockGitserverClient, &sgtypes.Repo{ID: 42}, mockCommit, "sub1/main.java", hunkCache); err != nil {
+		t.Fatalf("unexpected error setting local git tree translator: %s", err)
+	}
+	gitTreeTranslator := NewMockGitTreeTranslator()
+	gitTreeTranslator.GetTargetCommitPositionFromSourcePositionFunc.SetDefaultHook(func(_ context.Context, _ string, pos shared.Position, _ bool) (string, shared.Position, bool, error) {
+		return "sub1/main.java", pos, true, nil
+	})
+	gitTreeTranslator.GetTargetCommitRangeFromSourceRangeFunc.SetDefaultHook(func(_ context.Context, commit, _ string, rx shared.Range, _ bool) (string, shared.Range, bool, error) {
+		return commit, rx, true, nil
+	})
+	requestState.GitTreeTranslator = gitTreeTranslator
+
+	upload := uploadsshared.Dump{ID: 50, RepositoryID: 42, Commit: mockCommit, Root: "sub1/"}
+	remoteUpload := uploadsshared.Dump{ID: 60, RepositoryID: 43, Commit: "cafebabe", Root: "lib/"}
+	requestState.SetUploadsDataLoader([]uploadsshared.Dump{upload})
+
+	mockGitserverClient.CommitsExistFunc.SetDefaultHook(func(_ context.Context, _ authz.SubRepoPermissionChecker, rcs []api.RepoCommit) (exists []bool, _ error) {
+		for range rcs {
+			exists = append(exists, true)
+		}
+		return exists, nil
+	})
+	mockUploadSvc.GetDumpsByIDsFunc.SetDefaultHook(func(_ context.Context, ids []int) (dumps []uploadsshared.Dump, _ error) {
+		for _, id := range ids {
+			if id == remoteUpload.ID {
+				dumps = append(dumps, remoteUpload)
+			}
+		}
+		return dumps, nil
+	})
+
+	mockUploadSvc.GetUploadIDsWithReferencesFunc.SetDefaultHook(func(_ context.Context, monikers []precise.QualifiedMonikerData, _ []int, _ int, _ string, _, _ int) ([]int, int, int, error) {
+		if len(monikers) == 1 && monikers[0].Identifier == testTypeI {
+			return []int{remoteUpload.ID}, 1, 1, nil
+		}
+		return nil, 0, 0, nil
+	})
+
+	implementationOf := func(symbolNames ...string) (relationships []*scip.Relationship) {
+		for _, symbolName := range symbolNames {
+			relationships = append(relationships, &scip.Relationship{Symbol: symbolName, IsImplementation: true})
+		}
+		return relationships
+	}
+
+	definition := int32(scip.SymbolRole_Definition)
+	documents := map[string]*scip.Document{
+		"50:main.java": {
+			Occurrences: []*scip.Occurrence{
+				{Range: []int32{0, 10, 11}, Symbol: testTypeI, SymbolRoles: definition},
+				{Range: []int32{0, 20, 21}, Symbol: testTypeS},
+				{Range: []int32{1, 10, 11}, Symbol: testTypeJ, SymbolRoles: definition},
+				{Range: []int32{1, 20, 21}, Symbol: testTypeI},
+				{Range: []int32{2, 6, 7}, Symbol: testTypeS, SymbolRoles: definition},
+				{Range: []int32{2, 19, 20}, Symbol: testTypeJ},
+				{Range: []int32{2, 22, 23}, Symbol: testTypeI},
+				{Range: []int32{2, 25, 37}, Symbol: testTypeSerializable},
+				{Range: []int32{3, 6, 7}, Symbol: testTypeV, SymbolRoles: definition},
+				{Range: []int32{3, 16, 17}, Symbol: testTypeS},
+			},
+			Symbols: []*scip.SymbolInformation{
+				{Symbol: testTypeI, Relationships: implementationOf(testTypeS)},
+				{Symbol: testTypeJ, Relationships: implementationOf(testTypeI)},
+				{Symbol: testTypeS, Relationships: implementationOf(testTypeJ, testTypeI, testTypeSerializable)},
+				{Symbol: testTypeV, Relationships: implementationOf(testTypeS)},
+			},
+		},
+		"60:R.java": {
+			Occurrences: []*scip.Occurrence{
+				{Range: []int32{0, 6, 7}, Symbol: testTypeR, SymbolRoles: definition},
+				{Range: []int32{0, 19, 20}, Symbol: testTypeI},
+			},
+			Symbols: []*scip.SymbolInformation{
+				{Symbol: testTypeR, Relationships: implementationOf(testTypeI)},
+			},
+		},
+	}
+	mockLsifStore.SCIPDocumentFunc.SetDefaultHook(func(_ context.Context, id int, path string) (*scip.Document, error) {
+		return documents[fmt.Sprintf("%d:%s", id, path)], nil
+	})
+
+	// Implementations of I from the remote upload
+	mockLsifStore.GetMinimalBulkMonikerLocationsFunc.SetDefaultHook(func(_ context.Context, tableName string, uploadIDs []int, _ map[int]string, monikers []precise.MonikerData, _, _ int) ([]shared.Location, int, error) {
+		if tableName == "implementations" && len(uploadIDs) == 1 && uploadIDs[0] == remoteUpload.ID && strings.HasSuffix(monikers[0].Identifier, "/I#") {
+			return []shared.Location{{DumpID: remoteUpload.ID, Path: "R.java", Range: convertSCIPRange([]int32{0, 6, 7})}}, 1, nil
+		}
+		return nil, 0, nil
+	})
+
+	return svc, requestState
+}
@@ -13,6 +13,10 @@ type CodeNavService interface {
 	GetReferences(ctx context.Context, args codenav.PositionalRequestArgs, requestState codenav.RequestState, cursor codenav.ReferencesCursor) (_ []shared.UploadLocation, nextCursor codenav.ReferencesCursor, err error)
 	GetImplementations(ctx context.Context, args codenav.PositionalRequestArgs, requestState codenav.RequestState, cursor codenav.ImplementationsCursor) (_ []shared.UploadLocation, nextCursor codenav.ImplementationsCursor, err error)
 	GetPrototypes(ctx context.Context, args codenav.PositionalRequestArgs, requestState codenav.RequestState, cursor codenav.ImplementationsCursor) (_ []shared.UploadLocation, nextCursor codenav.ImplementationsCursor, err error)
+	GetIncomingCalls(ctx context.Context, args codenav.PositionalRequestArgs, requestState codenav.RequestState, depth int, cursor codenav.CallHierarchyCursor) (_ []codenav.CallHierarchyCall, nextCursor codenav.CallHierarchyCursor, err error)
+	GetOutgoingCalls(ctx context.Context, args codenav.PositionalRequestArgs, requestState codenav.RequestState, depth int, cursor codenav.CallHierarchyCursor) (_ []codenav.CallHierarchyCall, nextCursor codenav.CallHierarchyCursor, err error)
+	GetSupertypes(ctx context.Context, args codenav.PositionalRequestArgs, requestState codenav.RequestState, depth int) (_ []codenav.TypeHierarchyType, err error)
+	GetSubtypes(ctx context.Context, args codenav.PositionalRequestArgs, requestState codenav.RequestState, depth int) (_ []codenav.TypeHierarchyType, err error)
 	GetDefinitions(ctx context.Context, args codenav.PositionalRequestArgs, requestState codenav.RequestState) (_ []shared.UploadLocation, err error)
 	GetDiagnostics(ctx context.Context, args codenav.PositionalRequestArgs, requestState codenav.RequestState) (diagnosticsAtUploads []codenav.DiagnosticAtUpload, _ int, err error)
 	GetRanges(ctx context.Context, args codenav.PositionalRequestArgs, requestState codenav.RequestState, startLine, endLine int) (adjustedRanges []codenav.AdjustedCodeIntelligenceRange, err error)
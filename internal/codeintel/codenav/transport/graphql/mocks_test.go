@@ -193,6 +193,12 @@ type MockCodeNavService struct {
 	// GetImplementationsFunc is an instance of a mock function object
 	// controlling the behavior of the method GetImplementations.
 	GetImplementationsFunc *CodeNavServiceGetImplementationsFunc
+	// GetIncomingCallsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetIncomingCalls.
+	GetIncomingCallsFunc *CodeNavServiceGetIncomingCallsFunc
+	// GetOutgoingCallsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetOutgoingCalls.
+	GetOutgoingCallsFunc *CodeNavServiceGetOutgoingCallsFunc
 	// GetPrototypesFunc is an instance of a mock function object
 	// controlling the behavior of the method GetPrototypes.
 	GetPrototypesFunc *CodeNavServiceGetPrototypesFunc
@@ -205,6 +211,12 @@ type MockCodeNavService struct {
 	// GetStencilFunc is an instance of a mock function object controlling
 	// the behavior of the method GetStencil.
 	GetStencilFunc *CodeNavServiceGetStencilFunc
+	// GetSubtypesFunc is an instance of a mock function object controlling
+	// the behavior of the method GetSubtypes.
+	GetSubtypesFunc *CodeNavServiceGetSubtypesFunc
+	// GetSupertypesFunc is an instance of a mock function object
+	// controlling the behavior of the method GetSupertypes.
+	GetSupertypesFunc *CodeNavServiceGetSupertypesFunc
 	// SnapshotForDocumentFunc is an instance of a mock function object
 	// controlling the behavior of the method SnapshotForDocument.
 	SnapshotForDocumentFunc *CodeNavServiceSnapshotForDocumentFunc
@@ -242,6 +254,16 @@ func NewMockCodeNavService() *MockCodeNavService {
 				return
 			},
 		},
+		GetIncomingCallsFunc: &CodeNavServiceGetIncomingCallsFunc{
+			defaultHook: func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int, codenav.CallHierarchyCursor) (r0 []codenav.CallHierarchyCall, r1 codenav.CallHierarchyCursor, r2 error) {
+				return
+			},
+		},
+		GetOutgoingCallsFunc: &CodeNavServiceGetOutgoingCallsFunc{
+			defaultHook: func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int, codenav.CallHierarchyCursor) (r0 []codenav.CallHierarchyCall, r1 codenav.CallHierarchyCursor, r2 error) {
+				return
+			},
+		},
 		GetPrototypesFunc: &CodeNavServiceGetPrototypesFunc{
 			defaultHook: func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, codenav.ImplementationsCursor) (r0 []shared1.UploadLocation, r1 codenav.ImplementationsCursor, r2 error) {
 				return
@@ -262,6 +284,16 @@ func NewMockCodeNavService() *MockCodeNavService {
 				return
 			},
 		},
+		GetSubtypesFunc: &CodeNavServiceGetSubtypesFunc{
+			defaultHook: func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int) (r0 []codenav.TypeHierarchyType, r1 error) {
+				return
+			},
+		},
+		GetSupertypesFunc: &CodeNavServiceGetSupertypesFunc{
+			defaultHook: func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int) (r0 []codenav.TypeHierarchyType, r1 error) {
+				return
+			},
+		},
 		SnapshotForDocumentFunc: &CodeNavServiceSnapshotForDocumentFunc{
 			defaultHook: func(context.Context, int, string, string, int) (r0 []shared1.SnapshotData, r1 error) {
 				return
@@ -304,6 +336,16 @@ func NewStrictMockCodeNavService() *MockCodeNavService {
 				panic("unexpected invocation of MockCodeNavService.GetImplementations")
 			},
 		},
+		GetIncomingCallsFunc: &CodeNavServiceGetIncomingCallsFunc{
+			defaultHook: func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error) {
+				panic("unexpected invocation of MockCodeNavService.GetIncomingCalls")
+			},
+		},
+		GetOutgoingCallsFunc: &CodeNavServiceGetOutgoingCallsFunc{
+			defaultHook: func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error) {
+				panic("unexpected invocation of MockCodeNavService.GetOutgoingCalls")
+			},
+		},
 		GetPrototypesFunc: &CodeNavServiceGetPrototypesFunc{
 			defaultHook: func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, codenav.ImplementationsCursor) ([]shared1.UploadLocation, codenav.ImplementationsCursor, error) {
 				panic("unexpected invocation of MockCodeNavService.GetPrototypes")
@@ -324,6 +366,16 @@ func NewStrictMockCodeNavService() *MockCodeNavService {
 				panic("unexpected invocation of MockCodeNavService.GetStencil")
 			},
 		},
+		GetSubtypesFunc: &CodeNavServiceGetSubtypesFunc{
+			defaultHook: func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyType, error) {
+				panic("unexpected invocation of MockCodeNavService.GetSubtypes")
+			},
+		},
+		GetSupertypesFunc: &CodeNavServiceGetSupertypesFunc{
+			defaultHook: func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyType, error) {
+				panic("unexpected invocation of MockCodeNavService.GetSupertypes")
+			},
+		},
 		SnapshotForDocumentFunc: &CodeNavServiceSnapshotForDocumentFunc{
 			defaultHook: func(context.Context, int, string, string, int) ([]shared1.SnapshotData, error) {
 				panic("unexpected invocation of MockCodeNavService.SnapshotForDocument")
@@ -357,6 +409,12 @@ func NewMockCodeNavServiceFrom(i CodeNavService) *MockCodeNavService {
 		GetImplementationsFunc: &CodeNavServiceGetImplementationsFunc{
 			defaultHook: i.GetImplementations,
 		},
+		GetIncomingCallsFunc: &CodeNavServiceGetIncomingCallsFunc{
+			defaultHook: i.GetIncomingCalls,
+		},
+		GetOutgoingCallsFunc: &CodeNavServiceGetOutgoingCallsFunc{
+			defaultHook: i.GetOutgoingCalls,
+		},
 		GetPrototypesFunc: &CodeNavServiceGetPrototypesFunc{
 			defaultHook: i.GetPrototypes,
 		},
@@ -369,6 +427,12 @@ func NewMockCodeNavServiceFrom(i CodeNavService) *MockCodeNavService {
 		GetStencilFunc: &CodeNavServiceGetStencilFunc{
 			defaultHook: i.GetStencil,
 		},
+		GetSubtypesFunc: &CodeNavServiceGetSubtypesFunc{
+			defaultHook: i.GetSubtypes,
+		},
+		GetSupertypesFunc: &CodeNavServiceGetSupertypesFunc{
+			defaultHook: i.GetSupertypes,
+		},
 		SnapshotForDocumentFunc: &CodeNavServiceSnapshotForDocumentFunc{
 			defaultHook: i.SnapshotForDocument,
 		},
@@ -966,6 +1030,250 @@ func (c CodeNavServiceGetImplementationsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
+// CodeNavServiceGetIncomingCallsFunc describes the behavior when the
+// GetIncomingCalls method of the parent MockCodeNavService instance is
+// invoked.
+type CodeNavServiceGetIncomingCallsFunc struct {
+	defaultHook func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error)
+	hooks       []func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error)
+	history     []CodeNavServiceGetIncomingCallsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetIncomingCalls delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeNavService) GetIncomingCalls(v0 context.Context, v1 codenav.PositionalRequestArgs, v2 codenav.RequestState, v3 int, v4 codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error) {
+	r0, r1, r2 := m.GetIncomingCallsFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.GetIncomingCallsFunc.appendCall(CodeNavServiceGetIncomingCallsFuncCall{v0, v1, v2, v3, v4, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the GetIncomingCalls
+// method of the parent MockCodeNavService instance is invoked and the hook
+// queue is empty.
+func (f *CodeNavServiceGetIncomingCallsFunc) SetDefaultHook(hook func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetIncomingCalls method of the parent MockCodeNavService instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *CodeNavServiceGetIncomingCallsFunc) PushHook(hook func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeNavServiceGetIncomingCallsFunc) SetDefaultReturn(r0 []codenav.CallHierarchyCall, r1 codenav.CallHierarchyCursor, r2 error) {
+	f.SetDefaultHook(func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeNavServiceGetIncomingCallsFunc) PushReturn(r0 []codenav.CallHierarchyCall, r1 codenav.CallHierarchyCursor, r2 error) {
+	f.PushHook(func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *CodeNavServiceGetIncomingCallsFunc) nextHook() func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeNavServiceGetIncomingCallsFunc) appendCall(r0 CodeNavServiceGetIncomingCallsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeNavServiceGetIncomingCallsFuncCall
+// objects describing the invocations of this function.
+func (f *CodeNavServiceGetIncomingCallsFunc) History() []CodeNavServiceGetIncomingCallsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeNavServiceGetIncomingCallsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeNavServiceGetIncomingCallsFuncCall is an object that describes an
+// invocation of method GetIncomingCalls on an instance of
+// MockCodeNavService.
+type CodeNavServiceGetIncomingCallsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 codenav.PositionalRequestArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 codenav.RequestState
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 codenav.CallHierarchyCursor
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []codenav.CallHierarchyCall
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 codenav.CallHierarchyCursor
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeNavServiceGetIncomingCallsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeNavServiceGetIncomingCallsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// CodeNavServiceGetOutgoingCallsFunc describes the behavior when the
+// GetOutgoingCalls method of the parent MockCodeNavService instance is
+// invoked.
+type CodeNavServiceGetOutgoingCallsFunc struct {
+	defaultHook func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error)
+	hooks       []func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error)
+	history     []CodeNavServiceGetOutgoingCallsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetOutgoingCalls delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeNavService) GetOutgoingCalls(v0 context.Context, v1 codenav.PositionalRequestArgs, v2 codenav.RequestState, v3 int, v4 codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error) {
+	r0, r1, r2 := m.GetOutgoingCallsFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.GetOutgoingCallsFunc.appendCall(CodeNavServiceGetOutgoingCallsFuncCall{v0, v1, v2, v3, v4, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the GetOutgoingCalls
+// method of the parent MockCodeNavService instance is invoked and the hook
+// queue is empty.
+func (f *CodeNavServiceGetOutgoingCallsFunc) SetDefaultHook(hook func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetOutgoingCalls method of the parent MockCodeNavService instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *CodeNavServiceGetOutgoingCallsFunc) PushHook(hook func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeNavServiceGetOutgoingCallsFunc) SetDefaultReturn(r0 []codenav.CallHierarchyCall, r1 codenav.CallHierarchyCursor, r2 error) {
+	f.SetDefaultHook(func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeNavServiceGetOutgoingCallsFunc) PushReturn(r0 []codenav.CallHierarchyCall, r1 codenav.CallHierarchyCursor, r2 error) {
+	f.PushHook(func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *CodeNavServiceGetOutgoingCallsFunc) nextHook() func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeNavServiceGetOutgoingCallsFunc) appendCall(r0 CodeNavServiceGetOutgoingCallsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeNavServiceGetOutgoingCallsFuncCall
+// objects describing the invocations of this function.
+func (f *CodeNavServiceGetOutgoingCallsFunc) History() []CodeNavServiceGetOutgoingCallsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeNavServiceGetOutgoingCallsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeNavServiceGetOutgoingCallsFuncCall is an object that describes an
+// invocation of method GetOutgoingCalls on an instance of
+// MockCodeNavService.
+type CodeNavServiceGetOutgoingCallsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 codenav.PositionalRequestArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 codenav.RequestState
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 codenav.CallHierarchyCursor
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []codenav.CallHierarchyCall
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 codenav.CallHierarchyCursor
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeNavServiceGetOutgoingCallsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeNavServiceGetOutgoingCallsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
 // CodeNavServiceGetPrototypesFunc describes the behavior when the
 // GetPrototypes method of the parent MockCodeNavService instance is
 // invoked.
@@ -1430,6 +1738,235 @@ func (c CodeNavServiceGetStencilFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeNavServiceGetSubtypesFunc describes the behavior when the GetSubtypes
+// method of the parent MockCodeNavService instance is invoked.
+type CodeNavServiceGetSubtypesFunc struct {
+	defaultHook func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyType, error)
+	hooks       []func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyType, error)
+	history     []CodeNavServiceGetSubtypesFuncCall
+	mutex       sync.Mutex
+}
+
+// GetSubtypes delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockCodeNavService) GetSubtypes(v0 context.Context, v1 codenav.PositionalRequestArgs, v2 codenav.RequestState, v3 int) ([]codenav.TypeHierarchyType, error) {
+	r0, r1 := m.GetSubtypesFunc.nextHook()(v0, v1, v2, v3)
+	m.GetSubtypesFunc.appendCall(CodeNavServiceGetSubtypesFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetSubtypes method
+// of the parent MockCodeNavService instance is invoked and the hook queue
+// is empty.
+func (f *CodeNavServiceGetSubtypesFunc) SetDefaultHook(hook func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyType, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetSubtypes method of the parent MockCodeNavService instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *CodeNavServiceGetSubtypesFunc) PushHook(hook func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyType, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeNavServiceGetSubtypesFunc) SetDefaultReturn(r0 []codenav.TypeHierarchyType, r1 error) {
+	f.SetDefaultHook(func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyType, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeNavServiceGetSubtypesFunc) PushReturn(r0 []codenav.TypeHierarchyType, r1 error) {
+	f.PushHook(func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyType, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeNavServiceGetSubtypesFunc) nextHook() func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyType, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeNavServiceGetSubtypesFunc) appendCall(r0 CodeNavServiceGetSubtypesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeNavServiceGetSubtypesFuncCall objects
+// describing the invocations of this function.
+func (f *CodeNavServiceGetSubtypesFunc) History() []CodeNavServiceGetSubtypesFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeNavServiceGetSubtypesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeNavServiceGetSubtypesFuncCall is an object that describes an
+// invocation of method GetSubtypes on an instance of MockCodeNavService.
+type CodeNavServiceGetSubtypesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 codenav.PositionalRequestArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 codenav.RequestState
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []codenav.TypeHierarchyType
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeNavServiceGetSubtypesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeNavServiceGetSubtypesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CodeNavServiceGetSupertypesFunc describes the behavior when the
+// GetSupertypes method of the parent MockCodeNavService instance is
+// invoked.
+type CodeNavServiceGetSupertypesFunc struct {
+	defaultHook func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyType, error)
+	hooks       []func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyType, error)
+	history     []CodeNavServiceGetSupertypesFuncCall
+	mutex       sync.Mutex
+}
+
+// GetSupertypes delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockCodeNavService) GetSupertypes(v0 context.Context, v1 codenav.PositionalRequestArgs, v2 codenav.RequestState, v3 int) ([]codenav.TypeHierarchyType, error) {
+	r0, r1 := m.GetSupertypesFunc.nextHook()(v0, v1, v2, v3)
+	m.GetSupertypesFunc.appendCall(CodeNavServiceGetSupertypesFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetSupertypes method
+// of the parent MockCodeNavService instance is invoked and the hook queue
+// is empty.
+func (f *CodeNavServiceGetSupertypesFunc) SetDefaultHook(hook func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyType, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetSupertypes method of the parent MockCodeNavService instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *CodeNavServiceGetSupertypesFunc) PushHook(hook func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyType, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeNavServiceGetSupertypesFunc) SetDefaultReturn(r0 []codenav.TypeHierarchyType, r1 error) {
+	f.SetDefaultHook(func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyType, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeNavServiceGetSupertypesFunc) PushReturn(r0 []codenav.TypeHierarchyType, r1 error) {
+	f.PushHook(func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyType, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeNavServiceGetSupertypesFunc) nextHook() func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int) ([]codenav.TypeHierarchyType, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeNavServiceGetSupertypesFunc) appendCall(r0 CodeNavServiceGetSupertypesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeNavServiceGetSupertypesFuncCall objects
+// describing the invocations of this function.
+func (f *CodeNavServiceGetSupertypesFunc) History() []CodeNavServiceGetSupertypesFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeNavServiceGetSupertypesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeNavServiceGetSupertypesFuncCall is an object that describes an
+// invocation of method GetSupertypes on an instance of MockCodeNavService.
+type CodeNavServiceGetSupertypesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 codenav.PositionalRequestArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 codenav.RequestState
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []codenav.TypeHierarchyType
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeNavServiceGetSupertypesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeNavServiceGetSupertypesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeNavServiceSnapshotForDocumentFunc describes the behavior when the
 // SnapshotForDocument method of the parent MockCodeNavService instance is
 // invoked.
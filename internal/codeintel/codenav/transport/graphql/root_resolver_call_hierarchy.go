@@ -0,0 +1,156 @@
+package graphql
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav"
+	resolverstubs "github.com/sourcegraph/sourcegraph/internal/codeintel/resolvers"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/shared/resolvers/gitresolvers"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+// DefaultCallHierarchyPageSize is the call hierarchy result page size when no limit is supplied.
+const DefaultCallHierarchyPageSize = 100
+
+// ErrIllegalDepth occurs when the user requests a call hierarchy deeper than codenav.MaxCallHierarchyDepth,
+// or shallower than one call.
+var ErrIllegalDepth = errors.Newf("illegal depth: must be between 1 and %d", codenav.MaxCallHierarchyDepth)
+
+// IncomingCalls returns the calls to the function at the given position, transitively up to the given depth.
+func (r *gitBlobLSIFDataResolver) IncomingCalls(ctx context.Context, args *resolverstubs.LSIFCallHierarchyArgs) (_ resolverstubs.CallHierarchyCallConnectionResolver, err error) {
+	return r.callHierarchy(ctx, args, r.operations.incomingCalls, r.codeNavSvc.GetIncomingCalls)
+}
+
+// OutgoingCalls returns the calls from the function at the given position, transitively up to the given depth.
+func (r *gitBlobLSIFDataResolver) OutgoingCalls(ctx context.Context, args *resolverstubs.LSIFCallHierarchyArgs) (_ resolverstubs.CallHierarchyCallConnectionResolver, err error) {
+	return r.callHierarchy(ctx, args, r.operations.outgoingCalls, r.codeNavSvc.GetOutgoingCalls)
+}
+
+type getCallsFunc func(ctx context.Context, args codenav.PositionalRequestArgs, requestState codenav.RequestState, depth int, cursor codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error)
+
+func (r *gitBlobLSIFDataResolver) callHierarchy(ctx context.Context, args *resolverstubs.LSIFCallHierarchyArgs, operation *observation.Operation, getCalls getCallsFunc) (_ resolverstubs.CallHierarchyCallConnectionResolver, err error) {
+	limit := int(pointers.Deref(args.First, DefaultCallHierarchyPageSize))
+	if limit <= 0 {
+		return nil, ErrIllegalLimit
+	}
+	if args.Depth <= 0 || args.Depth > codenav.MaxCallHierarchyDepth {
+		return nil, ErrIllegalDepth
+	}
+
+	rawCursor, err := decodeCursor(args.After)
+	if err != nil {
+		return nil, err
+	}
+
+	requestArgs := codenav.PositionalRequestArgs{
+		RequestArgs: codenav.RequestArgs{
+			RepositoryID: r.requestState.RepositoryID,
+			Commit:       r.requestState.Commit,
+			Limit:        limit,
+			RawCursor:    rawCursor,
+		},
+		Path:      r.requestState.Path,
+		Line:      int(args.Line),
+		Character: int(args.Character),
+	}
+	ctx, _, endObservation := observeResolver(ctx, &err, operation, time.Second, getObservationArgs(requestArgs))
+	defer endObservation()
+
+	cursor, err := decodeCallHierarchyCursor(rawCursor)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("invalid cursor: %q", rawCursor))
+	}
+
+	calls, callsCursor, err := getCalls(ctx, requestArgs, r.requestState, int(args.Depth), cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextCursor string
+	if callsCursor.Phase != "done" {
+		nextCursor = encodeCallHierarchyCursor(callsCursor)
+	}
+
+	return resolverstubs.NewLazyConnectionResolver(func(ctx context.Context) ([]resolverstubs.CallHierarchyCallResolver, error) {
+		return resolveCallHierarchyCalls(ctx, r.locationResolver, calls)
+	}, encodeCursor(pointers.NonZeroPtr(nextCursor))), nil
+}
+
+// resolveCallHierarchyCalls creates a slice of CallHierarchyCallResolvers for the given list of calls. The
+// resulting list may be smaller than the input list as any call site with a commit not known by gitserver
+// will be skipped.
+func resolveCallHierarchyCalls(ctx context.Context, locationResolver *gitresolvers.CachedLocationResolver, calls []codenav.CallHierarchyCall) ([]resolverstubs.CallHierarchyCallResolver, error) {
+	resolvers := make([]resolverstubs.CallHierarchyCallResolver, 0, len(calls))
+	for _, call := range calls {
+		callSite, err := resolveLocation(ctx, locationResolver, call.CallSite)
+		if err != nil {
+			return nil, err
+		}
+		if callSite == nil {
+			continue
+		}
+
+		var definition resolverstubs.LocationResolver
+		if call.Definition != nil {
+			if definition, err = resolveLocation(ctx, locationResolver, *call.Definition); err != nil {
+				return nil, err
+			}
+		}
+
+		resolvers = append(resolvers, &callHierarchyCallResolver{
+			symbol:     call.Symbol,
+			definition: definition,
+			callSite:   callSite,
+			depth:      int32(call.Depth),
+		})
+	}
+
+	return resolvers, nil
+}
+
+//
+//
+
+type callHierarchyCallResolver struct {
+	symbol     string
+	definition resolverstubs.LocationResolver
+	callSite   resolverstubs.LocationResolver
+	depth      int32
+}
+
+func (r *callHierarchyCallResolver) Symbol() string                             { return r.symbol }
+func (r *callHierarchyCallResolver) Definition() resolverstubs.LocationResolver { return r.definition }
+func (r *callHierarchyCallResolver) CallSite() resolverstubs.LocationResolver   { return r.callSite }
+func (r *callHierarchyCallResolver) Depth() int32                               { return r.depth }
+
+//
+//
+
+// decodeCallHierarchyCursor is the inverse of encodeCallHierarchyCursor. If the given encoded string is
+// empty, then a fresh cursor is returned.
+func decodeCallHierarchyCursor(rawEncoded string) (codenav.CallHierarchyCursor, error) {
+	if rawEncoded == "" {
+		return codenav.CallHierarchyCursor{}, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(rawEncoded)
+	if err != nil {
+		return codenav.CallHierarchyCursor{}, err
+	}
+
+	var cursor codenav.CallHierarchyCursor
+	err = json.Unmarshal(raw, &cursor)
+	return cursor, err
+}
+
+// encodeCallHierarchyCursor returns an encoding of the given cursor suitable for a URL or a GraphQL token.
+func encodeCallHierarchyCursor(cursor codenav.CallHierarchyCursor) string {
+	rawEncoded, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(rawEncoded)
+}
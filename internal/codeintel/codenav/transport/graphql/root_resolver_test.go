@@ -217,6 +217,88 @@ func TestReferencesDefaultIllegalLimit(t *testing.T) {
 	}
 }
 
+func TestIncomingCalls(t *testing.T) {
+	mockCodeNavService := NewMockCodeNavService()
+	mockRequestState := codenav.RequestState{
+		RepositoryID: 1,
+		Commit:       "deadbeef1",
+		Path:         "/src/main",
+	}
+	mockOperations := newOperations(&observation.TestContext)
+
+	resolver := newGitBlobLSIFDataResolver(
+		mockCodeNavService,
+		nil,
+		mockRequestState,
+		nil,
+		nil,
+		nil,
+		mockOperations,
+	)
+
+	mockCallHierarchyCursor := codenav.CallHierarchyCursor{Phase: "calls", Offset: 3}
+	encodedCursor := encodeCallHierarchyCursor(mockCallHierarchyCursor)
+	mockCursor := base64.StdEncoding.EncodeToString([]byte(encodedCursor))
+
+	args := &resolverstubs.LSIFCallHierarchyArgs{
+		Line:                10,
+		Character:           15,
+		Depth:               3,
+		PagedConnectionArgs: resolverstubs.PagedConnectionArgs{After: &mockCursor},
+	}
+
+	if _, err := resolver.IncomingCalls(context.Background(), args); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(mockCodeNavService.GetIncomingCallsFunc.History()) != 1 {
+		t.Fatalf("unexpected call count. want=%d have=%d", 1, len(mockCodeNavService.GetIncomingCallsFunc.History()))
+	}
+	call := mockCodeNavService.GetIncomingCallsFunc.History()[0]
+	if call.Arg1.Line != 10 || call.Arg1.Character != 15 {
+		t.Fatalf("unexpected position. want=%v:%v have=%v:%v", 10, 15, call.Arg1.Line, call.Arg1.Character)
+	}
+	if call.Arg1.Limit != DefaultCallHierarchyPageSize {
+		t.Fatalf("unexpected limit. want=%v have=%v", DefaultCallHierarchyPageSize, call.Arg1.Limit)
+	}
+	if call.Arg3 != 3 {
+		t.Fatalf("unexpected depth. want=%v have=%v", 3, call.Arg3)
+	}
+	if call.Arg4.Phase != "calls" || call.Arg4.Offset != 3 {
+		t.Fatalf("unexpected cursor. want=%v have=%v", mockCallHierarchyCursor, call.Arg4)
+	}
+}
+
+func TestOutgoingCallsIllegalDepth(t *testing.T) {
+	mockCodeNavService := NewMockCodeNavService()
+	mockRequestState := codenav.RequestState{
+		RepositoryID: 1,
+		Commit:       "deadbeef1",
+		Path:         "/src/main",
+	}
+	mockOperations := newOperations(&observation.TestContext)
+
+	resolver := newGitBlobLSIFDataResolver(
+		mockCodeNavService,
+		nil,
+		mockRequestState,
+		nil,
+		nil,
+		nil,
+		mockOperations,
+	)
+
+	for _, depth := range []int32{0, codenav.MaxCallHierarchyDepth + 1} {
+		args := &resolverstubs.LSIFCallHierarchyArgs{Line: 10, Character: 15, Depth: depth}
+		if _, err := resolver.OutgoingCalls(context.Background(), args); err != ErrIllegalDepth {
+			t.Fatalf("unexpected error. want=%q have=%q", ErrIllegalDepth, err)
+		}
+	}
+	if len(mockCodeNavService.GetOutgoingCallsFunc.History()) != 0 {
+		t.Fatalf("unexpected call count. want=%d have=%d", 0, len(mockCodeNavService.GetOutgoingCallsFunc.History()))
+	}
+}
+
 func TestHover(t *testing.T) {
 	mockCodeNavService := NewMockCodeNavService()
 	mockRequestState := codenav.RequestState{
@@ -398,3 +480,91 @@ func TestResolveLocations(t *testing.T) {
 		t.Errorf("unexpected canonical url. want=%s have=%s", "/repo53@deadbeef4/-/blob/p4?L42:43-44:45", url)
 	}
 }
+
+func TestTypeHierarchy(t *testing.T) {
+	mockCodeNavService := NewMockCodeNavService()
+	mockRequestState := codenav.RequestState{
+		RepositoryID: 1,
+		Commit:       "deadbeef1",
+		Path:         "/src/main",
+	}
+	mockOperations := newOperations(&observation.TestContext)
+
+	resolver := newGitBlobLSIFDataResolver(
+		mockCodeNavService,
+		nil,
+		mockRequestState,
+		nil,
+		nil,
+		nil,
+		mockOperations,
+	)
+
+	mockCodeNavService.GetSubtypesFunc.SetDefaultReturn([]codenav.TypeHierarchyType{{Symbol: "child", Parent: "parent", Depth: 1}}, nil)
+
+	args := &resolverstubs.LSIFTypeHierarchyArgs{Line: 10, Character: 15, Direction: "SUBTYPES", Depth: 2}
+	types, err := resolver.TypeHierarchy(context.Background(), args)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(mockCodeNavService.GetSubtypesFunc.History()) != 1 {
+		t.Fatalf("unexpected call count. want=%d have=%d", 1, len(mockCodeNavService.GetSubtypesFunc.History()))
+	}
+	call := mockCodeNavService.GetSubtypesFunc.History()[0]
+	if call.Arg1.Line != 10 || call.Arg1.Character != 15 {
+		t.Fatalf("unexpected position. want=%v:%v have=%v:%v", 10, 15, call.Arg1.Line, call.Arg1.Character)
+	}
+	if call.Arg3 != 2 {
+		t.Fatalf("unexpected depth. want=%v have=%v", 2, call.Arg3)
+	}
+	if len(mockCodeNavService.GetSupertypesFunc.History()) != 0 {
+		t.Fatalf("unexpected call count. want=%d have=%d", 0, len(mockCodeNavService.GetSupertypesFunc.History()))
+	}
+
+	nodes, err := types.Nodes(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(nodes) != 1 || nodes[0].Symbol() != "child" || nodes[0].Parent() != "parent" || nodes[0].Depth() != 1 || nodes[0].Definition() != nil {
+		t.Fatalf("unexpected types: %v", nodes)
+	}
+}
+
+func TestTypeHierarchyIllegalArguments(t *testing.T) {
+	mockCodeNavService := NewMockCodeNavService()
+	mockRequestState := codenav.RequestState{
+		RepositoryID: 1,
+		Commit:       "deadbeef1",
+		Path:         "/src/main",
+	}
+	mockOperations := newOperations(&observation.TestContext)
+
+	resolver := newGitBlobLSIFDataResolver(
+		mockCodeNavService,
+		nil,
+		mockRequestState,
+		nil,
+		nil,
+		nil,
+		mockOperations,
+	)
+
+	for _, testCase := range []struct {
+		direction string
+		depth     int32
+		err       error
+	}{
+		{"SUPERTYPES", 0, ErrIllegalTypeHierarchyDepth},
+		{"SUBTYPES", codenav.MaxTypeHierarchyDepth + 1, ErrIllegalTypeHierarchyDepth},
+		{"SIBLINGS", 1, ErrIllegalDirection},
+	} {
+		args := &resolverstubs.LSIFTypeHierarchyArgs{Line: 10, Character: 15, Direction: testCase.direction, Depth: testCase.depth}
+		if _, err := resolver.TypeHierarchy(context.Background(), args); err != testCase.err {
+			t.Fatalf("unexpected error. want=%q have=%q", testCase.err, err)
+		}
+	}
+	if len(mockCodeNavService.GetSupertypesFunc.History()) != 0 || len(mockCodeNavService.GetSubtypesFunc.History()) != 0 {
+		t.Fatalf("unexpected calls to the service")
+	}
+}
@@ -0,0 +1,97 @@
+package graphql
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav"
+	resolverstubs "github.com/sourcegraph/sourcegraph/internal/codeintel/resolvers"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/shared/resolvers/gitresolvers"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ErrIllegalDirection occurs when the user requests a type hierarchy in an unknown direction.
+var ErrIllegalDirection = errors.New("illegal direction")
+
+// ErrIllegalTypeHierarchyDepth occurs when the user requests a type hierarchy deeper than
+// codenav.MaxTypeHierarchyDepth, or shallower than one type.
+var ErrIllegalTypeHierarchyDepth = errors.Newf("illegal depth: must be between 1 and %d", codenav.MaxTypeHierarchyDepth)
+
+// TypeHierarchy returns the supertypes or subtypes of the type at the given position, transitively up to
+// the given depth.
+func (r *gitBlobLSIFDataResolver) TypeHierarchy(ctx context.Context, args *resolverstubs.LSIFTypeHierarchyArgs) (_ resolverstubs.TypeHierarchyTypeConnectionResolver, err error) {
+	var getTypes func(ctx context.Context, args codenav.PositionalRequestArgs, requestState codenav.RequestState, depth int) ([]codenav.TypeHierarchyType, error)
+	switch args.Direction {
+	case "SUPERTYPES":
+		getTypes = r.codeNavSvc.GetSupertypes
+	case "SUBTYPES":
+		getTypes = r.codeNavSvc.GetSubtypes
+	default:
+		return nil, ErrIllegalDirection
+	}
+	if args.Depth <= 0 || args.Depth > codenav.MaxTypeHierarchyDepth {
+		return nil, ErrIllegalTypeHierarchyDepth
+	}
+
+	requestArgs := codenav.PositionalRequestArgs{
+		RequestArgs: codenav.RequestArgs{
+			RepositoryID: r.requestState.RepositoryID,
+			Commit:       r.requestState.Commit,
+		},
+		Path:      r.requestState.Path,
+		Line:      int(args.Line),
+		Character: int(args.Character),
+	}
+	ctx, _, endObservation := observeResolver(ctx, &err, r.operations.typeHierarchy, time.Second, getObservationArgs(requestArgs))
+	defer endObservation()
+
+	types, err := getTypes(ctx, requestArgs, r.requestState, int(args.Depth))
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers, err := resolveTypeHierarchyTypes(ctx, r.locationResolver, types)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolverstubs.NewConnectionResolver(resolvers), nil
+}
+
+// resolveTypeHierarchyTypes creates a slice of TypeHierarchyTypeResolvers for the given list of types.
+func resolveTypeHierarchyTypes(ctx context.Context, locationResolver *gitresolvers.CachedLocationResolver, types []codenav.TypeHierarchyType) ([]resolverstubs.TypeHierarchyTypeResolver, error) {
+	resolvers := make([]resolverstubs.TypeHierarchyTypeResolver, 0, len(types))
+	for _, t := range types {
+		var definition resolverstubs.LocationResolver
+		if t.Definition != nil {
+			var err error
+			if definition, err = resolveLocation(ctx, locationResolver, *t.Definition); err != nil {
+				return nil, err
+			}
+		}
+
+		resolvers = append(resolvers, &typeHierarchyTypeResolver{
+			symbol:     t.Symbol,
+			definition: definition,
+			parent:     t.Parent,
+			depth:      int32(t.Depth),
+		})
+	}
+
+	return resolvers, nil
+}
+
+//
+//
+
+type typeHierarchyTypeResolver struct {
+	symbol     string
+	definition resolverstubs.LocationResolver
+	parent     string
+	depth      int32
+}
+
+func (r *typeHierarchyTypeResolver) Symbol() string                             { return r.symbol }
+func (r *typeHierarchyTypeResolver) Definition() resolverstubs.LocationResolver { return r.definition }
+func (r *typeHierarchyTypeResolver) Parent() string                             { return r.parent }
+func (r *typeHierarchyTypeResolver) Depth() int32                               { return r.depth }
@@ -199,3 +199,43 @@ type RemoteCursor struct {
 	// The location offset within the associated batch of uploads.
 	LocationOffset int `json:"locationOffset"`
 }
+
+// CallHierarchyCall is a call within a call hierarchy: a call site and the function on the other
+// end of the call, which is the caller for incoming calls and the callee for outgoing calls. The
+// definition is nil when no index defines the function. Depth is 1 for the calls to or from the
+// requested function, 2 for the calls to or from those functions, and so on.
+type CallHierarchyCall struct {
+	Symbol     string
+	Definition *shared.UploadLocation
+	CallSite   shared.UploadLocation
+	Depth      int
+}
+
+// CallHierarchyCursor stores the state of a breadth-first call hierarchy traversal used to resume
+// it in a subsequent request.
+type CallHierarchyCursor struct {
+	Phase   string              `json:"phase"`   // "", "calls", or "done"
+	Queue   []CallHierarchyNode `json:"queue"`   // functions whose calls have not been returned yet
+	Offset  int                 `json:"offset"`  // number of returned calls of Queue[0]
+	Visited []string            `json:"visited"` // symbols of the functions queued so far
+}
+
+// CallHierarchyNode is a function in a call hierarchy traversal, identified by its symbol and the
+// position of an occurrence of it within an index, preferably its definition.
+type CallHierarchyNode struct {
+	UploadID int             `json:"uploadID"`
+	Path     string          `json:"path"` // relative to the upload root
+	Position shared.Position `json:"position"`
+	Symbol   string          `json:"symbol"`
+	Depth    int             `json:"depth"`
+}
+
+// TypeHierarchyType is a type within a type hierarchy: a supertype or a subtype of its parent type,
+// which is the requested type for the types of depth 1. The definition is nil when no index defines
+// the type. The types of a type hierarchy form a tree, as each type appears once.
+type TypeHierarchyType struct {
+	Symbol     string
+	Definition *shared.UploadLocation
+	Parent     string
+	Depth      int
+}
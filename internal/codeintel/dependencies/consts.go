@@ -9,4 +9,7 @@ const (
 	PythonPackagesScheme = shared.PythonPackagesScheme
 	RustPackagesScheme   = shared.RustPackagesScheme
 	RubyPackagesScheme   = shared.RubyPackagesScheme
+	NuGetPackagesScheme  = shared.NuGetPackagesScheme
+	HexPackagesScheme    = shared.HexPackagesScheme
+	PubPackagesScheme    = shared.PubPackagesScheme
 )
@@ -20,6 +20,7 @@ func scanDependencyRepoWithVersions(s dbutil.Scanner) (shared.PackageRepoReferen
 		ids            []int64
 		blocked        []bool
 		lastCheckedAt  []sql.NullString
+		licenses       []sql.NullString
 	)
 	err := s.Scan(
 		&ref.ID,
@@ -31,6 +32,7 @@ func scanDependencyRepoWithVersions(s dbutil.Scanner) (shared.PackageRepoReferen
 		pq.Array(&versionStrings),
 		pq.Array(&blocked),
 		pq.Array(&lastCheckedAt),
+		pq.Array(&licenses),
 	)
 	if err != nil {
 		return shared.PackageRepoReference{}, err
@@ -47,12 +49,17 @@ func scanDependencyRepoWithVersions(s dbutil.Scanner) (shared.PackageRepoReferen
 			}
 			t = &parsedT
 		}
+		var license *string
+		if licenses[i].Valid {
+			license = &licenses[i].String
+		}
 		ref.Versions = append(ref.Versions, shared.PackageRepoRefVersion{
 			ID:            int(ids[i]),
 			PackageRefID:  ref.ID,
 			Version:       version,
 			Blocked:       blocked[i],
 			LastCheckedAt: t,
+			License:       license,
 		})
 	}
 	return ref, err
@@ -76,6 +76,7 @@ const (
 type ListDependencyReposOpts struct {
 	Scheme         string
 	Name           reposource.PackageName
+	Names          []reposource.PackageName
 	Fuzziness      fuzziness
 	After          int
 	Limit          int
@@ -136,14 +137,15 @@ const groupedVersionedPackageReposColumns = `
 	array_agg(prv.id ORDER BY prv.id) as vid,
 	array_agg(prv.version ORDER BY prv.id) as version,
 	array_agg(prv.blocked ORDER BY prv.id) as vers_blocked,
-	array_agg(prv.last_checked_at ORDER BY prv.id) as vers_last_checked_at
+	array_agg(prv.last_checked_at ORDER BY prv.id) as vers_last_checked_at,
+	array_agg(prv.license ORDER BY prv.id) as vers_license
 `
 
 const listDependencyReposQuery = `
 SELECT %s
 FROM lsif_dependency_repos lr
 JOIN LATERAL (
-    SELECT id, package_id, version, blocked, last_checked_at
+    SELECT id, package_id, version, blocked, last_checked_at, license
     FROM package_repo_versions
     WHERE package_id = lr.id
     ORDER BY id
@@ -173,6 +175,10 @@ func makeListDependencyReposConds(opts ListDependencyReposOpts) *sqlf.Query {
 		}
 	}
 
+	if len(opts.Names) > 0 {
+		conds = append(conds, sqlf.Sprintf("name = ANY(%s)", pq.Array(opts.Names)))
+	}
+
 	if !opts.IncludeBlocked {
 		conds = append(conds, sqlf.Sprintf("lr.blocked <> true AND prv.blocked <> true"))
 	}
@@ -202,6 +208,7 @@ func makeOffset(id int) *sqlf.Query {
 
 // InsertDependencyRepos creates the given dependency repos if they don't yet exist. The values that did not exist previously are returned.
 // [{npm, @types/nodejs, [v0.0.1]}, {npm, @types/nodejs, [v0.0.2]}] will be collapsed into [{npm, @types/nodejs, [v0.0.1, v0.0.2]}]
+// Versions that already exist have their license updated when the given version carries one.
 func (s *store) InsertPackageRepoRefs(ctx context.Context, deps []shared.MinimalPackageRepoRef) (newDeps []shared.PackageRepoReference, newVersions []shared.PackageRepoRefVersion, err error) {
 	ctx, _, endObservation := s.operations.insertPackageRepoRefs.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
 		attribute.Int("numInputDeps", len(deps)),
@@ -337,11 +344,11 @@ func (s *store) InsertPackageRepoRefs(ctx context.Context, deps []shared.Minimal
 		tx.Handle(),
 		"t_package_repo_versions",
 		batch.MaxNumPostgresParameters,
-		[]string{"package_id", "version", "blocked", "last_checked_at"},
+		[]string{"package_id", "version", "blocked", "last_checked_at", "license"},
 		func(inserter *batch.Inserter) error {
 			for i, dep := range deps {
 				for _, version := range dep.Versions {
-					if err := inserter.Insert(ctx, allIDs[i], version.Version, version.Blocked, version.LastCheckedAt); err != nil {
+					if err := inserter.Insert(ctx, allIDs[i], version.Version, version.Blocked, version.LastCheckedAt, version.License); err != nil {
 						return err
 					}
 				}
@@ -353,13 +360,17 @@ func (s *store) InsertPackageRepoRefs(ctx context.Context, deps []shared.Minimal
 	}
 
 	newVersions, err = basestore.NewSliceScanner(func(rows dbutil.Scanner) (version shared.PackageRepoRefVersion, err error) {
-		err = rows.Scan(&version.ID, &version.PackageRefID, &version.Version, &version.Blocked, &version.LastCheckedAt)
+		err = rows.Scan(&version.ID, &version.PackageRefID, &version.Version, &version.Blocked, &version.LastCheckedAt, &version.License)
 		return
 	})(tx.Query(ctx, sqlf.Sprintf(transferPackageRepoRefVersionsQuery)))
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "failed to transfer package repos from temporary table")
 	}
 
+	if err := tx.Exec(ctx, sqlf.Sprintf(updatePackageRepoRefVersionLicensesQuery)); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to update package repo version licenses from temporary table")
+	}
+
 	return newDeps, newVersions, err
 }
 
@@ -377,7 +388,8 @@ CREATE TEMPORARY TABLE t_package_repo_versions (
 	package_id BIGINT NOT NULL,
 	version TEXT NOT NULL,
 	blocked BOOLEAN NOT NULL,
-	last_checked_at TIMESTAMPTZ
+	last_checked_at TIMESTAMPTZ,
+	license TEXT
 ) ON COMMIT DROP
 `
 
@@ -396,10 +408,10 @@ RETURNING id, scheme, name, blocked, last_checked_at
 `
 
 const transferPackageRepoRefVersionsQuery = `
-INSERT INTO package_repo_versions (package_id, version, blocked, last_checked_at)
+INSERT INTO package_repo_versions (package_id, version, blocked, last_checked_at, license)
 -- we dont reduce package repo versions,
 -- so DISTINCT here to avoid conflict
-SELECT DISTINCT ON (package_id, version) package_id, version, blocked, last_checked_at
+SELECT DISTINCT ON (package_id, version) package_id, version, blocked, last_checked_at, license
 FROM t_package_repo_versions t
 WHERE NOT EXISTS (
 	SELECT package_id, version
@@ -409,7 +421,22 @@ WHERE NOT EXISTS (
 )
 -- unit tests rely on a certain order
 ORDER BY package_id, version
-RETURNING id, package_id, version, blocked, last_checked_at
+RETURNING id, package_id, version, blocked, last_checked_at, license
+`
+
+const updatePackageRepoRefVersionLicensesQuery = `
+UPDATE package_repo_versions prv
+SET license = t.license
+FROM (
+	SELECT DISTINCT ON (package_id, version) package_id, version, license
+	FROM t_package_repo_versions
+	WHERE license IS NOT NULL
+	ORDER BY package_id, version
+) t
+WHERE
+	prv.package_id = t.package_id AND
+	prv.version = t.version AND
+	prv.license IS DISTINCT FROM t.license
 `
 
 const getAttemptedInsertDependencyReposQuery = `
@@ -8,6 +8,7 @@ import (
 	"github.com/sourcegraph/log/logtest"
 
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies/shared"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
@@ -297,6 +298,35 @@ func TestListPackageRepoRefsFuzzy(t *testing.T) {
 				},
 			},
 		},
+		{
+			opts: ListDependencyReposOpts{
+				Names: []reposource.PackageName{"bar", "turtle", "missing"},
+			},
+			results: []shared.PackageRepoReference{
+				{
+					ID:     3,
+					Scheme: "npm",
+					Name:   "bar",
+					Versions: []shared.PackageRepoRefVersion{{
+						ID:           3,
+						PackageRefID: 3,
+						Version:      "2.0.0",
+					}},
+				},
+				{
+					ID:     6,
+					Scheme: "npm",
+					Name:   "turtle",
+					Versions: []shared.PackageRepoRefVersion{
+						{
+							ID:           5,
+							PackageRefID: 6,
+							Version:      "4.2.0",
+						},
+					},
+				},
+			},
+		},
 	} {
 		listedPkgs, _, _, err := store.ListPackageRepoRefs(ctx, test.opts)
 		if err != nil {
@@ -41,6 +41,8 @@ type ListDependencyReposOpts struct {
 	Scheme string
 	// Name is the package name to filter for e.g. '@types/node' etc.
 	Name reposource.PackageName
+	// Names, if set, restricts results to packages with exactly one of these names.
+	Names []reposource.PackageName
 
 	// ExactNameOnly enables exact name matching instead of substring.
 	ExactNameOnly bool
@@ -68,6 +70,7 @@ func (s *Service) ListPackageRepoRefs(ctx context.Context, opts ListDependencyRe
 	storeopts := store.ListDependencyReposOpts{
 		Scheme:         opts.Scheme,
 		Name:           opts.Name,
+		Names:          opts.Names,
 		After:          opts.After,
 		Limit:          opts.Limit,
 		IncludeBlocked: opts.IncludeBlocked,
@@ -7,4 +7,7 @@ const (
 	PythonPackagesScheme = "python"
 	RustPackagesScheme   = "rust-analyzer"
 	RubyPackagesScheme   = "scip-ruby"
+	NuGetPackagesScheme  = "scip-dotnet"
+	HexPackagesScheme    = "hex"
+	PubPackagesScheme    = "scip-dart"
 )
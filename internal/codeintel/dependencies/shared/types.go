@@ -21,6 +21,9 @@ type PackageRepoRefVersion struct {
 	Version       string
 	Blocked       bool
 	LastCheckedAt *time.Time
+	// License is the SPDX license expression declared by this version, if the
+	// package syncer recorded one.
+	License *string
 }
 
 type MinimalPackageRepoRef struct {
@@ -35,6 +38,7 @@ type MinimalPackageRepoRefVersion struct {
 	Version       string
 	Blocked       bool
 	LastCheckedAt *time.Time
+	License       *string
 }
 
 type MinimialVersionedPackageRepo struct {
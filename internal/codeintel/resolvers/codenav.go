@@ -33,6 +33,9 @@ type GitBlobLSIFDataResolver interface {
 	References(ctx context.Context, args *LSIFPagedQueryPositionArgs) (LocationConnectionResolver, error)
 	Implementations(ctx context.Context, args *LSIFPagedQueryPositionArgs) (LocationConnectionResolver, error)
 	Prototypes(ctx context.Context, args *LSIFPagedQueryPositionArgs) (LocationConnectionResolver, error)
+	IncomingCalls(ctx context.Context, args *LSIFCallHierarchyArgs) (CallHierarchyCallConnectionResolver, error)
+	OutgoingCalls(ctx context.Context, args *LSIFCallHierarchyArgs) (CallHierarchyCallConnectionResolver, error)
+	TypeHierarchy(ctx context.Context, args *LSIFTypeHierarchyArgs) (TypeHierarchyTypeConnectionResolver, error)
 	Hover(ctx context.Context, args *LSIFQueryPositionArgs) (HoverResolver, error)
 	VisibleIndexes(ctx context.Context) (_ *[]PreciseIndexResolver, err error)
 	Snapshot(ctx context.Context, args *struct{ IndexID graphql.ID }) (_ *[]SnapshotDataResolver, err error)
@@ -61,6 +64,20 @@ type LSIFPagedQueryPositionArgs struct {
 	Filter *string
 }
 
+type LSIFCallHierarchyArgs struct {
+	Line      int32
+	Character int32
+	Depth     int32
+	PagedConnectionArgs
+}
+
+type LSIFTypeHierarchyArgs struct {
+	Line      int32
+	Character int32
+	Direction string
+	Depth     int32
+}
+
 type (
 	CodeIntelligenceRangeConnectionResolver = ConnectionResolver[CodeIntelligenceRangeResolver]
 )
@@ -94,6 +111,28 @@ type LocationResolver interface {
 	CanonicalURL() string
 }
 
+type (
+	CallHierarchyCallConnectionResolver = PagedConnectionResolver[CallHierarchyCallResolver]
+)
+
+type CallHierarchyCallResolver interface {
+	Symbol() string
+	Definition() LocationResolver
+	CallSite() LocationResolver
+	Depth() int32
+}
+
+type (
+	TypeHierarchyTypeConnectionResolver = ConnectionResolver[TypeHierarchyTypeResolver]
+)
+
+type TypeHierarchyTypeResolver interface {
+	Symbol() string
+	Definition() LocationResolver
+	Parent() string
+	Depth() int32
+}
+
 type HoverResolver interface {
 	Markdown() Markdown
 	Range() RangeResolver
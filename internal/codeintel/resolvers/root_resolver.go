@@ -113,6 +113,14 @@ func (r *Resolver) VulnerabilityMatchesCountByRepository(ctx context.Context, ar
 	return r.sentinelRootResolver.VulnerabilityMatchesCountByRepository(ctx, args)
 }
 
+func (r *Resolver) VulnerabilityImportSources(ctx context.Context) (_ []VulnerabilityImportSourceResolver, err error) {
+	return r.sentinelRootResolver.VulnerabilityImportSources(ctx)
+}
+
+func (r *Resolver) ImportVulnerabilities(ctx context.Context, args *ImportVulnerabilitiesArgs) (_ VulnerabilityImportSourceResolver, err error) {
+	return r.sentinelRootResolver.ImportVulnerabilities(ctx, args)
+}
+
 func (r *Resolver) IndexerKeys(ctx context.Context, opts *IndexerKeyQueryArgs) (_ []string, err error) {
 	return r.uploadsRootResolver.IndexerKeys(ctx, opts)
 }
@@ -18,6 +18,10 @@ type SentinelServiceResolver interface {
 	VulnerabilityMatchByID(ctx context.Context, id graphql.ID) (_ VulnerabilityMatchResolver, err error)
 	VulnerabilityMatchesSummaryCounts(ctx context.Context) (VulnerabilityMatchesSummaryCountResolver, error)
 	VulnerabilityMatchesCountByRepository(ctx context.Context, args GetVulnerabilityMatchCountByRepositoryArgs) (VulnerabilityMatchCountByRepositoryConnectionResolver, error)
+
+	// Import advisories
+	VulnerabilityImportSources(ctx context.Context) ([]VulnerabilityImportSourceResolver, error)
+	ImportVulnerabilities(ctx context.Context, args *ImportVulnerabilitiesArgs) (VulnerabilityImportSourceResolver, error)
 }
 
 type (
@@ -32,6 +36,7 @@ type GetVulnerabilityMatchesArgs struct {
 	Severity       *string
 	Language       *string
 	RepositoryName *string
+	Reachability   *string
 }
 
 type VulnerabilityResolver interface {
@@ -51,6 +56,7 @@ type VulnerabilityResolver interface {
 	Published() gqlutil.DateTime
 	Modified() *gqlutil.DateTime
 	Withdrawn() *gqlutil.DateTime
+	ImportSource() *string
 	AffectedPackages() []VulnerabilityAffectedPackageResolver
 }
 
@@ -74,6 +80,7 @@ type VulnerabilityMatchResolver interface {
 	Vulnerability(ctx context.Context) (VulnerabilityResolver, error)
 	AffectedPackage(ctx context.Context) (VulnerabilityAffectedPackageResolver, error)
 	PreciseIndex(ctx context.Context) (PreciseIndexResolver, error)
+	Reachability() string
 }
 
 type VulnerabilityMatchesSummaryCountResolver interface {
@@ -82,11 +89,15 @@ type VulnerabilityMatchesSummaryCountResolver interface {
 	Medium() int32
 	Low() int32
 	Repository() int32
+	Reachable() int32
+	Unreachable() int32
+	ReachabilityUnknown() int32
 }
 
 type GetVulnerabilityMatchCountByRepositoryArgs struct {
 	PagedConnectionArgs
 	RepositoryName *string
+	Reachability   *string
 }
 
 type VulnerabilityMatchCountByRepositoryResolver interface {
@@ -94,3 +105,20 @@ type VulnerabilityMatchCountByRepositoryResolver interface {
 	RepositoryName() string
 	MatchCount() int32
 }
+
+type ImportVulnerabilitiesArgs struct {
+	Source string
+	Force  *bool
+}
+
+type VulnerabilityImportSourceResolver interface {
+	Name() string
+	URL() string
+	Checksum() *string
+	AttemptedAt() *gqlutil.DateTime
+	ImportedAt() *gqlutil.DateTime
+	NumVulnerabilities() int32
+	NumInserted() int32
+	NumUpdated() int32
+	FailureMessage() *string
+}
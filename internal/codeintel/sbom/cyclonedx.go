@@ -0,0 +1,95 @@
+package sbom
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/version"
+)
+
+type cycloneDXDocument struct {
+	BOMFormat    string                `json:"bomFormat"`
+	SpecVersion  string                `json:"specVersion"`
+	SerialNumber string                `json:"serialNumber"`
+	Version      int                   `json:"version"`
+	Metadata     cycloneDXMetadata     `json:"metadata"`
+	Components   []cycloneDXComponent  `json:"components"`
+	Dependencies []cycloneDXDependency `json:"dependencies"`
+}
+
+type cycloneDXMetadata struct {
+	Timestamp string             `json:"timestamp"`
+	Tools     []cycloneDXTool    `json:"tools"`
+	Component cycloneDXComponent `json:"component"`
+}
+
+type cycloneDXTool struct {
+	Vendor  string `json:"vendor"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type cycloneDXComponent struct {
+	Type     string             `json:"type"`
+	BOMRef   string             `json:"bom-ref"`
+	Name     string             `json:"name"`
+	Version  string             `json:"version,omitempty"`
+	PURL     string             `json:"purl,omitempty"`
+	Licenses []cycloneDXLicense `json:"licenses,omitempty"`
+}
+
+type cycloneDXLicense struct {
+	Expression string `json:"expression"`
+}
+
+type cycloneDXDependency struct {
+	Ref       string   `json:"ref"`
+	DependsOn []string `json:"dependsOn"`
+}
+
+func encodeCycloneDX(w io.Writer, sbom SBOM) error {
+	rootRef := string(sbom.RepositoryName) + "@" + string(sbom.Commit)
+
+	components := make([]cycloneDXComponent, 0, len(sbom.Components))
+	dependsOn := make([]string, 0, len(sbom.Components))
+	for _, component := range sbom.Components {
+		var licenses []cycloneDXLicense
+		if component.License != "" {
+			licenses = append(licenses, cycloneDXLicense{Expression: component.License})
+		}
+
+		components = append(components, cycloneDXComponent{
+			Type:     "library",
+			BOMRef:   component.PURL,
+			Name:     component.Name,
+			Version:  component.Version,
+			PURL:     component.PURL,
+			Licenses: licenses,
+		})
+		dependsOn = append(dependsOn, component.PURL)
+	}
+
+	document := cycloneDXDocument{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  "1.4",
+		SerialNumber: sbom.ID.URN(),
+		Version:      1,
+		Metadata: cycloneDXMetadata{
+			Timestamp: sbom.CreatedAt.UTC().Format(time.RFC3339),
+			Tools:     []cycloneDXTool{{Vendor: "Sourcegraph", Name: "Sourcegraph", Version: version.Version()}},
+			Component: cycloneDXComponent{
+				Type:    "application",
+				BOMRef:  rootRef,
+				Name:    string(sbom.RepositoryName),
+				Version: string(sbom.Commit),
+			},
+		},
+		Components:   components,
+		Dependencies: []cycloneDXDependency{{Ref: rootRef, DependsOn: dependsOn}},
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(document)
+}
@@ -0,0 +1,57 @@
+package sbom
+
+import (
+	"io"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Format is a serialization format of a software bill of materials.
+type Format string
+
+const (
+	// FormatCycloneDX is a CycloneDX 1.4 JSON document. See https://cyclonedx.org/docs/1.4/json/.
+	FormatCycloneDX Format = "cyclonedx"
+	// FormatSPDX is an SPDX 2.3 JSON document. See https://spdx.github.io/spdx-spec/v2.3/.
+	FormatSPDX Format = "spdx"
+)
+
+// ParseFormat returns the format with the given name. An empty name selects CycloneDX.
+func ParseFormat(name string) (Format, error) {
+	switch Format(strings.ToLower(name)) {
+	case "", FormatCycloneDX:
+		return FormatCycloneDX, nil
+	case FormatSPDX:
+		return FormatSPDX, nil
+	}
+
+	return "", errors.Newf("unsupported SBOM format %q: expected %q or %q", name, FormatCycloneDX, FormatSPDX)
+}
+
+// ContentType returns the media type of documents in this format.
+func (f Format) ContentType() string {
+	if f == FormatSPDX {
+		return "application/spdx+json"
+	}
+
+	return "application/vnd.cyclonedx+json"
+}
+
+// FileExtension returns the conventional file extension of documents in this format.
+func (f Format) FileExtension() string {
+	if f == FormatSPDX {
+		return ".spdx.json"
+	}
+
+	return ".cdx.json"
+}
+
+// Encode writes the given bill of materials to w in this format.
+func (f Format) Encode(w io.Writer, sbom SBOM) error {
+	if f == FormatSPDX {
+		return encodeSPDX(w, sbom)
+	}
+
+	return encodeCycloneDX(w, sbom)
+}
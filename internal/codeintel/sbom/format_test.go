@@ -0,0 +1,58 @@
+package sbom
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hexops/autogold/v2"
+)
+
+var testSBOM = SBOM{
+	ID:             uuid.MustParse("6f8d5f6e-7b3c-4f7e-9d2a-0c1e5b7a9f31"),
+	RepositoryName: "github.com/sourcegraph/sourcegraph",
+	Commit:         "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef",
+	CreatedAt:      time.Date(2023, time.July, 15, 12, 30, 0, 0, time.UTC),
+	Namespace:      "https://sourcegraph.test/.api/sbom",
+	Components: []Component{
+		{Scheme: "scip-go", Manager: "gomod", Name: "github.com/sourcegraph/log", Version: "v0.0.1", PURL: "pkg:golang/github.com/sourcegraph/log@v0.0.1"},
+		{Scheme: "scip-typescript", Manager: "npm", Name: "left-pad", Version: "1.3.0", PURL: "pkg:npm/left-pad@1.3.0", License: "WTFPL"},
+	},
+}
+
+func TestParseFormat(t *testing.T) {
+	for name, expected := range map[string]Format{
+		"":          FormatCycloneDX,
+		"cyclonedx": FormatCycloneDX,
+		"SPDX":      FormatSPDX,
+	} {
+		if format, err := ParseFormat(name); err != nil {
+			t.Errorf("unexpected error parsing format %q: %s", name, err)
+		} else if format != expected {
+			t.Errorf("unexpected format for %q. want=%q have=%q", name, expected, format)
+		}
+	}
+
+	if _, err := ParseFormat("swid"); err == nil {
+		t.Errorf("expected error parsing unsupported format")
+	}
+}
+
+func TestEncodeCycloneDX(t *testing.T) {
+	var buf bytes.Buffer
+	if err := FormatCycloneDX.Encode(&buf, testSBOM); err != nil {
+		t.Fatalf("unexpected error encoding SBOM: %s", err)
+	}
+
+	autogold.ExpectFile(t, autogold.Raw(buf.String()))
+}
+
+func TestEncodeSPDX(t *testing.T) {
+	var buf bytes.Buffer
+	if err := FormatSPDX.Encode(&buf, testSBOM); err != nil {
+		t.Fatalf("unexpected error encoding SBOM: %s", err)
+	}
+
+	autogold.ExpectFile(t, autogold.Raw(buf.String()))
+}
@@ -0,0 +1,17 @@
+package sbom
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies"
+	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+)
+
+type UploadService interface {
+	InferClosestUploads(ctx context.Context, repositoryID int, commit, path string, exactPath bool, indexer string) ([]uploadsshared.Dump, error)
+	ReferencesForUpload(ctx context.Context, uploadID int) (uploadsshared.PackageReferenceScanner, error)
+}
+
+type DependenciesService interface {
+	ListPackageRepoRefs(ctx context.Context, opts dependencies.ListDependencyReposOpts) (_ []dependencies.PackageRepoReference, total int, hasMore bool, err error)
+}
@@ -0,0 +1,21 @@
+package sbom
+
+import (
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func NewService(
+	observationCtx *observation.Context,
+	uploadSvc UploadService,
+	dependenciesSvc DependenciesService,
+) *Service {
+	return newService(
+		scopedContext("service", observationCtx),
+		uploadSvc,
+		dependenciesSvc,
+	)
+}
+
+func scopedContext(component string, parent *observation.Context) *observation.Context {
+	return observation.ScopedContext("codeintel", "sbom", component, parent)
+}
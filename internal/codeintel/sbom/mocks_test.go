@@ -0,0 +1,476 @@
+// Code generated by go-mockgen 1.3.7; DO NOT EDIT.
+//
+// This file was generated by running `sg generate` (or `go-mockgen`) at the root of
+// this repository. To add additional mocks to this or another package, add a new entry
+// to the mockgen.yaml file in the root of this repository.
+
+package sbom
+
+import (
+	"context"
+	"sync"
+
+	dependencies "github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies"
+	shared "github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies/shared"
+	shared1 "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+)
+
+// MockDependenciesService is a mock implementation of the
+// DependenciesService interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/codeintel/sbom) used for unit
+// testing.
+type MockDependenciesService struct {
+	// ListPackageRepoRefsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListPackageRepoRefs.
+	ListPackageRepoRefsFunc *DependenciesServiceListPackageRepoRefsFunc
+}
+
+// NewMockDependenciesService creates a new mock of the DependenciesService
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockDependenciesService() *MockDependenciesService {
+	return &MockDependenciesService{
+		ListPackageRepoRefsFunc: &DependenciesServiceListPackageRepoRefsFunc{
+			defaultHook: func(context.Context, dependencies.ListDependencyReposOpts) (r0 []shared.PackageRepoReference, r1 int, r2 bool, r3 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockDependenciesService creates a new mock of the
+// DependenciesService interface. All methods panic on invocation, unless
+// overwritten.
+func NewStrictMockDependenciesService() *MockDependenciesService {
+	return &MockDependenciesService{
+		ListPackageRepoRefsFunc: &DependenciesServiceListPackageRepoRefsFunc{
+			defaultHook: func(context.Context, dependencies.ListDependencyReposOpts) ([]shared.PackageRepoReference, int, bool, error) {
+				panic("unexpected invocation of MockDependenciesService.ListPackageRepoRefs")
+			},
+		},
+	}
+}
+
+// NewMockDependenciesServiceFrom creates a new mock of the
+// MockDependenciesService interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockDependenciesServiceFrom(i DependenciesService) *MockDependenciesService {
+	return &MockDependenciesService{
+		ListPackageRepoRefsFunc: &DependenciesServiceListPackageRepoRefsFunc{
+			defaultHook: i.ListPackageRepoRefs,
+		},
+	}
+}
+
+// DependenciesServiceListPackageRepoRefsFunc describes the behavior when
+// the ListPackageRepoRefs method of the parent MockDependenciesService
+// instance is invoked.
+type DependenciesServiceListPackageRepoRefsFunc struct {
+	defaultHook func(context.Context, dependencies.ListDependencyReposOpts) ([]shared.PackageRepoReference, int, bool, error)
+	hooks       []func(context.Context, dependencies.ListDependencyReposOpts) ([]shared.PackageRepoReference, int, bool, error)
+	history     []DependenciesServiceListPackageRepoRefsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListPackageRepoRefs delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockDependenciesService) ListPackageRepoRefs(v0 context.Context, v1 dependencies.ListDependencyReposOpts) ([]shared.PackageRepoReference, int, bool, error) {
+	r0, r1, r2, r3 := m.ListPackageRepoRefsFunc.nextHook()(v0, v1)
+	m.ListPackageRepoRefsFunc.appendCall(DependenciesServiceListPackageRepoRefsFuncCall{v0, v1, r0, r1, r2, r3})
+	return r0, r1, r2, r3
+}
+
+// SetDefaultHook sets function that is called when the ListPackageRepoRefs
+// method of the parent MockDependenciesService instance is invoked and the
+// hook queue is empty.
+func (f *DependenciesServiceListPackageRepoRefsFunc) SetDefaultHook(hook func(context.Context, dependencies.ListDependencyReposOpts) ([]shared.PackageRepoReference, int, bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListPackageRepoRefs method of the parent MockDependenciesService instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *DependenciesServiceListPackageRepoRefsFunc) PushHook(hook func(context.Context, dependencies.ListDependencyReposOpts) ([]shared.PackageRepoReference, int, bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *DependenciesServiceListPackageRepoRefsFunc) SetDefaultReturn(r0 []shared.PackageRepoReference, r1 int, r2 bool, r3 error) {
+	f.SetDefaultHook(func(context.Context, dependencies.ListDependencyReposOpts) ([]shared.PackageRepoReference, int, bool, error) {
+		return r0, r1, r2, r3
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *DependenciesServiceListPackageRepoRefsFunc) PushReturn(r0 []shared.PackageRepoReference, r1 int, r2 bool, r3 error) {
+	f.PushHook(func(context.Context, dependencies.ListDependencyReposOpts) ([]shared.PackageRepoReference, int, bool, error) {
+		return r0, r1, r2, r3
+	})
+}
+
+func (f *DependenciesServiceListPackageRepoRefsFunc) nextHook() func(context.Context, dependencies.ListDependencyReposOpts) ([]shared.PackageRepoReference, int, bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DependenciesServiceListPackageRepoRefsFunc) appendCall(r0 DependenciesServiceListPackageRepoRefsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// DependenciesServiceListPackageRepoRefsFuncCall objects describing the
+// invocations of this function.
+func (f *DependenciesServiceListPackageRepoRefsFunc) History() []DependenciesServiceListPackageRepoRefsFuncCall {
+	f.mutex.Lock()
+	history := make([]DependenciesServiceListPackageRepoRefsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DependenciesServiceListPackageRepoRefsFuncCall is an object that
+// describes an invocation of method ListPackageRepoRefs on an instance of
+// MockDependenciesService.
+type DependenciesServiceListPackageRepoRefsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 dependencies.ListDependencyReposOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []shared.PackageRepoReference
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 bool
+	// Result3 is the value of the 4th result returned from this method
+	// invocation.
+	Result3 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DependenciesServiceListPackageRepoRefsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DependenciesServiceListPackageRepoRefsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2, c.Result3}
+}
+
+// MockUploadService is a mock implementation of the UploadService interface
+// (from the package
+// github.com/sourcegraph/sourcegraph/internal/codeintel/sbom) used for unit
+// testing.
+type MockUploadService struct {
+	// InferClosestUploadsFunc is an instance of a mock function object
+	// controlling the behavior of the method InferClosestUploads.
+	InferClosestUploadsFunc *UploadServiceInferClosestUploadsFunc
+	// ReferencesForUploadFunc is an instance of a mock function object
+	// controlling the behavior of the method ReferencesForUpload.
+	ReferencesForUploadFunc *UploadServiceReferencesForUploadFunc
+}
+
+// NewMockUploadService creates a new mock of the UploadService interface.
+// All methods return zero values for all results, unless overwritten.
+func NewMockUploadService() *MockUploadService {
+	return &MockUploadService{
+		InferClosestUploadsFunc: &UploadServiceInferClosestUploadsFunc{
+			defaultHook: func(context.Context, int, string, string, bool, string) (r0 []shared1.Dump, r1 error) {
+				return
+			},
+		},
+		ReferencesForUploadFunc: &UploadServiceReferencesForUploadFunc{
+			defaultHook: func(context.Context, int) (r0 shared1.PackageReferenceScanner, r1 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockUploadService creates a new mock of the UploadService
+// interface. All methods panic on invocation, unless overwritten.
+func NewStrictMockUploadService() *MockUploadService {
+	return &MockUploadService{
+		InferClosestUploadsFunc: &UploadServiceInferClosestUploadsFunc{
+			defaultHook: func(context.Context, int, string, string, bool, string) ([]shared1.Dump, error) {
+				panic("unexpected invocation of MockUploadService.InferClosestUploads")
+			},
+		},
+		ReferencesForUploadFunc: &UploadServiceReferencesForUploadFunc{
+			defaultHook: func(context.Context, int) (shared1.PackageReferenceScanner, error) {
+				panic("unexpected invocation of MockUploadService.ReferencesForUpload")
+			},
+		},
+	}
+}
+
+// NewMockUploadServiceFrom creates a new mock of the MockUploadService
+// interface. All methods delegate to the given implementation, unless
+// overwritten.
+func NewMockUploadServiceFrom(i UploadService) *MockUploadService {
+	return &MockUploadService{
+		InferClosestUploadsFunc: &UploadServiceInferClosestUploadsFunc{
+			defaultHook: i.InferClosestUploads,
+		},
+		ReferencesForUploadFunc: &UploadServiceReferencesForUploadFunc{
+			defaultHook: i.ReferencesForUpload,
+		},
+	}
+}
+
+// UploadServiceInferClosestUploadsFunc describes the behavior when the
+// InferClosestUploads method of the parent MockUploadService instance is
+// invoked.
+type UploadServiceInferClosestUploadsFunc struct {
+	defaultHook func(context.Context, int, string, string, bool, string) ([]shared1.Dump, error)
+	hooks       []func(context.Context, int, string, string, bool, string) ([]shared1.Dump, error)
+	history     []UploadServiceInferClosestUploadsFuncCall
+	mutex       sync.Mutex
+}
+
+// InferClosestUploads delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockUploadService) InferClosestUploads(v0 context.Context, v1 int, v2 string, v3 string, v4 bool, v5 string) ([]shared1.Dump, error) {
+	r0, r1 := m.InferClosestUploadsFunc.nextHook()(v0, v1, v2, v3, v4, v5)
+	m.InferClosestUploadsFunc.appendCall(UploadServiceInferClosestUploadsFuncCall{v0, v1, v2, v3, v4, v5, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the InferClosestUploads
+// method of the parent MockUploadService instance is invoked and the hook
+// queue is empty.
+func (f *UploadServiceInferClosestUploadsFunc) SetDefaultHook(hook func(context.Context, int, string, string, bool, string) ([]shared1.Dump, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// InferClosestUploads method of the parent MockUploadService instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *UploadServiceInferClosestUploadsFunc) PushHook(hook func(context.Context, int, string, string, bool, string) ([]shared1.Dump, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *UploadServiceInferClosestUploadsFunc) SetDefaultReturn(r0 []shared1.Dump, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string, string, bool, string) ([]shared1.Dump, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *UploadServiceInferClosestUploadsFunc) PushReturn(r0 []shared1.Dump, r1 error) {
+	f.PushHook(func(context.Context, int, string, string, bool, string) ([]shared1.Dump, error) {
+		return r0, r1
+	})
+}
+
+func (f *UploadServiceInferClosestUploadsFunc) nextHook() func(context.Context, int, string, string, bool, string) ([]shared1.Dump, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *UploadServiceInferClosestUploadsFunc) appendCall(r0 UploadServiceInferClosestUploadsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of UploadServiceInferClosestUploadsFuncCall
+// objects describing the invocations of this function.
+func (f *UploadServiceInferClosestUploadsFunc) History() []UploadServiceInferClosestUploadsFuncCall {
+	f.mutex.Lock()
+	history := make([]UploadServiceInferClosestUploadsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// UploadServiceInferClosestUploadsFuncCall is an object that describes an
+// invocation of method InferClosestUploads on an instance of
+// MockUploadService.
+type UploadServiceInferClosestUploadsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 bool
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []shared1.Dump
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c UploadServiceInferClosestUploadsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c UploadServiceInferClosestUploadsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// UploadServiceReferencesForUploadFunc describes the behavior when the
+// ReferencesForUpload method of the parent MockUploadService instance is
+// invoked.
+type UploadServiceReferencesForUploadFunc struct {
+	defaultHook func(context.Context, int) (shared1.PackageReferenceScanner, error)
+	hooks       []func(context.Context, int) (shared1.PackageReferenceScanner, error)
+	history     []UploadServiceReferencesForUploadFuncCall
+	mutex       sync.Mutex
+}
+
+// ReferencesForUpload delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockUploadService) ReferencesForUpload(v0 context.Context, v1 int) (shared1.PackageReferenceScanner, error) {
+	r0, r1 := m.ReferencesForUploadFunc.nextHook()(v0, v1)
+	m.ReferencesForUploadFunc.appendCall(UploadServiceReferencesForUploadFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ReferencesForUpload
+// method of the parent MockUploadService instance is invoked and the hook
+// queue is empty.
+func (f *UploadServiceReferencesForUploadFunc) SetDefaultHook(hook func(context.Context, int) (shared1.PackageReferenceScanner, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ReferencesForUpload method of the parent MockUploadService instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *UploadServiceReferencesForUploadFunc) PushHook(hook func(context.Context, int) (shared1.PackageReferenceScanner, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *UploadServiceReferencesForUploadFunc) SetDefaultReturn(r0 shared1.PackageReferenceScanner, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) (shared1.PackageReferenceScanner, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *UploadServiceReferencesForUploadFunc) PushReturn(r0 shared1.PackageReferenceScanner, r1 error) {
+	f.PushHook(func(context.Context, int) (shared1.PackageReferenceScanner, error) {
+		return r0, r1
+	})
+}
+
+func (f *UploadServiceReferencesForUploadFunc) nextHook() func(context.Context, int) (shared1.PackageReferenceScanner, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *UploadServiceReferencesForUploadFunc) appendCall(r0 UploadServiceReferencesForUploadFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of UploadServiceReferencesForUploadFuncCall
+// objects describing the invocations of this function.
+func (f *UploadServiceReferencesForUploadFunc) History() []UploadServiceReferencesForUploadFuncCall {
+	f.mutex.Lock()
+	history := make([]UploadServiceReferencesForUploadFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// UploadServiceReferencesForUploadFuncCall is an object that describes an
+// invocation of method ReferencesForUpload on an instance of
+// MockUploadService.
+type UploadServiceReferencesForUploadFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 shared1.PackageReferenceScanner
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c UploadServiceReferencesForUploadFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c UploadServiceReferencesForUploadFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
@@ -0,0 +1,96 @@
+package sbom
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies"
+	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+)
+
+// ecosystem describes how the packages of a moniker scheme are identified.
+type ecosystem struct {
+	// purlType is the package URL type of the ecosystem.
+	purlType string
+
+	// packageRepoScheme is the scheme of the package repo references synced for the ecosystem.
+	packageRepoScheme string
+}
+
+// ecosystemsByScheme maps the moniker schemes emitted by indexers to ecosystems.
+var ecosystemsByScheme = map[string]ecosystem{
+	"gomod":                           {purlType: "golang", packageRepoScheme: dependencies.GoPackagesScheme},
+	"scip-go":                         {purlType: "golang", packageRepoScheme: dependencies.GoPackagesScheme},
+	dependencies.JVMPackagesScheme:    {purlType: "maven", packageRepoScheme: dependencies.JVMPackagesScheme},
+	dependencies.NpmPackagesScheme:    {purlType: "npm", packageRepoScheme: dependencies.NpmPackagesScheme},
+	"scip-typescript":                 {purlType: "npm", packageRepoScheme: dependencies.NpmPackagesScheme},
+	dependencies.PythonPackagesScheme: {purlType: "pypi", packageRepoScheme: dependencies.PythonPackagesScheme},
+	"scip-python":                     {purlType: "pypi", packageRepoScheme: dependencies.PythonPackagesScheme},
+	dependencies.RustPackagesScheme:   {purlType: "cargo", packageRepoScheme: dependencies.RustPackagesScheme},
+	dependencies.RubyPackagesScheme:   {purlType: "gem", packageRepoScheme: dependencies.RubyPackagesScheme},
+	dependencies.NuGetPackagesScheme:  {purlType: "nuget", packageRepoScheme: dependencies.NuGetPackagesScheme},
+	dependencies.HexPackagesScheme:    {purlType: "hex", packageRepoScheme: dependencies.HexPackagesScheme},
+	dependencies.PubPackagesScheme:    {purlType: "pub", packageRepoScheme: dependencies.PubPackagesScheme},
+}
+
+// newComponent converts a package referenced by an index into a component. Packages
+// of unknown schemes are identified by a generic package URL.
+func newComponent(pkg uploadsshared.Package) Component {
+	name := pkg.Name
+	if pkg.Scheme == dependencies.JVMPackagesScheme {
+		// Maven packages are referenced as maven/<group>/<artifact>
+		name = strings.ReplaceAll(strings.TrimPrefix(name, "maven/"), "/", ":")
+	}
+
+	return Component{
+		Scheme:  pkg.Scheme,
+		Manager: pkg.Manager,
+		Name:    name,
+		Version: pkg.Version,
+		PURL:    packageURL(pkg.Scheme, name, pkg.Version),
+	}
+}
+
+// packageURL returns the package URL of the given package.
+func packageURL(scheme, name, version string) string {
+	purlType := "generic"
+	if ecosystem, ok := ecosystemsByScheme[scheme]; ok {
+		purlType = ecosystem.purlType
+	}
+
+	var segments []string
+	switch purlType {
+	case "golang", "npm":
+		// The leading components of Go module paths and npm scopes are namespaces
+		segments = strings.Split(name, "/")
+	case "maven":
+		segments = strings.SplitN(name, ":", 2)
+	case "pypi":
+		segments = []string{strings.ReplaceAll(strings.ToLower(name), "_", "-")}
+	default:
+		segments = []string{name}
+	}
+
+	for i, segment := range segments {
+		segments[i] = escapePURLComponent(segment)
+	}
+
+	purl := "pkg:" + purlType + "/" + strings.Join(segments, "/")
+	if version != "" {
+		purl += "@" + escapePURLComponent(version)
+	}
+
+	return purl
+}
+
+// escapePURLComponent percent-encodes a namespace segment, name, or version of a package URL.
+func escapePURLComponent(s string) string {
+	return strings.ReplaceAll(url.PathEscape(s), "@", "%40")
+}
+
+// packageRepoScheme returns the scheme of the package repo references of the given
+// moniker scheme, or false if packages of the scheme are not synced.
+func packageRepoScheme(scheme string) (string, bool) {
+	ecosystem, ok := ecosystemsByScheme[scheme]
+	return ecosystem.packageRepoScheme, ok
+}
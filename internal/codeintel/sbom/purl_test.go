@@ -0,0 +1,58 @@
+package sbom
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+)
+
+func TestNewComponent(t *testing.T) {
+	testCases := []struct {
+		pkg          uploadsshared.Package
+		expectedName string
+		expectedPURL string
+	}{
+		{
+			pkg:          uploadsshared.Package{Scheme: "scip-go", Manager: "gomod", Name: "github.com/sourcegraph/log", Version: "v0.0.0-20230523201558-ff5a2bd6b1a5"},
+			expectedName: "github.com/sourcegraph/log",
+			expectedPURL: "pkg:golang/github.com/sourcegraph/log@v0.0.0-20230523201558-ff5a2bd6b1a5",
+		},
+		{
+			pkg:          uploadsshared.Package{Scheme: "scip-typescript", Manager: "npm", Name: "@types/node", Version: "20.4.1"},
+			expectedName: "@types/node",
+			expectedPURL: "pkg:npm/%40types/node@20.4.1",
+		},
+		{
+			pkg:          uploadsshared.Package{Scheme: "semanticdb", Manager: "jvm-dependencies", Name: "maven/org.slf4j/slf4j-api", Version: "1.7.36"},
+			expectedName: "org.slf4j:slf4j-api",
+			expectedPURL: "pkg:maven/org.slf4j/slf4j-api@1.7.36",
+		},
+		{
+			pkg:          uploadsshared.Package{Scheme: "scip-python", Manager: "python", Name: "Typing_Extensions", Version: "4.7.1"},
+			expectedName: "Typing_Extensions",
+			expectedPURL: "pkg:pypi/typing-extensions@4.7.1",
+		},
+		{
+			pkg:          uploadsshared.Package{Scheme: "rust-analyzer", Manager: "cargo", Name: "serde", Version: "1.0.171"},
+			expectedName: "serde",
+			expectedPURL: "pkg:cargo/serde@1.0.171",
+		},
+		{
+			pkg:          uploadsshared.Package{Scheme: "scip-clang", Name: "zlib"},
+			expectedName: "zlib",
+			expectedPURL: "pkg:generic/zlib",
+		},
+	}
+
+	for _, testCase := range testCases {
+		component := newComponent(testCase.pkg)
+		if diff := cmp.Diff(testCase.expectedName, component.Name); diff != "" {
+			t.Errorf("unexpected name for %s (-want +got):\n%s", testCase.pkg.Name, diff)
+		}
+		if diff := cmp.Diff(testCase.expectedPURL, component.PURL); diff != "" {
+			t.Errorf("unexpected purl for %s (-want +got):\n%s", testCase.pkg.Name, diff)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package sbom
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type Service struct {
+	uploadSvc       UploadService
+	dependenciesSvc DependenciesService
+	operations      *operations
+}
+
+func newService(
+	observationCtx *observation.Context,
+	uploadSvc UploadService,
+	dependenciesSvc DependenciesService,
+) *Service {
+	return &Service{
+		uploadSvc:       uploadSvc,
+		dependenciesSvc: dependenciesSvc,
+		operations:      newOperations(observationCtx),
+	}
+}
+
+// GetSBOM returns a bill of materials for the given commit of a repository. The components are
+// the packages referenced by the precise indexes visible from the commit, and carry the licenses
+// recorded on the matching package repo reference versions. Packages which are only declared in
+// lockfiles or manifests, and not referenced by the indexes, are not components.
+func (s *Service) GetSBOM(ctx context.Context, repositoryID int, repositoryName api.RepoName, commit api.CommitID) (_ SBOM, err error) {
+	ctx, _, endObservation := s.operations.getSBOM.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", repositoryID),
+		attribute.String("commit", string(commit)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	dumps, err := s.uploadSvc.InferClosestUploads(ctx, repositoryID, string(commit), "", false, "")
+	if err != nil {
+		return SBOM{}, errors.Wrap(err, "uploadSvc.InferClosestUploads")
+	}
+
+	componentsByPURL := map[string]Component{}
+	for _, dump := range dumps {
+		if err := s.gatherComponents(ctx, dump.ID, componentsByPURL); err != nil {
+			return SBOM{}, err
+		}
+	}
+
+	components := make([]Component, 0, len(componentsByPURL))
+	for _, component := range componentsByPURL {
+		components = append(components, component)
+	}
+	sort.Slice(components, func(i, j int) bool { return components[i].PURL < components[j].PURL })
+
+	if err := s.attachLicenses(ctx, components); err != nil {
+		return SBOM{}, err
+	}
+
+	return SBOM{
+		ID:             uuid.New(),
+		RepositoryName: repositoryName,
+		Commit:         commit,
+		CreatedAt:      time.Now().UTC().Truncate(time.Second),
+		Namespace:      strings.TrimSuffix(conf.ExternalURL(), "/") + "/.api/sbom",
+		Components:     components,
+	}, nil
+}
+
+// gatherComponents adds a component for each package referenced by the given upload.
+func (s *Service) gatherComponents(ctx context.Context, uploadID int, componentsByPURL map[string]Component) (err error) {
+	scanner, err := s.uploadSvc.ReferencesForUpload(ctx, uploadID)
+	if err != nil {
+		return errors.Wrap(err, "uploadSvc.ReferencesForUpload")
+	}
+	defer func() {
+		if closeErr := scanner.Close(); closeErr != nil {
+			err = errors.Append(err, errors.Wrap(closeErr, "scanner.Close"))
+		}
+	}()
+
+	for {
+		reference, exists, err := scanner.Next()
+		if err != nil {
+			return errors.Wrap(err, "scanner.Next")
+		}
+		if !exists {
+			break
+		}
+
+		component := newComponent(reference.Package)
+		componentsByPURL[component.PURL] = component
+	}
+
+	return nil
+}
+
+// licensedPackageRepoSchemes are the schemes of package repo references whose syncer records
+// the license declared by each version. Only npm package metadata carries a license today, so
+// components of other ecosystems are left without one and are exported as NOASSERTION.
+var licensedPackageRepoSchemes = map[string]struct{}{
+	dependencies.NpmPackagesScheme: {},
+}
+
+// attachLicenses sets the license of each component whose version has a license recorded
+// on its package repo reference. The references of all packages of a scheme are fetched in
+// a single request.
+func (s *Service) attachLicenses(ctx context.Context, components []Component) error {
+	type packageKey struct {
+		name    string
+		version string
+	}
+	indexesBySchemeAndPackage := map[string]map[packageKey][]int{}
+	for i, component := range components {
+		scheme, ok := packageRepoScheme(component.Scheme)
+		if !ok || component.Version == "" {
+			continue
+		}
+		if _, ok := licensedPackageRepoSchemes[scheme]; !ok {
+			continue
+		}
+
+		if _, ok := indexesBySchemeAndPackage[scheme]; !ok {
+			indexesBySchemeAndPackage[scheme] = map[packageKey][]int{}
+		}
+		key := packageKey{component.Name, component.Version}
+		indexesBySchemeAndPackage[scheme][key] = append(indexesBySchemeAndPackage[scheme][key], i)
+	}
+
+	for scheme, indexesByPackage := range indexesBySchemeAndPackage {
+		names := make([]reposource.PackageName, 0, len(indexesByPackage))
+		seen := map[string]struct{}{}
+		for key := range indexesByPackage {
+			if _, ok := seen[key.name]; !ok {
+				seen[key.name] = struct{}{}
+				names = append(names, reposource.PackageName(key.name))
+			}
+		}
+		sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+
+		refs, _, _, err := s.dependenciesSvc.ListPackageRepoRefs(ctx, dependencies.ListDependencyReposOpts{
+			Scheme:         scheme,
+			Names:          names,
+			IncludeBlocked: true,
+		})
+		if err != nil {
+			return errors.Wrap(err, "dependenciesSvc.ListPackageRepoRefs")
+		}
+
+		for _, ref := range refs {
+			for _, version := range ref.Versions {
+				if version.License == nil {
+					continue
+				}
+				for _, i := range indexesByPackage[packageKey{string(ref.Name), version.Version}] {
+					components[i].License = *version.License
+				}
+			}
+		}
+	}
+
+	return nil
+}
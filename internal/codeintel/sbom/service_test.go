@@ -0,0 +1,120 @@
+package sbom
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies"
+	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+func TestGetSBOM(t *testing.T) {
+	mockUploadSvc := NewMockUploadService()
+	mockDependenciesSvc := NewMockDependenciesService()
+	svc := newService(&observation.TestContext, mockUploadSvc, mockDependenciesSvc)
+
+	mockUploadSvc.InferClosestUploadsFunc.SetDefaultReturn([]uploadsshared.Dump{{ID: 1}, {ID: 2}}, nil)
+	mockUploadSvc.ReferencesForUploadFunc.SetDefaultHook(func(_ context.Context, uploadID int) (uploadsshared.PackageReferenceScanner, error) {
+		switch uploadID {
+		case 1:
+			return newPackageReferenceScanner(
+				uploadsshared.Package{Scheme: "scip-typescript", Manager: "npm", Name: "left-pad", Version: "1.3.0"},
+				uploadsshared.Package{Scheme: "scip-typescript", Manager: "npm", Name: "is-sorted", Version: "1.0.0"},
+			), nil
+		default:
+			return newPackageReferenceScanner(
+				// Referenced by both uploads
+				uploadsshared.Package{Scheme: "scip-typescript", Manager: "npm", Name: "left-pad", Version: "1.3.0"},
+				uploadsshared.Package{Scheme: "scip-clang", Name: "zlib", Version: "1.2.13"},
+				uploadsshared.Package{Scheme: "scip-go", Manager: "gomod", Name: "github.com/google/uuid", Version: "v1.3.0"},
+			), nil
+		}
+	})
+	mockDependenciesSvc.ListPackageRepoRefsFunc.SetDefaultHook(func(_ context.Context, opts dependencies.ListDependencyReposOpts) ([]dependencies.PackageRepoReference, int, bool, error) {
+		if opts.Scheme != "npm" {
+			return nil, 0, false, nil
+		}
+
+		return []dependencies.PackageRepoReference{
+			{
+				Scheme: "npm",
+				Name:   "is-sorted",
+				Versions: []dependencies.PackageRepoRefVersion{
+					{Version: "1.0.0"},
+				},
+			},
+			{
+				Scheme: "npm",
+				Name:   "left-pad",
+				Versions: []dependencies.PackageRepoRefVersion{
+					{Version: "1.2.0", License: pointers.Ptr("MIT")},
+					{Version: "1.3.0", License: pointers.Ptr("WTFPL")},
+				},
+			},
+		}, 2, false, nil
+	})
+
+	sbom, err := svc.GetSBOM(context.Background(), 42, "github.com/sourcegraph/sourcegraph", "deadbeef")
+	if err != nil {
+		t.Fatalf("unexpected error getting SBOM: %s", err)
+	}
+
+	expectedComponents := []Component{
+		{Scheme: "scip-clang", Name: "zlib", Version: "1.2.13", PURL: "pkg:generic/zlib@1.2.13"},
+		{Scheme: "scip-go", Manager: "gomod", Name: "github.com/google/uuid", Version: "v1.3.0", PURL: "pkg:golang/github.com/google/uuid@v1.3.0"},
+		{Scheme: "scip-typescript", Manager: "npm", Name: "is-sorted", Version: "1.0.0", PURL: "pkg:npm/is-sorted@1.0.0"},
+		{Scheme: "scip-typescript", Manager: "npm", Name: "left-pad", Version: "1.3.0", PURL: "pkg:npm/left-pad@1.3.0", License: "WTFPL"},
+	}
+	if diff := cmp.Diff(expectedComponents, sbom.Components); diff != "" {
+		t.Errorf("unexpected components (-want +got):\n%s", diff)
+	}
+
+	if history := mockUploadSvc.InferClosestUploadsFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected number of calls to InferClosestUploads. want=%d have=%d", 1, len(history))
+	} else if history[0].Arg1 != 42 || history[0].Arg2 != "deadbeef" {
+		t.Errorf("unexpected arguments to InferClosestUploads. want=(%d, %q) have=(%d, %q)", 42, "deadbeef", history[0].Arg1, history[0].Arg2)
+	}
+
+	// Only npm package repo references carry licenses, and all of them are fetched at once
+	if history := mockDependenciesSvc.ListPackageRepoRefsFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected number of calls to ListPackageRepoRefs. want=%d have=%d", 1, len(history))
+	} else if diff := cmp.Diff(dependencies.ListDependencyReposOpts{
+		Scheme:         "npm",
+		Names:          []reposource.PackageName{"is-sorted", "left-pad"},
+		IncludeBlocked: true,
+	}, history[0].Arg1); diff != "" {
+		t.Errorf("unexpected package repo reference lookup (-want +got):\n%s", diff)
+	}
+}
+
+type packageReferenceScanner struct {
+	references []uploadsshared.PackageReference
+}
+
+func newPackageReferenceScanner(packages ...uploadsshared.Package) uploadsshared.PackageReferenceScanner {
+	references := make([]uploadsshared.PackageReference, 0, len(packages))
+	for _, pkg := range packages {
+		references = append(references, uploadsshared.PackageReference{Package: pkg})
+	}
+
+	return &packageReferenceScanner{references: references}
+}
+
+func (s *packageReferenceScanner) Next() (uploadsshared.PackageReference, bool, error) {
+	if len(s.references) == 0 {
+		return uploadsshared.PackageReference{}, false, nil
+	}
+
+	reference := s.references[0]
+	s.references = s.references[1:]
+	return reference, true, nil
+}
+
+func (s *packageReferenceScanner) Close() error {
+	return nil
+}
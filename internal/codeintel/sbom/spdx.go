@@ -0,0 +1,125 @@
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/version"
+)
+
+const (
+	spdxDocumentID   = "SPDXRef-DOCUMENT"
+	spdxRepositoryID = "SPDXRef-Repository"
+	spdxNoAssertion  = "NOASSERTION"
+)
+
+type spdxDocument struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	Name             string            `json:"name"`
+	SPDXID           string            `json:"SPDXID"`
+	VersionInfo      string            `json:"versionInfo,omitempty"`
+	DownloadLocation string            `json:"downloadLocation"`
+	FilesAnalyzed    bool              `json:"filesAnalyzed"`
+	LicenseConcluded string            `json:"licenseConcluded"`
+	LicenseDeclared  string            `json:"licenseDeclared"`
+	CopyrightText    string            `json:"copyrightText"`
+	ExternalRefs     []spdxExternalRef `json:"externalRefs,omitempty"`
+}
+
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type spdxRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+	RelationshipType   string `json:"relationshipType"`
+}
+
+func encodeSPDX(w io.Writer, sbom SBOM) error {
+	name := string(sbom.RepositoryName) + "@" + string(sbom.Commit)
+
+	packages := make([]spdxPackage, 0, len(sbom.Components)+1)
+	packages = append(packages, spdxPackage{
+		Name:             string(sbom.RepositoryName),
+		SPDXID:           spdxRepositoryID,
+		VersionInfo:      string(sbom.Commit),
+		DownloadLocation: spdxNoAssertion,
+		LicenseConcluded: spdxNoAssertion,
+		LicenseDeclared:  spdxNoAssertion,
+		CopyrightText:    spdxNoAssertion,
+	})
+
+	relationships := make([]spdxRelationship, 0, len(sbom.Components)+1)
+	relationships = append(relationships, spdxRelationship{
+		SPDXElementID:      spdxDocumentID,
+		RelatedSPDXElement: spdxRepositoryID,
+		RelationshipType:   "DESCRIBES",
+	})
+
+	for i, component := range sbom.Components {
+		id := fmt.Sprintf("SPDXRef-Package-%d", i+1)
+
+		licenseDeclared := spdxNoAssertion
+		if component.License != "" {
+			licenseDeclared = component.License
+		}
+
+		packages = append(packages, spdxPackage{
+			Name:             component.Name,
+			SPDXID:           id,
+			VersionInfo:      component.Version,
+			DownloadLocation: spdxNoAssertion,
+			LicenseConcluded: spdxNoAssertion,
+			LicenseDeclared:  licenseDeclared,
+			CopyrightText:    spdxNoAssertion,
+			ExternalRefs: []spdxExternalRef{{
+				ReferenceCategory: "PACKAGE-MANAGER",
+				ReferenceType:     "purl",
+				ReferenceLocator:  component.PURL,
+			}},
+		})
+		relationships = append(relationships, spdxRelationship{
+			SPDXElementID:      spdxRepositoryID,
+			RelatedSPDXElement: id,
+			RelationshipType:   "DEPENDS_ON",
+		})
+	}
+
+	document := spdxDocument{
+		SPDXVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            spdxDocumentID,
+		Name:              name,
+		DocumentNamespace: fmt.Sprintf("%s/%s-%s", sbom.Namespace, name, sbom.ID),
+		CreationInfo: spdxCreationInfo{
+			Created:  sbom.CreatedAt.UTC().Format(time.RFC3339),
+			Creators: []string{"Tool: Sourcegraph-" + version.Version()},
+		},
+		Packages:      packages,
+		Relationships: relationships,
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(document)
+}
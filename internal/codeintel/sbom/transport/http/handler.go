@@ -0,0 +1,83 @@
+package http
+
+import (
+	"mime"
+	"net/http"
+	"path"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sbom"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+)
+
+// newHandler returns a handler that exports a software bill of materials for a commit of a
+// repository. The request takes the following query parameters:
+//
+//   - repository: the name of the repository (required)
+//   - rev: the revision to resolve to a commit (defaults to HEAD)
+//   - format: one of `cyclonedx` (default) or `spdx`
+func newHandler(repoStore RepoStore, sbomSvc SBOMService, logger log.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		query := r.URL.Query()
+
+		format, err := sbom.ParseFormat(query.Get("format"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		repositoryName := query.Get("repository")
+		if repositoryName == "" {
+			http.Error(w, "repository is required", http.StatusBadRequest)
+			return
+		}
+
+		// 🚨 SECURITY: The repository is resolved with the actor of the request, so a bill of
+		// materials is only exported for repositories the current user is allowed to view.
+		repo, err := repoStore.GetByName(ctx, api.RepoName(repositoryName))
+		if err != nil {
+			if errcode.IsNotFound(err) {
+				http.Error(w, "repository not found", http.StatusNotFound)
+				return
+			}
+
+			logger.Error("failed to resolve repository", log.String("repository", repositoryName), log.Error(err))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		commit, err := repoStore.ResolveRev(ctx, repo, query.Get("rev"))
+		if err != nil {
+			if errcode.IsNotFound(err) {
+				http.Error(w, "revision not found", http.StatusNotFound)
+				return
+			}
+			if gitdomain.IsCloneInProgress(err) {
+				http.Error(w, "repository still cloning", http.StatusServiceUnavailable)
+				return
+			}
+
+			logger.Error("failed to resolve revision", log.String("repository", repositoryName), log.Error(err))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		document, err := sbomSvc.GetSBOM(ctx, int(repo.ID), repo.Name, commit)
+		if err != nil {
+			logger.Error("failed to generate SBOM", log.String("repository", repositoryName), log.String("commit", string(commit)), log.Error(err))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		filename := path.Base(string(repo.Name)) + "-" + string(commit) + format.FileExtension()
+		w.Header().Set("Content-Type", format.ContentType())
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+		if err := format.Encode(w, document); err != nil {
+			logger.Error("failed to write SBOM", log.Error(err))
+		}
+	})
+}
@@ -0,0 +1,86 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sbom"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+const testCommit = "deadbeef01deadbeef02deadbeef03deadbeef04"
+
+func TestHandler(t *testing.T) {
+	mockRepoStore := NewMockRepoStore()
+	mockRepoStore.GetByNameFunc.SetDefaultHook(func(_ context.Context, name api.RepoName) (*types.Repo, error) {
+		if name != "github.com/test/test" {
+			return nil, &database.RepoNotFoundErr{Name: name}
+		}
+		return &types.Repo{ID: 50, Name: name}, nil
+	})
+	mockRepoStore.ResolveRevFunc.SetDefaultHook(func(_ context.Context, _ *types.Repo, rev string) (api.CommitID, error) {
+		if rev != "" && rev != "main" {
+			return "", &gitdomain.RevisionNotFoundError{Spec: rev}
+		}
+		return testCommit, nil
+	})
+	mockSBOMService := NewMockSBOMService()
+	mockSBOMService.GetSBOMFunc.SetDefaultHook(func(_ context.Context, _ int, name api.RepoName, commit api.CommitID) (sbom.SBOM, error) {
+		return sbom.SBOM{
+			RepositoryName: name,
+			Commit:         commit,
+			Components: []sbom.Component{
+				{Scheme: "npm", Name: "left-pad", Version: "1.3.0", PURL: "pkg:npm/left-pad@1.3.0", License: "WTFPL"},
+			},
+		}, nil
+	})
+
+	handler := newHandler(mockRepoStore, mockSBOMService, logtest.Scoped(t))
+
+	testCases := []struct {
+		query               string
+		expectedStatusCode  int
+		expectedContentType string
+	}{
+		{"repository=github.com/test/test", http.StatusOK, "application/vnd.cyclonedx+json"},
+		{"repository=github.com/test/test&rev=main&format=spdx", http.StatusOK, "application/spdx+json"},
+		{"repository=github.com/test/test&format=swid", http.StatusBadRequest, ""},
+		{"format=spdx", http.StatusBadRequest, ""},
+		{"repository=github.com/test/missing", http.StatusNotFound, ""},
+		{"repository=github.com/test/test&rev=missing", http.StatusNotFound, ""},
+	}
+
+	for _, testCase := range testCases {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest("GET", "/sbom?"+testCase.query, nil))
+
+		if w.Code != testCase.expectedStatusCode {
+			t.Errorf("unexpected status code for %q. want=%d have=%d", testCase.query, testCase.expectedStatusCode, w.Code)
+			continue
+		}
+		if testCase.expectedStatusCode != http.StatusOK {
+			continue
+		}
+
+		if contentType := w.Header().Get("Content-Type"); contentType != testCase.expectedContentType {
+			t.Errorf("unexpected content type for %q. want=%q have=%q", testCase.query, testCase.expectedContentType, contentType)
+		}
+		if !json.Valid(w.Body.Bytes()) {
+			t.Errorf("unexpected invalid JSON document for %q", testCase.query)
+		}
+	}
+
+	if history := mockSBOMService.GetSBOMFunc.History(); len(history) != 2 {
+		t.Fatalf("unexpected number of calls to GetSBOM. want=%d have=%d", 2, len(history))
+	} else if history[0].Arg1 != 50 || history[0].Arg3 != testCommit {
+		t.Errorf("unexpected arguments to GetSBOM. want=(%d, %q) have=(%d, %q)", 50, testCommit, history[0].Arg1, history[0].Arg3)
+	}
+}
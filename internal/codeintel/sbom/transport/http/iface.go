@@ -0,0 +1,18 @@
+package http
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sbom"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+type RepoStore interface {
+	GetByName(ctx context.Context, name api.RepoName) (*types.Repo, error)
+	ResolveRev(ctx context.Context, repo *types.Repo, rev string) (api.CommitID, error)
+}
+
+type SBOMService interface {
+	GetSBOM(ctx context.Context, repositoryID int, repositoryName api.RepoName, commit api.CommitID) (sbom.SBOM, error)
+}
@@ -0,0 +1,21 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sbom"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+)
+
+func NewHandler(svc *sbom.Service, db database.DB, gitserverClient gitserver.Client) http.Handler {
+	logger := log.Scoped(
+		"sbom.handler",
+		"codeintel SBOM export http handler",
+	)
+
+	return newHandler(backend.NewRepos(logger, db, gitserverClient), svc, logger)
+}
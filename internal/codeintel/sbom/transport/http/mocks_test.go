@@ -0,0 +1,452 @@
+// Code generated by go-mockgen 1.3.7; DO NOT EDIT.
+//
+// This file was generated by running `sg generate` (or `go-mockgen`) at the root of
+// this repository. To add additional mocks to this or another package, add a new entry
+// to the mockgen.yaml file in the root of this repository.
+
+package http
+
+import (
+	"context"
+	"sync"
+
+	api "github.com/sourcegraph/sourcegraph/internal/api"
+	sbom "github.com/sourcegraph/sourcegraph/internal/codeintel/sbom"
+	types "github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// MockRepoStore is a mock implementation of the RepoStore interface (from
+// the package
+// github.com/sourcegraph/sourcegraph/internal/codeintel/sbom/transport/http)
+// used for unit testing.
+type MockRepoStore struct {
+	// GetByNameFunc is an instance of a mock function object controlling
+	// the behavior of the method GetByName.
+	GetByNameFunc *RepoStoreGetByNameFunc
+	// ResolveRevFunc is an instance of a mock function object controlling
+	// the behavior of the method ResolveRev.
+	ResolveRevFunc *RepoStoreResolveRevFunc
+}
+
+// NewMockRepoStore creates a new mock of the RepoStore interface. All
+// methods return zero values for all results, unless overwritten.
+func NewMockRepoStore() *MockRepoStore {
+	return &MockRepoStore{
+		GetByNameFunc: &RepoStoreGetByNameFunc{
+			defaultHook: func(context.Context, api.RepoName) (r0 *types.Repo, r1 error) {
+				return
+			},
+		},
+		ResolveRevFunc: &RepoStoreResolveRevFunc{
+			defaultHook: func(context.Context, *types.Repo, string) (r0 api.CommitID, r1 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockRepoStore creates a new mock of the RepoStore interface. All
+// methods panic on invocation, unless overwritten.
+func NewStrictMockRepoStore() *MockRepoStore {
+	return &MockRepoStore{
+		GetByNameFunc: &RepoStoreGetByNameFunc{
+			defaultHook: func(context.Context, api.RepoName) (*types.Repo, error) {
+				panic("unexpected invocation of MockRepoStore.GetByName")
+			},
+		},
+		ResolveRevFunc: &RepoStoreResolveRevFunc{
+			defaultHook: func(context.Context, *types.Repo, string) (api.CommitID, error) {
+				panic("unexpected invocation of MockRepoStore.ResolveRev")
+			},
+		},
+	}
+}
+
+// NewMockRepoStoreFrom creates a new mock of the MockRepoStore interface.
+// All methods delegate to the given implementation, unless overwritten.
+func NewMockRepoStoreFrom(i RepoStore) *MockRepoStore {
+	return &MockRepoStore{
+		GetByNameFunc: &RepoStoreGetByNameFunc{
+			defaultHook: i.GetByName,
+		},
+		ResolveRevFunc: &RepoStoreResolveRevFunc{
+			defaultHook: i.ResolveRev,
+		},
+	}
+}
+
+// RepoStoreGetByNameFunc describes the behavior when the GetByName method
+// of the parent MockRepoStore instance is invoked.
+type RepoStoreGetByNameFunc struct {
+	defaultHook func(context.Context, api.RepoName) (*types.Repo, error)
+	hooks       []func(context.Context, api.RepoName) (*types.Repo, error)
+	history     []RepoStoreGetByNameFuncCall
+	mutex       sync.Mutex
+}
+
+// GetByName delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) GetByName(v0 context.Context, v1 api.RepoName) (*types.Repo, error) {
+	r0, r1 := m.GetByNameFunc.nextHook()(v0, v1)
+	m.GetByNameFunc.appendCall(RepoStoreGetByNameFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetByName method of
+// the parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreGetByNameFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (*types.Repo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetByName method of the parent MockRepoStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RepoStoreGetByNameFunc) PushHook(hook func(context.Context, api.RepoName) (*types.Repo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreGetByNameFunc) SetDefaultReturn(r0 *types.Repo, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) (*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreGetByNameFunc) PushReturn(r0 *types.Repo, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName) (*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStoreGetByNameFunc) nextHook() func(context.Context, api.RepoName) (*types.Repo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreGetByNameFunc) appendCall(r0 RepoStoreGetByNameFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreGetByNameFuncCall objects
+// describing the invocations of this function.
+func (f *RepoStoreGetByNameFunc) History() []RepoStoreGetByNameFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreGetByNameFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreGetByNameFuncCall is an object that describes an invocation of
+// method GetByName on an instance of MockRepoStore.
+type RepoStoreGetByNameFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *types.Repo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStoreGetByNameFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreGetByNameFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoStoreResolveRevFunc describes the behavior when the ResolveRev method
+// of the parent MockRepoStore instance is invoked.
+type RepoStoreResolveRevFunc struct {
+	defaultHook func(context.Context, *types.Repo, string) (api.CommitID, error)
+	hooks       []func(context.Context, *types.Repo, string) (api.CommitID, error)
+	history     []RepoStoreResolveRevFuncCall
+	mutex       sync.Mutex
+}
+
+// ResolveRev delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockRepoStore) ResolveRev(v0 context.Context, v1 *types.Repo, v2 string) (api.CommitID, error) {
+	r0, r1 := m.ResolveRevFunc.nextHook()(v0, v1, v2)
+	m.ResolveRevFunc.appendCall(RepoStoreResolveRevFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ResolveRev method of
+// the parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreResolveRevFunc) SetDefaultHook(hook func(context.Context, *types.Repo, string) (api.CommitID, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ResolveRev method of the parent MockRepoStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RepoStoreResolveRevFunc) PushHook(hook func(context.Context, *types.Repo, string) (api.CommitID, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreResolveRevFunc) SetDefaultReturn(r0 api.CommitID, r1 error) {
+	f.SetDefaultHook(func(context.Context, *types.Repo, string) (api.CommitID, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreResolveRevFunc) PushReturn(r0 api.CommitID, r1 error) {
+	f.PushHook(func(context.Context, *types.Repo, string) (api.CommitID, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStoreResolveRevFunc) nextHook() func(context.Context, *types.Repo, string) (api.CommitID, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreResolveRevFunc) appendCall(r0 RepoStoreResolveRevFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreResolveRevFuncCall objects
+// describing the invocations of this function.
+func (f *RepoStoreResolveRevFunc) History() []RepoStoreResolveRevFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreResolveRevFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreResolveRevFuncCall is an object that describes an invocation of
+// method ResolveRev on an instance of MockRepoStore.
+type RepoStoreResolveRevFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *types.Repo
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 api.CommitID
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStoreResolveRevFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreResolveRevFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// MockSBOMService is a mock implementation of the SBOMService interface
+// (from the package
+// github.com/sourcegraph/sourcegraph/internal/codeintel/sbom/transport/http)
+// used for unit testing.
+type MockSBOMService struct {
+	// GetSBOMFunc is an instance of a mock function object controlling the
+	// behavior of the method GetSBOM.
+	GetSBOMFunc *SBOMServiceGetSBOMFunc
+}
+
+// NewMockSBOMService creates a new mock of the SBOMService interface. All
+// methods return zero values for all results, unless overwritten.
+func NewMockSBOMService() *MockSBOMService {
+	return &MockSBOMService{
+		GetSBOMFunc: &SBOMServiceGetSBOMFunc{
+			defaultHook: func(context.Context, int, api.RepoName, api.CommitID) (r0 sbom.SBOM, r1 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockSBOMService creates a new mock of the SBOMService interface.
+// All methods panic on invocation, unless overwritten.
+func NewStrictMockSBOMService() *MockSBOMService {
+	return &MockSBOMService{
+		GetSBOMFunc: &SBOMServiceGetSBOMFunc{
+			defaultHook: func(context.Context, int, api.RepoName, api.CommitID) (sbom.SBOM, error) {
+				panic("unexpected invocation of MockSBOMService.GetSBOM")
+			},
+		},
+	}
+}
+
+// NewMockSBOMServiceFrom creates a new mock of the MockSBOMService
+// interface. All methods delegate to the given implementation, unless
+// overwritten.
+func NewMockSBOMServiceFrom(i SBOMService) *MockSBOMService {
+	return &MockSBOMService{
+		GetSBOMFunc: &SBOMServiceGetSBOMFunc{
+			defaultHook: i.GetSBOM,
+		},
+	}
+}
+
+// SBOMServiceGetSBOMFunc describes the behavior when the GetSBOM method of
+// the parent MockSBOMService instance is invoked.
+type SBOMServiceGetSBOMFunc struct {
+	defaultHook func(context.Context, int, api.RepoName, api.CommitID) (sbom.SBOM, error)
+	hooks       []func(context.Context, int, api.RepoName, api.CommitID) (sbom.SBOM, error)
+	history     []SBOMServiceGetSBOMFuncCall
+	mutex       sync.Mutex
+}
+
+// GetSBOM delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockSBOMService) GetSBOM(v0 context.Context, v1 int, v2 api.RepoName, v3 api.CommitID) (sbom.SBOM, error) {
+	r0, r1 := m.GetSBOMFunc.nextHook()(v0, v1, v2, v3)
+	m.GetSBOMFunc.appendCall(SBOMServiceGetSBOMFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetSBOM method of
+// the parent MockSBOMService instance is invoked and the hook queue is
+// empty.
+func (f *SBOMServiceGetSBOMFunc) SetDefaultHook(hook func(context.Context, int, api.RepoName, api.CommitID) (sbom.SBOM, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetSBOM method of the parent MockSBOMService instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *SBOMServiceGetSBOMFunc) PushHook(hook func(context.Context, int, api.RepoName, api.CommitID) (sbom.SBOM, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *SBOMServiceGetSBOMFunc) SetDefaultReturn(r0 sbom.SBOM, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, api.RepoName, api.CommitID) (sbom.SBOM, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *SBOMServiceGetSBOMFunc) PushReturn(r0 sbom.SBOM, r1 error) {
+	f.PushHook(func(context.Context, int, api.RepoName, api.CommitID) (sbom.SBOM, error) {
+		return r0, r1
+	})
+}
+
+func (f *SBOMServiceGetSBOMFunc) nextHook() func(context.Context, int, api.RepoName, api.CommitID) (sbom.SBOM, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SBOMServiceGetSBOMFunc) appendCall(r0 SBOMServiceGetSBOMFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SBOMServiceGetSBOMFuncCall objects
+// describing the invocations of this function.
+func (f *SBOMServiceGetSBOMFunc) History() []SBOMServiceGetSBOMFuncCall {
+	f.mutex.Lock()
+	history := make([]SBOMServiceGetSBOMFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SBOMServiceGetSBOMFuncCall is an object that describes an invocation of
+// method GetSBOM on an instance of MockSBOMService.
+type SBOMServiceGetSBOMFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.RepoName
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 api.CommitID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 sbom.SBOM
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c SBOMServiceGetSBOMFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SBOMServiceGetSBOMFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
@@ -0,0 +1,44 @@
+package sbom
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+// SBOM is a software bill of materials listing the packages referenced by the precise
+// code intelligence indexes visible at a particular commit of a repository.
+type SBOM struct {
+	ID             uuid.UUID
+	RepositoryName api.RepoName
+	Commit         api.CommitID
+	CreatedAt      time.Time
+
+	// Namespace is the base URI under which the SPDX document namespace is minted.
+	Namespace string
+
+	Components []Component
+}
+
+// Component is a single package referenced by the indexes of a commit.
+type Component struct {
+	// Scheme and Manager are the moniker scheme and package manager reported by the indexer.
+	Scheme  string
+	Manager string
+
+	// Name is the name of the package in the syntax of its ecosystem, e.g., `@types/node`
+	// for npm or `org.slf4j:slf4j-api` for Maven.
+	Name    string
+	Version string
+
+	// PURL is the package URL identifying this component.
+	// See https://github.com/package-url/purl-spec.
+	PURL string
+
+	// License is the SPDX license expression declared by this version of the package. This
+	// is empty unless the package syncer has recorded a license for the version, which it
+	// currently only does for npm packages.
+	License string
+}
@@ -4,7 +4,9 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/background"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/background/downloader"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/background/matcher"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/lsifstore"
 	sentinelstore "github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/store"
+	codeintelshared "github.com/sourcegraph/sourcegraph/internal/codeintel/shared"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
@@ -13,10 +15,12 @@ import (
 func NewService(
 	observationCtx *observation.Context,
 	db database.DB,
+	codeIntelDB codeintelshared.CodeIntelDB,
 ) *Service {
 	return newService(
 		scopedContext("service", observationCtx),
 		sentinelstore.New(scopedContext("store", observationCtx), db),
+		lsifstore.New(scopedContext("lsifstore", observationCtx), codeIntelDB),
 	)
 }
 
@@ -29,6 +33,7 @@ func CVEScannerJob(observationCtx *observation.Context, service *Service) []goro
 	return background.CVEScannerJob(
 		scopedContext("cvescanner", observationCtx),
 		service.store,
+		service.lsifstore,
 		DownloaderConfigInst,
 		MatcherConfigInst,
 	)
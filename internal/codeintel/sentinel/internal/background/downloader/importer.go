@@ -0,0 +1,99 @@
+package downloader
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/store"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// UploadedBundleLocation is the location recorded for advisory sources whose bundle was uploaded by a
+// site admin rather than read from the codeIntelSentinel.advisorySources site configuration.
+const UploadedBundleLocation = "upload"
+
+// AdvisoryImporter imports the OSV advisory bundles configured in the codeIntelSentinel.advisorySources
+// site configuration or uploaded by a site admin, and records the status of each import.
+type AdvisoryImporter struct {
+	store  store.Store
+	parser *CVEParser
+}
+
+func NewAdvisoryImporter(store store.Store) *AdvisoryImporter {
+	return &AdvisoryImporter{
+		store:  store,
+		parser: NewCVEParser(),
+	}
+}
+
+// Import reads the bundle of the given source and upserts its vulnerabilities, which record the name of the
+// source. A bundle identical to the last one imported from the same location is skipped unless force is true.
+// The updated status of the source is returned, along with a flag indicating whether the bundle was imported.
+// The status is recorded whether or not the import succeeds.
+func (i *AdvisoryImporter) Import(ctx context.Context, name, location string, force bool) (_ shared.VulnerabilityImportSource, imported bool, err error) {
+	return i.importBundle(ctx, name, location, force, func() ([]shared.Vulnerability, string, error) {
+		return i.parser.ReadOSVBundle(ctx, location)
+	})
+}
+
+// ImportUpload reads the given uploaded bundle and upserts its vulnerabilities under the given source name,
+// in the same way as Import. The location of the source is recorded as UploadedBundleLocation.
+func (i *AdvisoryImporter) ImportUpload(ctx context.Context, name string, bundle io.Reader, force bool) (_ shared.VulnerabilityImportSource, imported bool, err error) {
+	return i.importBundle(ctx, name, UploadedBundleLocation, force, func() ([]shared.Vulnerability, string, error) {
+		return i.parser.ParseOSVBundle(bundle)
+	})
+}
+
+func (i *AdvisoryImporter) importBundle(
+	ctx context.Context,
+	name, location string,
+	force bool,
+	readBundle func() ([]shared.Vulnerability, string, error),
+) (_ shared.VulnerabilityImportSource, imported bool, err error) {
+	source, _, err := i.store.VulnerabilityImportSourceByName(ctx, name)
+	if err != nil {
+		return shared.VulnerabilityImportSource{}, false, err
+	}
+
+	now := time.Now()
+	previousURL := source.URL
+	source.Name = name
+	source.URL = location
+	source.AttemptedAt = &now
+	source.FailureMessage = nil
+
+	importErr := func() error {
+		vulnerabilities, checksum, err := readBundle()
+		if err != nil {
+			return errors.Wrap(err, "reading bundle")
+		}
+		if !force && previousURL == location && source.Checksum == checksum {
+			return nil
+		}
+
+		numInserted, numUpdated, err := i.store.UpsertVulnerabilities(ctx, name, vulnerabilities)
+		if err != nil {
+			return err
+		}
+
+		source.Checksum = checksum
+		source.ImportedAt = &now
+		source.NumVulnerabilities = len(vulnerabilities)
+		source.NumInserted = numInserted
+		source.NumUpdated = numUpdated
+		imported = true
+		return nil
+	}()
+	if importErr != nil {
+		failureMessage := importErr.Error()
+		source.FailureMessage = &failureMessage
+	}
+
+	if err := i.store.UpdateVulnerabilityImportSource(ctx, source); err != nil {
+		return source, imported, errors.Append(importErr, err)
+	}
+
+	return source, imported, importErr
+}
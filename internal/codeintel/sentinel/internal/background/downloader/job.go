@@ -8,8 +8,11 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/store"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
 )
 
 func NewCVEDownloader(store store.Store, observationCtx *observation.Context, config *Config) goroutine.BackgroundRoutine {
@@ -17,26 +20,50 @@ func NewCVEDownloader(store store.Store, observationCtx *observation.Context, co
 		store:  store,
 		logger: log.Scoped("sentinel.parser", ""),
 	}
+	importer := NewAdvisoryImporter(store)
 	metrics := newMetrics(observationCtx)
 
 	return goroutine.NewPeriodicGoroutine(
 		actor.WithInternalActor(context.Background()),
 		goroutine.HandlerFunc(func(ctx context.Context) error {
-			vulnerabilities, err := cveParser.handle(ctx)
-			if err != nil {
-				return err
+			var errs error
+			if pointers.Deref(conf.SiteConfig().CodeIntelSentinelPublicAdvisoryDatabasesEnabled, true) {
+				if err := func() error {
+					vulnerabilities, err := cveParser.handle(ctx)
+					if err != nil {
+						return err
+					}
+
+					numVulnerabilitiesInserted, err := store.InsertVulnerabilities(ctx, vulnerabilities)
+					if err != nil {
+						return err
+					}
+
+					metrics.numVulnerabilitiesInserted.Add(float64(numVulnerabilitiesInserted))
+					return nil
+				}(); err != nil {
+					errs = errors.Append(errs, errors.Wrap(err, "syncing GitHub advisory database"))
+				}
 			}
 
-			numVulnerabilitiesInserted, err := store.InsertVulnerabilities(ctx, vulnerabilities)
-			if err != nil {
-				return err
+			// Configured bundles are imported even if the public databases are unreachable
+			for _, source := range conf.SiteConfig().CodeIntelSentinelAdvisorySources {
+				importSource, imported, err := importer.Import(ctx, source.Name, source.Url, false)
+				if err != nil {
+					errs = errors.Append(errs, errors.Wrapf(err, "importing advisory source %q", source.Name))
+					continue
+				}
+
+				if imported {
+					metrics.numVulnerabilitiesInserted.Add(float64(importSource.NumInserted))
+					metrics.numVulnerabilitiesUpdated.Add(float64(importSource.NumUpdated))
+				}
 			}
 
-			metrics.numVulnerabilitiesInserted.Add(float64(numVulnerabilitiesInserted))
-			return nil
+			return errs
 		}),
 		goroutine.WithName("codeintel.sentinel-cve-downloader"),
-		goroutine.WithDescription("Periodically syncs GitHub advisory records into Postgres."),
+		goroutine.WithDescription("Periodically syncs GitHub advisory records and configured OSV advisory bundles into Postgres."),
 		goroutine.WithInterval(config.DownloaderInterval),
 	)
 }
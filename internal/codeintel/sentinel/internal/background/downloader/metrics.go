@@ -8,6 +8,7 @@ import (
 
 type metrics struct {
 	numVulnerabilitiesInserted prometheus.Counter
+	numVulnerabilitiesUpdated  prometheus.Counter
 }
 
 func newMetrics(observationCtx *observation.Context) *metrics {
@@ -26,7 +27,13 @@ func newMetrics(observationCtx *observation.Context) *metrics {
 		"The number of vulnerability records inserted into Postgres.",
 	)
 
+	numVulnerabilitiesUpdated := counter(
+		"src_codeintel_sentinel_num_vulnerabilities_updated_total",
+		"The number of vulnerability records updated in Postgres by advisory imports.",
+	)
+
 	return &metrics{
 		numVulnerabilitiesInserted: numVulnerabilitiesInserted,
+		numVulnerabilitiesUpdated:  numVulnerabilitiesUpdated,
 	}
 }
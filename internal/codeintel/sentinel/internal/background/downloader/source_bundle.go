@@ -0,0 +1,322 @@
+package downloader
+
+// Read and parse bundles of vulnerabilities in the Open Source Vulnerability (OSV) format, which are
+// uploaded by a site admin or placed at a location reachable by the instance. Bundles allow importing
+// advisories on instances without access to the public advisory databases.
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/conf/deploy"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// bundleFile is a JSON file of an OSV bundle.
+type bundleFile struct {
+	name    string
+	content []byte
+}
+
+// ReadOSVBundle reads the OSV bundle at the given location and converts its advisories to the internal
+// Vulnerability format. The location is a local path, a file:// URL, an http(s):// URL, or a blobstore://
+// URL naming a bucket and key of the instance's blobstore. The SHA-256 checksum of the bundle is also returned.
+func (parser *CVEParser) ReadOSVBundle(ctx context.Context, location string) (vulns []shared.Vulnerability, checksum string, err error) {
+	files, checksum, err := readBundleFiles(ctx, location)
+	if err != nil {
+		return nil, "", err
+	}
+
+	vulns, err = parser.parseOSVBundleFiles(files)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return vulns, checksum, nil
+}
+
+// ParseOSVBundle converts the advisories of the given uploaded OSV bundle to the internal Vulnerability format.
+// A bundle is a zip archive of OSV JSON files, or a single JSON file containing one advisory or an array of
+// advisories. The SHA-256 checksum of the bundle is also returned.
+func (parser *CVEParser) ParseOSVBundle(bundleReader io.Reader) (vulns []shared.Vulnerability, checksum string, err error) {
+	content, err := io.ReadAll(bundleReader)
+	if err != nil {
+		return nil, "", err
+	}
+
+	files, err := bundleFilesFromContent("bundle.json", content)
+	if err != nil {
+		return nil, "", err
+	}
+
+	vulns, err = parser.parseOSVBundleFiles(files)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return vulns, checksumOf(content), nil
+}
+
+func (parser *CVEParser) parseOSVBundleFiles(files []bundleFile) (vulns []shared.Vulnerability, err error) {
+	for _, f := range files {
+		osvVulns, err := parseOSVFile(f.content)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %q", f.name)
+		}
+
+		for _, osvVuln := range osvVulns {
+			if osvVuln.ID == "" {
+				parser.logger.Warn(
+					"skipping OSV record without an id",
+					log.String("type", "dataWarning"),
+					log.String("file", f.name),
+				)
+				continue
+			}
+
+			convertedVuln, err := parser.osvToVuln(osvVuln, osvHandlerForID(osvVuln.ID))
+			if err != nil {
+				if _, ok := err.(GHSAUnreviewedError); ok {
+					continue
+				} else {
+					return nil, errors.Wrapf(err, "failed to convert %q", osvVuln.ID)
+				}
+			}
+
+			vulns = append(vulns, convertedVuln)
+		}
+	}
+
+	return vulns, nil
+}
+
+// readBundleFiles returns the JSON files of the bundle at the given location, along with the SHA-256 checksum
+// of the bundle. A bundle on the local filesystem may also be a directory of JSON files, which are read recursively.
+func readBundleFiles(ctx context.Context, location string) ([]bundleFile, string, error) {
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "invalid bundle location")
+	}
+
+	switch u.Scheme {
+	case "http", "https":
+		content, err := fetchBundle(ctx, httpcli.ExternalDoer, location)
+		if err != nil {
+			return nil, "", err
+		}
+
+		files, err := bundleFilesFromContent(path.Base(u.Path), content)
+		return files, checksumOf(content), err
+
+	case "blobstore":
+		// The built-in blobstore serves objects over its S3-compatible API without authentication
+		key := strings.TrimPrefix(u.Path, "/")
+		if u.Host == "" || key == "" {
+			return nil, "", errors.Newf("invalid blobstore location %q: expected blobstore://<bucket>/<key>", location)
+		}
+
+		content, err := fetchBundle(ctx, httpcli.InternalDoer, blobstoreEndpoint()+"/"+u.Host+"/"+key)
+		if err != nil {
+			return nil, "", err
+		}
+
+		files, err := bundleFilesFromContent(path.Base(key), content)
+		return files, checksumOf(content), err
+
+	case "file":
+		return readLocalBundleFiles(u.Path)
+
+	case "":
+		return readLocalBundleFiles(location)
+
+	default:
+		return nil, "", errors.Newf("unsupported bundle location scheme %q", u.Scheme)
+	}
+}
+
+// blobstoreEndpoint returns the endpoint of the instance's blobstore. It is replaced in tests.
+var blobstoreEndpoint = deploy.BlobstoreDefaultEndpoint
+
+func fetchBundle(ctx context.Context, doer httpcli.Doer, location string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Newf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+func readLocalBundleFiles(name string) ([]bundleFile, string, error) {
+	info, err := os.Stat(name)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if !info.IsDir() {
+		content, err := os.ReadFile(name)
+		if err != nil {
+			return nil, "", err
+		}
+
+		files, err := bundleFilesFromContent(filepath.Base(name), content)
+		return files, checksumOf(content), err
+	}
+
+	// The checksum of a directory covers the names and contents of its JSON files, which
+	// are walked in lexical order.
+	var files []bundleFile
+	hash := sha256.New()
+	if err := filepath.WalkDir(name, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || filepath.Ext(p) != ".json" {
+			return err
+		}
+
+		content, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+
+		relativePath, err := filepath.Rel(name, p)
+		if err != nil {
+			return err
+		}
+
+		hash.Write([]byte(relativePath))
+		hash.Write([]byte{0})
+		hash.Write(content)
+		files = append(files, bundleFile{name: relativePath, content: content})
+		return nil
+	}); err != nil {
+		return nil, "", err
+	}
+
+	return files, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+var zipSignature = []byte("PK\x03\x04")
+
+// bundleFilesFromContent returns the JSON files of the given zip archive, or the given content itself
+// if it is not a zip archive.
+func bundleFilesFromContent(name string, content []byte) ([]bundleFile, error) {
+	if !bytes.HasPrefix(content, zipSignature) {
+		return []bundleFile{{name: name, content: content}}, nil
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, err
+	}
+
+	var files []bundleFile
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() || filepath.Ext(f.Name) != ".json" {
+			continue
+		}
+
+		r, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		fileContent, err := io.ReadAll(r)
+		r.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, bundleFile{name: f.Name, content: fileContent})
+	}
+
+	return files, nil
+}
+
+// parseOSVFile returns the advisories of a JSON file containing one advisory or an array of advisories.
+func parseOSVFile(content []byte) ([]OSV, error) {
+	if trimmed := bytes.TrimSpace(content); len(trimmed) > 0 && trimmed[0] == '[' {
+		var osvVulns []OSV
+		if err := json.Unmarshal(trimmed, &osvVulns); err != nil {
+			return nil, err
+		}
+
+		return osvVulns, nil
+	}
+
+	var osvVuln OSV
+	if err := json.Unmarshal(content, &osvVuln); err != nil {
+		return nil, err
+	}
+
+	return []OSV{osvVuln}, nil
+}
+
+func checksumOf(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// osvHandlerForID returns the handler of the database publishing the advisory with the given ID, which
+// is identified by the prefix of the ID. Advisories of other databases are parsed as plain OSV.
+func osvHandlerForID(id string) DataSourceHandler {
+	switch {
+	case strings.HasPrefix(id, "GHSA-"):
+		return GHSA(0)
+	case strings.HasPrefix(id, "GO-"):
+		return Govulndb(0)
+	default:
+		return OSVDatabase(0)
+	}
+}
+
+//
+// Handlers for advisories without database-specific extensions
+//
+
+type OSVDatabase int64
+
+func (d OSVDatabase) topLevelHandler(o OSV, v *shared.Vulnerability) error {
+	v.DataSource = "https://osv.dev/vulnerability/" + o.ID
+
+	// Prefer the advisory of the publishing database when it is referenced
+	for _, reference := range o.References {
+		if reference.Type == "ADVISORY" {
+			v.DataSource = reference.URL
+			break
+		}
+	}
+
+	return nil
+}
+
+func (d OSVDatabase) affectedHandler(a OSVAffected, affectedPackage *shared.AffectedPackage) error {
+	if language := githubEcosystemToLanguage(a.Package.Ecosystem); language != "" {
+		affectedPackage.Language = language
+	}
+	affectedPackage.Namespace = "osv:" + a.Package.Ecosystem
+
+	return nil
+}
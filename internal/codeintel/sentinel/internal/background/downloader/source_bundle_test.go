@@ -0,0 +1,184 @@
+package downloader
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const testGHSAAdvisory = `{
+	"id": "GHSA-1234-5678-9abc",
+	"modified": "2023-06-01T00:00:00Z",
+	"published": "2023-05-01T00:00:00Z",
+	"summary": "Reviewed advisory",
+	"affected": [{"package": {"ecosystem": "npm", "name": "left-pad"}, "ranges": [{"type": "SEMVER", "events": [{"introduced": "0"}, {"fixed": "1.3.0"}]}]}],
+	"database_specific": {"severity": "HIGH", "github_reviewed": true, "github_reviewed_at": "2023-05-02T00:00:00Z"}
+}`
+
+const testUnreviewedGHSAAdvisory = `{
+	"id": "GHSA-ffff-ffff-ffff",
+	"modified": "2023-06-01T00:00:00Z",
+	"summary": "Unreviewed advisory",
+	"database_specific": {"github_reviewed": false}
+}`
+
+const testGoAdvisories = `[
+	{
+		"id": "GO-2023-0001",
+		"modified": "2023-06-01T00:00:00Z",
+		"summary": "Go advisory",
+		"affected": [{"package": {"ecosystem": "Go", "name": "example.com/mod"}, "ecosystem_specific": {"imports": [{"path": "example.com/mod/pkg", "symbols": ["F"]}]}}]
+	},
+	{
+		"id": "PYSEC-2023-0001",
+		"modified": "2023-06-01T00:00:00Z",
+		"summary": "Python advisory",
+		"affected": [{"package": {"ecosystem": "PyPI", "name": "requests"}, "versions": ["2.0.0", "2.0.1"]}],
+		"references": [{"type": "WEB", "url": "https://example.com"}, {"type": "ADVISORY", "url": "https://example.com/advisory"}]
+	}
+]`
+
+func TestParseOSVBundle(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range map[string]string{
+		"advisories/ghsa.json":       testGHSAAdvisory,
+		"advisories/unreviewed.json": testUnreviewedGHSAAdvisory,
+		"advisories/go.json":         testGoAdvisories,
+		"advisories/README.md":       "not an advisory",
+	} {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatalf("unexpected error creating zip entry: %s", err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatalf("unexpected error writing zip entry: %s", err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("unexpected error closing zip: %s", err)
+	}
+
+	expectedChecksum := checksumOf(buf.Bytes())
+	vulns, checksum, err := NewCVEParser().ParseOSVBundle(&buf)
+	if err != nil {
+		t.Fatalf("unexpected error parsing bundle: %s", err)
+	}
+	if checksum != expectedChecksum {
+		t.Errorf("unexpected checksum. want=%q have=%q", expectedChecksum, checksum)
+	}
+
+	type summary struct {
+		SourceID   string
+		DataSource string
+		Language   string
+		Namespace  string
+		Versions   []string
+	}
+	var summaries []summary
+	for _, v := range vulns {
+		for _, ap := range v.AffectedPackages {
+			summaries = append(summaries, summary{v.SourceID, v.DataSource, ap.Language, ap.Namespace, ap.VersionConstraint})
+		}
+	}
+	sort.Slice(summaries, func(i, j int) bool { return summaries[i].SourceID < summaries[j].SourceID })
+
+	expected := []summary{
+		{"GHSA-1234-5678-9abc", "https://github.com/advisories/GHSA-1234-5678-9abc", "Javascript", "github:npm", []string{">=0", "<1.3.0"}},
+		{"GO-2023-0001", "https://pkg.go.dev/vuln/GO-2023-0001", "Go", "govulndb", nil},
+		{"PYSEC-2023-0001", "https://example.com/advisory", "python", "osv:PyPI", []string{"=2.0.0"}},
+	}
+	if diff := cmp.Diff(expected, summaries); diff != "" {
+		t.Errorf("unexpected vulnerabilities (-want +got):\n%s", diff)
+	}
+}
+
+func TestReadOSVBundleDirectory(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "go"), os.ModePerm); err != nil {
+		t.Fatalf("unexpected error creating directory: %s", err)
+	}
+	for name, content := range map[string]string{
+		"ghsa.json":  testGHSAAdvisory,
+		"go/go.json": testGoAdvisories,
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("unexpected error writing advisory: %s", err)
+		}
+	}
+
+	parser := NewCVEParser()
+	vulns, checksum, err := parser.ReadOSVBundle(context.Background(), "file://"+dir)
+	if err != nil {
+		t.Fatalf("unexpected error reading bundle: %s", err)
+	}
+	if len(vulns) != 3 {
+		t.Errorf("unexpected number of vulnerabilities. want=%d have=%d", 3, len(vulns))
+	}
+
+	_, unchangedChecksum, err := parser.ReadOSVBundle(context.Background(), dir)
+	if err != nil {
+		t.Fatalf("unexpected error reading bundle: %s", err)
+	}
+	if unchangedChecksum != checksum {
+		t.Errorf("unexpected checksum change for an unchanged bundle")
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "ghsa.json"), []byte(testUnreviewedGHSAAdvisory), 0o644); err != nil {
+		t.Fatalf("unexpected error writing advisory: %s", err)
+	}
+	_, changedChecksum, err := parser.ReadOSVBundle(context.Background(), dir)
+	if err != nil {
+		t.Fatalf("unexpected error reading bundle: %s", err)
+	}
+	if changedChecksum == checksum {
+		t.Errorf("expected checksum to change for a changed bundle")
+	}
+}
+
+func TestReadOSVBundleBlobstore(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/advisories/osv/go.json" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(testGoAdvisories))
+	}))
+	defer server.Close()
+
+	previousEndpoint := blobstoreEndpoint
+	blobstoreEndpoint = func() string { return server.URL }
+	t.Cleanup(func() { blobstoreEndpoint = previousEndpoint })
+
+	parser := NewCVEParser()
+	vulns, checksum, err := parser.ReadOSVBundle(context.Background(), "blobstore://advisories/osv/go.json")
+	if err != nil {
+		t.Fatalf("unexpected error reading bundle: %s", err)
+	}
+	if len(vulns) != 2 {
+		t.Errorf("unexpected number of vulnerabilities. want=%d have=%d", 2, len(vulns))
+	}
+	if expectedChecksum := checksumOf([]byte(testGoAdvisories)); checksum != expectedChecksum {
+		t.Errorf("unexpected checksum. want=%q have=%q", expectedChecksum, checksum)
+	}
+
+	for _, location := range []string{"blobstore://advisories/missing.json", "blobstore://advisories", "blobstore:///osv.zip"} {
+		if _, _, err := parser.ReadOSVBundle(context.Background(), location); err == nil {
+			t.Errorf("expected error reading bundle at %q", location)
+		}
+	}
+}
+
+func TestReadOSVBundleUnsupportedScheme(t *testing.T) {
+	if _, _, err := NewCVEParser().ReadOSVBundle(context.Background(), "s3://bucket/osv.zip"); err == nil {
+		t.Fatalf("expected error reading bundle with an unsupported scheme")
+	}
+}
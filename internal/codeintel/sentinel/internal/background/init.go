@@ -5,6 +5,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/background/downloader"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/background/matcher"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/lsifstore"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/store"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
@@ -13,6 +14,7 @@ import (
 func CVEScannerJob(
 	observationCtx *observation.Context,
 	store store.Store,
+	lsifStore lsifstore.Store,
 	downloaderConfig *downloader.Config,
 	matcherConfig *matcher.Config,
 ) []goroutine.BackgroundRoutine {
@@ -22,6 +24,6 @@ func CVEScannerJob(
 
 	return []goroutine.BackgroundRoutine{
 		downloader.NewCVEDownloader(store, observationCtx, downloaderConfig),
-		matcher.NewCVEMatcher(store, observationCtx, matcherConfig),
+		matcher.NewCVEMatcher(store, lsifStore, observationCtx, matcherConfig),
 	}
 }
@@ -4,12 +4,13 @@ import (
 	"context"
 
 	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/lsifstore"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/store"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 )
 
-func NewCVEMatcher(store store.Store, observationCtx *observation.Context, config *Config) goroutine.BackgroundRoutine {
+func NewCVEMatcher(store store.Store, lsifStore lsifstore.Store, observationCtx *observation.Context, config *Config) goroutine.BackgroundRoutine {
 	metrics := newMetrics(observationCtx)
 
 	return goroutine.NewPeriodicGoroutine(
@@ -22,10 +23,19 @@ func NewCVEMatcher(store store.Store, observationCtx *observation.Context, confi
 
 			metrics.numReferencesScanned.Add(float64(numReferencesScanned))
 			metrics.numVulnerabilityMatches.Add(float64(numVulnerabilityMatches))
+
+			numMatchesByReachability, err := classifyMatches(ctx, store, lsifStore, config.BatchSize)
+			if err != nil {
+				return err
+			}
+
+			for reachability, numMatches := range numMatchesByReachability {
+				metrics.numVulnerabilityMatchesClassified.WithLabelValues(string(reachability)).Add(float64(numMatches))
+			}
 			return nil
 		}),
 		goroutine.WithName("codeintel.sentinel-cve-matcher"),
-		goroutine.WithDescription("Matches SCIP indexes against known vulnerabilities."),
+		goroutine.WithDescription("Matches SCIP indexes against known vulnerabilities and classifies the reachability of matches."),
 		goroutine.WithInterval(config.MatcherInterval),
 	)
 }
@@ -7,8 +7,9 @@ import (
 )
 
 type metrics struct {
-	numReferencesScanned    prometheus.Counter
-	numVulnerabilityMatches prometheus.Counter
+	numReferencesScanned              prometheus.Counter
+	numVulnerabilityMatches           prometheus.Counter
+	numVulnerabilityMatchesClassified *prometheus.CounterVec
 }
 
 func newMetrics(observationCtx *observation.Context) *metrics {
@@ -30,9 +31,15 @@ func newMetrics(observationCtx *observation.Context) *metrics {
 		"src_codeintel_sentinel_num_vulnerability_matches_total",
 		"The total number of vulnerability matches found.",
 	)
+	numVulnerabilityMatchesClassified := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "src_codeintel_sentinel_num_vulnerability_matches_classified_total",
+		Help: "The total number of vulnerability matches classified by reachability.",
+	}, []string{"reachability"})
+	observationCtx.Registerer.MustRegister(numVulnerabilityMatchesClassified)
 
 	return &metrics{
-		numReferencesScanned:    numReferencesScanned,
-		numVulnerabilityMatches: numVulnerabilityMatches,
+		numReferencesScanned:              numReferencesScanned,
+		numVulnerabilityMatches:           numVulnerabilityMatches,
+		numVulnerabilityMatchesClassified: numVulnerabilityMatchesClassified,
 	}
 }
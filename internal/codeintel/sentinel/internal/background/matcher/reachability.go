@@ -0,0 +1,136 @@
+package matcher
+
+import (
+	"context"
+	"strings"
+
+	"github.com/sourcegraph/scip/bindings/go/scip"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/store"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
+
+// classifyMatches determines the reachability of a batch of vulnerability matches that are yet to be
+// classified and records the result. The number of matches classified as each reachability is returned.
+func classifyMatches(ctx context.Context, store store.Store, lsifStore lsifstore.Store, batchSize int) (map[shared.Reachability]int, error) {
+	matches, err := store.GetUnclassifiedVulnerabilityMatches(ctx, batchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[shared.Reachability]int{}
+	reachabilityByMatchID := make(map[int]shared.Reachability, len(matches))
+	for _, match := range matches {
+		reachability, err := classifyReachability(ctx, lsifStore, match)
+		if err != nil {
+			return nil, err
+		}
+
+		counts[reachability]++
+		reachabilityByMatchID[match.ID] = reachability
+	}
+
+	if err := store.UpdateVulnerabilityMatchReachability(ctx, reachabilityByMatchID); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// classifyReachability determines whether the upload of the given match references one of the symbols
+// affected by the vulnerability. The reachability is unknown when the vulnerability does not list the
+// affected symbols, or when the upload has no precise SCIP data to search for references.
+func classifyReachability(ctx context.Context, lsifStore lsifstore.Store, match shared.UnclassifiedVulnerabilityMatch) (shared.Reachability, error) {
+	if len(match.AffectedSymbols) == 0 || len(match.Packages) == 0 {
+		return shared.ReachabilityUnknown, nil
+	}
+
+	hasSCIPData, err := lsifStore.HasSCIPData(ctx, match.UploadID)
+	if err != nil {
+		return "", err
+	}
+	if !hasSCIPData {
+		return shared.ReachabilityUnknown, nil
+	}
+
+	for _, pkg := range match.Packages {
+		// The trie only contains the external symbols that occur in the upload, so any
+		// symbol of the vulnerable package found here is referenced by the indexed code
+		symbolNames, err := lsifStore.GetSymbolNamesByPrefix(ctx, match.UploadID, symbolPrefix(pkg))
+		if err != nil {
+			return "", err
+		}
+
+		for _, symbolName := range symbolNames {
+			symbol, err := scip.ParseSymbol(symbolName)
+			if err != nil {
+				continue
+			}
+
+			if isAffectedSymbol(symbol.Descriptors, match.AffectedSymbols) {
+				return shared.ReachabilityReachable, nil
+			}
+		}
+	}
+
+	return shared.ReachabilityUnreachable, nil
+}
+
+// symbolPrefix returns the prefix shared by the names of all SCIP symbols defined in the given package.
+// See https://github.com/sourcegraph/scip/blob/main/scip.proto for the format of symbol names.
+func symbolPrefix(pkg precise.Package) string {
+	escape := func(s string) string {
+		if s == "" {
+			return "."
+		}
+
+		return strings.ReplaceAll(s, " ", "  ")
+	}
+
+	return strings.Join([]string{
+		escape(pkg.Scheme),
+		escape(pkg.Manager),
+		escape(pkg.Name),
+		escape(pkg.Version),
+	}, " ") + " "
+}
+
+// isAffectedSymbol returns true if the given SCIP descriptors name one of the given affected symbols. The
+// leading namespace descriptors form the import path (e.g., `golang.org/x/net/html`), and the remaining
+// descriptors form the symbol name as listed in OSV `ecosystem_specific.imports` data (e.g., `Parse` or
+// `Tokenizer.Next`). An affected import path without symbols affects all of its symbols.
+func isAffectedSymbol(descriptors []*scip.Descriptor, affectedSymbols []shared.AffectedSymbol) bool {
+	var namespaces, names []string
+	for _, descriptor := range descriptors {
+		if descriptor.Suffix == scip.Descriptor_Namespace && len(names) == 0 {
+			namespaces = append(namespaces, descriptor.Name)
+		} else {
+			names = append(names, descriptor.Name)
+		}
+	}
+	if len(names) == 0 {
+		return false
+	}
+
+	path := strings.Join(namespaces, "/")
+	name := strings.Join(names, ".")
+
+	for _, affectedSymbol := range affectedSymbols {
+		if affectedSymbol.Path != path {
+			continue
+		}
+		if len(affectedSymbol.Symbols) == 0 {
+			return true
+		}
+
+		for _, symbol := range affectedSymbol.Symbols {
+			if symbol == name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
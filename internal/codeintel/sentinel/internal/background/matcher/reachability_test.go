@@ -0,0 +1,142 @@
+package matcher
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
+
+func TestClassifyReachability(t *testing.T) {
+	pkg := precise.Package{Scheme: "scip-go", Manager: "gomod", Name: "golang.org/x/net", Version: "v0.7.0"}
+	affectedSymbols := []shared.AffectedSymbol{
+		{Path: "golang.org/x/net/html", Symbols: []string{"Parse", "Tokenizer.Next"}},
+	}
+
+	testCases := []struct {
+		name            string
+		hasSCIPData     bool
+		symbolNames     []string
+		affectedSymbols []shared.AffectedSymbol
+		packages        []precise.Package
+		expected        shared.Reachability
+	}{
+		{
+			name:            "function reference",
+			hasSCIPData:     true,
+			symbolNames:     []string{"scip-go gomod golang.org/x/net v0.7.0 `golang.org/x/net/html`/Parse()."},
+			affectedSymbols: affectedSymbols,
+			packages:        []precise.Package{pkg},
+			expected:        shared.ReachabilityReachable,
+		},
+		{
+			name:            "method reference",
+			hasSCIPData:     true,
+			symbolNames:     []string{"scip-go gomod golang.org/x/net v0.7.0 `golang.org/x/net/html`/Tokenizer#Next()."},
+			affectedSymbols: affectedSymbols,
+			packages:        []precise.Package{pkg},
+			expected:        shared.ReachabilityReachable,
+		},
+		{
+			name:        "entire package",
+			hasSCIPData: true,
+			symbolNames: []string{"scip-go gomod golang.org/x/net v0.7.0 `golang.org/x/net/html`/Render()."},
+			affectedSymbols: []shared.AffectedSymbol{
+				{Path: "golang.org/x/net/html"},
+			},
+			packages: []precise.Package{pkg},
+			expected: shared.ReachabilityReachable,
+		},
+		{
+			name:        "unaffected symbols",
+			hasSCIPData: true,
+			symbolNames: []string{
+				"scip-go gomod golang.org/x/net v0.7.0 `golang.org/x/net/html`/Render().",
+				"scip-go gomod golang.org/x/net v0.7.0 `golang.org/x/net/http2`/Parse().",
+			},
+			affectedSymbols: affectedSymbols,
+			packages:        []precise.Package{pkg},
+			expected:        shared.ReachabilityUnreachable,
+		},
+		{
+			name:            "no affected symbols",
+			hasSCIPData:     true,
+			symbolNames:     []string{"scip-go gomod golang.org/x/net v0.7.0 `golang.org/x/net/html`/Parse()."},
+			affectedSymbols: nil,
+			packages:        []precise.Package{pkg},
+			expected:        shared.ReachabilityUnknown,
+		},
+		{
+			name:            "no package references",
+			hasSCIPData:     true,
+			affectedSymbols: affectedSymbols,
+			expected:        shared.ReachabilityUnknown,
+		},
+		{
+			name:            "no SCIP data",
+			hasSCIPData:     false,
+			affectedSymbols: affectedSymbols,
+			packages:        []precise.Package{pkg},
+			expected:        shared.ReachabilityUnknown,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			lsifStore := &fakeLSIFStore{hasSCIPData: testCase.hasSCIPData, symbolNames: testCase.symbolNames}
+			match := shared.UnclassifiedVulnerabilityMatch{
+				ID:              1,
+				UploadID:        42,
+				AffectedSymbols: testCase.affectedSymbols,
+				Packages:        testCase.packages,
+			}
+
+			reachability, err := classifyReachability(context.Background(), lsifStore, match)
+			if err != nil {
+				t.Fatalf("unexpected error classifying reachability: %s", err)
+			}
+			if reachability != testCase.expected {
+				t.Errorf("unexpected reachability. want=%s have=%s", testCase.expected, reachability)
+			}
+		})
+	}
+}
+
+func TestSymbolPrefix(t *testing.T) {
+	for _, testCase := range []struct {
+		pkg      precise.Package
+		expected string
+	}{
+		{precise.Package{Scheme: "scip-go", Manager: "gomod", Name: "golang.org/x/net", Version: "v0.7.0"}, "scip-go gomod golang.org/x/net v0.7.0 "},
+		{precise.Package{Scheme: "scip-typescript", Manager: "npm", Name: "left pad", Version: ""}, "scip-typescript npm left  pad . "},
+	} {
+		if prefix := symbolPrefix(testCase.pkg); prefix != testCase.expected {
+			t.Errorf("unexpected prefix. want=%q have=%q", testCase.expected, prefix)
+		}
+	}
+}
+
+type fakeLSIFStore struct {
+	hasSCIPData bool
+	symbolNames []string
+}
+
+var _ lsifstore.Store = &fakeLSIFStore{}
+
+func (s *fakeLSIFStore) HasSCIPData(ctx context.Context, uploadID int) (bool, error) {
+	return s.hasSCIPData, nil
+}
+
+func (s *fakeLSIFStore) GetSymbolNamesByPrefix(ctx context.Context, uploadID int, prefix string) ([]string, error) {
+	var symbolNames []string
+	for _, symbolName := range s.symbolNames {
+		if strings.HasPrefix(symbolName, prefix) {
+			symbolNames = append(symbolNames, symbolName)
+		}
+	}
+
+	return symbolNames, nil
+}
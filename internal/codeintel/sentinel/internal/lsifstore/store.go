@@ -0,0 +1,27 @@
+package lsifstore
+
+import (
+	"context"
+
+	codeintelshared "github.com/sourcegraph/sourcegraph/internal/codeintel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+type Store interface {
+	// Symbols
+	HasSCIPData(ctx context.Context, uploadID int) (_ bool, err error)
+	GetSymbolNamesByPrefix(ctx context.Context, uploadID int, prefix string) (_ []string, err error)
+}
+
+type store struct {
+	db         *basestore.Store
+	operations *operations
+}
+
+func New(observationCtx *observation.Context, db codeintelshared.CodeIntelDB) Store {
+	return &store{
+		db:         basestore.NewWithHandle(db.Handle()),
+		operations: newOperations(observationCtx),
+	}
+}
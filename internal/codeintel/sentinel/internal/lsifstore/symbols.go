@@ -0,0 +1,108 @@
+package lsifstore
+
+import (
+	"context"
+	"strings"
+
+	"github.com/keegancsmith/sqlf"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func (s *store) HasSCIPData(ctx context.Context, uploadID int) (_ bool, err error) {
+	ctx, _, endObservation := s.operations.hasSCIPData.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("uploadID", uploadID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	exists, _, err := basestore.ScanFirstBool(s.db.Query(ctx, sqlf.Sprintf(hasSCIPDataQuery, uploadID)))
+	return exists, err
+}
+
+const hasSCIPDataQuery = `
+SELECT EXISTS (
+	SELECT 1
+	FROM codeintel_scip_metadata
+	WHERE upload_id = %s
+)
+`
+
+func (s *store) GetSymbolNamesByPrefix(ctx context.Context, uploadID int, prefix string) (_ []string, err error) {
+	ctx, _, endObservation := s.operations.getSymbolNamesByPrefix.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("uploadID", uploadID),
+		attribute.String("prefix", prefix),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	symbolNames, err := basestore.ScanStrings(s.db.Query(ctx, sqlf.Sprintf(
+		getSymbolNamesByPrefixQuery,
+		prefix,
+		uploadID,
+		prefix,
+		prefix,
+		uploadID,
+		uploadID,
+	)))
+	if err != nil {
+		return nil, err
+	}
+
+	// Trie segments are compared with LIKE patterns above, which may over-match
+	// prefixes containing wildcard characters
+	filtered := symbolNames[:0]
+	for _, symbolName := range symbolNames {
+		if strings.HasPrefix(symbolName, prefix) {
+			filtered = append(filtered, symbolName)
+		}
+	}
+
+	return filtered, nil
+}
+
+const getSymbolNamesByPrefixQuery = `
+WITH RECURSIVE
+-- Walk the trie of symbol names of the upload, starting at its roots. Unlike an exact
+-- search, we descend into all children once the search term has been consumed, as any
+-- such path names a symbol prefixed by the search term.
+matching_prefixes(id, prefix, search) AS (
+	(
+		SELECT
+			ssn.id,
+			ssn.name_segment,
+			substring(%s from length(ssn.name_segment) + 1) AS search
+		FROM codeintel_scip_symbol_names ssn
+		WHERE
+			ssn.upload_id = %s AND
+			ssn.prefix_id IS NULL AND
+			(%s LIKE ssn.name_segment || '%%' OR ssn.name_segment LIKE %s || '%%')
+	) UNION (
+		SELECT
+			ssn.id,
+			mp.prefix || ssn.name_segment,
+			substring(mp.search from length(ssn.name_segment) + 1) AS search
+		FROM matching_prefixes mp
+		JOIN codeintel_scip_symbol_names ssn ON
+			ssn.upload_id = %s AND
+			ssn.prefix_id = mp.id
+		WHERE
+			mp.search = '' OR
+			mp.search LIKE ssn.name_segment || '%%' OR
+			ssn.name_segment LIKE mp.search || '%%'
+	)
+)
+SELECT mp.prefix
+FROM matching_prefixes mp
+WHERE
+	mp.search = '' AND
+	-- Intermediate trie nodes do not name a symbol
+	EXISTS (
+		SELECT 1
+		FROM codeintel_scip_symbols ss
+		WHERE
+			ss.upload_id = %s AND
+			ss.symbol_id = mp.id
+	)
+ORDER BY mp.prefix
+`
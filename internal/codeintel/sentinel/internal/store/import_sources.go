@@ -0,0 +1,121 @@
+package store
+
+import (
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func (s *store) GetVulnerabilityImportSources(ctx context.Context) (_ []shared.VulnerabilityImportSource, err error) {
+	ctx, _, endObservation := s.operations.getVulnerabilityImportSources.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	return scanVulnerabilityImportSources(s.db.Query(ctx, sqlf.Sprintf(getVulnerabilityImportSourcesQuery)))
+}
+
+const getVulnerabilityImportSourcesQuery = `
+SELECT
+	` + vulnerabilityImportSourceFields + `
+FROM vulnerability_import_sources vis
+ORDER BY vis.name
+`
+
+func (s *store) VulnerabilityImportSourceByName(ctx context.Context, name string) (_ shared.VulnerabilityImportSource, _ bool, err error) {
+	ctx, _, endObservation := s.operations.vulnerabilityImportSourceByName.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("name", name),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return scanFirstVulnerabilityImportSource(s.db.Query(ctx, sqlf.Sprintf(vulnerabilityImportSourceByNameQuery, name)))
+}
+
+const vulnerabilityImportSourceByNameQuery = `
+SELECT
+	` + vulnerabilityImportSourceFields + `
+FROM vulnerability_import_sources vis
+WHERE vis.name = %s
+`
+
+const vulnerabilityImportSourceFields = `
+	vis.name,
+	vis.url,
+	vis.checksum,
+	vis.attempted_at,
+	vis.imported_at,
+	vis.num_vulnerabilities,
+	vis.num_inserted,
+	vis.num_updated,
+	vis.failure_message
+`
+
+func (s *store) UpdateVulnerabilityImportSource(ctx context.Context, source shared.VulnerabilityImportSource) (err error) {
+	ctx, _, endObservation := s.operations.updateVulnerabilityImportSource.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("name", source.Name),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return s.db.Exec(ctx, sqlf.Sprintf(
+		updateVulnerabilityImportSourceQuery,
+		source.Name,
+		source.URL,
+		dbutil.NewNullString(source.Checksum),
+		dbutil.NullTime{Time: source.AttemptedAt},
+		dbutil.NullTime{Time: source.ImportedAt},
+		source.NumVulnerabilities,
+		source.NumInserted,
+		source.NumUpdated,
+		source.FailureMessage,
+	))
+}
+
+const updateVulnerabilityImportSourceQuery = `
+INSERT INTO vulnerability_import_sources (
+	name,
+	url,
+	checksum,
+	attempted_at,
+	imported_at,
+	num_vulnerabilities,
+	num_inserted,
+	num_updated,
+	failure_message
+)
+VALUES (%s, %s, %s, %s, %s, %s, %s, %s, %s)
+ON CONFLICT (name) DO UPDATE SET
+	url = EXCLUDED.url,
+	checksum = EXCLUDED.checksum,
+	attempted_at = EXCLUDED.attempted_at,
+	imported_at = EXCLUDED.imported_at,
+	num_vulnerabilities = EXCLUDED.num_vulnerabilities,
+	num_inserted = EXCLUDED.num_inserted,
+	num_updated = EXCLUDED.num_updated,
+	failure_message = EXCLUDED.failure_message
+`
+
+//
+//
+
+func scanVulnerabilityImportSource(s dbutil.Scanner) (source shared.VulnerabilityImportSource, _ error) {
+	return source, s.Scan(
+		&source.Name,
+		&source.URL,
+		&dbutil.NullString{S: &source.Checksum},
+		&source.AttemptedAt,
+		&source.ImportedAt,
+		&source.NumVulnerabilities,
+		&source.NumInserted,
+		&source.NumUpdated,
+		&source.FailureMessage,
+	)
+}
+
+var (
+	scanVulnerabilityImportSources     = basestore.NewSliceScanner(scanVulnerabilityImportSource)
+	scanFirstVulnerabilityImportSource = basestore.NewFirstScanner(scanVulnerabilityImportSource)
+)
@@ -0,0 +1,65 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestVulnerabilityImportSources(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	if _, ok, err := store.VulnerabilityImportSourceByName(ctx, "mirror"); err != nil {
+		t.Fatalf("unexpected error getting import source: %s", err)
+	} else if ok {
+		t.Fatalf("unexpected import source")
+	}
+
+	now := time.Unix(1686000000, 0).UTC()
+	failureMessage := "unexpected status code 404"
+	sources := []shared.VulnerabilityImportSource{
+		{Name: "uploaded", URL: "file:///mnt/osv.zip", AttemptedAt: &now, FailureMessage: &failureMessage},
+		{Name: "mirror", URL: "https://mirror.example.com/osv.zip", Checksum: "deadbeef", AttemptedAt: &now, ImportedAt: &now, NumVulnerabilities: 3, NumInserted: 2, NumUpdated: 1},
+	}
+	for _, source := range sources {
+		if err := store.UpdateVulnerabilityImportSource(ctx, source); err != nil {
+			t.Fatalf("unexpected error updating import source: %s", err)
+		}
+	}
+
+	// Update the failed source
+	sources[0].FailureMessage = nil
+	sources[0].Checksum = "cafebabe"
+	if err := store.UpdateVulnerabilityImportSource(ctx, sources[0]); err != nil {
+		t.Fatalf("unexpected error updating import source: %s", err)
+	}
+
+	source, ok, err := store.VulnerabilityImportSourceByName(ctx, "uploaded")
+	if err != nil {
+		t.Fatalf("unexpected error getting import source: %s", err)
+	} else if !ok {
+		t.Fatalf("expected import source to exist")
+	}
+	if diff := cmp.Diff(sources[0], source); diff != "" {
+		t.Errorf("unexpected import source (-want +got):\n%s", diff)
+	}
+
+	allSources, err := store.GetVulnerabilityImportSources(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error getting import sources: %s", err)
+	}
+	if diff := cmp.Diff([]shared.VulnerabilityImportSource{sources[1], sources[0]}, allSources); diff != "" {
+		t.Errorf("unexpected import sources (-want +got):\n%s", diff)
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database/batch"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
 )
 
 func (s *store) VulnerabilityMatchByID(ctx context.Context, id int) (_ shared.VulnerabilityMatch, _ bool, err error) {
@@ -45,6 +46,7 @@ SELECT
 	vas.path,
 	vas.symbols,
 	vul.severity,
+	m.reachability,
 	0 AS count
 FROM vulnerability_matches m
 LEFT JOIN vulnerability_affected_packages vap ON vap.id = m.vulnerability_affected_package_id
@@ -60,6 +62,7 @@ func (s *store) GetVulnerabilityMatches(ctx context.Context, args shared.GetVuln
 		attribute.String("severity", args.Severity),
 		attribute.String("language", args.Language),
 		attribute.String("repositoryName", args.RepositoryName),
+		attribute.String("reachability", string(args.Reachability)),
 	}})
 	defer endObservation(1, observation.Args{})
 
@@ -73,6 +76,9 @@ func (s *store) GetVulnerabilityMatches(ctx context.Context, args shared.GetVuln
 	if args.RepositoryName != "" {
 		conds = append(conds, sqlf.Sprintf("r.name = %s", args.RepositoryName))
 	}
+	if args.Reachability != "" {
+		conds = append(conds, makeReachabilityCondition("m", args.Reachability))
+	}
 	if len(conds) == 0 {
 		conds = append(conds, sqlf.Sprintf("TRUE"))
 	}
@@ -85,7 +91,8 @@ WITH limited_matches AS (
 	SELECT
 		m.id,
 		m.upload_id,
-		m.vulnerability_affected_package_id
+		m.vulnerability_affected_package_id,
+		m.reachability
 	FROM vulnerability_matches m
 	ORDER BY id
 )
@@ -102,6 +109,7 @@ SELECT
 	vas.path,
 	vas.symbols,
 	vul.severity,
+	m.reachability,
 	COUNT(*) OVER() AS count
 FROM limited_matches m
 LEFT JOIN vulnerability_affected_packages vap ON vap.id = m.vulnerability_affected_package_id
@@ -125,6 +133,9 @@ func (s *store) GetVulnerabilityMatchesSummaryCount(ctx context.Context) (counts
 		&counts.Low,
 		&counts.Critical,
 		&counts.Repositories,
+		&counts.Reachable,
+		&counts.Unreachable,
+		&counts.Unknown,
 	)
 	if err != nil {
 		return shared.GetVulnerabilityMatchesSummaryCounts{}, err
@@ -138,7 +149,8 @@ const getVulnerabilityMatchesSummaryCounts = `
 	SELECT
 		m.id,
 		m.upload_id,
-		m.vulnerability_affected_package_id
+		m.vulnerability_affected_package_id,
+		m.reachability
 	FROM vulnerability_matches m
 	ORDER BY id
 )
@@ -147,7 +159,10 @@ SELECT
   sum(case when vul.severity = 'MEDIUM' then 1 else 0 end) as medium,
   sum(case when vul.severity = 'LOW' then 1 else 0 end) as low,
   sum(case when vul.severity = 'CRITICAL' then 1 else 0 end) as critical,
-  count(distinct r.name) as repositories
+  count(distinct r.name) as repositories,
+  sum(case when m.reachability = 'reachable' then 1 else 0 end) as reachable,
+  sum(case when m.reachability = 'unreachable' then 1 else 0 end) as unreachable,
+  sum(case when m.reachability IS NULL OR m.reachability = 'unknown' then 1 else 0 end) as unknown
 FROM limited_matches m
 LEFT JOIN vulnerability_affected_packages vap ON vap.id = m.vulnerability_affected_package_id
 LEFT JOIN vulnerability_affected_symbols vas ON vas.vulnerability_affected_package_id = vap.id
@@ -161,6 +176,7 @@ func (s *store) GetVulnerabilityMatchesCountByRepository(ctx context.Context, ar
 		attribute.Int("limit", args.Limit),
 		attribute.Int("offset", args.Offset),
 		attribute.String("repositoryName", args.RepositoryName),
+		attribute.String("reachability", string(args.Reachability)),
 	}})
 	defer endObservation(1, observation.Args{})
 
@@ -168,6 +184,9 @@ func (s *store) GetVulnerabilityMatchesCountByRepository(ctx context.Context, ar
 	if args.RepositoryName != "" {
 		conds = append(conds, sqlf.Sprintf("r.name ILIKE %s", "%"+args.RepositoryName+"%"))
 	}
+	if args.Reachability != "" {
+		conds = append(conds, makeReachabilityCondition("vm", args.Reachability))
+	}
 	if len(conds) == 0 {
 		conds = append(conds, sqlf.Sprintf("TRUE"))
 	}
@@ -355,13 +374,158 @@ SELECT COUNT(*) FROM ins
 //
 //
 
+func (s *store) GetUnclassifiedVulnerabilityMatches(ctx context.Context, batchSize int) (_ []shared.UnclassifiedVulnerabilityMatch, err error) {
+	ctx, _, endObservation := s.operations.getUnclassifiedVulnerabilityMatches.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchSize", batchSize),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	rows, err := s.db.Query(ctx, sqlf.Sprintf(
+		getUnclassifiedVulnerabilityMatchesQuery,
+		batchSize,
+		sqlf.Join(makeSchemeTtoVulnerabilityLanguageMappingConditions(), " OR "),
+	))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var (
+		matches        []shared.UnclassifiedVulnerabilityMatch
+		seenSymbols    map[string]struct{}
+		seenReferences map[precise.Package]struct{}
+	)
+	for rows.Next() {
+		var (
+			id, uploadID       int
+			versionConstraints []string
+			symbol             shared.AffectedSymbol
+			pkg                precise.Package
+		)
+		if err := rows.Scan(
+			&id,
+			&uploadID,
+			pq.Array(&versionConstraints),
+			&dbutil.NullString{S: &symbol.Path},
+			pq.Array(&symbol.Symbols),
+			&dbutil.NullString{S: &pkg.Scheme},
+			&dbutil.NullString{S: &pkg.Manager},
+			&dbutil.NullString{S: &pkg.Name},
+			&dbutil.NullString{S: &pkg.Version},
+		); err != nil {
+			return nil, err
+		}
+
+		if len(matches) == 0 || matches[len(matches)-1].ID != id {
+			matches = append(matches, shared.UnclassifiedVulnerabilityMatch{ID: id, UploadID: uploadID})
+			seenSymbols = map[string]struct{}{}
+			seenReferences = map[precise.Package]struct{}{}
+		}
+		match := &matches[len(matches)-1]
+
+		// Rows are a product of the affected symbols and the package references of the upload
+		if symbol.Path != "" {
+			key := symbol.Path + "\x00" + strings.Join(symbol.Symbols, "\x00")
+			if _, ok := seenSymbols[key]; !ok {
+				seenSymbols[key] = struct{}{}
+				match.AffectedSymbols = append(match.AffectedSymbols, symbol)
+			}
+		}
+		if pkg.Name != "" {
+			if _, ok := seenReferences[pkg]; !ok {
+				seenReferences[pkg] = struct{}{}
+
+				// Only consider the references that made the upload vulnerable in the first place
+				if ok, _ := versionMatchesConstraints(pkg.Version, versionConstraints); ok {
+					match.Packages = append(match.Packages, pkg)
+				}
+			}
+		}
+	}
+
+	return matches, nil
+}
+
+const getUnclassifiedVulnerabilityMatchesQuery = `
+WITH candidates AS (
+	SELECT
+		m.id,
+		m.upload_id,
+		m.vulnerability_affected_package_id
+	FROM vulnerability_matches m
+	WHERE m.reachability IS NULL
+	ORDER BY m.id
+	LIMIT %s
+)
+SELECT
+	m.id,
+	m.upload_id,
+	vap.version_constraint,
+	vas.path,
+	vas.symbols,
+	r.scheme,
+	r.manager,
+	r.name,
+	r.version
+FROM candidates m
+JOIN vulnerability_affected_packages vap ON vap.id = m.vulnerability_affected_package_id
+LEFT JOIN vulnerability_affected_symbols vas ON vas.vulnerability_affected_package_id = vap.id
+LEFT JOIN lsif_references r ON
+	r.dump_id = m.upload_id AND
+	-- NOTE: This mirrors the package name condition of scanMatchesQuery
+	r.name LIKE '%%' || vap.package_name || '%%' AND
+	(%s)
+ORDER BY m.id, vas.id, r.id
+`
+
+func (s *store) UpdateVulnerabilityMatchReachability(ctx context.Context, reachabilityByMatchID map[int]shared.Reachability) (err error) {
+	ctx, _, endObservation := s.operations.updateVulnerabilityMatchReachability.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("numMatches", len(reachabilityByMatchID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if len(reachabilityByMatchID) == 0 {
+		return nil
+	}
+
+	ids := make([]int, 0, len(reachabilityByMatchID))
+	reachabilities := make([]string, 0, len(reachabilityByMatchID))
+	for id, reachability := range reachabilityByMatchID {
+		ids = append(ids, id)
+		reachabilities = append(reachabilities, string(reachability))
+	}
+
+	return s.db.Exec(ctx, sqlf.Sprintf(updateVulnerabilityMatchReachabilityQuery, pq.Array(ids), pq.Array(reachabilities)))
+}
+
+const updateVulnerabilityMatchReachabilityQuery = `
+UPDATE vulnerability_matches m
+SET reachability = u.reachability
+FROM (
+	SELECT
+		unnest(%s::integer[]) AS id,
+		unnest(%s::text[]) AS reachability
+) u
+WHERE m.id = u.id
+`
+
+// makeReachabilityCondition filters the matches aliased by the given name by their reachability. Matches that
+// are yet to be classified are considered to be of unknown reachability.
+func makeReachabilityCondition(alias string, reachability shared.Reachability) *sqlf.Query {
+	return sqlf.Sprintf("COALESCE("+alias+".reachability, %s) = %s", string(shared.ReachabilityUnknown), string(reachability))
+}
+
+//
+//
+
 var scanVulnerabilityMatchesAndCount = func(rows basestore.Rows, queryErr error) ([]shared.VulnerabilityMatch, int, error) {
 	matches, totalCount, err := basestore.NewSliceWithCountScanner(func(s dbutil.Scanner) (match shared.VulnerabilityMatch, count int, _ error) {
 		var (
-			vap     shared.AffectedPackage
-			vas     shared.AffectedSymbol
-			vul     shared.Vulnerability
-			fixedIn string
+			vap          shared.AffectedPackage
+			vas          shared.AffectedSymbol
+			vul          shared.Vulnerability
+			fixedIn      string
+			reachability string
 		)
 
 		if err := s.Scan(
@@ -376,8 +540,9 @@ var scanVulnerabilityMatchesAndCount = func(rows basestore.Rows, queryErr error)
 			&dbutil.NullBool{B: &vap.Fixed},
 			&dbutil.NullString{S: &fixedIn},
 			&dbutil.NullString{S: &vas.Path},
-			pq.Array(vas.Symbols),
+			pq.Array(&vas.Symbols),
 			&dbutil.NullString{S: &vul.Severity},
+			&dbutil.NullString{S: &reachability},
 			&count,
 		); err != nil {
 			return shared.VulnerabilityMatch{}, 0, err
@@ -392,6 +557,10 @@ var scanVulnerabilityMatchesAndCount = func(rows basestore.Rows, queryErr error)
 		if vap.PackageName != "" {
 			match.AffectedPackage = vap
 		}
+		match.Reachability = shared.ReachabilityUnknown
+		if reachability != "" {
+			match.Reachability = shared.Reachability(reachability)
+		}
 
 		return match, count, nil
 	})(rows, queryErr)
@@ -438,7 +607,10 @@ func versionMatchesConstraints(versionString string, constraints []string) (matc
 
 var scipSchemeToVulnerabilityLanguage = map[string]string{
 	"gomod": "go",
-	"npm":   "Javascript",
+	"npm":   "javascript",
+	// SCIP uploads record the scheme of the indexer that produced them
+	"scip-go":         "go",
+	"scip-typescript": "javascript",
 	// TODO - java mapping
 }
 
@@ -451,7 +623,8 @@ func makeSchemeTtoVulnerabilityLanguageMappingConditions() []*sqlf.Query {
 
 	mappings := make([]*sqlf.Query, 0, len(schemes))
 	for _, scheme := range schemes {
-		mappings = append(mappings, sqlf.Sprintf("(r.scheme = %s AND vap.language = %s)", scheme, scipSchemeToVulnerabilityLanguage[scheme]))
+		// Languages are compared case-insensitively, as advisory databases disagree on their casing (e.g., `Go` and `go`)
+		mappings = append(mappings, sqlf.Sprintf("(r.scheme = %s AND lower(vap.language) = %s)", scheme, scipSchemeToVulnerabilityLanguage[scheme]))
 	}
 
 	return mappings
@@ -49,6 +49,7 @@ func TestVulnerabilityMatchByID(t *testing.T) {
 		UploadID:        52,
 		VulnerabilityID: 1,
 		AffectedPackage: badConfig,
+		Reachability:    shared.ReachabilityUnknown,
 	}
 	if diff := cmp.Diff(expectedMatch, match); diff != "" {
 		t.Errorf("unexpected vulnerability match (-want +got):\n%s", diff)
@@ -382,6 +383,7 @@ func TestGetVulnerabilityMatchesSummaryCount(t *testing.T) {
 		Medium:       medium,
 		Low:          low,
 		Repositories: totalRepos,
+		Unknown:      critical + high + medium + low,
 	}
 
 	if diff := cmp.Diff(expectedSummaryCount, summaryCount); diff != "" {
@@ -389,6 +391,90 @@ func TestGetVulnerabilityMatchesSummaryCount(t *testing.T) {
 	}
 }
 
+func TestClassifyVulnerabilityMatches(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	setupReferences(t, db)
+
+	affectedPackage := shared.AffectedPackage{
+		Language:          "go",
+		PackageName:       "go-nacelle/config",
+		VersionConstraint: []string{"<= v1.2.5"},
+		AffectedSymbols: []shared.AffectedSymbol{
+			{Path: "github.com/go-nacelle/config", Symbols: []string{"Load", "Config.Load"}},
+		},
+	}
+	if _, err := store.InsertVulnerabilities(ctx, []shared.Vulnerability{
+		{ID: 1, SourceID: "CVE-ABC", Severity: "HIGH", AffectedPackages: []shared.AffectedPackage{affectedPackage}},
+	}); err != nil {
+		t.Fatalf("unexpected error inserting vulnerabilities: %s", err)
+	}
+
+	if _, _, err := store.ScanMatches(ctx, 100); err != nil {
+		t.Fatalf("unexpected error scanning vulnerability matches: %s", err)
+	}
+
+	unclassified, err := store.GetUnclassifiedVulnerabilityMatches(ctx, 100)
+	if err != nil {
+		t.Fatalf("unexpected error getting unclassified vulnerability matches: %s", err)
+	}
+
+	var uploadIDs []int
+	for _, match := range unclassified {
+		uploadIDs = append(uploadIDs, match.UploadID)
+
+		if diff := cmp.Diff(affectedPackage.AffectedSymbols, match.AffectedSymbols); diff != "" {
+			t.Errorf("unexpected affected symbols (-want +got):\n%s", diff)
+		}
+		if len(match.Packages) != 1 || match.Packages[0].Name != "github.com/go-nacelle/config" {
+			t.Errorf("unexpected packages: %v", match.Packages)
+		}
+	}
+	if diff := cmp.Diff([]int{50, 51, 52}, uploadIDs); diff != "" {
+		t.Fatalf("unexpected upload ids (-want +got):\n%s", diff)
+	}
+
+	if err := store.UpdateVulnerabilityMatchReachability(ctx, map[int]shared.Reachability{
+		unclassified[0].ID: shared.ReachabilityReachable,
+		unclassified[1].ID: shared.ReachabilityUnreachable,
+	}); err != nil {
+		t.Fatalf("unexpected error updating vulnerability match reachability: %s", err)
+	}
+
+	remaining, err := store.GetUnclassifiedVulnerabilityMatches(ctx, 100)
+	if err != nil {
+		t.Fatalf("unexpected error getting unclassified vulnerability matches: %s", err)
+	}
+	if len(remaining) != 1 || remaining[0].ID != unclassified[2].ID {
+		t.Errorf("unexpected unclassified vulnerability matches: %v", remaining)
+	}
+
+	for reachability, expectedCount := range map[shared.Reachability]int{
+		shared.ReachabilityReachable:   1,
+		shared.ReachabilityUnreachable: 1,
+		shared.ReachabilityUnknown:     1, // not yet classified
+	} {
+		matches, _, err := store.GetVulnerabilityMatches(ctx, shared.GetVulnerabilityMatchesArgs{Limit: 10, Reachability: reachability})
+		if err != nil {
+			t.Fatalf("unexpected error getting vulnerability matches: %s", err)
+		}
+		if len(matches) != expectedCount {
+			t.Errorf("unexpected number of %s matches. want=%d have=%d", reachability, expectedCount, len(matches))
+		}
+	}
+
+	summaryCount, err := store.GetVulnerabilityMatchesSummaryCount(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error getting vulnerability matches summary counts: %s", err)
+	}
+	if summaryCount.Reachable != 1 || summaryCount.Unreachable != 1 || summaryCount.Unknown != 1 {
+		t.Errorf("unexpected reachability counts. want=(1, 1, 1) have=(%d, %d, %d)", summaryCount.Reachable, summaryCount.Unreachable, summaryCount.Unknown)
+	}
+}
+
 func setupReferences(t *testing.T, db database.DB) {
 	store := basestore.NewWithHandle(db.Handle())
 
@@ -12,11 +12,17 @@ type operations struct {
 	getVulnerabilitiesByIDs                  *observation.Operation
 	getVulnerabilities                       *observation.Operation
 	insertVulnerabilities                    *observation.Operation
+	upsertVulnerabilities                    *observation.Operation
+	getVulnerabilityImportSources            *observation.Operation
+	vulnerabilityImportSourceByName          *observation.Operation
+	updateVulnerabilityImportSource          *observation.Operation
 	vulnerabilityMatchByID                   *observation.Operation
 	getVulnerabilityMatches                  *observation.Operation
 	getVulnerabilityMatchesSummaryCount      *observation.Operation
 	getVulnerabilityMatchesCountByRepository *observation.Operation
 	scanMatches                              *observation.Operation
+	getUnclassifiedVulnerabilityMatches      *observation.Operation
+	updateVulnerabilityMatchReachability     *observation.Operation
 }
 
 var m = new(metrics.SingletonREDMetrics)
@@ -44,10 +50,16 @@ func newOperations(observationCtx *observation.Context) *operations {
 		getVulnerabilitiesByIDs:                  op("GetVulnerabilitiesByIDs"),
 		getVulnerabilities:                       op("GetVulnerabilities"),
 		insertVulnerabilities:                    op("InsertVulnerabilities"),
+		upsertVulnerabilities:                    op("UpsertVulnerabilities"),
+		getVulnerabilityImportSources:            op("GetVulnerabilityImportSources"),
+		vulnerabilityImportSourceByName:          op("VulnerabilityImportSourceByName"),
+		updateVulnerabilityImportSource:          op("UpdateVulnerabilityImportSource"),
 		vulnerabilityMatchByID:                   op("VulnerabilityMatchByID"),
 		getVulnerabilityMatches:                  op("GetVulnerabilityMatches"),
 		getVulnerabilityMatchesSummaryCount:      op("GetVulnerabilityMatchesSummaryCount"),
 		getVulnerabilityMatchesCountByRepository: op("GetVulnerabilityMatchesCountByRepository"),
 		scanMatches:                              op("ScanMatches"),
+		getUnclassifiedVulnerabilityMatches:      op("GetUnclassifiedVulnerabilityMatches"),
+		updateVulnerabilityMatchReachability:     op("UpdateVulnerabilityMatchReachability"),
 	}
 }
@@ -17,6 +17,12 @@ type Store interface {
 	GetVulnerabilitiesByIDs(ctx context.Context, ids ...int) (_ []shared.Vulnerability, err error)
 	GetVulnerabilities(ctx context.Context, args shared.GetVulnerabilitiesArgs) (_ []shared.Vulnerability, _ int, err error)
 	InsertVulnerabilities(ctx context.Context, vulnerabilities []shared.Vulnerability) (_ int, err error)
+	UpsertVulnerabilities(ctx context.Context, importSource string, vulnerabilities []shared.Vulnerability) (numInserted, numUpdated int, err error)
+
+	// Vulnerability import sources
+	GetVulnerabilityImportSources(ctx context.Context) (_ []shared.VulnerabilityImportSource, err error)
+	VulnerabilityImportSourceByName(ctx context.Context, name string) (_ shared.VulnerabilityImportSource, _ bool, err error)
+	UpdateVulnerabilityImportSource(ctx context.Context, source shared.VulnerabilityImportSource) (err error)
 
 	// Vulnerability matches
 	VulnerabilityMatchByID(ctx context.Context, id int) (shared.VulnerabilityMatch, bool, error)
@@ -24,6 +30,8 @@ type Store interface {
 	GetVulnerabilityMatchesSummaryCount(ctx context.Context) (counts shared.GetVulnerabilityMatchesSummaryCounts, err error)
 	GetVulnerabilityMatchesCountByRepository(ctx context.Context, args shared.GetVulnerabilityMatchesCountByRepositoryArgs) (_ []shared.VulnerabilityMatchesByRepository, _ int, err error)
 	ScanMatches(ctx context.Context, batchSize int) (numReferencesScanned int, numVulnerabilityMatches int, _ error)
+	GetUnclassifiedVulnerabilityMatches(ctx context.Context, batchSize int) (_ []shared.UnclassifiedVulnerabilityMatch, err error)
+	UpdateVulnerabilityMatchReachability(ctx context.Context, reachabilityByMatchID map[int]shared.Reachability) (err error)
 }
 
 type store struct {
@@ -58,7 +58,8 @@ const vulnerabilityFields = `
 	v.cvss_score,
 	v.published_at,
 	v.modified_at,
-	v.withdrawn_at
+	v.withdrawn_at,
+	v.import_source
 `
 
 const vulnerabilityAffectedPackageFields = `
@@ -139,105 +140,7 @@ func (s *store) InsertVulnerabilities(ctx context.Context, vulnerabilities []sha
 
 	var a int
 	err = s.db.WithTransact(ctx, func(tx *basestore.Store) error {
-		if err := tx.Exec(ctx, sqlf.Sprintf(insertVulnerabilitiesTemporaryVulnerabilitiesTableQuery)); err != nil {
-			return err
-		}
-		if err := tx.Exec(ctx, sqlf.Sprintf(insertVulnerabilitiesTemporaryVulnerabilityAffectedPackagesTableQuery)); err != nil {
-			return err
-		}
-
-		if err := batch.WithInserter(
-			ctx,
-			tx.Handle(),
-			"t_vulnerabilities",
-			batch.MaxNumPostgresParameters,
-			[]string{
-				"source_id",
-				"summary",
-				"details",
-				"cpes",
-				"cwes",
-				"aliases",
-				"related",
-				"data_source",
-				"urls",
-				"severity",
-				"cvss_vector",
-				"cvss_score",
-				"published_at",
-				"modified_at",
-				"withdrawn_at",
-			},
-			func(inserter *batch.Inserter) error {
-				for _, v := range vulnerabilities {
-					if err := inserter.Insert(
-						ctx,
-						v.SourceID,
-						v.Summary,
-						v.Details,
-						v.CPEs,
-						v.CWEs,
-						v.Aliases,
-						v.Related,
-						v.DataSource,
-						v.URLs,
-						v.Severity,
-						v.CVSSVector,
-						v.CVSSScore,
-						v.PublishedAt,
-						dbutil.NullTime{Time: v.ModifiedAt},
-						dbutil.NullTime{Time: v.WithdrawnAt},
-					); err != nil {
-						return err
-					}
-				}
-
-				return nil
-			}); err != nil {
-			return err
-		}
-
-		if err := batch.WithInserter(
-			ctx,
-			tx.Handle(),
-			"t_vulnerability_affected_packages",
-			batch.MaxNumPostgresParameters,
-			[]string{
-				"source_id",
-				"package_name",
-				"language",
-				"namespace",
-				"version_constraint",
-				"fixed",
-				"fixed_in",
-				"affected_symbols",
-			},
-			func(inserter *batch.Inserter) error {
-				for _, v := range vulnerabilities {
-					for _, ap := range v.AffectedPackages {
-						serialized, err := json.Marshal(ap.AffectedSymbols)
-						if err != nil {
-							return err
-						}
-
-						if err := inserter.Insert(
-							ctx,
-							v.SourceID,
-							ap.PackageName,
-							ap.Language,
-							ap.Namespace,
-							ap.VersionConstraint,
-							ap.Fixed,
-							ap.FixedIn,
-							serialized,
-						); err != nil {
-							return err
-						}
-					}
-				}
-
-				return nil
-			}); err != nil {
+		if err := insertTemporaryVulnerabilities(ctx, tx, vulnerabilities); err != nil {
 			return err
 		}
 
@@ -259,6 +162,114 @@ func (s *store) InsertVulnerabilities(ctx context.Context, vulnerabilities []sha
 	return a, err
 }
 
+// insertTemporaryVulnerabilities creates the temporary tables t_vulnerabilities and t_vulnerability_affected_packages,
+// which are dropped at the end of the given transaction, and fills them with the given vulnerabilities.
+func insertTemporaryVulnerabilities(ctx context.Context, tx *basestore.Store, vulnerabilities []shared.Vulnerability) error {
+	if err := tx.Exec(ctx, sqlf.Sprintf(insertVulnerabilitiesTemporaryVulnerabilitiesTableQuery)); err != nil {
+		return err
+	}
+	if err := tx.Exec(ctx, sqlf.Sprintf(insertVulnerabilitiesTemporaryVulnerabilityAffectedPackagesTableQuery)); err != nil {
+		return err
+	}
+
+	if err := batch.WithInserter(
+		ctx,
+		tx.Handle(),
+		"t_vulnerabilities",
+		batch.MaxNumPostgresParameters,
+		[]string{
+			"source_id",
+			"summary",
+			"details",
+			"cpes",
+			"cwes",
+			"aliases",
+			"related",
+			"data_source",
+			"urls",
+			"severity",
+			"cvss_vector",
+			"cvss_score",
+			"published_at",
+			"modified_at",
+			"withdrawn_at",
+		},
+		func(inserter *batch.Inserter) error {
+			for _, v := range vulnerabilities {
+				if err := inserter.Insert(
+					ctx,
+					v.SourceID,
+					v.Summary,
+					v.Details,
+					v.CPEs,
+					v.CWEs,
+					v.Aliases,
+					v.Related,
+					v.DataSource,
+					v.URLs,
+					v.Severity,
+					v.CVSSVector,
+					v.CVSSScore,
+					v.PublishedAt,
+					dbutil.NullTime{Time: v.ModifiedAt},
+					dbutil.NullTime{Time: v.WithdrawnAt},
+				); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}); err != nil {
+		return err
+	}
+
+	if err := batch.WithInserter(
+		ctx,
+		tx.Handle(),
+		"t_vulnerability_affected_packages",
+		batch.MaxNumPostgresParameters,
+		[]string{
+			"source_id",
+			"package_name",
+			"language",
+			"namespace",
+			"version_constraint",
+			"fixed",
+			"fixed_in",
+			"affected_symbols",
+		},
+		func(inserter *batch.Inserter) error {
+			for _, v := range vulnerabilities {
+				for _, ap := range v.AffectedPackages {
+					serialized, err := json.Marshal(ap.AffectedSymbols)
+					if err != nil {
+						return err
+					}
+
+					if err := inserter.Insert(
+						ctx,
+						v.SourceID,
+						ap.PackageName,
+						ap.Language,
+						ap.Namespace,
+						ap.VersionConstraint,
+						ap.Fixed,
+						ap.FixedIn,
+						serialized,
+					); err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 const insertVulnerabilitiesTemporaryVulnerabilitiesTableQuery = `
 CREATE TEMPORARY TABLE t_vulnerabilities (
 	source_id     TEXT NOT NULL,
@@ -383,6 +394,195 @@ SELECT c.id, c.path, c.symbols FROM candidates c
 ON CONFLICT DO NOTHING
 `
 
+func (s *store) UpsertVulnerabilities(ctx context.Context, importSource string, vulnerabilities []shared.Vulnerability) (numInserted, numUpdated int, err error) {
+	ctx, _, endObservation := s.operations.upsertVulnerabilities.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("importSource", importSource),
+		attribute.Int("numVulnerabilities", len(vulnerabilities)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	vulnerabilities = canonicalizeVulnerabilities(deduplicateVulnerabilities(vulnerabilities))
+
+	err = s.db.WithTransact(ctx, func(tx *basestore.Store) error {
+		if err := insertTemporaryVulnerabilities(ctx, tx, vulnerabilities); err != nil {
+			return err
+		}
+		if err := tx.Exec(ctx, sqlf.Sprintf(upsertVulnerabilitiesTemporaryUpsertedVulnerabilitiesTableQuery)); err != nil {
+			return err
+		}
+
+		if err := tx.Exec(ctx, sqlf.Sprintf(upsertVulnerabilitiesUpdateQuery, importSource)); err != nil {
+			return err
+		}
+		if err := tx.QueryRow(ctx, sqlf.Sprintf(upsertVulnerabilitiesCountQuery)).Scan(&numInserted, &numUpdated); err != nil {
+			return err
+		}
+		if numInserted+numUpdated == 0 {
+			return nil
+		}
+
+		if err := tx.Exec(ctx, sqlf.Sprintf(upsertVulnerabilitiesDeleteAffectedPackagesQuery)); err != nil {
+			return err
+		}
+		if err := tx.Exec(ctx, sqlf.Sprintf(upsertVulnerabilitiesAffectedPackagesUpdateQuery)); err != nil {
+			return err
+		}
+		if err := tx.Exec(ctx, sqlf.Sprintf(upsertVulnerabilitiesAffectedSymbolsUpdateQuery)); err != nil {
+			return err
+		}
+
+		// Uploads are scanned once, so rescan all uploads against the new and updated vulnerabilities
+		return tx.Exec(ctx, sqlf.Sprintf(upsertVulnerabilitiesResetScansQuery))
+	})
+
+	return numInserted, numUpdated, err
+}
+
+const upsertVulnerabilitiesTemporaryUpsertedVulnerabilitiesTableQuery = `
+CREATE TEMPORARY TABLE t_upserted_vulnerabilities (
+	vulnerability_id  INTEGER NOT NULL,
+	source_id         TEXT NOT NULL,
+	inserted          BOOLEAN NOT NULL
+) ON COMMIT DROP
+`
+
+// An existing vulnerability is updated when its modification time changes, unless it was written by another
+// source and the new record is not more recent. This keeps sources with overlapping advisories from replacing
+// each other's records on every import.
+const upsertVulnerabilitiesUpdateQuery = `
+WITH upserted AS (
+	INSERT INTO vulnerabilities (
+		source_id,
+		summary,
+		details,
+		cpes,
+		cwes,
+		aliases,
+		related,
+		data_source,
+		urls,
+		severity,
+		cvss_vector,
+		cvss_score,
+		published_at,
+		modified_at,
+		withdrawn_at,
+		import_source
+	)
+	SELECT
+		source_id,
+		summary,
+		details,
+		cpes,
+		cwes,
+		aliases,
+		related,
+		data_source,
+		urls,
+		severity,
+		cvss_vector,
+		cvss_score,
+		published_at,
+		modified_at,
+		withdrawn_at,
+		%s
+	FROM t_vulnerabilities
+	ON CONFLICT (source_id) DO UPDATE SET
+		summary = EXCLUDED.summary,
+		details = EXCLUDED.details,
+		cpes = EXCLUDED.cpes,
+		cwes = EXCLUDED.cwes,
+		aliases = EXCLUDED.aliases,
+		related = EXCLUDED.related,
+		data_source = EXCLUDED.data_source,
+		urls = EXCLUDED.urls,
+		severity = EXCLUDED.severity,
+		cvss_vector = EXCLUDED.cvss_vector,
+		cvss_score = EXCLUDED.cvss_score,
+		published_at = EXCLUDED.published_at,
+		modified_at = EXCLUDED.modified_at,
+		withdrawn_at = EXCLUDED.withdrawn_at,
+		import_source = EXCLUDED.import_source
+	WHERE
+		vulnerabilities.modified_at IS DISTINCT FROM EXCLUDED.modified_at AND (
+			vulnerabilities.import_source IS NOT DISTINCT FROM EXCLUDED.import_source OR
+			vulnerabilities.modified_at IS NULL OR
+			vulnerabilities.modified_at < EXCLUDED.modified_at
+		)
+	RETURNING id, source_id, xmax = 0 AS inserted
+)
+INSERT INTO t_upserted_vulnerabilities (vulnerability_id, source_id, inserted)
+SELECT id, source_id, inserted FROM upserted
+`
+
+const upsertVulnerabilitiesCountQuery = `
+SELECT
+	COUNT(*) FILTER (WHERE inserted),
+	COUNT(*) FILTER (WHERE NOT inserted)
+FROM t_upserted_vulnerabilities
+`
+
+// The affected packages of updated vulnerabilities are replaced. Their symbols and matches are removed
+// by cascading deletes, and matches are recreated by the next scan.
+const upsertVulnerabilitiesDeleteAffectedPackagesQuery = `
+DELETE FROM vulnerability_affected_packages vap
+USING t_upserted_vulnerabilities uv
+WHERE
+	vap.vulnerability_id = uv.vulnerability_id AND
+	NOT uv.inserted
+`
+
+const upsertVulnerabilitiesAffectedPackagesUpdateQuery = `
+INSERT INTO vulnerability_affected_packages(
+	vulnerability_id,
+	package_name,
+	language,
+	namespace,
+	version_constraint,
+	fixed,
+	fixed_in
+)
+SELECT
+	uv.vulnerability_id,
+	vap.package_name,
+	vap.language,
+	vap.namespace,
+	vap.version_constraint,
+	vap.fixed,
+	vap.fixed_in
+FROM t_vulnerability_affected_packages vap
+JOIN t_upserted_vulnerabilities uv ON uv.source_id = vap.source_id
+ON CONFLICT DO NOTHING
+`
+
+const upsertVulnerabilitiesAffectedSymbolsUpdateQuery = `
+WITH
+json_candidates AS (
+	SELECT
+		vap.id,
+		json_array_elements(tvap.affected_symbols) AS affected_symbol
+	FROM t_vulnerability_affected_packages tvap
+	JOIN t_upserted_vulnerabilities uv ON uv.source_id = tvap.source_id
+	JOIN vulnerability_affected_packages vap ON
+		vap.vulnerability_id = uv.vulnerability_id AND
+		vap.package_name = tvap.package_name
+),
+candidates AS (
+	SELECT
+		c.id,
+		c.affected_symbol->'path'::text AS path,
+		ARRAY(SELECT json_array_elements_text(c.affected_symbol->'symbols'))::text[] AS symbols
+	FROM json_candidates c
+)
+INSERT INTO vulnerability_affected_symbols(vulnerability_affected_package_id, path, symbols)
+SELECT c.id, c.path, c.symbols FROM candidates c
+ON CONFLICT DO NOTHING
+`
+
+const upsertVulnerabilitiesResetScansQuery = `
+DELETE FROM lsif_uploads_vulnerability_scan
+`
+
 //
 //
 
@@ -410,6 +610,7 @@ var scanSingleVulnerabilityAndCount = func(s dbutil.Scanner) (v shared.Vulnerabi
 		&v.PublishedAt,
 		&v.ModifiedAt,
 		&v.WithdrawnAt,
+		&dbutil.NullString{S: &v.ImportSource},
 		// RHS(s) of left join (may be null)
 		&dbutil.NullString{S: &vap.PackageName},
 		&dbutil.NullString{S: &vap.Language},
@@ -476,6 +677,27 @@ var scanVulnerabilitiesAndCount = func(rows basestore.Rows, queryErr error) ([]s
 	return flattenVulnerabilities(values), totalCount, nil
 }
 
+// deduplicateVulnerabilities returns the given vulnerabilities with a single record per source ID, which
+// is the most recently modified one. Duplicate records cannot be upserted in a single statement.
+func deduplicateVulnerabilities(vs []shared.Vulnerability) []shared.Vulnerability {
+	indexes := make(map[string]int, len(vs))
+	deduplicated := make([]shared.Vulnerability, 0, len(vs))
+	for _, v := range vs {
+		i, ok := indexes[v.SourceID]
+		if !ok {
+			indexes[v.SourceID] = len(deduplicated)
+			deduplicated = append(deduplicated, v)
+			continue
+		}
+
+		if modifiedAt := deduplicated[i].ModifiedAt; modifiedAt == nil || (v.ModifiedAt != nil && v.ModifiedAt.After(*modifiedAt)) {
+			deduplicated[i] = v
+		}
+	}
+
+	return deduplicated
+}
+
 func canonicalizeVulnerabilities(vs []shared.Vulnerability) []shared.Vulnerability {
 	for i, v := range vs {
 		vs[i] = canonicalizeVulnerability(v)
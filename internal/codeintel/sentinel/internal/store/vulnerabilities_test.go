@@ -4,6 +4,7 @@ import (
 	"context"
 	"math"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/sourcegraph/log/logtest"
@@ -135,3 +136,69 @@ func TestGetVulnerabilities(t *testing.T) {
 		}
 	}
 }
+
+func TestUpsertVulnerabilities(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	if _, err := store.InsertVulnerabilities(ctx, testVulnerabilities[:2]); err != nil {
+		t.Fatalf("unexpected error inserting vulnerabilities: %s", err)
+	}
+
+	modifiedAt := time.Unix(1686000000, 0).UTC()
+	fixedConfig := badConfig
+	fixedConfig.VersionConstraint = []string{"< v1.2.6"}
+	vulnerabilities := []shared.Vulnerability{
+		// Updated, as its modification time changed
+		{SourceID: "CVE-ABC", Summary: "updated", ModifiedAt: &modifiedAt, AffectedPackages: []shared.AffectedPackage{fixedConfig}},
+		// Inserted, with a duplicate of which the most recently modified record is kept
+		{SourceID: "CVE-NEW", Summary: "stale"},
+		{SourceID: "CVE-NEW", Summary: "new", ModifiedAt: &modifiedAt},
+	}
+
+	numInserted, numUpdated, err := store.UpsertVulnerabilities(ctx, "mirror", vulnerabilities)
+	if err != nil {
+		t.Fatalf("unexpected error upserting vulnerabilities: %s", err)
+	}
+	if numInserted != 1 || numUpdated != 1 {
+		t.Errorf("unexpected counts. want=(1, 1) have=(%d, %d)", numInserted, numUpdated)
+	}
+
+	updated, _, err := store.VulnerabilityByID(ctx, 1)
+	if err != nil {
+		t.Fatalf("unexpected error getting vulnerability: %s", err)
+	}
+	expected := canonicalizeVulnerability(shared.Vulnerability{
+		ID:               1,
+		SourceID:         "CVE-ABC",
+		Summary:          "updated",
+		ModifiedAt:       &modifiedAt,
+		ImportSource:     "mirror",
+		AffectedPackages: []shared.AffectedPackage{fixedConfig},
+	})
+	expected.AffectedPackages[0].AffectedSymbols = nil
+	if diff := cmp.Diff(expected, updated); diff != "" {
+		t.Errorf("unexpected vulnerability (-want +got):\n%s", diff)
+	}
+
+	// Upserting the same records again is a no-op
+	numInserted, numUpdated, err = store.UpsertVulnerabilities(ctx, "mirror", vulnerabilities)
+	if err != nil {
+		t.Fatalf("unexpected error upserting vulnerabilities: %s", err)
+	}
+	if numInserted != 0 || numUpdated != 0 {
+		t.Errorf("unexpected counts. want=(0, 0) have=(%d, %d)", numInserted, numUpdated)
+	}
+
+	// Older records from another source do not replace existing records
+	olderModifiedAt := modifiedAt.Add(-time.Hour)
+	if _, numUpdated, err := store.UpsertVulnerabilities(ctx, "other", []shared.Vulnerability{
+		{SourceID: "CVE-ABC", Summary: "older", ModifiedAt: &olderModifiedAt},
+	}); err != nil {
+		t.Fatalf("unexpected error upserting vulnerabilities: %s", err)
+	} else if numUpdated != 0 {
+		t.Errorf("unexpected number of updated vulnerabilities. want=%d have=%d", 0, numUpdated)
+	}
+}
@@ -2,23 +2,43 @@ package sentinel
 
 import (
 	"context"
+	"io"
 
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/background/downloader"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/lsifstore"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/store"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
+// ErrUnknownAdvisorySource occurs when an import is requested for a source missing from the
+// codeIntelSentinel.advisorySources site configuration.
+var ErrUnknownAdvisorySource = errors.New("unknown advisory source")
+
+// ErrConfiguredAdvisorySource occurs when a bundle is uploaded under the name of a source of the
+// codeIntelSentinel.advisorySources site configuration, whose periodic import would replace it.
+var ErrConfiguredAdvisorySource = errors.New("advisory source is configured in the site configuration")
+
 type Service struct {
 	store      store.Store
+	lsifstore  lsifstore.Store
+	importer   *downloader.AdvisoryImporter
 	operations *operations
 }
 
 func newService(
 	observationCtx *observation.Context,
 	store store.Store,
+	lsifstore lsifstore.Store,
 ) *Service {
 	return &Service{
 		store:      store,
+		lsifstore:  lsifstore,
+		importer:   downloader.NewAdvisoryImporter(store),
 		operations: newOperations(observationCtx),
 	}
 }
@@ -50,3 +70,78 @@ func (s *Service) GetVulnerabilityMatchesSummaryCounts(ctx context.Context) (sha
 func (s *Service) GetVulnerabilityMatchesCountByRepository(ctx context.Context, args shared.GetVulnerabilityMatchesCountByRepositoryArgs) ([]shared.VulnerabilityMatchesByRepository, int, error) {
 	return s.store.GetVulnerabilityMatchesCountByRepository(ctx, args)
 }
+
+// GetVulnerabilityImportSources returns the advisory sources of the site configuration along with the
+// status of their last import, followed by the sources of uploaded bundles. Sources which have never been
+// imported have no status.
+func (s *Service) GetVulnerabilityImportSources(ctx context.Context) ([]shared.VulnerabilityImportSource, error) {
+	importSources, err := s.store.GetVulnerabilityImportSources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	importSourcesByName := make(map[string]shared.VulnerabilityImportSource, len(importSources))
+	for _, importSource := range importSources {
+		importSourcesByName[importSource.Name] = importSource
+	}
+
+	configuredSources := conf.SiteConfig().CodeIntelSentinelAdvisorySources
+	configuredNames := make(map[string]struct{}, len(configuredSources))
+	sources := make([]shared.VulnerabilityImportSource, 0, len(configuredSources))
+	for _, source := range configuredSources {
+		importSource, ok := importSourcesByName[source.Name]
+		if !ok {
+			importSource = shared.VulnerabilityImportSource{Name: source.Name}
+		}
+		importSource.URL = source.Url
+		sources = append(sources, importSource)
+		configuredNames[source.Name] = struct{}{}
+	}
+
+	for _, importSource := range importSources {
+		if _, ok := configuredNames[importSource.Name]; !ok && importSource.URL == downloader.UploadedBundleLocation {
+			sources = append(sources, importSource)
+		}
+	}
+
+	return sources, nil
+}
+
+// ImportVulnerabilities imports the bundle of the advisory source with the given name from the site
+// configuration. An unchanged bundle is skipped unless force is true.
+func (s *Service) ImportVulnerabilities(ctx context.Context, name string, force bool) (_ shared.VulnerabilityImportSource, err error) {
+	ctx, _, endObservation := s.operations.importVulnerabilities.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("name", name),
+		attribute.Bool("force", force),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	for _, source := range conf.SiteConfig().CodeIntelSentinelAdvisorySources {
+		if source.Name == name {
+			importSource, _, err := s.importer.Import(ctx, source.Name, source.Url, force)
+			return importSource, err
+		}
+	}
+
+	return shared.VulnerabilityImportSource{}, errors.Wrapf(ErrUnknownAdvisorySource, "%q", name)
+}
+
+// UploadVulnerabilities imports the given uploaded bundle under the source with the given name. The name
+// must not be one of the sources of the site configuration. An unchanged bundle is skipped unless force
+// is true.
+func (s *Service) UploadVulnerabilities(ctx context.Context, name string, bundle io.Reader, force bool) (_ shared.VulnerabilityImportSource, err error) {
+	ctx, _, endObservation := s.operations.uploadVulnerabilities.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("name", name),
+		attribute.Bool("force", force),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	for _, source := range conf.SiteConfig().CodeIntelSentinelAdvisorySources {
+		if source.Name == name {
+			return shared.VulnerabilityImportSource{}, errors.Wrapf(ErrConfiguredAdvisorySource, "%q", name)
+		}
+	}
+
+	importSource, _, err := s.importer.ImportUpload(ctx, name, bundle, force)
+	return importSource, err
+}
@@ -3,6 +3,8 @@ package shared
 import (
 	"strconv"
 	"time"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
 )
 
 type Vulnerability struct {
@@ -22,6 +24,7 @@ type Vulnerability struct {
 	PublishedAt      time.Time
 	ModifiedAt       *time.Time
 	WithdrawnAt      *time.Time
+	ImportSource     string // name of the advisory source that last wrote the vulnerability, if any
 	AffectedPackages []AffectedPackage
 }
 
@@ -51,11 +54,43 @@ type AffectedSymbol struct {
 	Symbols []string `json:"symbols"`
 }
 
+// VulnerabilityImportSource tracks the imports of an OSV advisory bundle configured by a site admin.
+type VulnerabilityImportSource struct {
+	Name               string
+	URL                string
+	Checksum           string     // SHA-256 checksum of the last imported bundle
+	AttemptedAt        *time.Time // last time the bundle was read
+	ImportedAt         *time.Time // last time the vulnerabilities of a changed bundle were written
+	NumVulnerabilities int
+	NumInserted        int
+	NumUpdated         int
+	FailureMessage     *string
+}
+
 type VulnerabilityMatch struct {
 	ID              int
 	UploadID        int
 	VulnerabilityID int
 	AffectedPackage AffectedPackage
+	Reachability    Reachability
+}
+
+// Reachability classifies whether an upload references the symbols affected by a vulnerability.
+type Reachability string
+
+const (
+	ReachabilityReachable   Reachability = "reachable"   // the upload references an affected symbol
+	ReachabilityUnreachable Reachability = "unreachable" // the upload references none of the affected symbols
+	ReachabilityUnknown     Reachability = "unknown"     // the affected symbols or the precise references are unavailable
+)
+
+// UnclassifiedVulnerabilityMatch is a vulnerability match whose reachability is yet to be determined,
+// along with the package references of the upload that satisfy the affected package.
+type UnclassifiedVulnerabilityMatch struct {
+	ID              int
+	UploadID        int
+	AffectedSymbols []AffectedSymbol
+	Packages        []precise.Package
 }
 
 type GetVulnerabilitiesArgs struct {
@@ -69,6 +104,7 @@ type GetVulnerabilityMatchesArgs struct {
 	Severity       string
 	Language       string
 	RepositoryName string
+	Reachability   Reachability
 }
 
 type GetVulnerabilityMatchesSummaryCounts struct {
@@ -77,10 +113,14 @@ type GetVulnerabilityMatchesSummaryCounts struct {
 	Medium       int32
 	Low          int32
 	Repositories int32
+	Reachable    int32
+	Unreachable  int32
+	Unknown      int32
 }
 
 type GetVulnerabilityMatchesCountByRepositoryArgs struct {
 	RepositoryName string
+	Reachability   Reachability
 	Limit          int
 	Offset         int
 }
@@ -15,4 +15,7 @@ type SentinelService interface {
 	VulnerabilityMatchByID(ctx context.Context, id int) (shared.VulnerabilityMatch, bool, error)
 	GetVulnerabilityMatchesSummaryCounts(ctx context.Context) (shared.GetVulnerabilityMatchesSummaryCounts, error)
 	GetVulnerabilityMatchesCountByRepository(ctx context.Context, args shared.GetVulnerabilityMatchesCountByRepositoryArgs) (_ []shared.VulnerabilityMatchesByRepository, _ int, err error)
+
+	GetVulnerabilityImportSources(ctx context.Context) ([]shared.VulnerabilityImportSource, error)
+	ImportVulnerabilities(ctx context.Context, name string, force bool) (shared.VulnerabilityImportSource, error)
 }
@@ -14,6 +14,8 @@ type operations struct {
 	vulnerabilityMatchByID                *observation.Operation
 	vulnerabilityMatchesSummaryCounts     *observation.Operation
 	vulnerabilityMatchesCountByRepository *observation.Operation
+	vulnerabilityImportSources            *observation.Operation
+	importVulnerabilities                 *observation.Operation
 }
 
 func newOperations(observationCtx *observation.Context) *operations {
@@ -39,5 +41,7 @@ func newOperations(observationCtx *observation.Context) *operations {
 		vulnerabilityMatchByID:                op("VulnerabilityMatchByID"),
 		vulnerabilityMatchesSummaryCounts:     op("VulnerabilityMatchesSummaryCounts"),
 		vulnerabilityMatchesCountByRepository: op("VulnerabilityMatchesCountByRepository"),
+		vulnerabilityImportSources:            op("VulnerabilityImportSources"),
+		importVulnerabilities:                 op("ImportVulnerabilities"),
 	}
 }
@@ -2,12 +2,14 @@ package graphql
 
 import (
 	"context"
+	"strings"
 
 	"github.com/graph-gophers/graphql-go"
 	"go.opentelemetry.io/otel/attribute"
 
 	resolverstubs "github.com/sourcegraph/sourcegraph/internal/codeintel/resolvers"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	sharedresolvers "github.com/sourcegraph/sourcegraph/internal/codeintel/shared/resolvers"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/shared/resolvers/gitresolvers"
 	uploadsgraphql "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/transport/graphql"
 	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
@@ -17,6 +19,7 @@ import (
 
 type rootResolver struct {
 	sentinelSvc                 SentinelService
+	siteAdminChecker            sharedresolvers.SiteAdminChecker
 	vulnerabilityLoaderFactory  VulnerabilityLoaderFactory
 	uploadLoaderFactory         uploadsgraphql.UploadLoaderFactory
 	indexLoaderFactory          uploadsgraphql.IndexLoaderFactory
@@ -28,6 +31,7 @@ type rootResolver struct {
 func NewRootResolver(
 	observationCtx *observation.Context,
 	sentinelSvc SentinelService,
+	siteAdminChecker sharedresolvers.SiteAdminChecker,
 	uploadLoaderFactory uploadsgraphql.UploadLoaderFactory,
 	indexLoaderFactory uploadsgraphql.IndexLoaderFactory,
 	locationResolverFactory *gitresolvers.CachedLocationResolverFactory,
@@ -35,6 +39,7 @@ func NewRootResolver(
 ) resolverstubs.SentinelServiceResolver {
 	return &rootResolver{
 		sentinelSvc:                 sentinelSvc,
+		siteAdminChecker:            siteAdminChecker,
 		vulnerabilityLoaderFactory:  NewVulnerabilityLoaderFactory(sentinelSvc),
 		uploadLoaderFactory:         uploadLoaderFactory,
 		indexLoaderFactory:          indexLoaderFactory,
@@ -105,6 +110,7 @@ func (r *rootResolver) VulnerabilityMatches(ctx context.Context, args resolverst
 		Language:       language,
 		Severity:       severity,
 		RepositoryName: repositoryName,
+		Reachability:   reachabilityFromEnum(args.Reachability),
 	})
 	if err != nil {
 		return nil, err
@@ -152,6 +158,7 @@ func (r *rootResolver) VulnerabilityMatchesCountByRepository(ctx context.Context
 		Limit:          int(limit),
 		Offset:         int(offset),
 		RepositoryName: repositoryName,
+		Reachability:   reachabilityFromEnum(args.Reachability),
 	})
 	if err != nil {
 		return nil, err
@@ -231,11 +238,14 @@ func (r *rootResolver) VulnerabilityMatchesSummaryCounts(ctx context.Context) (_
 	}
 
 	return &vulnerabilityMatchesSummaryCountResolver{
-		critical:   counts.Critical,
-		high:       counts.High,
-		medium:     counts.Medium,
-		low:        counts.Low,
-		repository: counts.Repositories,
+		critical:    counts.Critical,
+		high:        counts.High,
+		medium:      counts.Medium,
+		low:         counts.Low,
+		repository:  counts.Repositories,
+		reachable:   counts.Reachable,
+		unreachable: counts.Unreachable,
+		unknown:     counts.Unknown,
 	}, nil
 }
 
@@ -274,6 +284,10 @@ func (r *vulnerabilityResolver) Withdrawn() *gqlutil.DateTime {
 	return gqlutil.DateTimeOrNil(r.v.WithdrawnAt)
 }
 
+func (r *vulnerabilityResolver) ImportSource() *string {
+	return pointers.NonZeroPtr(r.v.ImportSource)
+}
+
 func (r *vulnerabilityResolver) AffectedPackages() []resolverstubs.VulnerabilityAffectedPackageResolver {
 	var resolvers []resolverstubs.VulnerabilityAffectedPackageResolver
 	for _, p := range r.v.AffectedPackages {
@@ -352,15 +366,32 @@ func (r *vulnerabilityMatchResolver) PreciseIndex(ctx context.Context) (resolver
 	return r.preciseIndexResolverFactory.Create(ctx, r.uploadLoader, r.indexLoader, r.locationResolver, r.errTracer, &upload, nil)
 }
 
+func (r *vulnerabilityMatchResolver) Reachability() string {
+	return strings.ToUpper(string(r.m.Reachability))
+}
+
+// reachabilityFromEnum converts a VulnerabilityReachability enum value into the reachability
+// stored with vulnerability matches. A missing value does not filter matches.
+func reachabilityFromEnum(value *string) shared.Reachability {
+	if value == nil {
+		return ""
+	}
+
+	return shared.Reachability(strings.ToLower(*value))
+}
+
 //
 //
 
 type vulnerabilityMatchesSummaryCountResolver struct {
-	critical   int32
-	high       int32
-	medium     int32
-	low        int32
-	repository int32
+	critical    int32
+	high        int32
+	medium      int32
+	low         int32
+	repository  int32
+	reachable   int32
+	unreachable int32
+	unknown     int32
 }
 
 func (v *vulnerabilityMatchesSummaryCountResolver) Critical() int32 { return v.critical }
@@ -371,6 +402,12 @@ func (v *vulnerabilityMatchesSummaryCountResolver) Repository() int32 {
 	return v.repository
 }
 
+func (v *vulnerabilityMatchesSummaryCountResolver) Reachable() int32   { return v.reachable }
+func (v *vulnerabilityMatchesSummaryCountResolver) Unreachable() int32 { return v.unreachable }
+func (v *vulnerabilityMatchesSummaryCountResolver) ReachabilityUnknown() int32 {
+	return v.unknown
+}
+
 type vulnerabilityMatchCountByRepositoryResolver struct {
 	v shared.VulnerabilityMatchesByRepository
 }
@@ -0,0 +1,85 @@
+package graphql
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	resolverstubs "github.com/sourcegraph/sourcegraph/internal/codeintel/resolvers"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+// 🚨 SECURITY: Only site admins may list advisory sources, whose URLs may point to internal services
+func (r *rootResolver) VulnerabilityImportSources(ctx context.Context) (_ []resolverstubs.VulnerabilityImportSourceResolver, err error) {
+	ctx, _, endObservation := r.operations.vulnerabilityImportSources.WithErrors(ctx, &err, observation.Args{})
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	sources, err := r.sentinelSvc.GetVulnerabilityImportSources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]resolverstubs.VulnerabilityImportSourceResolver, 0, len(sources))
+	for _, source := range sources {
+		resolvers = append(resolvers, &vulnerabilityImportSourceResolver{source: source})
+	}
+
+	return resolvers, nil
+}
+
+// 🚨 SECURITY: Only site admins may import advisories
+func (r *rootResolver) ImportVulnerabilities(ctx context.Context, args *resolverstubs.ImportVulnerabilitiesArgs) (_ resolverstubs.VulnerabilityImportSourceResolver, err error) {
+	force := pointers.Deref(args.Force, false)
+	ctx, _, endObservation := r.operations.importVulnerabilities.WithErrors(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("source", args.Source),
+		attribute.Bool("force", force),
+	}})
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	source, err := r.sentinelSvc.ImportVulnerabilities(ctx, args.Source, force)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vulnerabilityImportSourceResolver{source: source}, nil
+}
+
+//
+//
+
+type vulnerabilityImportSourceResolver struct {
+	source shared.VulnerabilityImportSource
+}
+
+func (r *vulnerabilityImportSourceResolver) Name() string { return r.source.Name }
+func (r *vulnerabilityImportSourceResolver) URL() string  { return r.source.URL }
+func (r *vulnerabilityImportSourceResolver) Checksum() *string {
+	return pointers.NonZeroPtr(r.source.Checksum)
+}
+
+func (r *vulnerabilityImportSourceResolver) AttemptedAt() *gqlutil.DateTime {
+	return gqlutil.DateTimeOrNil(r.source.AttemptedAt)
+}
+
+func (r *vulnerabilityImportSourceResolver) ImportedAt() *gqlutil.DateTime {
+	return gqlutil.DateTimeOrNil(r.source.ImportedAt)
+}
+
+func (r *vulnerabilityImportSourceResolver) NumVulnerabilities() int32 {
+	return int32(r.source.NumVulnerabilities)
+}
+
+func (r *vulnerabilityImportSourceResolver) NumInserted() int32      { return int32(r.source.NumInserted) }
+func (r *vulnerabilityImportSourceResolver) NumUpdated() int32       { return int32(r.source.NumUpdated) }
+func (r *vulnerabilityImportSourceResolver) FailureMessage() *string { return r.source.FailureMessage }
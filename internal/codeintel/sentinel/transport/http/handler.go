@@ -0,0 +1,95 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// sourceNamePattern matches the names allowed for the sources of the codeIntelSentinel.advisorySources
+// site configuration.
+var sourceNamePattern = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+
+// newUploadHandler returns a handler that imports the OSV advisory bundle in the request body. The request
+// takes the following query parameters:
+//
+//   - source: the name recorded for the imported vulnerabilities (required)
+//   - force: import the bundle even if it has not changed since the last upload (defaults to false)
+//
+// The response is the status of the source after the import.
+func newUploadHandler(sentinelSvc SentinelService, siteAdminChecker SiteAdminChecker, logger log.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		query := r.URL.Query()
+
+		// 🚨 SECURITY: Only site admins may import advisories
+		if err := siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+			switch {
+			case errors.Is(err, auth.ErrNotAuthenticated):
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+			case errors.Is(err, auth.ErrMustBeSiteAdmin):
+				http.Error(w, err.Error(), http.StatusForbidden)
+			default:
+				logger.Error("failed to check site admin", log.Error(err))
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			}
+			return
+		}
+
+		name := query.Get("source")
+		if !sourceNamePattern.MatchString(name) {
+			http.Error(w, "source must be a name of letters, digits, '.', '_' or '-'", http.StatusBadRequest)
+			return
+		}
+
+		var force bool
+		if value := query.Get("force"); value != "" {
+			var err error
+			if force, err = strconv.ParseBool(value); err != nil {
+				http.Error(w, "invalid force value", http.StatusBadRequest)
+				return
+			}
+		}
+
+		source, err := sentinelSvc.UploadVulnerabilities(ctx, name, r.Body, force)
+		if err != nil {
+			if errors.Is(err, sentinel.ErrConfiguredAdvisorySource) {
+				http.Error(w, err.Error(), http.StatusConflict)
+				return
+			}
+
+			logger.Error("failed to import uploaded bundle", log.String("source", name), log.Error(err))
+			http.Error(w, "failed to import bundle: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(uploadResponse{
+			Name:               source.Name,
+			Checksum:           source.Checksum,
+			ImportedAt:         source.ImportedAt,
+			NumVulnerabilities: source.NumVulnerabilities,
+			NumInserted:        source.NumInserted,
+			NumUpdated:         source.NumUpdated,
+		}); err != nil {
+			logger.Error("failed to write response", log.Error(err))
+		}
+	})
+}
+
+type uploadResponse struct {
+	Name               string     `json:"name"`
+	Checksum           string     `json:"checksum"`
+	ImportedAt         *time.Time `json:"importedAt"`
+	NumVulnerabilities int        `json:"numVulnerabilities"`
+	NumInserted        int        `json:"numInserted"`
+	NumUpdated         int        `json:"numUpdated"`
+}
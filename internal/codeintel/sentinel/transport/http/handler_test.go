@@ -0,0 +1,78 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+)
+
+func TestUploadHandler(t *testing.T) {
+	mockSentinelService := NewMockSentinelService()
+	mockSentinelService.UploadVulnerabilitiesFunc.SetDefaultHook(func(_ context.Context, name string, bundle io.Reader, _ bool) (shared.VulnerabilityImportSource, error) {
+		if name == "configured" {
+			return shared.VulnerabilityImportSource{}, sentinel.ErrConfiguredAdvisorySource
+		}
+		content, err := io.ReadAll(bundle)
+		if err != nil {
+			return shared.VulnerabilityImportSource{}, err
+		}
+		return shared.VulnerabilityImportSource{Name: name, Checksum: string(content), NumVulnerabilities: 3, NumInserted: 2, NumUpdated: 1}, nil
+	})
+	mockSiteAdminChecker := NewMockSiteAdminChecker()
+
+	handler := newUploadHandler(mockSentinelService, mockSiteAdminChecker, logtest.Scoped(t))
+
+	testCases := []struct {
+		query              string
+		siteAdminErr       error
+		expectedStatusCode int
+	}{
+		{"source=uploaded", nil, http.StatusOK},
+		{"source=uploaded&force=true", nil, http.StatusOK},
+		{"source=uploaded&force=maybe", nil, http.StatusBadRequest},
+		{"source=../uploaded", nil, http.StatusBadRequest},
+		{"", nil, http.StatusBadRequest},
+		{"source=configured", nil, http.StatusConflict},
+		{"source=uploaded", auth.ErrNotAuthenticated, http.StatusUnauthorized},
+		{"source=uploaded", auth.ErrMustBeSiteAdmin, http.StatusForbidden},
+	}
+
+	for _, testCase := range testCases {
+		mockSiteAdminChecker.CheckCurrentUserIsSiteAdminFunc.SetDefaultReturn(testCase.siteAdminErr)
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest("POST", "/vulnerabilities/upload?"+testCase.query, strings.NewReader("bundle")))
+
+		if w.Code != testCase.expectedStatusCode {
+			t.Errorf("unexpected status code for %q. want=%d have=%d", testCase.query, testCase.expectedStatusCode, w.Code)
+			continue
+		}
+		if testCase.expectedStatusCode != http.StatusOK {
+			continue
+		}
+
+		var response uploadResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+			t.Fatalf("unexpected error decoding response: %s", err)
+		}
+		if response.Name != "uploaded" || response.Checksum != "bundle" || response.NumInserted != 2 || response.NumUpdated != 1 {
+			t.Errorf("unexpected response for %q: %+v", testCase.query, response)
+		}
+	}
+
+	if history := mockSentinelService.UploadVulnerabilitiesFunc.History(); len(history) != 3 {
+		t.Fatalf("unexpected number of calls to UploadVulnerabilities. want=%d have=%d", 3, len(history))
+	} else if history[0].Arg3 || !history[1].Arg3 {
+		t.Errorf("unexpected force arguments to UploadVulnerabilities. want=(false, true) have=(%v, %v)", history[0].Arg3, history[1].Arg3)
+	}
+}
@@ -0,0 +1,16 @@
+package http
+
+import (
+	"context"
+	"io"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+)
+
+type SentinelService interface {
+	UploadVulnerabilities(ctx context.Context, name string, bundle io.Reader, force bool) (shared.VulnerabilityImportSource, error)
+}
+
+type SiteAdminChecker interface {
+	CheckCurrentUserIsSiteAdmin(ctx context.Context) error
+}
@@ -0,0 +1,20 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel"
+	sharedresolvers "github.com/sourcegraph/sourcegraph/internal/codeintel/shared/resolvers"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+)
+
+func NewUploadHandler(svc *sentinel.Service, db database.DB) http.Handler {
+	logger := log.Scoped(
+		"sentinel.handler",
+		"codeintel vulnerability bundle upload http handler",
+	)
+
+	return newUploadHandler(svc, sharedresolvers.NewSiteAdminChecker(db), logger)
+}
@@ -0,0 +1,332 @@
+// Code generated by go-mockgen 1.3.7; DO NOT EDIT.
+//
+// This file was generated by running `sg generate` (or `go-mockgen`) at the root of
+// this repository. To add additional mocks to this or another package, add a new entry
+// to the mockgen.yaml file in the root of this repository.
+
+package http
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	shared "github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+)
+
+// MockSentinelService is a mock implementation of the SentinelService
+// interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/transport/http)
+// used for unit testing.
+type MockSentinelService struct {
+	// UploadVulnerabilitiesFunc is an instance of a mock function object
+	// controlling the behavior of the method UploadVulnerabilities.
+	UploadVulnerabilitiesFunc *SentinelServiceUploadVulnerabilitiesFunc
+}
+
+// NewMockSentinelService creates a new mock of the SentinelService
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockSentinelService() *MockSentinelService {
+	return &MockSentinelService{
+		UploadVulnerabilitiesFunc: &SentinelServiceUploadVulnerabilitiesFunc{
+			defaultHook: func(context.Context, string, io.Reader, bool) (r0 shared.VulnerabilityImportSource, r1 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockSentinelService creates a new mock of the SentinelService
+// interface. All methods panic on invocation, unless overwritten.
+func NewStrictMockSentinelService() *MockSentinelService {
+	return &MockSentinelService{
+		UploadVulnerabilitiesFunc: &SentinelServiceUploadVulnerabilitiesFunc{
+			defaultHook: func(context.Context, string, io.Reader, bool) (shared.VulnerabilityImportSource, error) {
+				panic("unexpected invocation of MockSentinelService.UploadVulnerabilities")
+			},
+		},
+	}
+}
+
+// NewMockSentinelServiceFrom creates a new mock of the MockSentinelService
+// interface. All methods delegate to the given implementation, unless
+// overwritten.
+func NewMockSentinelServiceFrom(i SentinelService) *MockSentinelService {
+	return &MockSentinelService{
+		UploadVulnerabilitiesFunc: &SentinelServiceUploadVulnerabilitiesFunc{
+			defaultHook: i.UploadVulnerabilities,
+		},
+	}
+}
+
+// SentinelServiceUploadVulnerabilitiesFunc describes the behavior when the
+// UploadVulnerabilities method of the parent MockSentinelService instance
+// is invoked.
+type SentinelServiceUploadVulnerabilitiesFunc struct {
+	defaultHook func(context.Context, string, io.Reader, bool) (shared.VulnerabilityImportSource, error)
+	hooks       []func(context.Context, string, io.Reader, bool) (shared.VulnerabilityImportSource, error)
+	history     []SentinelServiceUploadVulnerabilitiesFuncCall
+	mutex       sync.Mutex
+}
+
+// UploadVulnerabilities delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockSentinelService) UploadVulnerabilities(v0 context.Context, v1 string, v2 io.Reader, v3 bool) (shared.VulnerabilityImportSource, error) {
+	r0, r1 := m.UploadVulnerabilitiesFunc.nextHook()(v0, v1, v2, v3)
+	m.UploadVulnerabilitiesFunc.appendCall(SentinelServiceUploadVulnerabilitiesFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// UploadVulnerabilities method of the parent MockSentinelService instance
+// is invoked and the hook queue is empty.
+func (f *SentinelServiceUploadVulnerabilitiesFunc) SetDefaultHook(hook func(context.Context, string, io.Reader, bool) (shared.VulnerabilityImportSource, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UploadVulnerabilities method of the parent MockSentinelService instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *SentinelServiceUploadVulnerabilitiesFunc) PushHook(hook func(context.Context, string, io.Reader, bool) (shared.VulnerabilityImportSource, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *SentinelServiceUploadVulnerabilitiesFunc) SetDefaultReturn(r0 shared.VulnerabilityImportSource, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, io.Reader, bool) (shared.VulnerabilityImportSource, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *SentinelServiceUploadVulnerabilitiesFunc) PushReturn(r0 shared.VulnerabilityImportSource, r1 error) {
+	f.PushHook(func(context.Context, string, io.Reader, bool) (shared.VulnerabilityImportSource, error) {
+		return r0, r1
+	})
+}
+
+func (f *SentinelServiceUploadVulnerabilitiesFunc) nextHook() func(context.Context, string, io.Reader, bool) (shared.VulnerabilityImportSource, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SentinelServiceUploadVulnerabilitiesFunc) appendCall(r0 SentinelServiceUploadVulnerabilitiesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// SentinelServiceUploadVulnerabilitiesFuncCall objects describing the
+// invocations of this function.
+func (f *SentinelServiceUploadVulnerabilitiesFunc) History() []SentinelServiceUploadVulnerabilitiesFuncCall {
+	f.mutex.Lock()
+	history := make([]SentinelServiceUploadVulnerabilitiesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SentinelServiceUploadVulnerabilitiesFuncCall is an object that describes
+// an invocation of method UploadVulnerabilities on an instance of
+// MockSentinelService.
+type SentinelServiceUploadVulnerabilitiesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 io.Reader
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 bool
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 shared.VulnerabilityImportSource
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c SentinelServiceUploadVulnerabilitiesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SentinelServiceUploadVulnerabilitiesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// MockSiteAdminChecker is a mock implementation of the SiteAdminChecker
+// interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/transport/http)
+// used for unit testing.
+type MockSiteAdminChecker struct {
+	// CheckCurrentUserIsSiteAdminFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// CheckCurrentUserIsSiteAdmin.
+	CheckCurrentUserIsSiteAdminFunc *SiteAdminCheckerCheckCurrentUserIsSiteAdminFunc
+}
+
+// NewMockSiteAdminChecker creates a new mock of the SiteAdminChecker
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockSiteAdminChecker() *MockSiteAdminChecker {
+	return &MockSiteAdminChecker{
+		CheckCurrentUserIsSiteAdminFunc: &SiteAdminCheckerCheckCurrentUserIsSiteAdminFunc{
+			defaultHook: func(context.Context) (r0 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockSiteAdminChecker creates a new mock of the SiteAdminChecker
+// interface. All methods panic on invocation, unless overwritten.
+func NewStrictMockSiteAdminChecker() *MockSiteAdminChecker {
+	return &MockSiteAdminChecker{
+		CheckCurrentUserIsSiteAdminFunc: &SiteAdminCheckerCheckCurrentUserIsSiteAdminFunc{
+			defaultHook: func(context.Context) error {
+				panic("unexpected invocation of MockSiteAdminChecker.CheckCurrentUserIsSiteAdmin")
+			},
+		},
+	}
+}
+
+// NewMockSiteAdminCheckerFrom creates a new mock of the
+// MockSiteAdminChecker interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockSiteAdminCheckerFrom(i SiteAdminChecker) *MockSiteAdminChecker {
+	return &MockSiteAdminChecker{
+		CheckCurrentUserIsSiteAdminFunc: &SiteAdminCheckerCheckCurrentUserIsSiteAdminFunc{
+			defaultHook: i.CheckCurrentUserIsSiteAdmin,
+		},
+	}
+}
+
+// SiteAdminCheckerCheckCurrentUserIsSiteAdminFunc describes the behavior
+// when the CheckCurrentUserIsSiteAdmin method of the parent
+// MockSiteAdminChecker instance is invoked.
+type SiteAdminCheckerCheckCurrentUserIsSiteAdminFunc struct {
+	defaultHook func(context.Context) error
+	hooks       []func(context.Context) error
+	history     []SiteAdminCheckerCheckCurrentUserIsSiteAdminFuncCall
+	mutex       sync.Mutex
+}
+
+// CheckCurrentUserIsSiteAdmin delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockSiteAdminChecker) CheckCurrentUserIsSiteAdmin(v0 context.Context) error {
+	r0 := m.CheckCurrentUserIsSiteAdminFunc.nextHook()(v0)
+	m.CheckCurrentUserIsSiteAdminFunc.appendCall(SiteAdminCheckerCheckCurrentUserIsSiteAdminFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// CheckCurrentUserIsSiteAdmin method of the parent MockSiteAdminChecker
+// instance is invoked and the hook queue is empty.
+func (f *SiteAdminCheckerCheckCurrentUserIsSiteAdminFunc) SetDefaultHook(hook func(context.Context) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CheckCurrentUserIsSiteAdmin method of the parent MockSiteAdminChecker
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *SiteAdminCheckerCheckCurrentUserIsSiteAdminFunc) PushHook(hook func(context.Context) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *SiteAdminCheckerCheckCurrentUserIsSiteAdminFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *SiteAdminCheckerCheckCurrentUserIsSiteAdminFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context) error {
+		return r0
+	})
+}
+
+func (f *SiteAdminCheckerCheckCurrentUserIsSiteAdminFunc) nextHook() func(context.Context) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SiteAdminCheckerCheckCurrentUserIsSiteAdminFunc) appendCall(r0 SiteAdminCheckerCheckCurrentUserIsSiteAdminFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// SiteAdminCheckerCheckCurrentUserIsSiteAdminFuncCall objects describing
+// the invocations of this function.
+func (f *SiteAdminCheckerCheckCurrentUserIsSiteAdminFunc) History() []SiteAdminCheckerCheckCurrentUserIsSiteAdminFuncCall {
+	f.mutex.Lock()
+	history := make([]SiteAdminCheckerCheckCurrentUserIsSiteAdminFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SiteAdminCheckerCheckCurrentUserIsSiteAdminFuncCall is an object that
+// describes an invocation of method CheckCurrentUserIsSiteAdmin on an
+// instance of MockSiteAdminChecker.
+type SiteAdminCheckerCheckCurrentUserIsSiteAdminFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c SiteAdminCheckerCheckCurrentUserIsSiteAdminFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SiteAdminCheckerCheckCurrentUserIsSiteAdminFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
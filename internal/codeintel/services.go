@@ -8,6 +8,7 @@ import (
 	ossdependencies "github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/policies"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/ranking"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sbom"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel"
 	codeintelshared "github.com/sourcegraph/sourcegraph/internal/codeintel/shared"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads"
@@ -25,6 +26,7 @@ type Services struct {
 	UploadsService      *uploads.Service
 	SentinelService     *sentinel.Service
 	ContextService      *context.Service
+	SBOMService         *sbom.Service
 	GitserverClient     gitserver.Client
 }
 
@@ -44,8 +46,9 @@ func NewServices(deps ServiceDependencies) (Services, error) {
 	autoIndexingSvc := autoindexing.NewService(deps.ObservationCtx, db, dependenciesSvc, policiesSvc, gitserverClient)
 	codenavSvc := codenav.NewService(deps.ObservationCtx, db, codeIntelDB, uploadsSvc, gitserverClient)
 	rankingSvc := ranking.NewService(deps.ObservationCtx, db, codeIntelDB)
-	sentinelService := sentinel.NewService(deps.ObservationCtx, db)
+	sentinelService := sentinel.NewService(deps.ObservationCtx, db, codeIntelDB)
 	contextService := context.NewService(deps.ObservationCtx, db)
+	sbomService := sbom.NewService(deps.ObservationCtx, uploadsSvc, dependenciesSvc)
 
 	return Services{
 		AutoIndexingService: autoIndexingSvc,
@@ -56,6 +59,7 @@ func NewServices(deps ServiceDependencies) (Services, error) {
 		UploadsService:      uploadsSvc,
 		SentinelService:     sentinelService,
 		ContextService:      contextService,
+		SBOMService:         sbomService,
 		GitserverClient:     gitserverClient,
 	}, nil
 }
@@ -101,6 +101,8 @@ func resultChunks(r result.Match, kind string, onlyPath bool) []string {
 		return []string{content}
 	case *result.OwnerMatch:
 		return []string{m.ResolvedOwner.Identifier()}
+	case *result.CaptureMatch:
+		return []string{m.Value}
 	default:
 		panic("unsupported result kind in compute output command")
 	}
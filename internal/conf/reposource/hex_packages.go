@@ -0,0 +1,89 @@
+package reposource
+
+import (
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const hexPackagesPrefix = "hex/"
+
+type HexVersionedPackage struct {
+	Name    PackageName
+	Version string
+}
+
+func NewHexVersionedPackage(name PackageName, version string) *HexVersionedPackage {
+	return &HexVersionedPackage{
+		Name:    name,
+		Version: version,
+	}
+}
+
+// ParseHexVersionedPackage parses a string in a '<name>(@version>)?' format into an
+// HexVersionedPackage.
+func ParseHexVersionedPackage(dependency string) *HexVersionedPackage {
+	var dep HexVersionedPackage
+	if i := strings.LastIndex(dependency, "@"); i == -1 {
+		dep.Name = PackageName(dependency)
+	} else {
+		dep.Name = PackageName(strings.TrimSpace(dependency[:i]))
+		dep.Version = strings.TrimSpace(dependency[i+1:])
+	}
+	return &dep
+}
+
+func ParseHexPackageFromName(name PackageName) *HexVersionedPackage {
+	return ParseHexVersionedPackage(string(name))
+}
+
+// ParseHexPackageFromRepoName is a convenience function to parse a repo name in a
+// 'hex/<name>(@<version>)?' format into a HexVersionedPackage.
+func ParseHexPackageFromRepoName(name api.RepoName) (*HexVersionedPackage, error) {
+	dependency := strings.TrimPrefix(string(name), hexPackagesPrefix)
+	if len(dependency) == len(name) {
+		return nil, errors.Newf("invalid Hex dependency repo name, missing %s prefix '%s'", hexPackagesPrefix, name)
+	}
+	return ParseHexVersionedPackage(dependency), nil
+}
+
+func (p *HexVersionedPackage) Scheme() string {
+	return "hex"
+}
+
+func (p *HexVersionedPackage) PackageSyntax() PackageName {
+	return p.Name
+}
+
+func (p *HexVersionedPackage) VersionedPackageSyntax() string {
+	if p.Version == "" {
+		return string(p.Name)
+	}
+	return string(p.Name) + "@" + p.Version
+}
+
+func (p *HexVersionedPackage) PackageVersion() string {
+	return p.Version
+}
+
+func (p *HexVersionedPackage) Description() string { return "" }
+
+func (p *HexVersionedPackage) RepoName() api.RepoName {
+	return api.RepoName(hexPackagesPrefix + p.Name)
+}
+
+func (p *HexVersionedPackage) GitTagFromVersion() string {
+	version := strings.TrimPrefix(p.Version, "v")
+	return "v" + version
+}
+
+func (p *HexVersionedPackage) Less(other VersionedPackage) bool {
+	o := other.(*HexVersionedPackage)
+
+	if p.Name == o.Name {
+		return versionGreaterThan(p.Version, o.Version)
+	}
+
+	return p.Name > o.Name
+}
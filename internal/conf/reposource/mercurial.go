@@ -0,0 +1,10 @@
+package reposource
+
+import "github.com/sourcegraph/sourcegraph/internal/api"
+
+// MercurialRepoName returns the Sourcegraph repository name of a Mercurial
+// repository, given the repositoryPathPattern of its connection, the host of
+// the Mercurial server and the path of the repository.
+func MercurialRepoName(repositoryPathPattern, host, repo string) api.RepoName {
+	return hostRepoName(repositoryPathPattern, host, repo)
+}
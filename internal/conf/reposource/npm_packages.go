@@ -172,6 +172,9 @@ type NpmVersionedPackage struct {
 
 	// The description of the package. Possibly empty.
 	PackageDescription string
+
+	// The SPDX license expression declared by this version. Possibly empty.
+	PackageLicense string
 }
 
 // ParseNpmVersionedPackage parses a string in a '(@scope/)?module@version' format into an NpmVersionedPackage.
@@ -215,6 +218,10 @@ func (d *NpmVersionedPackage) Description() string {
 	return d.PackageDescription
 }
 
+func (d *NpmVersionedPackage) License() string {
+	return d.PackageLicense
+}
+
 type NpmMetadata struct {
 	Package *NpmPackageName
 }
@@ -0,0 +1,89 @@
+package reposource
+
+import (
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const pubPackagesPrefix = "pub/"
+
+type PubVersionedPackage struct {
+	Name    PackageName
+	Version string
+}
+
+func NewPubVersionedPackage(name PackageName, version string) *PubVersionedPackage {
+	return &PubVersionedPackage{
+		Name:    name,
+		Version: version,
+	}
+}
+
+// ParsePubVersionedPackage parses a string in a '<name>(@version>)?' format into an
+// PubVersionedPackage.
+func ParsePubVersionedPackage(dependency string) *PubVersionedPackage {
+	var dep PubVersionedPackage
+	if i := strings.LastIndex(dependency, "@"); i == -1 {
+		dep.Name = PackageName(dependency)
+	} else {
+		dep.Name = PackageName(strings.TrimSpace(dependency[:i]))
+		dep.Version = strings.TrimSpace(dependency[i+1:])
+	}
+	return &dep
+}
+
+func ParsePubPackageFromName(name PackageName) *PubVersionedPackage {
+	return ParsePubVersionedPackage(string(name))
+}
+
+// ParsePubPackageFromRepoName is a convenience function to parse a repo name in a
+// 'pub/<name>(@<version>)?' format into a PubVersionedPackage.
+func ParsePubPackageFromRepoName(name api.RepoName) (*PubVersionedPackage, error) {
+	dependency := strings.TrimPrefix(string(name), pubPackagesPrefix)
+	if len(dependency) == len(name) {
+		return nil, errors.Newf("invalid Pub dependency repo name, missing %s prefix '%s'", pubPackagesPrefix, name)
+	}
+	return ParsePubVersionedPackage(dependency), nil
+}
+
+func (p *PubVersionedPackage) Scheme() string {
+	return "scip-dart"
+}
+
+func (p *PubVersionedPackage) PackageSyntax() PackageName {
+	return p.Name
+}
+
+func (p *PubVersionedPackage) VersionedPackageSyntax() string {
+	if p.Version == "" {
+		return string(p.Name)
+	}
+	return string(p.Name) + "@" + p.Version
+}
+
+func (p *PubVersionedPackage) PackageVersion() string {
+	return p.Version
+}
+
+func (p *PubVersionedPackage) Description() string { return "" }
+
+func (p *PubVersionedPackage) RepoName() api.RepoName {
+	return api.RepoName(pubPackagesPrefix + p.Name)
+}
+
+func (p *PubVersionedPackage) GitTagFromVersion() string {
+	version := strings.TrimPrefix(p.Version, "v")
+	return "v" + version
+}
+
+func (p *PubVersionedPackage) Less(other VersionedPackage) bool {
+	o := other.(*PubVersionedPackage)
+
+	if p.Name == o.Name {
+		return versionGreaterThan(p.Version, o.Version)
+	}
+
+	return p.Name > o.Name
+}
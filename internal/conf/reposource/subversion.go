@@ -0,0 +1,30 @@
+package reposource
+
+import (
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+// SubversionRepoName returns the Sourcegraph repository name of a Subversion
+// repository, given the repositoryPathPattern of its connection, the host of
+// the Subversion server and the path of the repository.
+func SubversionRepoName(repositoryPathPattern, host, repo string) api.RepoName {
+	return hostRepoName(repositoryPathPattern, host, repo)
+}
+
+// hostRepoName replaces "{host}" and "{repo}" in repositoryPathPattern, which
+// defaults to "{host}/{repo}".
+func hostRepoName(repositoryPathPattern, host, repo string) api.RepoName {
+	if repositoryPathPattern == "" {
+		repositoryPathPattern = "{host}/{repo}"
+	}
+
+	name := strings.NewReplacer(
+		"{host}", host,
+		"{repo}", strings.Trim(repo, "/"),
+	).Replace(repositoryPathPattern)
+
+	// Repositories served from the file system have no host.
+	return api.RepoName(strings.TrimLeft(name, "/"))
+}
@@ -19,7 +19,19 @@ type VersionedPackage interface {
 	Less(VersionedPackage) bool
 }
 
+// LicensedPackage is implemented by versioned packages whose declared license is
+// learned from the package host while the package is downloaded.
+type LicensedPackage interface {
+	VersionedPackage
+
+	// License returns the SPDX license expression declared by this version of the package.
+	// May be empty.
+	License() string
+}
+
 var (
+	_ LicensedPackage = (*NpmVersionedPackage)(nil)
+
 	_ VersionedPackage = (*MavenVersionedPackage)(nil)
 	_ VersionedPackage = (*NpmVersionedPackage)(nil)
 	_ VersionedPackage = (*GoVersionedPackage)(nil)
@@ -549,8 +549,18 @@ func scanRepo(logger log.Logger, rows *sql.Rows, r *types.Repo) (err error) {
 		r.Metadata = &struct{}{}
 	case extsvc.TypeRubyPackages:
 		r.Metadata = &struct{}{}
+	case extsvc.TypeNuGetPackages:
+		r.Metadata = &struct{}{}
+	case extsvc.TypeHexPackages:
+		r.Metadata = &struct{}{}
+	case extsvc.TypePubPackages:
+		r.Metadata = &struct{}{}
 	case extsvc.VariantLocalGit.AsType():
 		r.Metadata = new(extsvc.LocalGitMetadata)
+	case extsvc.VariantSubversion.AsType():
+		r.Metadata = new(extsvc.SubversionRepoMetadata)
+	case extsvc.VariantMercurial.AsType():
+		r.Metadata = new(extsvc.MercurialRepoMetadata)
 	default:
 		logger.Warn("unknown service type", log.String("type", typ))
 		return nil
@@ -15,7 +15,7 @@ type CodeHost struct {
 
 func (c *CodeHost) IsPackageHost() bool {
 	switch c.ServiceType {
-	case TypeNpmPackages, TypeJVMPackages, TypeGoModules, TypePythonPackages, TypeRustPackages, TypeRubyPackages:
+	case TypeNpmPackages, TypeJVMPackages, TypeGoModules, TypePythonPackages, TypeRustPackages, TypeRubyPackages, TypeNuGetPackages, TypeHexPackages, TypePubPackages:
 		return true
 	}
 	return false
@@ -49,6 +49,15 @@ var (
 	RubyURL      = &url.URL{Host: "rubygems"}
 	RubyPackages = NewCodeHost(RubyURL, TypeRubyPackages)
 
+	NuGetURL      = &url.URL{Host: "nuget"}
+	NuGetPackages = NewCodeHost(NuGetURL, TypeNuGetPackages)
+
+	HexURL      = &url.URL{Host: "hex"}
+	HexPackages = NewCodeHost(HexURL, TypeHexPackages)
+
+	PubURL      = &url.URL{Host: "pub"}
+	PubPackages = NewCodeHost(PubURL, TypePubPackages)
+
 	PublicCodeHosts = []*CodeHost{
 		GitHubDotCom,
 		GitLabDotCom,
@@ -58,6 +67,9 @@ var (
 		PythonPackages,
 		RustPackages,
 		RubyPackages,
+		NuGetPackages,
+		HexPackages,
+		PubPackages,
 	}
 )
 
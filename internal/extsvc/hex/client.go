@@ -0,0 +1,99 @@
+// Package hex is a client for Hex repositories, such as repo.hex.pm, as
+// described in https://github.com/hexpm/specifications/blob/main/endpoints.md#repository.
+package hex
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// DefaultRepositoryURL is the URL of the public Hex repository, which is used
+// when no repository is configured.
+const DefaultRepositoryURL = "https://repo.hex.pm"
+
+type Client struct {
+	repositoryURL string
+
+	uncachedClient httpcli.Doer
+
+	// Self-imposed rate-limiter.
+	limiter *ratelimit.InstrumentedLimiter
+}
+
+func NewClient(urn string, repositoryURL string, httpfactory *httpcli.Factory) (*Client, error) {
+	uncached, err := httpfactory.Doer(httpcli.NewCachedTransportOpt(httpcli.NoopCache{}, false))
+	if err != nil {
+		return nil, err
+	}
+	if repositoryURL == "" {
+		repositoryURL = DefaultRepositoryURL
+	}
+	return &Client{
+		repositoryURL:  repositoryURL,
+		uncachedClient: uncached,
+		limiter:        ratelimit.DefaultRegistry.Get(urn),
+	}, nil
+}
+
+// GetPackageContents returns the tarball of the given package version. The
+// tarball is an uncompressed tar archive containing the metadata.config of
+// the package and its files in contents.tar.gz, see
+// https://github.com/hexpm/specifications/blob/main/package_tarball.md.
+func (c *Client) GetPackageContents(ctx context.Context, dep reposource.VersionedPackage) (body io.ReadCloser, err error) {
+	url := fmt.Sprintf("%s/tarballs/%s-%s.tar", strings.TrimSuffix(c.repositoryURL, "/"), dep.PackageSyntax(), dep.PackageVersion())
+
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("User-Agent", "sourcegraph-hex-syncer (sourcegraph.com)")
+
+	body, err = c.do(c.uncachedClient, req)
+	if err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+type Error struct {
+	path    string
+	code    int
+	message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("bad response with status code %d for %s: %s", e.code, e.path, e.message)
+}
+
+func (e *Error) NotFound() bool {
+	return e.code == http.StatusNotFound
+}
+
+func (c *Client) do(doer httpcli.Doer, req *http.Request) (io.ReadCloser, error) {
+	resp, err := doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+
+		bs, err := io.ReadAll(resp.Body)
+		if err != nil {
+			bs = []byte(errors.Wrap(err, "failed to read body").Error())
+		}
+		return nil, &Error{path: req.URL.Path, code: resp.StatusCode, message: string(bs)}
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,41 @@
+package hex
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+)
+
+func TestGetPackageContents(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repo/tarballs/phoenix-1.7.7.tar" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte("tarball"))
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient("hex_urn", srv.URL+"/repo/", httpcli.NewFactory(nil))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	body, err := client.GetPackageContents(ctx, reposource.ParseHexVersionedPackage("phoenix@1.7.7"))
+	require.NoError(t, err)
+	defer body.Close()
+	contents, err := io.ReadAll(body)
+	require.NoError(t, err)
+	require.Equal(t, "tarball", string(contents))
+
+	_, err = client.GetPackageContents(ctx, reposource.ParseHexVersionedPackage("phoenix@0.0.1"))
+	var hexErr *Error
+	require.ErrorAs(t, err, &hexErr)
+	require.True(t, hexErr.NotFound())
+}
@@ -99,14 +99,39 @@ func (client *HTTPClient) GetPackageInfo(ctx context.Context, pkg *reposource.Np
 }
 
 type DependencyInfo struct {
-	Description string             `json:"description"`
-	Dist        DependencyInfoDist `json:"dist"`
+	Description string                `json:"description"`
+	Dist        DependencyInfoDist    `json:"dist"`
+	License     DependencyInfoLicense `json:"license"`
 }
 
 type DependencyInfoDist struct {
 	TarballURL string `json:"tarball"`
 }
 
+// DependencyInfoLicense is the SPDX license expression declared in the "license" field
+// of a package manifest. Older packages declare an object of the form {"type": "MIT"}
+// instead of a string, which is also accepted. Any other value decodes as empty.
+type DependencyInfoLicense string
+
+func (l *DependencyInfoLicense) UnmarshalJSON(data []byte) error {
+	var expression string
+	if err := json.Unmarshal(data, &expression); err == nil {
+		*l = DependencyInfoLicense(expression)
+		return nil
+	}
+
+	var legacy struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &legacy); err == nil {
+		*l = DependencyInfoLicense(legacy.Type)
+		return nil
+	}
+
+	*l = ""
+	return nil
+}
+
 type illFormedJSONError struct {
 	url string
 }
@@ -2,6 +2,7 @@ package npm
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"net/http"
@@ -128,6 +129,7 @@ func TestGetDependencyInfo(t *testing.T) {
 	info, err := client.GetDependencyInfo(ctx, dep)
 	require.NoError(t, err)
 	require.NotNil(t, info)
+	require.Equal(t, DependencyInfoLicense("WTFPL"), info.License)
 	dep, err = reposource.ParseNpmVersionedPackage("left-pad@1.3.1")
 	require.NoError(t, err)
 	info, err = client.GetDependencyInfo(ctx, dep)
@@ -135,6 +137,19 @@ func TestGetDependencyInfo(t *testing.T) {
 	require.ErrorAs(t, err, &npmError{})
 }
 
+func TestDependencyInfoLicense(t *testing.T) {
+	for input, expected := range map[string]DependencyInfoLicense{
+		`{"license": "(MIT OR Apache-2.0)"}`:                         "(MIT OR Apache-2.0)",
+		`{"license": {"type": "ISC", "url": "https://example.com"}}`: "ISC",
+		`{"license": ["MIT"]}`:                                       "",
+		`{}`:                                                         "",
+	} {
+		var info DependencyInfo
+		require.NoError(t, json.Unmarshal([]byte(input), &info))
+		require.Equal(t, expected, info.License, input)
+	}
+}
+
 func TestFetchSources(t *testing.T) {
 	ctx := context.Background()
 	client, stop := newTestHTTPClient(t)
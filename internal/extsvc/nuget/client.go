@@ -0,0 +1,196 @@
+// Package nuget is a client for NuGet V3 feeds, such as nuget.org.
+//
+// Packages are downloaded from the package content resource of a feed, which
+// is found in its service index as described in
+// https://learn.microsoft.com/en-us/nuget/api/service-index and
+// https://learn.microsoft.com/en-us/nuget/api/package-base-address-resource.
+package nuget
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// DefaultServiceIndexURL is the URL of the service index of nuget.org, which
+// is used when no feed is configured.
+const DefaultServiceIndexURL = "https://api.nuget.org/v3/index.json"
+
+// packageBaseAddressType is the type of the package content resource in a
+// service index.
+const packageBaseAddressType = "PackageBaseAddress/3.0.0"
+
+type Client struct {
+	// serviceIndexURL is the URL of the service index of the feed, e.g.
+	// https://api.nuget.org/v3/index.json. It can include credentials.
+	serviceIndexURL string
+
+	uncachedClient httpcli.Doer
+	cachedClient   httpcli.Doer
+
+	// Self-imposed rate-limiter.
+	limiter *ratelimit.InstrumentedLimiter
+}
+
+func NewClient(urn string, serviceIndexURL string, httpfactory *httpcli.Factory) (*Client, error) {
+	uncached, err := httpfactory.Doer(httpcli.NewCachedTransportOpt(httpcli.NoopCache{}, false))
+	if err != nil {
+		return nil, err
+	}
+	cached, err := httpfactory.Doer()
+	if err != nil {
+		return nil, err
+	}
+	if serviceIndexURL == "" {
+		serviceIndexURL = DefaultServiceIndexURL
+	}
+	return &Client{
+		serviceIndexURL: serviceIndexURL,
+		uncachedClient:  uncached,
+		cachedClient:    cached,
+		limiter:         ratelimit.DefaultRegistry.Get(urn),
+	}, nil
+}
+
+// GetPackageContents returns the .nupkg archive of the given package version.
+func (c *Client) GetPackageContents(ctx context.Context, dep reposource.VersionedPackage) (body io.ReadCloser, err error) {
+	baseAddress, err := c.packageBaseAddress(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Package IDs are case-insensitive, the package content resource expects
+	// them in lower case.
+	id := strings.ToLower(string(dep.PackageSyntax()))
+	version := NormalizeVersion(dep.PackageVersion())
+	nupkgURL := fmt.Sprintf("%s/%s/%s/%s.%s.nupkg", strings.TrimSuffix(baseAddress, "/"), id, version, id, version)
+
+	return c.get(ctx, c.uncachedClient, nupkgURL)
+}
+
+// serviceIndex is the subset of a service index we use.
+type serviceIndex struct {
+	Resources []struct {
+		ID   string `json:"@id"`
+		Type string `json:"@type"`
+	} `json:"resources"`
+}
+
+// packageBaseAddress returns the URL of the package content resource of the
+// feed.
+func (c *Client) packageBaseAddress(ctx context.Context) (string, error) {
+	body, err := c.get(ctx, c.cachedClient, c.serviceIndexURL)
+	if err != nil {
+		return "", errors.Wrap(err, "fetching NuGet service index")
+	}
+	defer body.Close()
+
+	var index serviceIndex
+	if err := json.NewDecoder(body).Decode(&index); err != nil {
+		return "", errors.Wrap(err, "decoding NuGet service index")
+	}
+	for _, r := range index.Resources {
+		if r.Type == packageBaseAddressType {
+			return r.ID, nil
+		}
+	}
+	return "", errors.Newf("NuGet service index %q has no %s resource", redactedURL(c.serviceIndexURL), packageBaseAddressType)
+}
+
+func (c *Client) get(ctx context.Context, doer httpcli.Doer, rawURL string) (io.ReadCloser, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("User-Agent", "sourcegraph-nuget-syncer (sourcegraph.com)")
+
+	// The resources of a private feed are usually served by the same host as
+	// its service index, and need the same credentials.
+	if u, err := url.Parse(c.serviceIndexURL); err == nil && u.User != nil && u.Host == req.URL.Host && req.URL.User == nil {
+		password, _ := u.User.Password()
+		req.SetBasicAuth(u.User.Username(), password)
+	}
+
+	return c.do(doer, req)
+}
+
+// NormalizeVersion returns the normalized form of a NuGet version, which is
+// used in the URLs of the package content resource. See
+// https://learn.microsoft.com/en-us/nuget/concepts/package-versioning#normalized-version-numbers
+func NormalizeVersion(version string) string {
+	version, _, _ = strings.Cut(version, "+")
+	release, prerelease, isPrerelease := strings.Cut(version, "-")
+
+	parts := strings.Split(release, ".")
+	for i, part := range parts {
+		// Leading zeroes are removed.
+		if n, err := strconv.Atoi(part); err == nil {
+			parts[i] = strconv.Itoa(n)
+		}
+	}
+	for len(parts) < 3 {
+		parts = append(parts, "0")
+	}
+	if len(parts) == 4 && parts[3] == "0" {
+		parts = parts[:3]
+	}
+
+	normalized := strings.Join(parts, ".")
+	if isPrerelease {
+		normalized += "-" + prerelease
+	}
+	return strings.ToLower(normalized)
+}
+
+func redactedURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return u.Redacted()
+}
+
+type Error struct {
+	path    string
+	code    int
+	message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("bad response with status code %d for %s: %s", e.code, e.path, e.message)
+}
+
+func (e *Error) NotFound() bool {
+	return e.code == http.StatusNotFound
+}
+
+func (c *Client) do(doer httpcli.Doer, req *http.Request) (io.ReadCloser, error) {
+	resp, err := doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+
+		bs, err := io.ReadAll(resp.Body)
+		if err != nil {
+			bs = []byte(errors.Wrap(err, "failed to read body").Error())
+		}
+		return nil, &Error{path: req.URL.Path, code: resp.StatusCode, message: string(bs)}
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,71 @@
+package nuget
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+)
+
+func TestGetPackageContents(t *testing.T) {
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, _ := r.BasicAuth(); user != "admin" || pass != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/v3/index.json":
+			fmt.Fprintf(w, `{"version": "3.0.0", "resources": [
+				{"@id": "%[1]s/query", "@type": "SearchQueryService"},
+				{"@id": "%[1]s/v3-flatcontainer/", "@type": "PackageBaseAddress/3.0.0"}
+			]}`, srv.URL)
+		case "/v3-flatcontainer/newtonsoft.json/13.0.3/newtonsoft.json.13.0.3.nupkg":
+			_, _ = w.Write([]byte("nupkg"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	indexURL := strings.Replace(srv.URL, "http://", "http://admin:secret@", 1) + "/v3/index.json"
+	client, err := NewClient("nuget_urn", indexURL, httpcli.NewFactory(nil))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	body, err := client.GetPackageContents(ctx, reposource.ParseNuGetVersionedPackage("Newtonsoft.Json@13.0.3"))
+	require.NoError(t, err)
+	defer body.Close()
+	contents, err := io.ReadAll(body)
+	require.NoError(t, err)
+	require.Equal(t, "nupkg", string(contents))
+
+	_, err = client.GetPackageContents(ctx, reposource.ParseNuGetVersionedPackage("Newtonsoft.Json@0.0.1"))
+	var nugetErr *Error
+	require.ErrorAs(t, err, &nugetErr)
+	require.True(t, nugetErr.NotFound())
+}
+
+func TestNormalizeVersion(t *testing.T) {
+	for version, want := range map[string]string{
+		"13.0.3":           "13.0.3",
+		"1.0":              "1.0.0",
+		"1.00.01":          "1.0.1",
+		"1.0.0.0":          "1.0.0",
+		"1.0.0.4":          "1.0.0.4",
+		"1.0.0-Beta.1":     "1.0.0-beta.1",
+		"2.1.0+build.1234": "2.1.0",
+	} {
+		if got := NormalizeVersion(version); got != want {
+			t.Errorf("NormalizeVersion(%q) = %q, want %q", version, got, want)
+		}
+	}
+}
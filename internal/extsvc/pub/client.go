@@ -0,0 +1,163 @@
+// Package pub is a client for hosted Pub repositories, such as pub.dev, as
+// described in https://github.com/dart-lang/pub/blob/master/doc/repository-spec-v2.md.
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// DefaultRepositoryURL is the URL of pub.dev, which is used when no repository
+// is configured.
+const DefaultRepositoryURL = "https://pub.dev"
+
+type Client struct {
+	// repositoryURL is the hosted URL of the repository, e.g. https://pub.dev.
+	// It can include credentials.
+	repositoryURL string
+
+	uncachedClient httpcli.Doer
+	cachedClient   httpcli.Doer
+
+	// Self-imposed rate-limiter.
+	limiter *ratelimit.InstrumentedLimiter
+}
+
+func NewClient(urn string, repositoryURL string, httpfactory *httpcli.Factory) (*Client, error) {
+	uncached, err := httpfactory.Doer(httpcli.NewCachedTransportOpt(httpcli.NoopCache{}, false))
+	if err != nil {
+		return nil, err
+	}
+	cached, err := httpfactory.Doer()
+	if err != nil {
+		return nil, err
+	}
+	if repositoryURL == "" {
+		repositoryURL = DefaultRepositoryURL
+	}
+	return &Client{
+		repositoryURL:  repositoryURL,
+		uncachedClient: uncached,
+		cachedClient:   cached,
+		limiter:        ratelimit.DefaultRegistry.Get(urn),
+	}, nil
+}
+
+// Version is a version of a package, as listed by the repository.
+type Version struct {
+	Version    string `json:"version"`
+	ArchiveURL string `json:"archive_url"`
+}
+
+// Package is the listing of the versions of a package.
+type Package struct {
+	Name     string    `json:"name"`
+	Versions []Version `json:"versions"`
+}
+
+// Package returns the versions of the given package.
+func (c *Client) Package(ctx context.Context, name reposource.PackageName) (*Package, error) {
+	u := fmt.Sprintf("%s/api/packages/%s", strings.TrimSuffix(c.repositoryURL, "/"), url.PathEscape(string(name)))
+
+	body, err := c.get(ctx, c.cachedClient, u)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var pkg Package
+	if err := json.NewDecoder(body).Decode(&pkg); err != nil {
+		return nil, errors.Wrapf(err, "decoding versions of Pub package %q", name)
+	}
+	return &pkg, nil
+}
+
+// GetPackageContents returns the .tar.gz archive of the given package version.
+func (c *Client) GetPackageContents(ctx context.Context, dep reposource.VersionedPackage) (body io.ReadCloser, err error) {
+	pkg, err := c.Package(ctx, dep.PackageSyntax())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range pkg.Versions {
+		if v.Version != dep.PackageVersion() {
+			continue
+		}
+		// archive_url is absolute in the specification, but we resolve it in
+		// case a repository uses a relative URL.
+		base, err := url.Parse(c.repositoryURL)
+		if err != nil {
+			return nil, err
+		}
+		archiveURL, err := base.Parse(v.ArchiveURL)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid archive URL %q", v.ArchiveURL)
+		}
+		return c.get(ctx, c.uncachedClient, archiveURL.String())
+	}
+
+	return nil, &Error{path: dep.VersionedPackageSyntax(), code: http.StatusNotFound, message: "version not found"}
+}
+
+func (c *Client) get(ctx context.Context, doer httpcli.Doer, rawURL string) (io.ReadCloser, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("User-Agent", "sourcegraph-pub-syncer (sourcegraph.com)")
+	req.Header.Add("Accept", "application/vnd.pub.v2+json")
+
+	// Archives of a private repository are usually served by the same host as
+	// its API, and need the same credentials.
+	if u, err := url.Parse(c.repositoryURL); err == nil && u.User != nil && u.Host == req.URL.Host && req.URL.User == nil {
+		password, _ := u.User.Password()
+		req.SetBasicAuth(u.User.Username(), password)
+	}
+
+	return c.do(doer, req)
+}
+
+type Error struct {
+	path    string
+	code    int
+	message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("bad response with status code %d for %s: %s", e.code, e.path, e.message)
+}
+
+func (e *Error) NotFound() bool {
+	return e.code == http.StatusNotFound
+}
+
+func (c *Client) do(doer httpcli.Doer, req *http.Request) (io.ReadCloser, error) {
+	resp, err := doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+
+		bs, err := io.ReadAll(resp.Body)
+		if err != nil {
+			bs = []byte(errors.Wrap(err, "failed to read body").Error())
+		}
+		return nil, &Error{path: req.URL.Path, code: resp.StatusCode, message: string(bs)}
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,57 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+)
+
+func TestGetPackageContents(t *testing.T) {
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/packages/http":
+			if r.Header.Get("Accept") != "application/vnd.pub.v2+json" {
+				w.WriteHeader(http.StatusNotAcceptable)
+				return
+			}
+			fmt.Fprintf(w, `{"name": "http", "versions": [
+				{"version": "1.0.0", "archive_url": "%[1]s/archives/http-1.0.0.tar.gz"},
+				{"version": "1.1.0", "archive_url": "/archives/http-1.1.0.tar.gz"}
+			]}`, srv.URL)
+		case "/archives/http-1.0.0.tar.gz", "/archives/http-1.1.0.tar.gz":
+			_, _ = w.Write([]byte(r.URL.Path))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient("pub_urn", srv.URL, httpcli.NewFactory(nil))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	for _, version := range []string{"1.0.0", "1.1.0"} {
+		body, err := client.GetPackageContents(ctx, reposource.ParsePubVersionedPackage("http@"+version))
+		require.NoError(t, err)
+		contents, err := io.ReadAll(body)
+		body.Close()
+		require.NoError(t, err)
+		require.Equal(t, "/archives/http-"+version+".tar.gz", string(contents))
+	}
+
+	for _, dep := range []string{"http@0.0.1", "unknown@1.0.0"} {
+		_, err = client.GetPackageContents(ctx, reposource.ParsePubVersionedPackage(dep))
+		var pubErr *Error
+		require.ErrorAs(t, err, &pubErr)
+		require.True(t, pubErr.NotFound(), dep)
+	}
+}
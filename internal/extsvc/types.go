@@ -212,6 +212,23 @@ const (
 
 	// VariantLocalGit is the (api.ExternalRepoSpec).ServiceType for local git repositories
 	VariantLocalGit
+
+	// VariantSubversion is the (api.ExternalRepoSpec).ServiceType value for Subversion repositories. The
+	// ServiceID value is the root URL of the Subversion server.
+	VariantSubversion
+
+	// VariantMercurial is the (api.ExternalRepoSpec).ServiceType value for Mercurial repositories. The
+	// ServiceID value is the root URL of the Mercurial repositories.
+	VariantMercurial
+
+	// VariantNuGetPackages is the (api.ExternalRepoSpec).ServiceType value for NuGet packages (.NET ecosystem libraries).
+	VariantNuGetPackages
+
+	// VariantHexPackages is the (api.ExternalRepoSpec).ServiceType value for Hex packages (Elixir/Erlang ecosystem libraries).
+	VariantHexPackages
+
+	// VariantPubPackages is the (api.ExternalRepoSpec).ServiceType value for Pub packages (Dart/Flutter ecosystem libraries).
+	VariantPubPackages
 )
 
 type variantValues struct {
@@ -232,17 +249,22 @@ var variantValuesMap = map[Variant]variantValues{
 	VariantGitLab:          {AsKind: "GITLAB", AsType: "gitlab", ConfigPrototype: func() any { return &schema.GitLabConnection{} }, WebhookURLPath: "gitlab-webhooks", SupportsRepoExclusion: true},
 	VariantGitolite:        {AsKind: "GITOLITE", AsType: "gitolite", ConfigPrototype: func() any { return &schema.GitoliteConnection{} }, SupportsRepoExclusion: true},
 	VariantGoPackages:      {AsKind: "GOMODULES", AsType: "goModules", ConfigPrototype: func() any { return &schema.GoModulesConnection{} }},
+	VariantHexPackages:     {AsKind: "HEXPACKAGES", AsType: "hexPackages", ConfigPrototype: func() any { return &schema.HexPackagesConnection{} }},
 	VariantJVMPackages:     {AsKind: "JVMPACKAGES", AsType: "jvmPackages", ConfigPrototype: func() any { return &schema.JVMPackagesConnection{} }},
 	VariantNpmPackages:     {AsKind: "NPMPACKAGES", AsType: "npmPackages", ConfigPrototype: func() any { return &schema.NpmPackagesConnection{} }},
+	VariantNuGetPackages:   {AsKind: "NUGETPACKAGES", AsType: "nugetPackages", ConfigPrototype: func() any { return &schema.NuGetPackagesConnection{} }},
 	VariantOther:           {AsKind: "OTHER", AsType: "other", ConfigPrototype: func() any { return &schema.OtherExternalServiceConnection{} }},
 	VariantPagure:          {AsKind: "PAGURE", AsType: "pagure", ConfigPrototype: func() any { return &schema.PagureConnection{} }},
 	VariantPerforce:        {AsKind: "PERFORCE", AsType: "perforce", ConfigPrototype: func() any { return &schema.PerforceConnection{} }},
 	VariantPhabricator:     {AsKind: "PHABRICATOR", AsType: "phabricator", ConfigPrototype: func() any { return &schema.PhabricatorConnection{} }},
+	VariantPubPackages:     {AsKind: "PUBPACKAGES", AsType: "pubPackages", ConfigPrototype: func() any { return &schema.PubPackagesConnection{} }},
 	VariantPythonPackages:  {AsKind: "PYTHONPACKAGES", AsType: "pythonPackages", ConfigPrototype: func() any { return &schema.PythonPackagesConnection{} }},
 	VariantRubyPackages:    {AsKind: "RUBYPACKAGES", AsType: "rubyPackages", ConfigPrototype: func() any { return &schema.RubyPackagesConnection{} }},
 	VariantRustPackages:    {AsKind: "RUSTPACKAGES", AsType: "rustPackages", ConfigPrototype: func() any { return &schema.RustPackagesConnection{} }},
 	VariantSCIM:            {AsKind: "SCIM", AsType: "scim"},
 	VariantLocalGit:        {AsKind: "LOCALGIT", AsType: "localgit", ConfigPrototype: func() any { return &schema.LocalGitExternalService{} }},
+	VariantSubversion:      {AsKind: "SUBVERSION", AsType: "subversion", ConfigPrototype: func() any { return &schema.SubversionConnection{} }},
+	VariantMercurial:       {AsKind: "MERCURIAL", AsType: "mercurial", ConfigPrototype: func() any { return &schema.MercurialConnection{} }},
 }
 
 func (v Variant) AsKind() string {
@@ -305,6 +327,9 @@ var (
 	KindPythonPackages  = VariantPythonPackages.AsKind()
 	KindRustPackages    = VariantRustPackages.AsKind()
 	KindRubyPackages    = VariantRubyPackages.AsKind()
+	KindNuGetPackages   = VariantNuGetPackages.AsKind()
+	KindHexPackages     = VariantHexPackages.AsKind()
+	KindPubPackages     = VariantPubPackages.AsKind()
 	KindNpmPackages     = VariantNpmPackages.AsKind()
 	KindPagure          = VariantPagure.AsKind()
 	KindAzureDevOps     = VariantAzureDevOps.AsKind()
@@ -373,6 +398,15 @@ var (
 	// TypeRubyPackages is the (api.ExternalRepoSpec).ServiceType value for Ruby packages.
 	TypeRubyPackages = VariantRubyPackages.AsType()
 
+	// TypeNuGetPackages is the (api.ExternalRepoSpec).ServiceType value for NuGet packages.
+	TypeNuGetPackages = VariantNuGetPackages.AsType()
+
+	// TypeHexPackages is the (api.ExternalRepoSpec).ServiceType value for Hex packages.
+	TypeHexPackages = VariantHexPackages.AsType()
+
+	// TypePubPackages is the (api.ExternalRepoSpec).ServiceType value for Pub packages.
+	TypePubPackages = VariantPubPackages.AsType()
+
 	// TypeOther is the (api.ExternalRepoSpec).ServiceType value for other projects.
 	TypeOther = VariantOther.AsType()
 )
@@ -698,6 +732,26 @@ func GetLimitFromConfig(kind string, config any) (rate.Limit, error) {
 		if c != nil && c.RateLimit != nil {
 			limit = limitOrInf(c.RateLimit.Enabled, c.RateLimit.RequestsPerHour)
 		}
+	case *schema.NuGetPackagesConnection:
+		// Packages are downloaded from the flat container of nuget.org, which
+		// is served by a CDN without a documented rate limit.
+		limit = rate.Limit(36000.0 / 3600.0) // Same as default in nuget-packages.schema.json
+		if c != nil && c.RateLimit != nil {
+			limit = limitOrInf(c.RateLimit.Enabled, c.RateLimit.RequestsPerHour)
+		}
+	case *schema.HexPackagesConnection:
+		// Tarballs are downloaded from repo.hex.pm, which is served by a CDN
+		// without a documented rate limit, unlike the hex.pm API.
+		limit = rate.Limit(36000.0 / 3600.0) // Same as default in hex-packages.schema.json
+		if c != nil && c.RateLimit != nil {
+			limit = limitOrInf(c.RateLimit.Enabled, c.RateLimit.RequestsPerHour)
+		}
+	case *schema.PubPackagesConnection:
+		// pub.dev doesn't document an enforced req/s rate limit.
+		limit = rate.Limit(36000.0 / 3600.0) // Same as default in pub-packages.schema.json
+		if c != nil && c.RateLimit != nil {
+			limit = limitOrInf(c.RateLimit.Enabled, c.RateLimit.RequestsPerHour)
+		}
 	default:
 		return limit, ErrRateLimitUnsupported{codehostKind: kind}
 	}
@@ -764,6 +818,23 @@ type LocalGitMetadata struct {
 	AbsRepoPath string
 }
 
+type SubversionRepoMetadata struct {
+	// Path is the path of the repository relative to the root URL of the
+	// Subversion server, which is the ServiceID.
+	Path string
+	// Layout is the layout of the repository, either "standard" or "none".
+	Layout string
+}
+
+type MercurialRepoMetadata struct {
+	// Path is the path of the repository relative to the root URL of the
+	// Mercurial repositories, which is the ServiceID.
+	Path string
+	// Description is the description of the repository, if it was listed by
+	// an hgweb server.
+	Description string
+}
+
 func UniqueEncryptableCodeHostIdentifier(ctx context.Context, kind string, config *EncryptableConfig) (string, error) {
 	cfg, err := ParseEncryptableConfig(ctx, kind, config)
 	if err != nil {
@@ -834,8 +905,18 @@ func uniqueCodeHostIdentifier(kind string, cfg any) (string, error) {
 		return VariantRustPackages.AsKind(), nil
 	case *schema.RubyPackagesConnection:
 		return VariantRubyPackages.AsKind(), nil
+	case *schema.NuGetPackagesConnection:
+		return VariantNuGetPackages.AsKind(), nil
+	case *schema.HexPackagesConnection:
+		return VariantHexPackages.AsKind(), nil
+	case *schema.PubPackagesConnection:
+		return VariantPubPackages.AsKind(), nil
 	case *schema.PagureConnection:
 		rawURL = c.Url
+	case *schema.SubversionConnection:
+		rawURL = c.Url
+	case *schema.MercurialConnection:
+		rawURL = c.Url
 	case *schema.LocalGitExternalService:
 		return VariantLocalGit.AsKind(), nil
 	default:
@@ -76,6 +76,15 @@ func TestVariantConfigPrototypePointers(t *testing.T) {
 	if y, ok := VariantRustPackages.ConfigPrototype().(*schema.RustPackagesConnection); !ok {
 		t.Errorf("wrong type for Rust Packages configuration prototype: %T", y)
 	}
+	if y, ok := VariantNuGetPackages.ConfigPrototype().(*schema.NuGetPackagesConnection); !ok {
+		t.Errorf("wrong type for NuGet Packages configuration prototype: %T", y)
+	}
+	if y, ok := VariantHexPackages.ConfigPrototype().(*schema.HexPackagesConnection); !ok {
+		t.Errorf("wrong type for Hex Packages configuration prototype: %T", y)
+	}
+	if y, ok := VariantPubPackages.ConfigPrototype().(*schema.PubPackagesConnection); !ok {
+		t.Errorf("wrong type for Pub Packages configuration prototype: %T", y)
+	}
 }
 
 func TestExtractToken(t *testing.T) {
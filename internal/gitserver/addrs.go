@@ -31,14 +31,15 @@ var addrForRepoInvoked = promauto.NewCounterVec(prometheus.CounterOpts{
 	Help: "Number of times gitserver.AddrForRepo was invoked",
 }, []string{"user_agent"})
 
-// NewGitserverAddressesFromConf fetches the current set of gitserver addresses
-// and pinned repos for gitserver.
+// NewGitserverAddressesFromConf fetches the current set of gitserver addresses,
+// pinned repos and replication factor for gitserver.
 func NewGitserverAddressesFromConf(cfg *conf.Unified) GitserverAddresses {
 	addrs := GitserverAddresses{
 		Addresses: cfg.ServiceConnectionConfig.GitServers,
 	}
 	if cfg.ExperimentalFeatures != nil {
 		addrs.PinnedServers = cfg.ExperimentalFeatures.GitServerPinnedRepos
+		addrs.ReplicationFactor = cfg.ExperimentalFeatures.GitServerReplicationFactor
 	}
 	return addrs
 }
@@ -51,6 +52,10 @@ type TestClientSourceOptions struct {
 	// Logger is the log.Logger instance that the test ClientSource will use to
 	// log various metadata to.
 	Logger log.Logger
+
+	// ReplicationFactor is the number of gitservers which store a copy of each
+	// repo. ClientFunc is not used for repos with more than one replica.
+	ReplicationFactor int
 }
 
 func NewTestClientSource(t *testing.T, addrs []string, options ...func(o *TestClientSourceOptions)) ClientSource {
@@ -83,7 +88,8 @@ func NewTestClientSource(t *testing.T, addrs []string, options ...func(o *TestCl
 	source := testGitserverConns{
 		conns: &GitserverConns{
 			GitserverAddresses: GitserverAddresses{
-				Addresses: addrs,
+				Addresses:         addrs,
+				ReplicationFactor: opts.ReplicationFactor,
 			},
 			grpcConns: conns,
 		},
@@ -107,6 +113,12 @@ func (c *testGitserverConns) AddrForRepo(userAgent string, repo api.RepoName) st
 	return c.conns.AddrForRepo(userAgent, repo)
 }
 
+// AddrsForRepo returns the addresses of the gitservers which store a replica of
+// the given repo name.
+func (c *testGitserverConns) AddrsForRepo(userAgent string, repo api.RepoName) []string {
+	return c.conns.AddrsForRepo(userAgent, repo)
+}
+
 // Addresses returns the current list of gitserver addresses.
 func (c *testGitserverConns) Addresses() []AddressWithClient {
 	return c.testAddresses
@@ -114,12 +126,15 @@ func (c *testGitserverConns) Addresses() []AddressWithClient {
 
 // ClientForRepo returns a client or host for the given repo name.
 func (c *testGitserverConns) ClientForRepo(userAgent string, repo api.RepoName) (proto.GitserverServiceClient, error) {
-	conn, err := c.conns.ConnForRepo(userAgent, repo)
+	conns, err := c.conns.connsForRepo(userAgent, repo)
 	if err != nil {
 		return nil, err
 	}
+	if len(conns) > 1 {
+		return proto.NewGitserverServiceClient(&failoverConn{conns: conns}), nil
+	}
 
-	return c.clientFunc(conn), nil
+	return c.clientFunc(conns[0]), nil
 }
 
 func (c *testGitserverConns) ConnForRepo(userAgent string, repo api.RepoName) (*grpc.ClientConn, error) {
@@ -154,6 +169,10 @@ type GitserverAddresses struct {
 	// ensures that, even if the number of gitservers changes, these repos will
 	// not be moved.
 	PinnedServers map[string]string
+
+	// The number of gitserver instances which store a copy of each repo. Zero
+	// and one mean that repos are not replicated.
+	ReplicationFactor int
 }
 
 // AddrForRepo returns the gitserver address to use for the given repo name.
@@ -170,6 +189,32 @@ func (g GitserverAddresses) AddrForRepo(userAgent string, repo api.RepoName) str
 	return addrForKey(rs, g.Addresses)
 }
 
+// AddrsForRepo returns the addresses of the gitservers which store a replica of
+// the given repo name. The first address is the primary, as returned by
+// AddrForRepo, and is followed by the next ReplicationFactor-1 addresses in the
+// list of gitserver addresses. Pinned repos are not replicated.
+func (g GitserverAddresses) AddrsForRepo(userAgent string, repo api.RepoName) []string {
+	primary := g.AddrForRepo(userAgent, repo)
+	if g.ReplicationFactor <= 1 {
+		return []string{primary}
+	}
+	if _, ok := g.PinnedServers[string(protocol.NormalizeRepo(repo))]; ok {
+		return []string{primary}
+	}
+
+	i := slices.Index(g.Addresses, primary)
+
+	n := g.ReplicationFactor
+	if n > len(g.Addresses) {
+		n = len(g.Addresses)
+	}
+	addrs := make([]string, 0, n)
+	for j := 0; j < n; j++ {
+		addrs = append(addrs, g.Addresses[(i+j)%len(g.Addresses)])
+	}
+	return addrs
+}
+
 // addrForKey returns the gitserver address to use for the given string key,
 // which is hashed for sharding purposes.
 func addrForKey(key string, addrs []string) string {
@@ -193,6 +238,32 @@ func (g *GitserverConns) ConnForRepo(userAgent string, repo api.RepoName) (*grpc
 	return ce.conn, ce.err
 }
 
+// connsForRepo returns the connections to the gitservers which store a replica
+// of the given repo, starting with the primary. Replicas without a connection
+// are skipped.
+func (g *GitserverConns) connsForRepo(userAgent string, repo api.RepoName) ([]*grpc.ClientConn, error) {
+	addrs := g.AddrsForRepo(userAgent, repo)
+	conns := make([]*grpc.ClientConn, 0, len(addrs))
+	var firstErr error
+	for _, addr := range addrs {
+		ce, ok := g.grpcConns[addr]
+		if !ok {
+			ce.err = errors.Newf("no gRPC connection found for address %q", addr)
+		}
+		if ce.err != nil {
+			if firstErr == nil {
+				firstErr = ce.err
+			}
+			continue
+		}
+		conns = append(conns, ce.conn)
+	}
+	if len(conns) == 0 {
+		return nil, firstErr
+	}
+	return conns, nil
+}
+
 // AddressWithClient is a gitserver address with a client.
 type AddressWithClient interface {
 	Address() string                                   // returns the address of the endpoint that this GRPC client is targeting
@@ -222,12 +293,19 @@ func (a *atomicGitServerConns) AddrForRepo(userAgent string, repo api.RepoName)
 	return a.get().AddrForRepo(userAgent, repo)
 }
 
+func (a *atomicGitServerConns) AddrsForRepo(userAgent string, repo api.RepoName) []string {
+	return a.get().AddrsForRepo(userAgent, repo)
+}
+
 func (a *atomicGitServerConns) ClientForRepo(userAgent string, repo api.RepoName) (proto.GitserverServiceClient, error) {
-	conn, err := a.get().ConnForRepo(userAgent, repo)
+	conns, err := a.get().connsForRepo(userAgent, repo)
 	if err != nil {
 		return nil, err
 	}
-	return proto.NewGitserverServiceClient(conn), nil
+	if len(conns) > 1 {
+		return proto.NewGitserverServiceClient(&failoverConn{conns: conns}), nil
+	}
+	return proto.NewGitserverServiceClient(conns[0]), nil
 }
 
 func (a *atomicGitServerConns) ConnForRepo(userAgent string, repo api.RepoName) (*grpc.ClientConn, error) {
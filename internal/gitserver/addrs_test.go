@@ -3,6 +3,8 @@ package gitserver
 import (
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
 	"github.com/sourcegraph/sourcegraph/internal/api"
 )
 
@@ -50,3 +52,68 @@ func TestAddrForRepo(t *testing.T) {
 		})
 	}
 }
+
+func TestAddrsForRepo(t *testing.T) {
+	addrs := []string{"gitserver-1", "gitserver-2", "gitserver-3"}
+	pinned := map[string]string{
+		"repo2": "gitserver-1",
+		"repo3": "gitserver-4",
+	}
+
+	testCases := []struct {
+		name              string
+		repo              api.RepoName
+		replicationFactor int
+		want              []string
+	}{
+		{
+			name: "not replicated",
+			repo: api.RepoName("repo1"),
+			want: []string{"gitserver-3"},
+		},
+		{
+			name:              "replicas follow the primary",
+			repo:              api.RepoName("repo1"),
+			replicationFactor: 2,
+			want:              []string{"gitserver-3", "gitserver-1"},
+		},
+		{
+			name:              "another repo",
+			repo:              api.RepoName("github.com/sourcegraph/sourcegraph.git"),
+			replicationFactor: 2,
+			want:              []string{"gitserver-2", "gitserver-3"},
+		},
+		{
+			name:              "more replicas than gitservers",
+			repo:              api.RepoName("repo1"),
+			replicationFactor: 5,
+			want:              []string{"gitserver-3", "gitserver-1", "gitserver-2"},
+		},
+		{
+			name:              "pinned repo",
+			repo:              api.RepoName("repo2"),
+			replicationFactor: 2,
+			want:              []string{"gitserver-1"},
+		},
+		{
+			name:              "repo pinned to an unknown gitserver",
+			repo:              api.RepoName("repo3"),
+			replicationFactor: 2,
+			want:              []string{"gitserver-4"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ga := GitserverAddresses{
+				Addresses:         addrs,
+				PinnedServers:     pinned,
+				ReplicationFactor: tc.replicationFactor,
+			}
+			got := ga.AddrsForRepo("gitserver", tc.repo)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Fatalf("unexpected addresses (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
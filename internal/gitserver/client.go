@@ -77,6 +77,9 @@ type ClientSource interface {
 	ConnForRepo(userAgent string, repo api.RepoName) (*grpc.ClientConn, error)
 	// AddrForRepo returns the address of the gitserver for the given repo.
 	AddrForRepo(userAgent string, repo api.RepoName) string
+	// AddrsForRepo returns the addresses of the gitservers which store a
+	// replica of the given repo, starting with AddrForRepo.
+	AddrsForRepo(userAgent string, repo api.RepoName) []string
 	// Address the current list of gitserver addresses.
 	Addresses() []AddressWithClient
 }
@@ -247,6 +250,12 @@ type Client interface {
 	// If possible, the error returned will be of type protocol.CreateCommitFromPatchError
 	CreateCommitFromPatch(context.Context, protocol.CreateCommitFromPatchRequest) (*protocol.CreateCommitFromPatchResponse, error)
 
+	// CreateCommitFromFileOperations will attempt to create a commit by applying
+	// file operations to a base commit. The commit is pushed or shelved the same
+	// way as in CreateCommitFromPatch.
+	// If possible, the error returned will be of type protocol.CreateCommitFromPatchError
+	CreateCommitFromFileOperations(context.Context, protocol.CreateCommitFromFileOperationsRequest) (*protocol.CreateCommitFromPatchResponse, error)
+
 	// GetDefaultBranch returns the name of the default branch and the commit it's
 	// currently at from the given repository. If short is true, then `main` instead
 	// of `refs/heads/main` would be returned.
@@ -288,6 +297,15 @@ type Client interface {
 
 	RepoCloneProgress(context.Context, ...api.RepoName) (*protocol.RepoCloneProgressResponse, error)
 
+	// RepoSizeBreakdown returns the disk usage of the repository broken down by
+	// the kind of data stored, and its disk quota.
+	RepoSizeBreakdown(context.Context, api.RepoName) (*protocol.RepoSizeBreakdown, error)
+
+	// RepoMaintenance returns the maintenance status of the repository on its
+	// primary gitserver. If trigger is true, maintenance of the repository is
+	// scheduled ahead of all other repositories first.
+	RepoMaintenance(ctx context.Context, repo api.RepoName, trigger bool) (*protocol.RepoMaintenance, error)
+
 	// ResolveRevision will return the absolute commit for a commit-ish spec. If spec is empty, HEAD is
 	// used.
 	//
@@ -371,6 +389,10 @@ type Client interface {
 	// file is read. (If you just need to check a file's existence, use Stat, not ReadFile.)
 	ReadFile(ctx context.Context, checker authz.SubRepoPermissionChecker, repo api.RepoName, commit api.CommitID, name string) ([]byte, error)
 
+	// ReadFileWithOptions is like ReadFile, with options for how the file is
+	// read.
+	ReadFileWithOptions(ctx context.Context, checker authz.SubRepoPermissionChecker, repo api.RepoName, commit api.CommitID, name string, opts FileReaderOptions) ([]byte, error)
+
 	// BranchesContaining returns a map from branch names to branch tip hashes for
 	// each branch containing the given commit.
 	BranchesContaining(ctx context.Context, checker authz.SubRepoPermissionChecker, repo api.RepoName, commit api.CommitID) ([]string, error)
@@ -398,6 +420,11 @@ type Client interface {
 	// error and zero-valued time.
 	CommitDate(ctx context.Context, checker authz.SubRepoPermissionChecker, repo api.RepoName, commit api.CommitID) (string, time.Time, bool, error)
 
+	// MercurialChangeset returns the hash of the Mercurial changeset the given
+	// commit was converted from. If the commit was not converted from a
+	// Mercurial repository, an empty string is returned along with a nil error.
+	MercurialChangeset(ctx context.Context, checker authz.SubRepoPermissionChecker, repo api.RepoName, commit api.CommitID) (string, error)
+
 	// CommitGraph returns the commit graph for the given repository as a mapping
 	// from a commit to its parents. If a commit is supplied, the returned graph will
 	// be rooted at the given commit. If a non-zero limit is supplied, at most that
@@ -477,6 +504,17 @@ type ArchiveOptions struct {
 	Treeish   string               // the tree or commit to produce an archive for
 	Format    ArchiveFormat        // format of the resulting archive (usually "tar" or "zip")
 	Pathspecs []gitdomain.Pathspec // if nonempty, only include these pathspecs.
+	SmudgeLFS bool                 // if true, include the content of Git LFS files instead of their pointer files, see FileReaderOptions.
+}
+
+// FileReaderOptions specifies options for reading files.
+type FileReaderOptions struct {
+	// SmudgeLFS returns the content of files tracked by Git LFS instead of
+	// their LFS pointer files, like git-lfs does when checking out files. This
+	// only applies to repositories whose LFS objects are fetched by gitserver,
+	// as set in the cloneOptions of their code host connection. Files larger
+	// than the size limit of LFS objects are still returned as pointer files.
+	SmudgeLFS bool
 }
 
 func (a *ArchiveOptions) Attrs() []attribute.KeyValue {
@@ -488,6 +526,7 @@ func (a *ArchiveOptions) Attrs() []attribute.KeyValue {
 		attribute.String("treeish", a.Treeish),
 		attribute.String("format", string(a.Format)),
 		attribute.StringSlice("pathspecs", specs),
+		attribute.Bool("smudgeLFS", a.SmudgeLFS),
 	}
 }
 
@@ -503,6 +542,7 @@ func (o *ArchiveOptions) FromProto(x *proto.ArchiveRequest) {
 		Treeish:   x.GetTreeish(),
 		Format:    ArchiveFormat(x.GetFormat()),
 		Pathspecs: pathSpecs,
+		SmudgeLFS: x.GetSmudgeLfs(),
 	}
 }
 
@@ -518,6 +558,7 @@ func (o *ArchiveOptions) ToProto(repo string) *proto.ArchiveRequest {
 		Treeish:   o.Treeish,
 		Format:    string(o.Format),
 		Pathspecs: protoPathSpecs,
+		SmudgeLfs: o.SmudgeLFS,
 	}
 }
 
@@ -568,6 +609,10 @@ func (c *clientImplementor) archiveURL(repo api.RepoName, opt ArchiveOptions) *u
 		q.Add("path", string(pathspec))
 	}
 
+	if opt.SmudgeLFS {
+		q.Set("lfs", "true")
+	}
+
 	addrForRepo := c.AddrForRepo(repo)
 	return &url.URL{
 		Scheme:   "http",
@@ -617,6 +662,7 @@ func (c *RemoteGitCommand) sendExec(ctx context.Context) (_ io.ReadCloser, err e
 			Args:           c.args[1:],
 			Stdin:          c.stdin,
 			NoTimeout:      c.noTimeout,
+			SmudgeLfs:      c.smudgeLFS,
 		}
 
 		stream, err := client.Exec(ctx, req)
@@ -642,6 +688,7 @@ func (c *RemoteGitCommand) sendExec(ctx context.Context) (_ io.ReadCloser, err e
 			Args:           c.args[1:],
 			Stdin:          c.stdin,
 			NoTimeout:      c.noTimeout,
+			SmudgeLFS:      c.smudgeLFS,
 		}
 		resp, err := c.execer.httpPost(ctx, repoName, "exec", req)
 		if err != nil {
@@ -1167,8 +1214,25 @@ func (c *clientImplementor) RequestRepoUpdate(ctx context.Context, repo api.Repo
 		Since: since,
 	}
 
+	// Every replica of the repo is updated. We return the response of the
+	// first replica which succeeded.
+	var info *protocol.RepoUpdateResponse
+	err := c.forEachReplica(repo, "RepoUpdate", func(addr string) error {
+		resp, err := c.requestRepoUpdate(ctx, addr, req)
+		if err == nil && info == nil {
+			info = resp
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+func (c *clientImplementor) requestRepoUpdate(ctx context.Context, addr string, req *protocol.RepoUpdateRequest) (*protocol.RepoUpdateResponse, error) {
 	if internalgrpc.IsGRPCEnabled(ctx) {
-		client, err := c.ClientForRepo(repo)
+		client, err := c.clientForAddr(addr)
 		if err != nil {
 			return nil, err
 		}
@@ -1184,7 +1248,11 @@ func (c *clientImplementor) RequestRepoUpdate(ctx context.Context, repo api.Repo
 		return &info, nil
 
 	} else {
-		resp, err := c.httpPost(ctx, repo, "repo-update", req)
+		b, err := json.Marshal(req)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.httpPostTo(ctx, req.Repo, addr, "repo-update", b)
 		if err != nil {
 			return nil, err
 		}
@@ -1203,10 +1271,26 @@ func (c *clientImplementor) RequestRepoUpdate(ctx context.Context, repo api.Repo
 	}
 }
 
-// RequestRepoClone requests that the gitserver does an asynchronous clone of the repository.
+// RequestRepoClone requests that the gitservers storing a replica of the
+// repository do an asynchronous clone of it.
 func (c *clientImplementor) RequestRepoClone(ctx context.Context, repo api.RepoName) (*protocol.RepoCloneResponse, error) {
+	var info *protocol.RepoCloneResponse
+	err := c.forEachReplica(repo, "RepoClone", func(addr string) error {
+		resp, err := c.requestRepoClone(ctx, repo, addr)
+		if err == nil && info == nil {
+			info = resp
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+func (c *clientImplementor) requestRepoClone(ctx context.Context, repo api.RepoName, addr string) (*protocol.RepoCloneResponse, error) {
 	if internalgrpc.IsGRPCEnabled(ctx) {
-		client, err := c.ClientForRepo(repo)
+		client, err := c.clientForAddr(addr)
 		if err != nil {
 			return nil, err
 		}
@@ -1226,10 +1310,13 @@ func (c *clientImplementor) RequestRepoClone(ctx context.Context, repo api.RepoN
 
 	} else {
 
-		req := &protocol.RepoCloneRequest{
+		b, err := json.Marshal(&protocol.RepoCloneRequest{
 			Repo: repo,
+		})
+		if err != nil {
+			return nil, err
 		}
-		resp, err := c.httpPost(ctx, repo, "repo-clone", req)
+		resp, err := c.httpPostTo(ctx, repo, addr, "repo-clone", b)
 		if err != nil {
 			return nil, err
 		}
@@ -1333,6 +1420,84 @@ func (e *RepoNotCloneableErr) Error() string {
 	return fmt.Sprintf("%s (name=%q notfound=%v) because %s", msg, e.repo, e.notFound, e.reason)
 }
 
+func (c *clientImplementor) RepoSizeBreakdown(ctx context.Context, repo api.RepoName) (*protocol.RepoSizeBreakdown, error) {
+	var resp protocol.RepoSizeBreakdown
+
+	if internalgrpc.IsGRPCEnabled(ctx) {
+		client, err := c.ClientForRepo(repo)
+		if err != nil {
+			return nil, err
+		}
+
+		r, err := client.RepoSizeBreakdown(ctx, &proto.RepoSizeBreakdownRequest{
+			Repo: string(repo),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		resp.FromProto(r)
+		return &resp, nil
+	}
+
+	req := &protocol.RepoSizeBreakdownRequest{
+		Repo: repo,
+	}
+	r, err := c.httpPost(ctx, repo, "repo-size-breakdown", req)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	if r.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("gitserver error (status code %d): %s", r.StatusCode, readResponseBody(r.Body))
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *clientImplementor) RepoMaintenance(ctx context.Context, repo api.RepoName, trigger bool) (*protocol.RepoMaintenance, error) {
+	var resp protocol.RepoMaintenance
+
+	if internalgrpc.IsGRPCEnabled(ctx) {
+		client, err := c.ClientForRepo(repo)
+		if err != nil {
+			return nil, err
+		}
+
+		r, err := client.RepoMaintenance(ctx, &proto.RepoMaintenanceRequest{
+			Repo:    string(repo),
+			Trigger: trigger,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		resp.FromProto(r)
+		return &resp, nil
+	}
+
+	req := &protocol.RepoMaintenanceRequest{
+		Repo:    repo,
+		Trigger: trigger,
+	}
+	r, err := c.httpPost(ctx, repo, "repo-maintenance", req)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	if r.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("gitserver error (status code %d): %s", r.StatusCode, readResponseBody(r.Body))
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 func (c *clientImplementor) RepoCloneProgress(ctx context.Context, repos ...api.RepoName) (*protocol.RepoCloneProgressResponse, error) {
 	numPossibleShards := len(c.Addrs())
 
@@ -1510,19 +1675,26 @@ func (c *clientImplementor) Remove(ctx context.Context, repo api.RepoName) error
 	// In case the repo has already been deleted from the database we need to pass
 	// the old name in order to land on the correct gitserver instance
 	repo = api.UndeletedRepoName(repo)
-	if internalgrpc.IsGRPCEnabled(ctx) {
-		client, err := c.ClientForRepo(repo)
-		if err != nil {
-			return err
+
+	// Every replica of the repo has to be removed, so we return the errors of
+	// all of them.
+	var errs error
+	for _, addr := range c.clientSource.AddrsForRepo(c.userAgent, repo) {
+		if internalgrpc.IsGRPCEnabled(ctx) {
+			client, err := c.clientForAddr(addr)
+			if err != nil {
+				errs = errors.Append(errs, err)
+				continue
+			}
+			_, err = client.RepoDelete(ctx, &proto.RepoDeleteRequest{
+				Repo: string(repo),
+			})
+			errs = errors.Append(errs, err)
+		} else {
+			errs = errors.Append(errs, c.RemoveFrom(ctx, repo, addr))
 		}
-		_, err = client.RepoDelete(ctx, &proto.RepoDeleteRequest{
-			Repo: string(repo),
-		})
-		return err
-	} else {
-		addr := c.AddrForRepo(repo)
-		return c.RemoveFrom(ctx, repo, addr)
 	}
+	return errs
 }
 
 func (c *clientImplementor) RemoveFrom(ctx context.Context, repo api.RepoName, from string) error {
@@ -1551,16 +1723,30 @@ func (c *clientImplementor) RemoveFrom(ctx context.Context, repo api.RepoName, f
 }
 
 // httpPost will apply the MD5 hashing scheme on the repo name to determine the gitserver instance
-// to which the HTTP POST request is sent.
+// to which the HTTP POST request is sent. If the gitserver cannot be reached, the request is sent
+// to the next replica of the repo.
 func (c *clientImplementor) httpPost(ctx context.Context, repo api.RepoName, op string, payload any) (resp *http.Response, err error) {
 	b, err := json.Marshal(payload)
 	if err != nil {
 		return nil, err
 	}
 
-	addrForRepo := c.AddrForRepo(repo)
-	uri := "http://" + addrForRepo + "/" + op
-	return c.do(ctx, repo, "POST", uri, b)
+	for i, addr := range c.clientSource.AddrsForRepo(c.userAgent, repo) {
+		if i > 0 {
+			replicaFailoverCounter.WithLabelValues("http").Inc()
+		}
+		resp, err = c.httpPostTo(ctx, repo, addr, op, b)
+		if err == nil || ctx.Err() != nil {
+			return resp, err
+		}
+	}
+	return resp, err
+}
+
+// httpPostTo sends the HTTP POST request to the gitserver instance at addr.
+func (c *clientImplementor) httpPostTo(ctx context.Context, repo api.RepoName, addr, op string, payload []byte) (*http.Response, error) {
+	uri := "http://" + addr + "/" + op
+	return c.do(ctx, repo, "POST", uri, payload)
 }
 
 // do performs a request to a gitserver instance based on the address in the uri
@@ -1647,6 +1833,53 @@ func (c *clientImplementor) CreateCommitFromPatch(ctx context.Context, req proto
 	}
 }
 
+func (c *clientImplementor) CreateCommitFromFileOperations(ctx context.Context, req protocol.CreateCommitFromFileOperationsRequest) (*protocol.CreateCommitFromPatchResponse, error) {
+	if internalgrpc.IsGRPCEnabled(ctx) {
+		client, err := c.ClientForRepo(req.Repo)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := client.CreateCommitFromFileOperations(ctx, req.ToProto())
+		if err != nil {
+			return nil, err
+		}
+
+		var res protocol.CreateCommitFromPatchResponse
+		res.FromFileOperationsProto(resp)
+
+		if resp.GetError() != nil {
+			return &res, errors.New(resp.GetError().String())
+		}
+
+		return &res, nil
+	} else {
+		resp, err := c.httpPost(ctx, req.Repo, "create-commit-from-file-operations", req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read response body")
+		}
+		var res protocol.CreateCommitFromPatchResponse
+		if err := json.Unmarshal(body, &res); err != nil {
+			c.logger.Warn("decoding gitserver create-commit-from-file-operations response", sglog.Error(err))
+			return nil, &url.Error{
+				URL: resp.Request.URL.String(),
+				Op:  "CreateCommitFromFileOperations",
+				Err: errors.Errorf("CreateCommitFromFileOperations: http status %d, %s", resp.StatusCode, string(body)),
+			}
+		}
+
+		if res.Error != nil {
+			return &res, res.Error
+		}
+		return &res, nil
+	}
+}
+
 func (c *clientImplementor) GetObject(ctx context.Context, repo api.RepoName, objectName string) (*gitdomain.GitObject, error) {
 	if ClientMocks.GetObject != nil {
 		return ClientMocks.GetObject(repo, objectName)
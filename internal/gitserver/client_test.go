@@ -256,6 +256,96 @@ func TestClient_CreateCommitFromPatchRequest_ProtoRoundTrip(t *testing.T) {
 	})
 }
 
+func TestClient_CreateCommitFromFileOperationsRequest_ProtoRoundTrip(t *testing.T) {
+	var diff string
+
+	t.Run("request", func(t *testing.T) {
+		fileOperationTypes := []protocol.FileOperationType{
+			protocol.FileOperationWrite,
+			protocol.FileOperationDelete,
+			protocol.FileOperationChmod,
+		}
+
+		fn := func(
+			repo string,
+			baseCommit string,
+			fileOperations []struct {
+				Type       uint8
+				Path       string
+				Contents   []byte
+				Executable bool
+			},
+			targetRef string,
+			uniqueRef bool,
+			pushRef *string,
+
+			commitInfo struct {
+				Messages    []string
+				AuthorName  string
+				AuthorEmail string
+				Date        fuzzTime
+			},
+
+			pushConfig *protocol.PushConfig,
+		) bool {
+			var ops []protocol.FileOperation
+			for _, op := range fileOperations {
+				ops = append(ops, protocol.FileOperation{
+					Type:       fileOperationTypes[int(op.Type)%len(fileOperationTypes)],
+					Path:       op.Path,
+					Contents:   op.Contents,
+					Executable: op.Executable,
+				})
+			}
+
+			original := protocol.CreateCommitFromFileOperationsRequest{
+				Repo:           api.RepoName(repo),
+				BaseCommit:     api.CommitID(baseCommit),
+				FileOperations: ops,
+				TargetRef:      targetRef,
+				UniqueRef:      uniqueRef,
+				CommitInfo: protocol.PatchCommitInfo{
+					Messages:    commitInfo.Messages,
+					AuthorName:  commitInfo.AuthorName,
+					AuthorEmail: commitInfo.AuthorEmail,
+					Date:        time.Time(commitInfo.Date),
+				},
+				Push:    pushConfig,
+				PushRef: pushRef,
+			}
+			var converted protocol.CreateCommitFromFileOperationsRequest
+			converted.FromProto(original.ToProto())
+
+			if diff = cmp.Diff(original, converted); diff != "" {
+				return false
+			}
+
+			return true
+		}
+
+		if err := quick.Check(fn, nil); err != nil {
+			t.Errorf("CreateCommitFromFileOperationsRequest proto roundtrip failed (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("response", func(t *testing.T) {
+		fn := func(original protocol.CreateCommitFromPatchResponse) bool {
+			var converted protocol.CreateCommitFromPatchResponse
+			converted.FromFileOperationsProto(original.ToFileOperationsProto())
+
+			if diff = cmp.Diff(original, converted); diff != "" {
+				return false
+			}
+
+			return true
+		}
+
+		if err := quick.Check(fn, nil); err != nil {
+			t.Errorf("CreateCommitFromFileOperations response proto roundtrip failed (-want +got):\n%s", diff)
+		}
+	})
+}
+
 func TestClient_BatchLog_ProtoRoundTrip(t *testing.T) {
 	var diff string
 
@@ -314,6 +404,62 @@ func TestClient_RepoCloneProgress_ProtoRoundTrip(t *testing.T) {
 	}
 }
 
+func TestClient_RepoSizeBreakdown_ProtoRoundTrip(t *testing.T) {
+	var diff string
+
+	fn := func(original protocol.RepoSizeBreakdown) bool {
+		var converted protocol.RepoSizeBreakdown
+		converted.FromProto(original.ToProto())
+
+		if diff = cmp.Diff(original, converted); diff != "" {
+			return false
+		}
+
+		return true
+	}
+
+	if err := quick.Check(fn, nil); err != nil {
+		t.Errorf("RepoSizeBreakdown proto roundtrip failed (-want +got):\n%s", diff)
+	}
+}
+
+func TestClient_RepoMaintenance_ProtoRoundTrip(t *testing.T) {
+	var diff string
+
+	fn := func(cloned, queued, running bool, queuePosition int64, priority, reads, fetches float64, lastRunAt fuzzTime, lastDuration int64, lastTask, lastError string) bool {
+		lastRunAtPtr := time.Time(lastRunAt)
+		if queuePosition < 0 {
+			queuePosition = -queuePosition
+		}
+
+		original := protocol.RepoMaintenance{
+			Cloned:        cloned,
+			Queued:        queued,
+			QueuePosition: queuePosition,
+			Running:       running,
+			Priority:      priority,
+			Reads:         reads,
+			Fetches:       fetches,
+			LastRunAt:     &lastRunAtPtr,
+			LastDuration:  time.Duration(lastDuration),
+			LastTasks:     []string{lastTask},
+			LastError:     lastError,
+		}
+		var converted protocol.RepoMaintenance
+		converted.FromProto(original.ToProto())
+
+		if diff = cmp.Diff(original, converted); diff != "" {
+			return false
+		}
+
+		return true
+	}
+
+	if err := quick.Check(fn, nil); err != nil {
+		t.Errorf("RepoMaintenance proto roundtrip failed (-want +got):\n%s", diff)
+	}
+}
+
 func TestClient_P4ExecRequest_ProtoRoundTrip(t *testing.T) {
 	var diff string
 
@@ -1621,20 +1767,23 @@ func TestGitserverClient_RepoClone(t *testing.T) {
 }
 
 type mockClient struct {
-	mockBatchLog                    func(ctx context.Context, in *proto.BatchLogRequest, opts ...grpc.CallOption) (*proto.BatchLogResponse, error)
-	mockCreateCommitFromPatchBinary func(ctx context.Context, in *proto.CreateCommitFromPatchBinaryRequest, opts ...grpc.CallOption) (*proto.CreateCommitFromPatchBinaryResponse, error)
-	mockExec                        func(ctx context.Context, in *proto.ExecRequest, opts ...grpc.CallOption) (proto.GitserverService_ExecClient, error)
-	mockGetObject                   func(ctx context.Context, in *proto.GetObjectRequest, opts ...grpc.CallOption) (*proto.GetObjectResponse, error)
-	mockIsRepoCloneable             func(ctx context.Context, in *proto.IsRepoCloneableRequest, opts ...grpc.CallOption) (*proto.IsRepoCloneableResponse, error)
-	mockListGitolite                func(ctx context.Context, in *proto.ListGitoliteRequest, opts ...grpc.CallOption) (*proto.ListGitoliteResponse, error)
-	mockRepoClone                   func(ctx context.Context, in *proto.RepoCloneRequest, opts ...grpc.CallOption) (*proto.RepoCloneResponse, error)
-	mockRepoCloneProgress           func(ctx context.Context, in *proto.RepoCloneProgressRequest, opts ...grpc.CallOption) (*proto.RepoCloneProgressResponse, error)
-	mockRepoDelete                  func(ctx context.Context, in *proto.RepoDeleteRequest, opts ...grpc.CallOption) (*proto.RepoDeleteResponse, error)
-	mockRepoStats                   func(ctx context.Context, in *proto.ReposStatsRequest, opts ...grpc.CallOption) (*proto.ReposStatsResponse, error)
-	mockRepoUpdate                  func(ctx context.Context, in *proto.RepoUpdateRequest, opts ...grpc.CallOption) (*proto.RepoUpdateResponse, error)
-	mockArchive                     func(ctx context.Context, in *proto.ArchiveRequest, opts ...grpc.CallOption) (proto.GitserverService_ArchiveClient, error)
-	mockSearch                      func(ctx context.Context, in *proto.SearchRequest, opts ...grpc.CallOption) (proto.GitserverService_SearchClient, error)
-	mockP4Exec                      func(ctx context.Context, in *proto.P4ExecRequest, opts ...grpc.CallOption) (proto.GitserverService_P4ExecClient, error)
+	mockBatchLog                       func(ctx context.Context, in *proto.BatchLogRequest, opts ...grpc.CallOption) (*proto.BatchLogResponse, error)
+	mockCreateCommitFromPatchBinary    func(ctx context.Context, in *proto.CreateCommitFromPatchBinaryRequest, opts ...grpc.CallOption) (*proto.CreateCommitFromPatchBinaryResponse, error)
+	mockCreateCommitFromFileOperations func(ctx context.Context, in *proto.CreateCommitFromFileOperationsRequest, opts ...grpc.CallOption) (*proto.CreateCommitFromFileOperationsResponse, error)
+	mockExec                           func(ctx context.Context, in *proto.ExecRequest, opts ...grpc.CallOption) (proto.GitserverService_ExecClient, error)
+	mockGetObject                      func(ctx context.Context, in *proto.GetObjectRequest, opts ...grpc.CallOption) (*proto.GetObjectResponse, error)
+	mockIsRepoCloneable                func(ctx context.Context, in *proto.IsRepoCloneableRequest, opts ...grpc.CallOption) (*proto.IsRepoCloneableResponse, error)
+	mockListGitolite                   func(ctx context.Context, in *proto.ListGitoliteRequest, opts ...grpc.CallOption) (*proto.ListGitoliteResponse, error)
+	mockRepoClone                      func(ctx context.Context, in *proto.RepoCloneRequest, opts ...grpc.CallOption) (*proto.RepoCloneResponse, error)
+	mockRepoCloneProgress              func(ctx context.Context, in *proto.RepoCloneProgressRequest, opts ...grpc.CallOption) (*proto.RepoCloneProgressResponse, error)
+	mockRepoDelete                     func(ctx context.Context, in *proto.RepoDeleteRequest, opts ...grpc.CallOption) (*proto.RepoDeleteResponse, error)
+	mockRepoSizeBreakdown              func(ctx context.Context, in *proto.RepoSizeBreakdownRequest, opts ...grpc.CallOption) (*proto.RepoSizeBreakdownResponse, error)
+	mockRepoMaintenance                func(ctx context.Context, in *proto.RepoMaintenanceRequest, opts ...grpc.CallOption) (*proto.RepoMaintenanceResponse, error)
+	mockRepoStats                      func(ctx context.Context, in *proto.ReposStatsRequest, opts ...grpc.CallOption) (*proto.ReposStatsResponse, error)
+	mockRepoUpdate                     func(ctx context.Context, in *proto.RepoUpdateRequest, opts ...grpc.CallOption) (*proto.RepoUpdateResponse, error)
+	mockArchive                        func(ctx context.Context, in *proto.ArchiveRequest, opts ...grpc.CallOption) (proto.GitserverService_ArchiveClient, error)
+	mockSearch                         func(ctx context.Context, in *proto.SearchRequest, opts ...grpc.CallOption) (proto.GitserverService_SearchClient, error)
+	mockP4Exec                         func(ctx context.Context, in *proto.P4ExecRequest, opts ...grpc.CallOption) (proto.GitserverService_P4ExecClient, error)
 }
 
 // BatchLog implements v1.GitserverServiceClient.
@@ -1662,6 +1811,11 @@ func (mc *mockClient) CreateCommitFromPatchBinary(ctx context.Context, in *proto
 	return mc.mockCreateCommitFromPatchBinary(ctx, in, opts...)
 }
 
+// CreateCommitFromFileOperations implements v1.GitserverServiceClient.
+func (mc *mockClient) CreateCommitFromFileOperations(ctx context.Context, in *proto.CreateCommitFromFileOperationsRequest, opts ...grpc.CallOption) (*proto.CreateCommitFromFileOperationsResponse, error) {
+	return mc.mockCreateCommitFromFileOperations(ctx, in, opts...)
+}
+
 // RepoUpdate implements v1.GitserverServiceClient
 func (mc *mockClient) RepoUpdate(ctx context.Context, in *proto.RepoUpdateRequest, opts ...grpc.CallOption) (*proto.RepoUpdateResponse, error) {
 	return mc.mockRepoUpdate(ctx, in, opts...)
@@ -1677,6 +1831,16 @@ func (mc *mockClient) RepoCloneProgress(ctx context.Context, in *proto.RepoClone
 	return mc.mockRepoCloneProgress(ctx, in, opts...)
 }
 
+// RepoSizeBreakdown implements v1.GitserverServiceClient
+func (mc *mockClient) RepoSizeBreakdown(ctx context.Context, in *proto.RepoSizeBreakdownRequest, opts ...grpc.CallOption) (*proto.RepoSizeBreakdownResponse, error) {
+	return mc.mockRepoSizeBreakdown(ctx, in, opts...)
+}
+
+// RepoMaintenance implements v1.GitserverServiceClient
+func (mc *mockClient) RepoMaintenance(ctx context.Context, in *proto.RepoMaintenanceRequest, opts ...grpc.CallOption) (*proto.RepoMaintenanceResponse, error) {
+	return mc.mockRepoMaintenance(ctx, in, opts...)
+}
+
 // Exec implements v1.GitserverServiceClient
 func (mc *mockClient) Exec(ctx context.Context, in *proto.ExecRequest, opts ...grpc.CallOption) (proto.GitserverService_ExecClient, error) {
 	return mc.mockExec(ctx, in, opts...)
@@ -1372,14 +1372,20 @@ func (c *clientImplementor) GetBehindAhead(ctx context.Context, repo api.RepoNam
 // ReadFile returns the first maxBytes of the named file at commit. If maxBytes <= 0, the entire
 // file is read. (If you just need to check a file's existence, use Stat, not ReadFile.)
 func (c *clientImplementor) ReadFile(ctx context.Context, checker authz.SubRepoPermissionChecker, repo api.RepoName, commit api.CommitID, name string) (_ []byte, err error) {
+	return c.ReadFileWithOptions(ctx, checker, repo, commit, name, FileReaderOptions{})
+}
+
+// ReadFileWithOptions is like ReadFile, with options for how the file is read.
+func (c *clientImplementor) ReadFileWithOptions(ctx context.Context, checker authz.SubRepoPermissionChecker, repo api.RepoName, commit api.CommitID, name string, opts FileReaderOptions) (_ []byte, err error) {
 	ctx, _, endObservation := c.operations.readFile.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
 		repo.Attr(),
 		commit.Attr(),
 		attribute.String("name", name),
+		attribute.Bool("smudgeLFS", opts.SmudgeLFS),
 	}})
 	defer endObservation(1, observation.Args{})
 
-	br, err := c.NewFileReader(ctx, checker, repo, commit, name)
+	br, err := c.newFileReader(ctx, checker, repo, commit, name, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -1396,11 +1402,17 @@ func (c *clientImplementor) ReadFile(ctx context.Context, checker authz.SubRepoP
 // NewFileReader returns an io.ReadCloser reading from the named file at commit.
 // The caller should always close the reader after use
 func (c *clientImplementor) NewFileReader(ctx context.Context, checker authz.SubRepoPermissionChecker, repo api.RepoName, commit api.CommitID, name string) (_ io.ReadCloser, err error) {
+	return c.newFileReader(ctx, checker, repo, commit, name, FileReaderOptions{})
+}
+
+// newFileReader is like NewFileReader, with options for how the file is read.
+func (c *clientImplementor) newFileReader(ctx context.Context, checker authz.SubRepoPermissionChecker, repo api.RepoName, commit api.CommitID, name string, opts FileReaderOptions) (_ io.ReadCloser, err error) {
 	// TODO: this does not capture the lifetime of the request since we return a reader
 	ctx, _, endObservation := c.operations.newFileReader.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
 		repo.Attr(),
 		commit.Attr(),
 		attribute.String("name", name),
+		attribute.Bool("smudgeLFS", opts.SmudgeLFS),
 	}})
 	defer endObservation(1, observation.Args{})
 
@@ -1412,7 +1424,7 @@ func (c *clientImplementor) NewFileReader(ctx context.Context, checker authz.Sub
 	}
 
 	name = rel(name)
-	br, err := c.newBlobReader(ctx, repo, commit, name)
+	br, err := c.newBlobReader(ctx, repo, commit, name, opts)
 	if err != nil {
 		return nil, errors.Wrapf(err, "getting blobReader for %q", name)
 	}
@@ -1451,7 +1463,7 @@ func (c *clientImplementor) blobOID(ctx context.Context, repo api.RepoName, comm
 	return string(fields[2]), nil
 }
 
-func (c *clientImplementor) newBlobReader(ctx context.Context, repo api.RepoName, commit api.CommitID, name string) (*blobReader, error) {
+func (c *clientImplementor) newBlobReader(ctx context.Context, repo api.RepoName, commit api.CommitID, name string, opts FileReaderOptions) (*blobReader, error) {
 	if err := gitdomain.EnsureAbsoluteCommit(commit); err != nil {
 		return nil, err
 	}
@@ -1479,6 +1491,9 @@ func (c *clientImplementor) newBlobReader(ctx context.Context, repo api.RepoName
 		// Otherwise we can rely on a single command git show sha:name.
 		cmd = c.gitCommand(repo, "show", string(commit)+":"+name)
 	}
+	if opts.SmudgeLFS {
+		cmd.SmudgeLFS()
+	}
 
 	stdout, err := cmd.StdoutReader(ctx)
 	if err != nil {
@@ -2489,6 +2504,26 @@ func (c *clientImplementor) CommitDate(ctx context.Context, checker authz.SubRep
 	return parts[0], duration, true, nil
 }
 
+// mercurialNotesRef is the notes ref in which gitserver records the Mercurial
+// changeset each commit of a Mercurial repository was converted from.
+const mercurialNotesRef = "hg"
+
+func (c *clientImplementor) MercurialChangeset(ctx context.Context, checker authz.SubRepoPermissionChecker, repo api.RepoName, commit api.CommitID) (string, error) {
+	if authz.SubRepoEnabled(checker) {
+		// GetCommit to validate that the user has permissions to access it.
+		if _, err := c.GetCommit(ctx, checker, repo, commit, ResolveRevisionOptions{}); err != nil {
+			return "", err
+		}
+	}
+
+	cmd := c.gitCommand(repo, "log", "-n1", "--notes="+mercurialNotesRef, "--format=%N", string(commit))
+	out, err := cmd.Output(ctx)
+	if err != nil {
+		return "", errors.WithMessage(err, fmt.Sprintf("git command %v failed (output: %q)", cmd.Args(), out))
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
 type ArchiveFormat string
 
 const (
@@ -2331,6 +2331,39 @@ func TestCommitDate(t *testing.T) {
 	})
 }
 
+func TestMercurialChangeset(t *testing.T) {
+	ClientMocks.LocalGitserver = true
+	defer ResetClientMocks()
+	ctx := actor.WithActor(context.Background(), &actor.Actor{
+		UID: 1,
+	})
+	client := NewClient()
+	gitCommands := append(getGitCommandsWithFiles("file1", "file2"),
+		"git notes --ref=hg add -m 9117c6561b0bd7792fa13b50d28239d51b78e51f HEAD",
+	)
+	repo := MakeGitRepository(t, gitCommands...)
+
+	t.Run("converted commit", func(t *testing.T) {
+		changeset, err := client.MercurialChangeset(ctx, nil, repo, "2ba4dd2b9a27ec125fea7d72e12b9824ead18631")
+		if err != nil {
+			t.Fatalf("error fetching MercurialChangeset: %s", err)
+		}
+		if want := "9117c6561b0bd7792fa13b50d28239d51b78e51f"; changeset != want {
+			t.Errorf("unexpected changeset: have %q, want %q", changeset, want)
+		}
+	})
+
+	t.Run("commit without note", func(t *testing.T) {
+		changeset, err := client.MercurialChangeset(ctx, nil, repo, "d38233a79e037d2ab8170b0d0bc0aa438473e6da")
+		if err != nil {
+			t.Fatalf("error fetching MercurialChangeset: %s", err)
+		}
+		if changeset != "" {
+			t.Errorf("expected no changeset, got %q", changeset)
+		}
+	})
+}
+
 func testCommits(ctx context.Context, label string, repo api.RepoName, opt CommitsOptions, checker authz.SubRepoPermissionChecker, wantCommits []*gitdomain.Commit, t *testing.T) {
 	t.Helper()
 	client := NewClient().(*clientImplementor)
@@ -54,6 +54,10 @@ type GitCommand interface {
 	// SetStdin will write b to stdin when running the command.
 	SetStdin(b []byte)
 
+	// SmudgeLFS makes gitserver replace Git LFS pointer files in the output of
+	// the command by the content of the files, see FileReaderOptions.
+	SmudgeLFS()
+
 	// String returns string representation of the command (in fact prints args parameter of the command)
 	String() string
 
@@ -155,6 +159,10 @@ func (l *LocalGitCommand) EnsureRevision() string { return l.ensureRevision }
 
 func (l *LocalGitCommand) SetStdin(b []byte) { l.stdin = b }
 
+func (l *LocalGitCommand) SmudgeLFS() {
+	// No-op because LFS objects are only fetched by gitserver
+}
+
 func (l *LocalGitCommand) StdoutReader(ctx context.Context) (io.ReadCloser, error) {
 	output, err := l.Output(ctx)
 	return io.NopCloser(bytes.NewReader(output)), err
@@ -169,6 +177,7 @@ type RemoteGitCommand struct {
 	args           []string
 	stdin          []byte
 	noTimeout      bool
+	smudgeLFS      bool
 	exitStatus     int
 	execer         execer
 	execOp         *observation.Operation
@@ -232,6 +241,8 @@ func (c *RemoteGitCommand) EnsureRevision() string { return c.ensureRevision }
 
 func (c *RemoteGitCommand) SetStdin(b []byte) { c.stdin = b }
 
+func (c *RemoteGitCommand) SmudgeLFS() { c.smudgeLFS = true }
+
 func (c *RemoteGitCommand) String() string { return fmt.Sprintf("%q", c.args) }
 
 // StdoutReader returns an io.ReadCloser of stdout of c. If the command has a
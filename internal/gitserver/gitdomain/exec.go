@@ -60,6 +60,7 @@ var (
 		"--until", "--since", "--author", "--committer",
 		"--all-match", "--invert-grep", "--extended-regexp",
 		"--no-color", "--decorate", "--no-patch", "--exclude",
+		"--notes",
 		"--no-merges",
 		"--no-renames",
 		"--full-index",
@@ -91,6 +91,54 @@ func TestClient_GRPCRouting(t *testing.T) {
 	}
 }
 
+func TestClient_GRPCReplicaFailover(t *testing.T) {
+	gs1 := grpc.NewServer()
+	m1 := &mockGitserver{}
+	proto.RegisterGitserverServiceServer(gs1, m1)
+	srv1 := httptest.NewServer(internalgrpc.MultiplexHandlers(gs1, m1))
+
+	gs2 := grpc.NewServer()
+	m2 := &mockGitserver{}
+	proto.RegisterGitserverServiceServer(gs2, m2)
+	srv2 := httptest.NewServer(internalgrpc.MultiplexHandlers(gs2, m2))
+	t.Cleanup(srv2.Close)
+
+	u1, _ := url.Parse(srv1.URL)
+	u2, _ := url.Parse(srv2.URL)
+
+	source := NewTestClientSource(t, []string{u1.Host, u2.Host}, func(o *TestClientSourceOptions) {
+		o.ReplicationFactor = 2
+	})
+	client := NewTestClient(http.DefaultClient, source)
+
+	// Find a repo whose primary is srv1.
+	var repo api.RepoName
+	for _, r := range []api.RepoName{"a", "b", "c", "d", "e", "f"} {
+		if addrs := source.AddrsForRepo("test", r); addrs[0] == u1.Host {
+			require.Equal(t, []string{u1.Host, u2.Host}, addrs)
+			repo = r
+			break
+		}
+	}
+	require.NotEmpty(t, repo)
+
+	t.Setenv("SG_FEATURE_FLAG_GRPC", "true")
+
+	_, _ = client.ResolveRevision(context.Background(), repo, "HEAD", ResolveRevisionOptions{})
+	if !(m1.called && !m2.called) {
+		t.Fatalf("expected repo %q to hit its primary, got %v, %v", repo, m1.called, m2.called)
+	}
+
+	// The request fails over to the replica when the primary is unavailable.
+	gs1.Stop()
+	srv1.Close()
+	m1.called = false
+	_, _ = client.ResolveRevision(context.Background(), repo, "HEAD", ResolveRevisionOptions{})
+	if !(!m1.called && m2.called) {
+		t.Fatalf("expected repo %q to fail over to its replica, got %v, %v", repo, m1.called, m2.called)
+	}
+}
+
 func TestClient_AddrForRepo_UsesConfToRead_PinnedRepos(t *testing.T) {
 	client := NewClient()
 
@@ -0,0 +1,82 @@
+package inttests
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slices"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+)
+
+func TestReplication(t *testing.T) {
+	for _, grpcEnabled := range []bool{false, true} {
+		t.Run(fmt.Sprintf("grpc=%t", grpcEnabled), func(t *testing.T) {
+			t.Setenv("SG_FEATURE_FLAG_GRPC", strconv.FormatBool(grpcEnabled))
+			ctx := context.Background()
+
+			// Start three gitservers, which store two replicas of each repo.
+			var addrs []string
+			reposDirs := make(map[string]string)
+			stops := make(map[string]func())
+			for i := 0; i < 3; i++ {
+				reposDir := t.TempDir()
+				handler, stopGRPC := newTestGitserver(logtest.Scoped(t), reposDir)
+				srv := httptest.NewServer(handler)
+				u, err := url.Parse(srv.URL)
+				require.NoError(t, err)
+
+				stop := func() {
+					stopGRPC()
+					srv.Close()
+				}
+				t.Cleanup(stop)
+
+				addrs = append(addrs, u.Host)
+				reposDirs[u.Host] = reposDir
+				stops[u.Host] = stop
+			}
+
+			source := gitserver.NewTestClientSource(t, addrs, func(o *gitserver.TestClientSourceOptions) {
+				o.ReplicationFactor = 2
+			})
+			client := gitserver.NewTestClient(httpcli.InternalDoer, source)
+
+			remote := InitGitRepository(t, "echo hello > hello.txt", "git add hello.txt", "git commit -m hello")
+			repo := api.RepoName(filepath.Base(remote))
+
+			// Updating the repo clones it on both replicas.
+			resp, err := client.RequestRepoUpdate(ctx, repo, 0)
+			require.NoError(t, err)
+			require.Empty(t, resp.Error)
+
+			replicas := source.AddrsForRepo("test", repo)
+			require.Len(t, replicas, 2)
+			for _, addr := range addrs {
+				_, err := os.Stat(filepath.Join(reposDirs[addr], string(repo), ".git", "HEAD"))
+				assert.Equal(t, slices.Contains(replicas, addr), err == nil, "unexpected clone state on %s", addr)
+			}
+
+			// Reads fail over to the replica when the primary is stopped.
+			stops[replicas[0]]()
+
+			commitID, err := client.ResolveRevision(ctx, repo, "HEAD", gitserver.ResolveRevisionOptions{})
+			require.NoError(t, err)
+			content, err := client.ReadFile(ctx, authz.DefaultSubRepoPermsChecker, repo, commitID, "hello.txt")
+			require.NoError(t, err)
+			assert.Equal(t, "hello\n", string(content))
+		})
+	}
+}
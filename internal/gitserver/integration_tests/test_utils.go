@@ -55,6 +55,27 @@ func InitGitserver() {
 		logger.Fatal(err.Error())
 	}
 
+	handler, _ := newTestGitserver(logger, filepath.Join(root, "repos"))
+
+	srv := &http.Server{
+		Handler: handler,
+	}
+	go func() {
+		if err := srv.Serve(l); err != nil {
+			logger.Fatal(err.Error())
+		}
+	}()
+
+	serverAddress := l.Addr().String()
+	source := gitserver.NewTestClientSource(&t, []string{serverAddress})
+	testGitserverClient = gitserver.NewTestClient(httpcli.InternalDoer, source)
+	GitserverAddresses = []string{serverAddress}
+}
+
+// newTestGitserver returns the handler of a gitserver which stores repos in
+// reposDir and clones them from the remotes created by InitGitRepository. stop
+// stops its gRPC server.
+func newTestGitserver(logger sglog.Logger, reposDir string) (handler http.Handler, stop func()) {
 	db := database.NewMockDB()
 	db.GitserverReposFunc.SetDefaultReturn(database.NewMockGitserverRepoStore())
 	db.FeatureFlagsFunc.SetDefaultReturn(database.NewMockFeatureFlagStore())
@@ -73,7 +94,7 @@ func InitGitserver() {
 	s := server.Server{
 		Logger:         sglog.Scoped("server", "the gitserver service"),
 		ObservationCtx: &observation.TestContext,
-		ReposDir:       filepath.Join(root, "repos"),
+		ReposDir:       reposDir,
 		GetRemoteURLFunc: func(ctx context.Context, name api.RepoName) (string, error) {
 			return filepath.Join(root, "remotes", string(name)), nil
 		},
@@ -87,21 +108,7 @@ func InitGitserver() {
 
 	grpcServer := defaults.NewServer(logger)
 	proto.RegisterGitserverServiceServer(grpcServer, &server.GRPCServer{Server: &s})
-	handler := internalgrpc.MultiplexHandlers(grpcServer, s.Handler())
-
-	srv := &http.Server{
-		Handler: handler,
-	}
-	go func() {
-		if err := srv.Serve(l); err != nil {
-			logger.Fatal(err.Error())
-		}
-	}()
-
-	serverAddress := l.Addr().String()
-	source := gitserver.NewTestClientSource(&t, []string{serverAddress})
-	testGitserverClient = gitserver.NewTestClient(httpcli.InternalDoer, source)
-	GitserverAddresses = []string{serverAddress}
+	return internalgrpc.MultiplexHandlers(grpcServer, s.Handler()), grpcServer.Stop
 }
 
 // MakeGitRepository calls initGitRepository to create a new Git repository and returns a handle to
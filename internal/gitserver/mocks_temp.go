@@ -67,6 +67,10 @@ type MockClient struct {
 	// ContributorCountFunc is an instance of a mock function object
 	// controlling the behavior of the method ContributorCount.
 	ContributorCountFunc *ClientContributorCountFunc
+	// CreateCommitFromFileOperationsFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// CreateCommitFromFileOperations.
+	CreateCommitFromFileOperationsFunc *ClientCreateCommitFromFileOperationsFunc
 	// CreateCommitFromPatchFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateCommitFromPatch.
 	CreateCommitFromPatchFunc *ClientCreateCommitFromPatchFunc
@@ -127,6 +131,9 @@ type MockClient struct {
 	// LsFilesFunc is an instance of a mock function object controlling the
 	// behavior of the method LsFiles.
 	LsFilesFunc *ClientLsFilesFunc
+	// MercurialChangesetFunc is an instance of a mock function object
+	// controlling the behavior of the method MercurialChangeset.
+	MercurialChangesetFunc *ClientMercurialChangesetFunc
 	// MergeBaseFunc is an instance of a mock function object controlling
 	// the behavior of the method MergeBase.
 	MergeBaseFunc *ClientMergeBaseFunc
@@ -145,6 +152,9 @@ type MockClient struct {
 	// ReadFileFunc is an instance of a mock function object controlling the
 	// behavior of the method ReadFile.
 	ReadFileFunc *ClientReadFileFunc
+	// ReadFileWithOptionsFunc is an instance of a mock function object
+	// controlling the behavior of the method ReadFileWithOptions.
+	ReadFileWithOptionsFunc *ClientReadFileWithOptionsFunc
 	// RefDescriptionsFunc is an instance of a mock function object
 	// controlling the behavior of the method RefDescriptions.
 	RefDescriptionsFunc *ClientRefDescriptionsFunc
@@ -157,6 +167,12 @@ type MockClient struct {
 	// RepoCloneProgressFunc is an instance of a mock function object
 	// controlling the behavior of the method RepoCloneProgress.
 	RepoCloneProgressFunc *ClientRepoCloneProgressFunc
+	// RepoMaintenanceFunc is an instance of a mock function object
+	// controlling the behavior of the method RepoMaintenance.
+	RepoMaintenanceFunc *ClientRepoMaintenanceFunc
+	// RepoSizeBreakdownFunc is an instance of a mock function object
+	// controlling the behavior of the method RepoSizeBreakdown.
+	RepoSizeBreakdownFunc *ClientRepoSizeBreakdownFunc
 	// ReposStatsFunc is an instance of a mock function object controlling
 	// the behavior of the method ReposStats.
 	ReposStatsFunc *ClientReposStatsFunc
@@ -260,6 +276,11 @@ func NewMockClient() *MockClient {
 				return
 			},
 		},
+		CreateCommitFromFileOperationsFunc: &ClientCreateCommitFromFileOperationsFunc{
+			defaultHook: func(context.Context, protocol.CreateCommitFromFileOperationsRequest) (r0 *protocol.CreateCommitFromPatchResponse, r1 error) {
+				return
+			},
+		},
 		CreateCommitFromPatchFunc: &ClientCreateCommitFromPatchFunc{
 			defaultHook: func(context.Context, protocol.CreateCommitFromPatchRequest) (r0 *protocol.CreateCommitFromPatchResponse, r1 error) {
 				return
@@ -360,6 +381,11 @@ func NewMockClient() *MockClient {
 				return
 			},
 		},
+		MercurialChangesetFunc: &ClientMercurialChangesetFunc{
+			defaultHook: func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID) (r0 string, r1 error) {
+				return
+			},
+		},
 		MergeBaseFunc: &ClientMergeBaseFunc{
 			defaultHook: func(context.Context, api.RepoName, api.CommitID, api.CommitID) (r0 api.CommitID, r1 error) {
 				return
@@ -390,6 +416,11 @@ func NewMockClient() *MockClient {
 				return
 			},
 		},
+		ReadFileWithOptionsFunc: &ClientReadFileWithOptionsFunc{
+			defaultHook: func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID, string, FileReaderOptions) (r0 []byte, r1 error) {
+				return
+			},
+		},
 		RefDescriptionsFunc: &ClientRefDescriptionsFunc{
 			defaultHook: func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, ...string) (r0 map[string][]gitdomain.RefDescription, r1 error) {
 				return
@@ -410,6 +441,16 @@ func NewMockClient() *MockClient {
 				return
 			},
 		},
+		RepoMaintenanceFunc: &ClientRepoMaintenanceFunc{
+			defaultHook: func(context.Context, api.RepoName, bool) (r0 *protocol.RepoMaintenance, r1 error) {
+				return
+			},
+		},
+		RepoSizeBreakdownFunc: &ClientRepoSizeBreakdownFunc{
+			defaultHook: func(context.Context, api.RepoName) (r0 *protocol.RepoSizeBreakdown, r1 error) {
+				return
+			},
+		},
 		ReposStatsFunc: &ClientReposStatsFunc{
 			defaultHook: func(context.Context) (r0 map[string]*protocol.ReposStats, r1 error) {
 				return
@@ -532,6 +573,11 @@ func NewStrictMockClient() *MockClient {
 				panic("unexpected invocation of MockClient.ContributorCount")
 			},
 		},
+		CreateCommitFromFileOperationsFunc: &ClientCreateCommitFromFileOperationsFunc{
+			defaultHook: func(context.Context, protocol.CreateCommitFromFileOperationsRequest) (*protocol.CreateCommitFromPatchResponse, error) {
+				panic("unexpected invocation of MockClient.CreateCommitFromFileOperations")
+			},
+		},
 		CreateCommitFromPatchFunc: &ClientCreateCommitFromPatchFunc{
 			defaultHook: func(context.Context, protocol.CreateCommitFromPatchRequest) (*protocol.CreateCommitFromPatchResponse, error) {
 				panic("unexpected invocation of MockClient.CreateCommitFromPatch")
@@ -632,6 +678,11 @@ func NewStrictMockClient() *MockClient {
 				panic("unexpected invocation of MockClient.LsFiles")
 			},
 		},
+		MercurialChangesetFunc: &ClientMercurialChangesetFunc{
+			defaultHook: func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID) (string, error) {
+				panic("unexpected invocation of MockClient.MercurialChangeset")
+			},
+		},
 		MergeBaseFunc: &ClientMergeBaseFunc{
 			defaultHook: func(context.Context, api.RepoName, api.CommitID, api.CommitID) (api.CommitID, error) {
 				panic("unexpected invocation of MockClient.MergeBase")
@@ -662,6 +713,11 @@ func NewStrictMockClient() *MockClient {
 				panic("unexpected invocation of MockClient.ReadFile")
 			},
 		},
+		ReadFileWithOptionsFunc: &ClientReadFileWithOptionsFunc{
+			defaultHook: func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID, string, FileReaderOptions) ([]byte, error) {
+				panic("unexpected invocation of MockClient.ReadFileWithOptions")
+			},
+		},
 		RefDescriptionsFunc: &ClientRefDescriptionsFunc{
 			defaultHook: func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, ...string) (map[string][]gitdomain.RefDescription, error) {
 				panic("unexpected invocation of MockClient.RefDescriptions")
@@ -682,6 +738,16 @@ func NewStrictMockClient() *MockClient {
 				panic("unexpected invocation of MockClient.RepoCloneProgress")
 			},
 		},
+		RepoMaintenanceFunc: &ClientRepoMaintenanceFunc{
+			defaultHook: func(context.Context, api.RepoName, bool) (*protocol.RepoMaintenance, error) {
+				panic("unexpected invocation of MockClient.RepoMaintenance")
+			},
+		},
+		RepoSizeBreakdownFunc: &ClientRepoSizeBreakdownFunc{
+			defaultHook: func(context.Context, api.RepoName) (*protocol.RepoSizeBreakdown, error) {
+				panic("unexpected invocation of MockClient.RepoSizeBreakdown")
+			},
+		},
 		ReposStatsFunc: &ClientReposStatsFunc{
 			defaultHook: func(context.Context) (map[string]*protocol.ReposStats, error) {
 				panic("unexpected invocation of MockClient.ReposStats")
@@ -776,6 +842,9 @@ func NewMockClientFrom(i Client) *MockClient {
 		ContributorCountFunc: &ClientContributorCountFunc{
 			defaultHook: i.ContributorCount,
 		},
+		CreateCommitFromFileOperationsFunc: &ClientCreateCommitFromFileOperationsFunc{
+			defaultHook: i.CreateCommitFromFileOperations,
+		},
 		CreateCommitFromPatchFunc: &ClientCreateCommitFromPatchFunc{
 			defaultHook: i.CreateCommitFromPatch,
 		},
@@ -836,6 +905,9 @@ func NewMockClientFrom(i Client) *MockClient {
 		LsFilesFunc: &ClientLsFilesFunc{
 			defaultHook: i.LsFiles,
 		},
+		MercurialChangesetFunc: &ClientMercurialChangesetFunc{
+			defaultHook: i.MercurialChangeset,
+		},
 		MergeBaseFunc: &ClientMergeBaseFunc{
 			defaultHook: i.MergeBase,
 		},
@@ -854,6 +926,9 @@ func NewMockClientFrom(i Client) *MockClient {
 		ReadFileFunc: &ClientReadFileFunc{
 			defaultHook: i.ReadFile,
 		},
+		ReadFileWithOptionsFunc: &ClientReadFileWithOptionsFunc{
+			defaultHook: i.ReadFileWithOptions,
+		},
 		RefDescriptionsFunc: &ClientRefDescriptionsFunc{
 			defaultHook: i.RefDescriptions,
 		},
@@ -866,6 +941,12 @@ func NewMockClientFrom(i Client) *MockClient {
 		RepoCloneProgressFunc: &ClientRepoCloneProgressFunc{
 			defaultHook: i.RepoCloneProgress,
 		},
+		RepoMaintenanceFunc: &ClientRepoMaintenanceFunc{
+			defaultHook: i.RepoMaintenance,
+		},
+		RepoSizeBreakdownFunc: &ClientRepoSizeBreakdownFunc{
+			defaultHook: i.RepoSizeBreakdown,
+		},
 		ReposStatsFunc: &ClientReposStatsFunc{
 			defaultHook: i.ReposStats,
 		},
@@ -2452,6 +2533,118 @@ func (c ClientContributorCountFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// ClientCreateCommitFromFileOperationsFunc describes the behavior when the
+// CreateCommitFromFileOperations method of the parent MockClient instance
+// is invoked.
+type ClientCreateCommitFromFileOperationsFunc struct {
+	defaultHook func(context.Context, protocol.CreateCommitFromFileOperationsRequest) (*protocol.CreateCommitFromPatchResponse, error)
+	hooks       []func(context.Context, protocol.CreateCommitFromFileOperationsRequest) (*protocol.CreateCommitFromPatchResponse, error)
+	history     []ClientCreateCommitFromFileOperationsFuncCall
+	mutex       sync.Mutex
+}
+
+// CreateCommitFromFileOperations delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockClient) CreateCommitFromFileOperations(v0 context.Context, v1 protocol.CreateCommitFromFileOperationsRequest) (*protocol.CreateCommitFromPatchResponse, error) {
+	r0, r1 := m.CreateCommitFromFileOperationsFunc.nextHook()(v0, v1)
+	m.CreateCommitFromFileOperationsFunc.appendCall(ClientCreateCommitFromFileOperationsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// CreateCommitFromFileOperations method of the parent MockClient instance
+// is invoked and the hook queue is empty.
+func (f *ClientCreateCommitFromFileOperationsFunc) SetDefaultHook(hook func(context.Context, protocol.CreateCommitFromFileOperationsRequest) (*protocol.CreateCommitFromPatchResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateCommitFromFileOperations method of the parent MockClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *ClientCreateCommitFromFileOperationsFunc) PushHook(hook func(context.Context, protocol.CreateCommitFromFileOperationsRequest) (*protocol.CreateCommitFromPatchResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientCreateCommitFromFileOperationsFunc) SetDefaultReturn(r0 *protocol.CreateCommitFromPatchResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, protocol.CreateCommitFromFileOperationsRequest) (*protocol.CreateCommitFromPatchResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientCreateCommitFromFileOperationsFunc) PushReturn(r0 *protocol.CreateCommitFromPatchResponse, r1 error) {
+	f.PushHook(func(context.Context, protocol.CreateCommitFromFileOperationsRequest) (*protocol.CreateCommitFromPatchResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientCreateCommitFromFileOperationsFunc) nextHook() func(context.Context, protocol.CreateCommitFromFileOperationsRequest) (*protocol.CreateCommitFromPatchResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientCreateCommitFromFileOperationsFunc) appendCall(r0 ClientCreateCommitFromFileOperationsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// ClientCreateCommitFromFileOperationsFuncCall objects describing the
+// invocations of this function.
+func (f *ClientCreateCommitFromFileOperationsFunc) History() []ClientCreateCommitFromFileOperationsFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientCreateCommitFromFileOperationsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientCreateCommitFromFileOperationsFuncCall is an object that describes
+// an invocation of method CreateCommitFromFileOperations on an instance of
+// MockClient.
+type ClientCreateCommitFromFileOperationsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 protocol.CreateCommitFromFileOperationsRequest
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *protocol.CreateCommitFromPatchResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientCreateCommitFromFileOperationsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientCreateCommitFromFileOperationsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // ClientCreateCommitFromPatchFunc describes the behavior when the
 // CreateCommitFromPatch method of the parent MockClient instance is
 // invoked.
@@ -4721,6 +4914,120 @@ func (c ClientLsFilesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// ClientMercurialChangesetFunc describes the behavior when the
+// MercurialChangeset method of the parent MockClient instance is invoked.
+type ClientMercurialChangesetFunc struct {
+	defaultHook func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID) (string, error)
+	hooks       []func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID) (string, error)
+	history     []ClientMercurialChangesetFuncCall
+	mutex       sync.Mutex
+}
+
+// MercurialChangeset delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) MercurialChangeset(v0 context.Context, v1 authz.SubRepoPermissionChecker, v2 api.RepoName, v3 api.CommitID) (string, error) {
+	r0, r1 := m.MercurialChangesetFunc.nextHook()(v0, v1, v2, v3)
+	m.MercurialChangesetFunc.appendCall(ClientMercurialChangesetFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the MercurialChangeset
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientMercurialChangesetFunc) SetDefaultHook(hook func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID) (string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// MercurialChangeset method of the parent MockClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ClientMercurialChangesetFunc) PushHook(hook func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID) (string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientMercurialChangesetFunc) SetDefaultReturn(r0 string, r1 error) {
+	f.SetDefaultHook(func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID) (string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientMercurialChangesetFunc) PushReturn(r0 string, r1 error) {
+	f.PushHook(func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID) (string, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientMercurialChangesetFunc) nextHook() func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID) (string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientMercurialChangesetFunc) appendCall(r0 ClientMercurialChangesetFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientMercurialChangesetFuncCall objects
+// describing the invocations of this function.
+func (f *ClientMercurialChangesetFunc) History() []ClientMercurialChangesetFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientMercurialChangesetFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientMercurialChangesetFuncCall is an object that describes an
+// invocation of method MercurialChangeset on an instance of MockClient.
+type ClientMercurialChangesetFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 authz.SubRepoPermissionChecker
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.RepoName
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 api.CommitID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientMercurialChangesetFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientMercurialChangesetFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // ClientMergeBaseFunc describes the behavior when the MergeBase method of
 // the parent MockClient instance is invoked.
 type ClientMergeBaseFunc struct {
@@ -5422,6 +5729,126 @@ func (c ClientReadFileFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// ClientReadFileWithOptionsFunc describes the behavior when the
+// ReadFileWithOptions method of the parent MockClient instance is invoked.
+type ClientReadFileWithOptionsFunc struct {
+	defaultHook func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID, string, FileReaderOptions) ([]byte, error)
+	hooks       []func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID, string, FileReaderOptions) ([]byte, error)
+	history     []ClientReadFileWithOptionsFuncCall
+	mutex       sync.Mutex
+}
+
+// ReadFileWithOptions delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) ReadFileWithOptions(v0 context.Context, v1 authz.SubRepoPermissionChecker, v2 api.RepoName, v3 api.CommitID, v4 string, v5 FileReaderOptions) ([]byte, error) {
+	r0, r1 := m.ReadFileWithOptionsFunc.nextHook()(v0, v1, v2, v3, v4, v5)
+	m.ReadFileWithOptionsFunc.appendCall(ClientReadFileWithOptionsFuncCall{v0, v1, v2, v3, v4, v5, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ReadFileWithOptions
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientReadFileWithOptionsFunc) SetDefaultHook(hook func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID, string, FileReaderOptions) ([]byte, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ReadFileWithOptions method of the parent MockClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ClientReadFileWithOptionsFunc) PushHook(hook func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID, string, FileReaderOptions) ([]byte, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientReadFileWithOptionsFunc) SetDefaultReturn(r0 []byte, r1 error) {
+	f.SetDefaultHook(func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID, string, FileReaderOptions) ([]byte, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientReadFileWithOptionsFunc) PushReturn(r0 []byte, r1 error) {
+	f.PushHook(func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID, string, FileReaderOptions) ([]byte, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientReadFileWithOptionsFunc) nextHook() func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID, string, FileReaderOptions) ([]byte, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientReadFileWithOptionsFunc) appendCall(r0 ClientReadFileWithOptionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientReadFileWithOptionsFuncCall objects
+// describing the invocations of this function.
+func (f *ClientReadFileWithOptionsFunc) History() []ClientReadFileWithOptionsFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientReadFileWithOptionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientReadFileWithOptionsFuncCall is an object that describes an
+// invocation of method ReadFileWithOptions on an instance of MockClient.
+type ClientReadFileWithOptionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 authz.SubRepoPermissionChecker
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.RepoName
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 api.CommitID
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 string
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 FileReaderOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []byte
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientReadFileWithOptionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientReadFileWithOptionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // ClientRefDescriptionsFunc describes the behavior when the RefDescriptions
 // method of the parent MockClient instance is invoked.
 type ClientRefDescriptionsFunc struct {
@@ -5869,6 +6296,225 @@ func (c ClientRepoCloneProgressFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// ClientRepoMaintenanceFunc describes the behavior when the RepoMaintenance
+// method of the parent MockClient instance is invoked.
+type ClientRepoMaintenanceFunc struct {
+	defaultHook func(context.Context, api.RepoName, bool) (*protocol.RepoMaintenance, error)
+	hooks       []func(context.Context, api.RepoName, bool) (*protocol.RepoMaintenance, error)
+	history     []ClientRepoMaintenanceFuncCall
+	mutex       sync.Mutex
+}
+
+// RepoMaintenance delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) RepoMaintenance(v0 context.Context, v1 api.RepoName, v2 bool) (*protocol.RepoMaintenance, error) {
+	r0, r1 := m.RepoMaintenanceFunc.nextHook()(v0, v1, v2)
+	m.RepoMaintenanceFunc.appendCall(ClientRepoMaintenanceFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the RepoMaintenance
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientRepoMaintenanceFunc) SetDefaultHook(hook func(context.Context, api.RepoName, bool) (*protocol.RepoMaintenance, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RepoMaintenance method of the parent MockClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientRepoMaintenanceFunc) PushHook(hook func(context.Context, api.RepoName, bool) (*protocol.RepoMaintenance, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientRepoMaintenanceFunc) SetDefaultReturn(r0 *protocol.RepoMaintenance, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, bool) (*protocol.RepoMaintenance, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientRepoMaintenanceFunc) PushReturn(r0 *protocol.RepoMaintenance, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, bool) (*protocol.RepoMaintenance, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientRepoMaintenanceFunc) nextHook() func(context.Context, api.RepoName, bool) (*protocol.RepoMaintenance, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientRepoMaintenanceFunc) appendCall(r0 ClientRepoMaintenanceFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientRepoMaintenanceFuncCall objects
+// describing the invocations of this function.
+func (f *ClientRepoMaintenanceFunc) History() []ClientRepoMaintenanceFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientRepoMaintenanceFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientRepoMaintenanceFuncCall is an object that describes an invocation
+// of method RepoMaintenance on an instance of MockClient.
+type ClientRepoMaintenanceFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 bool
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *protocol.RepoMaintenance
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientRepoMaintenanceFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientRepoMaintenanceFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientRepoSizeBreakdownFunc describes the behavior when the
+// RepoSizeBreakdown method of the parent MockClient instance is invoked.
+type ClientRepoSizeBreakdownFunc struct {
+	defaultHook func(context.Context, api.RepoName) (*protocol.RepoSizeBreakdown, error)
+	hooks       []func(context.Context, api.RepoName) (*protocol.RepoSizeBreakdown, error)
+	history     []ClientRepoSizeBreakdownFuncCall
+	mutex       sync.Mutex
+}
+
+// RepoSizeBreakdown delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) RepoSizeBreakdown(v0 context.Context, v1 api.RepoName) (*protocol.RepoSizeBreakdown, error) {
+	r0, r1 := m.RepoSizeBreakdownFunc.nextHook()(v0, v1)
+	m.RepoSizeBreakdownFunc.appendCall(ClientRepoSizeBreakdownFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the RepoSizeBreakdown
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientRepoSizeBreakdownFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (*protocol.RepoSizeBreakdown, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RepoSizeBreakdown method of the parent MockClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ClientRepoSizeBreakdownFunc) PushHook(hook func(context.Context, api.RepoName) (*protocol.RepoSizeBreakdown, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientRepoSizeBreakdownFunc) SetDefaultReturn(r0 *protocol.RepoSizeBreakdown, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) (*protocol.RepoSizeBreakdown, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientRepoSizeBreakdownFunc) PushReturn(r0 *protocol.RepoSizeBreakdown, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName) (*protocol.RepoSizeBreakdown, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientRepoSizeBreakdownFunc) nextHook() func(context.Context, api.RepoName) (*protocol.RepoSizeBreakdown, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientRepoSizeBreakdownFunc) appendCall(r0 ClientRepoSizeBreakdownFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientRepoSizeBreakdownFuncCall objects
+// describing the invocations of this function.
+func (f *ClientRepoSizeBreakdownFunc) History() []ClientRepoSizeBreakdownFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientRepoSizeBreakdownFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientRepoSizeBreakdownFuncCall is an object that describes an invocation
+// of method RepoSizeBreakdown on an instance of MockClient.
+type ClientRepoSizeBreakdownFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *protocol.RepoSizeBreakdown
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientRepoSizeBreakdownFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientRepoSizeBreakdownFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // ClientReposStatsFunc describes the behavior when the ReposStats method of
 // the parent MockClient instance is invoked.
 type ClientReposStatsFunc struct {
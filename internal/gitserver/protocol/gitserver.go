@@ -258,6 +258,10 @@ type ExecRequest struct {
 	Args           []string `json:"args"`
 	Stdin          []byte   `json:"stdin,omitempty"`
 	NoTimeout      bool     `json:"noTimeout"`
+	// SmudgeLFS replaces Git LFS pointer files in the output of commands
+	// printing blobs or archives by the content of the files, if gitserver
+	// fetches the LFS objects of the repository.
+	SmudgeLFS bool `json:"smudgeLFS,omitempty"`
 }
 
 // ListFilesOpts specifies options when calling gitserverClient.ListFiles.
@@ -565,6 +569,134 @@ func (rs *ReposStats) ToProto() *proto.ReposStatsResponse {
 	}
 }
 
+// RepoSizeBreakdownRequest is a request for the disk usage of a repository.
+type RepoSizeBreakdownRequest struct {
+	Repo api.RepoName
+}
+
+// RepoSizeBreakdown is the disk usage of a repository broken down by the kind
+// of data stored in its .git directory.
+type RepoSizeBreakdown struct {
+	// Cloned is true if the repository is cloned. If it is false, all sizes
+	// are zero.
+	Cloned bool
+
+	TotalBytes       int64 // size of the .git directory
+	PackfileBytes    int64 // size of the packfiles and their indexes
+	PackfileCount    int64 // number of packfiles
+	LooseObjectBytes int64 // size of the loose objects
+	LooseObjectCount int64 // number of loose objects
+	CommitGraphBytes int64 // size of the commit-graph files
+	OtherBytes       int64 // size of everything else, such as refs and config
+
+	// DiskQuotaBytes is the maximum size of the repository, or zero if it has
+	// no quota.
+	DiskQuotaBytes int64
+}
+
+func (r *RepoSizeBreakdown) ToProto() *proto.RepoSizeBreakdownResponse {
+	return &proto.RepoSizeBreakdownResponse{
+		Cloned:           r.Cloned,
+		TotalBytes:       uint64(r.TotalBytes),
+		PackfileBytes:    uint64(r.PackfileBytes),
+		PackfileCount:    uint64(r.PackfileCount),
+		LooseObjectBytes: uint64(r.LooseObjectBytes),
+		LooseObjectCount: uint64(r.LooseObjectCount),
+		CommitGraphBytes: uint64(r.CommitGraphBytes),
+		OtherBytes:       uint64(r.OtherBytes),
+		DiskQuotaBytes:   uint64(r.DiskQuotaBytes),
+	}
+}
+
+func (r *RepoSizeBreakdown) FromProto(p *proto.RepoSizeBreakdownResponse) {
+	*r = RepoSizeBreakdown{
+		Cloned:           p.GetCloned(),
+		TotalBytes:       int64(p.GetTotalBytes()),
+		PackfileBytes:    int64(p.GetPackfileBytes()),
+		PackfileCount:    int64(p.GetPackfileCount()),
+		LooseObjectBytes: int64(p.GetLooseObjectBytes()),
+		LooseObjectCount: int64(p.GetLooseObjectCount()),
+		CommitGraphBytes: int64(p.GetCommitGraphBytes()),
+		OtherBytes:       int64(p.GetOtherBytes()),
+		DiskQuotaBytes:   int64(p.GetDiskQuotaBytes()),
+	}
+}
+
+// RepoMaintenanceRequest is a request for the maintenance status of a
+// repository.
+type RepoMaintenanceRequest struct {
+	Repo api.RepoName
+	// Trigger schedules maintenance of the repository ahead of all other
+	// repositories.
+	Trigger bool
+}
+
+// RepoMaintenance is the maintenance status of a repository on gitserver.
+type RepoMaintenance struct {
+	Cloned bool // whether the repository is cloned
+	Queued bool // whether maintenance of the repository is scheduled
+	// QueuePosition is the number of repositories which are maintained before
+	// the repository, if it is queued.
+	QueuePosition int64
+	Running       bool // whether the repository is being maintained
+
+	// Priority is the priority of the repository in the maintenance queue,
+	// computed from its recent reads and fetches. Both are decayed over time.
+	Priority float64
+	Reads    float64
+	Fetches  float64
+
+	// LastRunAt is when the last maintenance of the repository finished. It is
+	// nil if the repository was not maintained since gitserver started.
+	LastRunAt    *time.Time
+	LastDuration time.Duration
+	LastTasks    []string // the tasks which ran during the last maintenance
+	LastError    string   // the error of the last maintenance, if it failed
+}
+
+func (r *RepoMaintenance) ToProto() *proto.RepoMaintenanceResponse {
+	var lastRunAt *timestamppb.Timestamp
+	if r.LastRunAt != nil {
+		lastRunAt = timestamppb.New(*r.LastRunAt)
+	}
+
+	return &proto.RepoMaintenanceResponse{
+		Cloned:        r.Cloned,
+		Queued:        r.Queued,
+		QueuePosition: uint64(r.QueuePosition),
+		Running:       r.Running,
+		Priority:      r.Priority,
+		Reads:         r.Reads,
+		Fetches:       r.Fetches,
+		LastRunAt:     lastRunAt,
+		LastDuration:  durationpb.New(r.LastDuration),
+		LastTasks:     r.LastTasks,
+		LastError:     r.LastError,
+	}
+}
+
+func (r *RepoMaintenance) FromProto(p *proto.RepoMaintenanceResponse) {
+	var lastRunAt *time.Time
+	if p.LastRunAt != nil {
+		t := p.GetLastRunAt().AsTime()
+		lastRunAt = &t
+	}
+
+	*r = RepoMaintenance{
+		Cloned:        p.GetCloned(),
+		Queued:        p.GetQueued(),
+		QueuePosition: int64(p.GetQueuePosition()),
+		Running:       p.GetRunning(),
+		Priority:      p.GetPriority(),
+		Reads:         p.GetReads(),
+		Fetches:       p.GetFetches(),
+		LastRunAt:     lastRunAt,
+		LastDuration:  p.GetLastDuration().AsDuration(),
+		LastTasks:     p.GetLastTasks(),
+		LastError:     p.GetLastError(),
+	}
+}
+
 // RepoCloneProgressRequest is a request for information about the clone progress of multiple
 // repositories on gitserver.
 type RepoCloneProgressRequest struct {
@@ -696,6 +828,148 @@ func (c *CreateCommitFromPatchRequest) FromProto(p *proto.CreateCommitFromPatchB
 	}
 }
 
+// FileOperationType is the kind of change a FileOperation makes to a file.
+type FileOperationType string
+
+const (
+	// FileOperationWrite sets the contents and executable bit of a file,
+	// creating it if it doesn't exist.
+	FileOperationWrite FileOperationType = "write"
+	// FileOperationDelete deletes a file.
+	FileOperationDelete FileOperationType = "delete"
+	// FileOperationChmod sets the executable bit of a file.
+	FileOperationChmod FileOperationType = "chmod"
+)
+
+func (t FileOperationType) ToProto() proto.FileOperationType {
+	switch t {
+	case FileOperationWrite:
+		return proto.FileOperationType_FILE_OPERATION_TYPE_WRITE
+	case FileOperationDelete:
+		return proto.FileOperationType_FILE_OPERATION_TYPE_DELETE
+	case FileOperationChmod:
+		return proto.FileOperationType_FILE_OPERATION_TYPE_CHMOD
+	default:
+		return proto.FileOperationType_FILE_OPERATION_TYPE_UNSPECIFIED
+	}
+}
+
+func FileOperationTypeFromProto(t proto.FileOperationType) FileOperationType {
+	switch t {
+	case proto.FileOperationType_FILE_OPERATION_TYPE_WRITE:
+		return FileOperationWrite
+	case proto.FileOperationType_FILE_OPERATION_TYPE_DELETE:
+		return FileOperationDelete
+	case proto.FileOperationType_FILE_OPERATION_TYPE_CHMOD:
+		return FileOperationChmod
+	default:
+		return ""
+	}
+}
+
+// FileOperation is a change to a file of the tree of a commit.
+type FileOperation struct {
+	// Type is the kind of change.
+	Type FileOperationType
+	// Path is the path of the file, relative to the root of the repository.
+	Path string
+	// Contents are the new contents of the file, for FileOperationWrite.
+	Contents []byte
+	// Executable is whether the file is executable, for FileOperationWrite and
+	// FileOperationChmod.
+	Executable bool
+}
+
+func (o *FileOperation) ToProto() *proto.FileOperation {
+	return &proto.FileOperation{
+		Type:       o.Type.ToProto(),
+		Path:       o.Path,
+		Contents:   o.Contents,
+		Executable: o.Executable,
+	}
+}
+
+func (o *FileOperation) FromProto(p *proto.FileOperation) {
+	*o = FileOperation{
+		Type:       FileOperationTypeFromProto(p.GetType()),
+		Path:       p.GetPath(),
+		Contents:   p.GetContents(),
+		Executable: p.GetExecutable(),
+	}
+}
+
+// CreateCommitFromFileOperationsRequest is the request information needed for
+// creating a commit from a list of file operations on a base commit. Apart
+// from how the tree of the commit is built, it behaves like
+// CreateCommitFromPatchRequest.
+type CreateCommitFromFileOperationsRequest struct {
+	// Repo is the repository to get information about.
+	Repo api.RepoName
+	// BaseCommit is the revision that the file operations are applied to.
+	BaseCommit api.CommitID
+	// FileOperations are the changes to the tree of the base commit, applied
+	// in order.
+	FileOperations []FileOperation
+	// TargetRef is the ref that will be created for the commit
+	TargetRef string
+	// If set to true and the TargetRef already exists, an unique number will be appended to the end (ie TargetRef-{#}). The generated ref will be returned.
+	UniqueRef bool
+	// CommitInfo is the information that will be used when creating the commit
+	CommitInfo PatchCommitInfo
+	// Push specifies whether the target ref will be pushed to the code host: if
+	// nil, no push will be attempted, if non-nil, a push will be attempted.
+	Push *PushConfig
+	// If specified, the changes will be pushed to this ref as opposed to TargetRef.
+	PushRef *string
+}
+
+func (c *CreateCommitFromFileOperationsRequest) ToProto() *proto.CreateCommitFromFileOperationsRequest {
+	var ops []*proto.FileOperation
+	for i := range c.FileOperations {
+		ops = append(ops, c.FileOperations[i].ToProto())
+	}
+
+	return &proto.CreateCommitFromFileOperationsRequest{
+		Repo:           string(c.Repo),
+		BaseCommit:     string(c.BaseCommit),
+		FileOperations: ops,
+		TargetRef:      c.TargetRef,
+		UniqueRef:      c.UniqueRef,
+		CommitInfo:     c.CommitInfo.ToProto(),
+		Push:           c.Push.ToProto(),
+		PushRef:        c.PushRef,
+	}
+}
+
+func (c *CreateCommitFromFileOperationsRequest) FromProto(p *proto.CreateCommitFromFileOperationsRequest) {
+	var ops []FileOperation
+	for _, op := range p.GetFileOperations() {
+		var o FileOperation
+		o.FromProto(op)
+		ops = append(ops, o)
+	}
+
+	var pushConfig *PushConfig
+	if gp := p.GetPush(); gp != nil {
+		pushConfig = &PushConfig{}
+		pushConfig.FromProto(gp)
+	}
+
+	*c = CreateCommitFromFileOperationsRequest{
+		Repo:           api.RepoName(p.GetRepo()),
+		BaseCommit:     api.CommitID(p.GetBaseCommit()),
+		FileOperations: ops,
+		TargetRef:      p.GetTargetRef(),
+		UniqueRef:      p.GetUniqueRef(),
+		CommitInfo:     PatchCommitInfoFromProto(p.GetCommitInfo()),
+		Push:           pushConfig,
+	}
+
+	if p != nil {
+		c.PushRef = p.PushRef
+	}
+}
+
 // PatchCommitInfo will be used for commit information when creating a commit from a patch
 type PatchCommitInfo struct {
 	Messages       []string
@@ -812,6 +1086,32 @@ func (r *CreateCommitFromPatchResponse) FromProto(p *proto.CreateCommitFromPatch
 	r.ChangelistId = p.ChangelistId
 }
 
+// ToFileOperationsProto converts r to the response of
+// CreateCommitFromFileOperations, which has the same fields as the response of
+// CreateCommitFromPatchBinary.
+func (r *CreateCommitFromPatchResponse) ToFileOperationsProto() *proto.CreateCommitFromFileOperationsResponse {
+	var err *proto.CreateCommitFromPatchError
+	if r.Error != nil {
+		err = r.Error.ToProto()
+	}
+	return &proto.CreateCommitFromFileOperationsResponse{
+		Rev:          r.Rev,
+		Error:        err,
+		ChangelistId: r.ChangelistId,
+	}
+}
+
+func (r *CreateCommitFromPatchResponse) FromFileOperationsProto(p *proto.CreateCommitFromFileOperationsResponse) {
+	if p.GetError() == nil {
+		r.Error = nil
+	} else {
+		r.Error = &CreateCommitFromPatchError{}
+		r.Error.FromProto(p.GetError())
+	}
+	r.Rev = p.GetRev()
+	r.ChangelistId = p.GetChangelistId()
+}
+
 // SetError adds the supplied error related details to e.
 func (e *CreateCommitFromPatchResponse) SetError(repo, command, out string, err error) {
 	if e.Error == nil {
@@ -0,0 +1,186 @@
+package gitserver
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	sglog "github.com/sourcegraph/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+var replicaFailoverCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "src_gitserver_client_replica_failover_total",
+	Help: "Number of requests to gitserver which failed over to another replica of the repository",
+}, []string{"protocol"})
+
+// failoverConn is a grpc.ClientConnInterface which sends requests to the first
+// of conns, and fails over to the next connection when a gitserver is
+// unavailable. conns are the connections to the replicas of a repo, starting
+// with the primary.
+type failoverConn struct {
+	conns []*grpc.ClientConn
+}
+
+func (f *failoverConn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) (err error) {
+	for i, conn := range f.conns {
+		if i > 0 {
+			replicaFailoverCounter.WithLabelValues("grpc").Inc()
+		}
+		err = conn.Invoke(ctx, method, args, reply, opts...)
+		if !shouldFailover(ctx, err) {
+			return err
+		}
+	}
+	return err
+}
+
+func (f *failoverConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	s := &failoverStream{
+		ctx:    ctx,
+		desc:   desc,
+		method: method,
+		opts:   opts,
+		conns:  f.conns,
+	}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// failoverStream is a grpc.ClientStream which is reopened on the next replica
+// if the gitserver becomes unavailable before the first response message was
+// received. Only streams with a single request message are reopened, since the
+// request has to be sent again.
+type failoverStream struct {
+	grpc.ClientStream
+
+	ctx    context.Context
+	desc   *grpc.StreamDesc
+	method string
+	opts   []grpc.CallOption
+
+	// conns are the connections which have not been tried yet, starting with
+	// the connection of the current stream.
+	conns []*grpc.ClientConn
+
+	request    any
+	closedSend bool
+	received   bool
+}
+
+// open opens the stream on the first available connection.
+func (s *failoverStream) open() error {
+	for {
+		stream, err := s.conns[0].NewStream(s.ctx, s.desc, s.method, s.opts...)
+		if err == nil {
+			s.ClientStream = stream
+			return nil
+		}
+		if len(s.conns) == 1 || !shouldFailover(s.ctx, err) {
+			return err
+		}
+		s.conns = s.conns[1:]
+		replicaFailoverCounter.WithLabelValues("grpc").Inc()
+	}
+}
+
+func (s *failoverStream) SendMsg(m any) error {
+	if !s.desc.ClientStreams {
+		s.request = m
+	}
+	return s.ClientStream.SendMsg(m)
+}
+
+func (s *failoverStream) CloseSend() error {
+	s.closedSend = true
+	return s.ClientStream.CloseSend()
+}
+
+func (s *failoverStream) RecvMsg(m any) error {
+	for {
+		err := s.ClientStream.RecvMsg(m)
+		if err == nil {
+			s.received = true
+			return nil
+		}
+		if s.received || s.desc.ClientStreams || len(s.conns) == 1 || !shouldFailover(s.ctx, err) {
+			return err
+		}
+
+		// Nothing was received yet, so we can send the request to the next
+		// replica instead.
+		s.conns = s.conns[1:]
+		replicaFailoverCounter.WithLabelValues("grpc").Inc()
+		if err := s.open(); err != nil {
+			return err
+		}
+		if s.request != nil {
+			if err := s.ClientStream.SendMsg(s.request); err != nil {
+				return err
+			}
+		}
+		if s.closedSend {
+			if err := s.ClientStream.CloseSend(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// shouldFailover reports whether a request which failed with err should be
+// sent to the next replica.
+func shouldFailover(ctx context.Context, err error) bool {
+	return err != nil && ctx.Err() == nil && status.Code(err) == codes.Unavailable
+}
+
+// clientForAddr returns the gRPC client for the gitserver at addr.
+func (c *clientImplementor) clientForAddr(addr string) (proto.GitserverServiceClient, error) {
+	for _, a := range c.clientSource.Addresses() {
+		if a.Address() == addr {
+			return a.GRPCClient()
+		}
+	}
+	return nil, errors.Newf("no gRPC connection found for address %q", addr)
+}
+
+// forEachReplica calls f with the address of every gitserver which stores a
+// replica of repo, starting with the primary. It returns nil if f succeeded
+// for at least one replica, and the error of the first replica otherwise.
+// Errors of the other replicas are logged, since they catch up on their next
+// update.
+func (c *clientImplementor) forEachReplica(repo api.RepoName, op string, f func(addr string) error) error {
+	addrs := c.clientSource.AddrsForRepo(c.userAgent, repo)
+	if len(addrs) == 1 {
+		return f(addrs[0])
+	}
+
+	var firstErr error
+	succeeded := false
+	for _, addr := range addrs {
+		if err := f(addr); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			c.logger.Warn("gitserver replica request failed",
+				sglog.String("op", op),
+				sglog.String("repo", string(repo)),
+				sglog.String("addr", addr),
+				sglog.Error(err),
+			)
+			continue
+		}
+		succeeded = true
+	}
+	if succeeded {
+		return nil
+	}
+	return firstErr
+}
@@ -19,20 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	GitserverService_BatchLog_FullMethodName                    = "/gitserver.v1.GitserverService/BatchLog"
-	GitserverService_CreateCommitFromPatchBinary_FullMethodName = "/gitserver.v1.GitserverService/CreateCommitFromPatchBinary"
-	GitserverService_Exec_FullMethodName                        = "/gitserver.v1.GitserverService/Exec"
-	GitserverService_GetObject_FullMethodName                   = "/gitserver.v1.GitserverService/GetObject"
-	GitserverService_IsRepoCloneable_FullMethodName             = "/gitserver.v1.GitserverService/IsRepoCloneable"
-	GitserverService_ListGitolite_FullMethodName                = "/gitserver.v1.GitserverService/ListGitolite"
-	GitserverService_Search_FullMethodName                      = "/gitserver.v1.GitserverService/Search"
-	GitserverService_Archive_FullMethodName                     = "/gitserver.v1.GitserverService/Archive"
-	GitserverService_P4Exec_FullMethodName                      = "/gitserver.v1.GitserverService/P4Exec"
-	GitserverService_RepoClone_FullMethodName                   = "/gitserver.v1.GitserverService/RepoClone"
-	GitserverService_RepoCloneProgress_FullMethodName           = "/gitserver.v1.GitserverService/RepoCloneProgress"
-	GitserverService_RepoDelete_FullMethodName                  = "/gitserver.v1.GitserverService/RepoDelete"
-	GitserverService_RepoUpdate_FullMethodName                  = "/gitserver.v1.GitserverService/RepoUpdate"
-	GitserverService_ReposStats_FullMethodName                  = "/gitserver.v1.GitserverService/ReposStats"
+	GitserverService_BatchLog_FullMethodName                       = "/gitserver.v1.GitserverService/BatchLog"
+	GitserverService_CreateCommitFromPatchBinary_FullMethodName    = "/gitserver.v1.GitserverService/CreateCommitFromPatchBinary"
+	GitserverService_CreateCommitFromFileOperations_FullMethodName = "/gitserver.v1.GitserverService/CreateCommitFromFileOperations"
+	GitserverService_Exec_FullMethodName                           = "/gitserver.v1.GitserverService/Exec"
+	GitserverService_GetObject_FullMethodName                      = "/gitserver.v1.GitserverService/GetObject"
+	GitserverService_IsRepoCloneable_FullMethodName                = "/gitserver.v1.GitserverService/IsRepoCloneable"
+	GitserverService_ListGitolite_FullMethodName                   = "/gitserver.v1.GitserverService/ListGitolite"
+	GitserverService_Search_FullMethodName                         = "/gitserver.v1.GitserverService/Search"
+	GitserverService_Archive_FullMethodName                        = "/gitserver.v1.GitserverService/Archive"
+	GitserverService_P4Exec_FullMethodName                         = "/gitserver.v1.GitserverService/P4Exec"
+	GitserverService_RepoClone_FullMethodName                      = "/gitserver.v1.GitserverService/RepoClone"
+	GitserverService_RepoCloneProgress_FullMethodName              = "/gitserver.v1.GitserverService/RepoCloneProgress"
+	GitserverService_RepoDelete_FullMethodName                     = "/gitserver.v1.GitserverService/RepoDelete"
+	GitserverService_RepoUpdate_FullMethodName                     = "/gitserver.v1.GitserverService/RepoUpdate"
+	GitserverService_ReposStats_FullMethodName                     = "/gitserver.v1.GitserverService/ReposStats"
+	GitserverService_RepoSizeBreakdown_FullMethodName              = "/gitserver.v1.GitserverService/RepoSizeBreakdown"
+	GitserverService_RepoMaintenance_FullMethodName                = "/gitserver.v1.GitserverService/RepoMaintenance"
 )
 
 // GitserverServiceClient is the client API for GitserverService service.
@@ -41,6 +44,7 @@ const (
 type GitserverServiceClient interface {
 	BatchLog(ctx context.Context, in *BatchLogRequest, opts ...grpc.CallOption) (*BatchLogResponse, error)
 	CreateCommitFromPatchBinary(ctx context.Context, in *CreateCommitFromPatchBinaryRequest, opts ...grpc.CallOption) (*CreateCommitFromPatchBinaryResponse, error)
+	CreateCommitFromFileOperations(ctx context.Context, in *CreateCommitFromFileOperationsRequest, opts ...grpc.CallOption) (*CreateCommitFromFileOperationsResponse, error)
 	Exec(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (GitserverService_ExecClient, error)
 	GetObject(ctx context.Context, in *GetObjectRequest, opts ...grpc.CallOption) (*GetObjectResponse, error)
 	IsRepoCloneable(ctx context.Context, in *IsRepoCloneableRequest, opts ...grpc.CallOption) (*IsRepoCloneableResponse, error)
@@ -53,6 +57,8 @@ type GitserverServiceClient interface {
 	RepoDelete(ctx context.Context, in *RepoDeleteRequest, opts ...grpc.CallOption) (*RepoDeleteResponse, error)
 	RepoUpdate(ctx context.Context, in *RepoUpdateRequest, opts ...grpc.CallOption) (*RepoUpdateResponse, error)
 	ReposStats(ctx context.Context, in *ReposStatsRequest, opts ...grpc.CallOption) (*ReposStatsResponse, error)
+	RepoSizeBreakdown(ctx context.Context, in *RepoSizeBreakdownRequest, opts ...grpc.CallOption) (*RepoSizeBreakdownResponse, error)
+	RepoMaintenance(ctx context.Context, in *RepoMaintenanceRequest, opts ...grpc.CallOption) (*RepoMaintenanceResponse, error)
 }
 
 type gitserverServiceClient struct {
@@ -81,6 +87,15 @@ func (c *gitserverServiceClient) CreateCommitFromPatchBinary(ctx context.Context
 	return out, nil
 }
 
+func (c *gitserverServiceClient) CreateCommitFromFileOperations(ctx context.Context, in *CreateCommitFromFileOperationsRequest, opts ...grpc.CallOption) (*CreateCommitFromFileOperationsResponse, error) {
+	out := new(CreateCommitFromFileOperationsResponse)
+	err := c.cc.Invoke(ctx, GitserverService_CreateCommitFromFileOperations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gitserverServiceClient) Exec(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (GitserverService_ExecClient, error) {
 	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[0], GitserverService_Exec_FullMethodName, opts...)
 	if err != nil {
@@ -281,12 +296,31 @@ func (c *gitserverServiceClient) ReposStats(ctx context.Context, in *ReposStatsR
 	return out, nil
 }
 
+func (c *gitserverServiceClient) RepoSizeBreakdown(ctx context.Context, in *RepoSizeBreakdownRequest, opts ...grpc.CallOption) (*RepoSizeBreakdownResponse, error) {
+	out := new(RepoSizeBreakdownResponse)
+	err := c.cc.Invoke(ctx, GitserverService_RepoSizeBreakdown_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) RepoMaintenance(ctx context.Context, in *RepoMaintenanceRequest, opts ...grpc.CallOption) (*RepoMaintenanceResponse, error) {
+	out := new(RepoMaintenanceResponse)
+	err := c.cc.Invoke(ctx, GitserverService_RepoMaintenance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GitserverServiceServer is the server API for GitserverService service.
 // All implementations must embed UnimplementedGitserverServiceServer
 // for forward compatibility
 type GitserverServiceServer interface {
 	BatchLog(context.Context, *BatchLogRequest) (*BatchLogResponse, error)
 	CreateCommitFromPatchBinary(context.Context, *CreateCommitFromPatchBinaryRequest) (*CreateCommitFromPatchBinaryResponse, error)
+	CreateCommitFromFileOperations(context.Context, *CreateCommitFromFileOperationsRequest) (*CreateCommitFromFileOperationsResponse, error)
 	Exec(*ExecRequest, GitserverService_ExecServer) error
 	GetObject(context.Context, *GetObjectRequest) (*GetObjectResponse, error)
 	IsRepoCloneable(context.Context, *IsRepoCloneableRequest) (*IsRepoCloneableResponse, error)
@@ -299,6 +333,8 @@ type GitserverServiceServer interface {
 	RepoDelete(context.Context, *RepoDeleteRequest) (*RepoDeleteResponse, error)
 	RepoUpdate(context.Context, *RepoUpdateRequest) (*RepoUpdateResponse, error)
 	ReposStats(context.Context, *ReposStatsRequest) (*ReposStatsResponse, error)
+	RepoSizeBreakdown(context.Context, *RepoSizeBreakdownRequest) (*RepoSizeBreakdownResponse, error)
+	RepoMaintenance(context.Context, *RepoMaintenanceRequest) (*RepoMaintenanceResponse, error)
 	mustEmbedUnimplementedGitserverServiceServer()
 }
 
@@ -312,6 +348,9 @@ func (UnimplementedGitserverServiceServer) BatchLog(context.Context, *BatchLogRe
 func (UnimplementedGitserverServiceServer) CreateCommitFromPatchBinary(context.Context, *CreateCommitFromPatchBinaryRequest) (*CreateCommitFromPatchBinaryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCommitFromPatchBinary not implemented")
 }
+func (UnimplementedGitserverServiceServer) CreateCommitFromFileOperations(context.Context, *CreateCommitFromFileOperationsRequest) (*CreateCommitFromFileOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCommitFromFileOperations not implemented")
+}
 func (UnimplementedGitserverServiceServer) Exec(*ExecRequest, GitserverService_ExecServer) error {
 	return status.Errorf(codes.Unimplemented, "method Exec not implemented")
 }
@@ -348,6 +387,12 @@ func (UnimplementedGitserverServiceServer) RepoUpdate(context.Context, *RepoUpda
 func (UnimplementedGitserverServiceServer) ReposStats(context.Context, *ReposStatsRequest) (*ReposStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReposStats not implemented")
 }
+func (UnimplementedGitserverServiceServer) RepoSizeBreakdown(context.Context, *RepoSizeBreakdownRequest) (*RepoSizeBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepoSizeBreakdown not implemented")
+}
+func (UnimplementedGitserverServiceServer) RepoMaintenance(context.Context, *RepoMaintenanceRequest) (*RepoMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepoMaintenance not implemented")
+}
 func (UnimplementedGitserverServiceServer) mustEmbedUnimplementedGitserverServiceServer() {}
 
 // UnsafeGitserverServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -397,6 +442,24 @@ func _GitserverService_CreateCommitFromPatchBinary_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GitserverService_CreateCommitFromFileOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommitFromFileOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).CreateCommitFromFileOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_CreateCommitFromFileOperations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).CreateCommitFromFileOperations(ctx, req.(*CreateCommitFromFileOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GitserverService_Exec_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(ExecRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -625,6 +688,42 @@ func _GitserverService_ReposStats_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GitserverService_RepoSizeBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoSizeBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).RepoSizeBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_RepoSizeBreakdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).RepoSizeBreakdown(ctx, req.(*RepoSizeBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitserverService_RepoMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).RepoMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_RepoMaintenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).RepoMaintenance(ctx, req.(*RepoMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GitserverService_ServiceDesc is the grpc.ServiceDesc for GitserverService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -640,6 +739,10 @@ var GitserverService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateCommitFromPatchBinary",
 			Handler:    _GitserverService_CreateCommitFromPatchBinary_Handler,
 		},
+		{
+			MethodName: "CreateCommitFromFileOperations",
+			Handler:    _GitserverService_CreateCommitFromFileOperations_Handler,
+		},
 		{
 			MethodName: "GetObject",
 			Handler:    _GitserverService_GetObject_Handler,
@@ -672,6 +775,14 @@ var GitserverService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReposStats",
 			Handler:    _GitserverService_ReposStats_Handler,
 		},
+		{
+			MethodName: "RepoSizeBreakdown",
+			Handler:    _GitserverService_RepoSizeBreakdown_Handler,
+		},
+		{
+			MethodName: "RepoMaintenance",
+			Handler:    _GitserverService_RepoMaintenance_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -87,6 +87,14 @@ func cloneURL(ctx context.Context, parsed any, logger log.Logger, kind string, r
 		if r, ok := repo.Metadata.(*extsvc.OtherRepoMetadata); ok {
 			return otherCloneURL(repo, r), nil
 		}
+	case *schema.SubversionConnection:
+		if r, ok := repo.Metadata.(*extsvc.SubversionRepoMetadata); ok {
+			return subversionCloneURL(r.Path, t), nil
+		}
+	case *schema.MercurialConnection:
+		if r, ok := repo.Metadata.(*extsvc.MercurialRepoMetadata); ok {
+			return mercurialCloneURL(r.Path, t), nil
+		}
 	case *schema.LocalGitExternalService:
 		return localCloneURL(repo), nil
 	case *schema.GoModulesConnection:
@@ -97,6 +105,12 @@ func cloneURL(ctx context.Context, parsed any, logger log.Logger, kind string, r
 		return string(repo.Name), nil
 	case *schema.RubyPackagesConnection:
 		return string(repo.Name), nil
+	case *schema.NuGetPackagesConnection:
+		return string(repo.Name), nil
+	case *schema.HexPackagesConnection:
+		return string(repo.Name), nil
+	case *schema.PubPackagesConnection:
+		return string(repo.Name), nil
 	case *schema.JVMPackagesConnection:
 		if r, ok := repo.Metadata.(*reposource.MavenMetadata); ok {
 			return r.Module.CloneURL(), nil
@@ -0,0 +1,128 @@
+package repos
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestMercurialSource_ListRepos(t *testing.T) {
+	hgweb := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/hg/" || r.URL.Query().Get("style") != "json" {
+			http.NotFound(w, r)
+			return
+		}
+		if user, pass, _ := r.BasicAuth(); user != "admin" || pass != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"entries": [
+			{"name": "project-a", "description": "Project A", "contact": "", "lastchange": [0, 0], "labels": []},
+			{"name": "libraries/project-b", "description": "", "contact": "", "lastchange": [0, 0], "labels": []}
+		]}`))
+	}))
+	t.Cleanup(hgweb.Close)
+
+	root := t.TempDir()
+	for _, dir := range []string{"project-a/.hg", "libraries/project-b/.hg", "libraries/project-b/nested/.hg", "not-a-repo/src", ".hidden/.hg"} {
+		if err := os.MkdirAll(filepath.Join(root, dir), 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		conf      *schema.MercurialConnection
+		wantNames []api.RepoName
+		wantURLs  []string
+	}{
+		{
+			name: "configured repos",
+			conf: &schema.MercurialConnection{
+				Url:   "ssh://hg@hg.example.com/repos",
+				Repos: []string{"project-a", "libraries/project-b/"},
+			},
+			wantNames: []api.RepoName{"hg.example.com/project-a", "hg.example.com/libraries/project-b"},
+			wantURLs: []string{
+				"ssh://hg@hg.example.com/repos/project-a",
+				"ssh://hg@hg.example.com/repos/libraries/project-b",
+			},
+		},
+		{
+			name: "hgweb",
+			conf: &schema.MercurialConnection{
+				Url:                   hgweb.URL + "/hg",
+				Username:              "admin",
+				Password:              "secret",
+				Exclude:               []string{"libraries/project-b"},
+				RepositoryPathPattern: "hg/{repo}",
+			},
+			wantNames: []api.RepoName{"hg/project-a"},
+			wantURLs:  []string{"http://admin:secret@" + hgweb.Listener.Addr().String() + "/hg/project-a"},
+		},
+		{
+			name: "directory",
+			conf: &schema.MercurialConnection{
+				Url: "file://" + root,
+			},
+			wantNames: []api.RepoName{"libraries/project-b", "project-a"},
+			wantURLs: []string{
+				"file://" + root + "/libraries/project-b",
+				"file://" + root + "/project-a",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &types.ExternalService{
+				Kind:   extsvc.VariantMercurial.AsKind(),
+				Config: extsvc.NewUnencryptedConfig(MarshalJSON(t, tc.conf)),
+			}
+
+			src, err := newMercurialSource(svc, tc.conf, http.DefaultClient)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			repos, err := ListAll(context.Background(), src)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var names []api.RepoName
+			var urls []string
+			for _, r := range repos {
+				names = append(names, r.Name)
+				for _, info := range r.Sources {
+					urls = append(urls, info.CloneURL)
+				}
+
+				// The clone URL is recomputed from the metadata when cloning.
+				cloneURL, err := cloneURL(context.Background(), tc.conf, nil, svc.Kind, r)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if cloneURL != r.Sources[svc.URN()].CloneURL {
+					t.Errorf("cloneURL: have %q, want %q", cloneURL, r.Sources[svc.URN()].CloneURL)
+				}
+			}
+			if diff := cmp.Diff(tc.wantNames, names); diff != "" {
+				t.Errorf("names mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantURLs, urls); diff != "" {
+				t.Errorf("clone URLs mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -78,10 +78,20 @@ func NewSource(ctx context.Context, logger log.Logger, db database.DB, svc *type
 		return NewRustPackagesSource(ctx, svc, cf)
 	case extsvc.KindRubyPackages:
 		return NewRubyPackagesSource(ctx, svc, cf)
+	case extsvc.KindNuGetPackages:
+		return NewNuGetPackagesSource(ctx, svc, cf)
+	case extsvc.KindHexPackages:
+		return NewHexPackagesSource(ctx, svc, cf)
+	case extsvc.KindPubPackages:
+		return NewPubPackagesSource(ctx, svc, cf)
 	case extsvc.KindOther:
 		return NewOtherSource(ctx, svc, cf, logger.Scoped("OtherSource", ""))
 	case extsvc.VariantLocalGit.AsKind():
 		return NewLocalGitSource(ctx, logger.Scoped("LocalSource", "local repo source"), svc)
+	case extsvc.VariantSubversion.AsKind():
+		return NewSubversionSource(ctx, svc)
+	case extsvc.VariantMercurial.AsKind():
+		return NewMercurialSource(ctx, svc, cf)
 	default:
 		return nil, errors.Newf("cannot create source for kind %q", svc.Kind)
 	}
@@ -12,6 +12,10 @@ const (
 	File       = "file"
 	Repository = "repo"
 	Symbol     = "symbol"
+
+	// Capture selects the first capture group of a regular expression
+	// pattern from content matches (`select:content.capture`).
+	Capture = "capture"
 )
 
 // SelectPath represents a parsed and validated select value
@@ -39,7 +43,9 @@ var validSelectors = object{
 			"removed": nil,
 		},
 	},
-	Content: nil,
+	Content: object{
+		Capture: nil,
+	},
 	File: {
 		"directory": nil,
 		"path":      nil,
@@ -90,6 +90,10 @@ func (s *SequentialJob) Run(ctx context.Context, clients job.RuntimeClients, par
 			for _, match := range event.Results {
 				seen := dedup.Seen(match)
 				if seen {
+					// Matches which add up are merged by the receiver
+					if result.AddsUp(match) {
+						results = append(results, match)
+					}
 					continue
 				}
 				dedup.Add(match)
@@ -14,6 +14,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/search/job/mockjob"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -214,3 +215,25 @@ func TestSequentialJob(t *testing.T) {
 		require.Equal(t, 5, len(sent))
 	})
 }
+
+func TestSequentialJobCaptures(t *testing.T) {
+	repo := types.MinimalRepo{ID: 1, Name: "foo"}
+	mockJob := mockjob.NewMockJob()
+	mockJob.RunFunc.SetDefaultHook(func(_ context.Context, _ job.RuntimeClients, s streaming.Sender) (*search.Alert, error) {
+		s.Send(streaming.SearchEvent{Results: result.Matches{&result.CaptureMatch{Value: "1.2", Repo: repo, Count: 2}}})
+		s.Send(streaming.SearchEvent{Results: result.Matches{&result.CaptureMatch{Value: "1.2", Repo: repo, Count: 3}}})
+		return nil, nil
+	})
+
+	// Capture matches with the same value are sent on, and add up to the
+	// count of the value.
+	agg := streaming.NewAggregatingStream()
+	_, err := NewSequentialJob(true, mockJob).Run(context.Background(), job.RuntimeClients{}, agg)
+	require.NoError(t, err)
+
+	dedup := result.NewDeduper()
+	for _, match := range agg.Results {
+		dedup.Add(match)
+	}
+	require.Equal(t, result.Matches{&result.CaptureMatch{Value: "1.2", Repo: repo, Count: 5}}, dedup.Results())
+}
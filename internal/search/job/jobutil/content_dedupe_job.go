@@ -0,0 +1,128 @@
+package jobutil
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+)
+
+// NewContentDedupeJob creates a job that collapses file matches with identical
+// content at the same path across repositories, such as the copies of a file
+// in forks and mirrors of a repository, into a single result listing the
+// other repositories (`dedupe:content`).
+//
+// The first occurrence of a file is sent as soon as it is found. Copies found
+// in later events are reported by sending the file match again with only the
+// new duplicate repos, and are counted in the stats of the search. Files are
+// identified by the checksum of their contents, which Zoekt and searcher
+// compute the same way.
+func NewContentDedupeJob(child job.Job) job.Job {
+	return &contentDedupeJob{child: child}
+}
+
+type contentDedupeJob struct {
+	child job.Job
+}
+
+// contentKey identifies a file with the same content at the same path across
+// repositories.
+type contentKey struct {
+	path     string
+	checksum string
+}
+
+// contentCopies is the first occurrence of a file and the repositories it was
+// found in.
+type contentCopies struct {
+	first *result.FileMatch
+	repos map[api.RepoID]struct{}
+}
+
+func (j *contentDedupeJob) Run(ctx context.Context, clients job.RuntimeClients, stream streaming.Sender) (alert *search.Alert, err error) {
+	_, ctx, stream, finish := job.StartSpan(ctx, stream, j)
+	defer func() { finish(alert, err) }()
+
+	var (
+		mu     sync.Mutex
+		copies = make(map[contentKey]*contentCopies)
+	)
+
+	dedupeStream := streaming.StreamFunc(func(event streaming.SearchEvent) {
+		mu.Lock()
+		// Copies of files first found in this event are added to the match
+		// before it is sent, and copies of files sent earlier are sent again
+		// as an update.
+		var (
+			results = event.Results[:0]
+			firsts  = make(map[contentKey]struct{})
+			updates = make(map[contentKey]*result.FileMatch)
+		)
+		for _, res := range event.Results {
+			fm, ok := res.(*result.FileMatch)
+			if !ok || len(fm.ContentChecksum) == 0 {
+				results = append(results, res)
+				continue
+			}
+
+			key := contentKey{path: fm.Path, checksum: string(fm.ContentChecksum)}
+			c, ok := copies[key]
+			if !ok {
+				copies[key] = &contentCopies{first: fm, repos: map[api.RepoID]struct{}{fm.Repo.ID: {}}}
+				firsts[key] = struct{}{}
+				results = append(results, fm)
+				continue
+			}
+
+			// Copies in a repository the file was already found in, e.g. in
+			// other revisions, are hidden without being listed.
+			event.Stats.DuplicateMatches++
+			if _, ok := c.repos[fm.Repo.ID]; ok {
+				continue
+			}
+			c.repos[fm.Repo.ID] = struct{}{}
+
+			if _, ok := firsts[key]; ok {
+				c.first.DuplicateRepos = append(c.first.DuplicateRepos, fm.Repo)
+				continue
+			}
+			update, ok := updates[key]
+			if !ok {
+				update = &result.FileMatch{File: c.first.File}
+				updates[key] = update
+				results = append(results, update)
+			}
+			update.DuplicateRepos = append(update.DuplicateRepos, fm.Repo)
+		}
+		mu.Unlock()
+
+		event.Results = results
+		stream.Send(event)
+	})
+
+	return j.child.Run(ctx, clients, dedupeStream)
+}
+
+func (j *contentDedupeJob) Name() string {
+	return "ContentDedupeJob"
+}
+
+func (j *contentDedupeJob) Attributes(job.Verbosity) []attribute.KeyValue {
+	return nil
+}
+
+func (j *contentDedupeJob) Children() []job.Describer {
+	return []job.Describer{j.child}
+}
+
+func (j *contentDedupeJob) MapChildren(fn job.MapFunc) job.Job {
+	cp := *j
+	cp.child = job.Map(j.child, fn)
+	return &cp
+}
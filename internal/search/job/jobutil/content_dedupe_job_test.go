@@ -0,0 +1,97 @@
+package jobutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/job/mockjob"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestContentDedupeJob(t *testing.T) {
+	upstream := types.MinimalRepo{ID: 1, Name: "upstream"}
+	fork := types.MinimalRepo{ID: 2, Name: "fork"}
+	mirror := types.MinimalRepo{ID: 3, Name: "mirror"}
+	fileMatch := func(repo types.MinimalRepo, path, checksum string) *result.FileMatch {
+		return &result.FileMatch{
+			File:            result.File{Repo: repo, Path: path},
+			ContentChecksum: []byte(checksum),
+		}
+	}
+
+	// main.go is unchanged in the fork and mirror, util.go was changed in the
+	// fork and copied to vendor/util.go in the mirror. LICENSE and
+	// docs/LICENSE are identical files in the same repository. The checksum
+	// of large.bin is not known.
+	childJob := mockjob.NewMockJob()
+	childJob.RunFunc.SetDefaultHook(func(_ context.Context, _ job.RuntimeClients, s streaming.Sender) (*search.Alert, error) {
+		s.Send(streaming.SearchEvent{Results: result.Matches{
+			fileMatch(upstream, "main.go", "a"),
+			fileMatch(fork, "main.go", "a"),
+			&result.RepoMatch{Name: upstream.Name, ID: upstream.ID},
+			fileMatch(upstream, "LICENSE", "l"),
+			fileMatch(upstream, "docs/LICENSE", "l"),
+		}})
+		s.Send(streaming.SearchEvent{Results: result.Matches{
+			fileMatch(mirror, "main.go", "a"),
+			fileMatch(upstream, "util.go", "b"),
+			fileMatch(fork, "util.go", "c"),
+			fileMatch(mirror, "vendor/util.go", "b"),
+			fileMatch(mirror, "util.go", "b"),
+			fileMatch(upstream, "main.go", "a"),
+			fileMatch(mirror, "large.bin", ""),
+			fileMatch(fork, "large.bin", ""),
+		}})
+		return nil, nil
+	})
+
+	type sentMatch struct {
+		Repo       api.RepoName
+		Path       string
+		Duplicates []api.RepoName
+	}
+	var events [][]sentMatch
+	var stats streaming.Stats
+	stream := streaming.StreamFunc(func(ev streaming.SearchEvent) {
+		var sent []sentMatch
+		for _, m := range ev.Results {
+			if fm, ok := m.(*result.FileMatch); ok {
+				match := sentMatch{Repo: fm.Repo.Name, Path: fm.Path}
+				for _, repo := range fm.DuplicateRepos {
+					match.Duplicates = append(match.Duplicates, repo.Name)
+				}
+				sent = append(sent, match)
+			}
+		}
+		events = append(events, sent)
+		stats.Update(&ev.Stats)
+	})
+
+	j := NewContentDedupeJob(childJob)
+	_, err := j.Run(context.Background(), job.RuntimeClients{}, stream)
+	require.NoError(t, err)
+
+	// The first occurrence of each file is sent with the event it was found
+	// in, listing the copies found with it. Copies of files sent earlier are
+	// sent as updates listing only the new copies.
+	require.Equal(t, [][]sentMatch{{
+		{Repo: "upstream", Path: "main.go", Duplicates: []api.RepoName{"fork"}},
+		{Repo: "upstream", Path: "LICENSE"},
+		{Repo: "upstream", Path: "docs/LICENSE"},
+	}, {
+		{Repo: "upstream", Path: "main.go", Duplicates: []api.RepoName{"mirror"}},
+		{Repo: "upstream", Path: "util.go", Duplicates: []api.RepoName{"mirror"}},
+		{Repo: "fork", Path: "util.go"},
+		{Repo: "mirror", Path: "vendor/util.go"},
+		{Repo: "mirror", Path: "large.bin"},
+		{Repo: "fork", Path: "large.bin"},
+	}}, events)
+	require.Equal(t, 4, stats.DuplicateMatches)
+}
@@ -0,0 +1,67 @@
+package jobutil
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+)
+
+// defaultMaxReorderQueueSize is the number of file matches held back to be
+// reordered if experimentalFeatures.ranking.maxReorderQueueSize is not set. It
+// is the same default as for results from Zoekt.
+const defaultMaxReorderQueueSize = 24
+
+// NewDocumentRankJob creates a job that reorders the file matches of its child
+// by the document ranks computed by the code intelligence ranking service, so
+// that results of unindexed and structural search are ordered like those of
+// indexed search.
+func NewDocumentRankJob(child job.Job, ranker streaming.DocumentRanker) job.Job {
+	return &documentRankJob{child: child, ranker: ranker}
+}
+
+type documentRankJob struct {
+	child  job.Job
+	ranker streaming.DocumentRanker
+}
+
+func (j *documentRankJob) Run(ctx context.Context, clients job.RuntimeClients, stream streaming.Sender) (alert *search.Alert, err error) {
+	_, ctx, stream, finish := job.StartSpan(ctx, stream, j)
+	defer func() { finish(alert, err) }()
+
+	rankingStream := streaming.NewRankingStream(ctx, j.ranker, maxReorderQueueSize(), stream)
+	defer rankingStream.Done()
+
+	return j.child.Run(ctx, clients, rankingStream)
+}
+
+func (j *documentRankJob) Name() string {
+	return "DocumentRankJob"
+}
+
+func (j *documentRankJob) Attributes(job.Verbosity) []attribute.KeyValue {
+	return nil
+}
+
+func (j *documentRankJob) Children() []job.Describer {
+	return []job.Describer{j.child}
+}
+
+func (j *documentRankJob) MapChildren(fn job.MapFunc) job.Job {
+	cp := *j
+	cp.child = job.Map(j.child, fn)
+	return &cp
+}
+
+// maxReorderQueueSize returns the number of file matches to hold back to be
+// reordered, or -1 if unbounded.
+func maxReorderQueueSize() int {
+	if ef := conf.Get().ExperimentalFeatures; ef != nil && ef.Ranking != nil && ef.Ranking.MaxReorderQueueSize != nil {
+		return *ef.Ranking.MaxReorderQueueSize
+	}
+	return defaultMaxReorderQueueSize
+}
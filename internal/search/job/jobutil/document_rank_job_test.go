@@ -0,0 +1,49 @@
+package jobutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	codeinteltypes "github.com/sourcegraph/sourcegraph/internal/codeintel/types"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/job/mockjob"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+type staticDocumentRanker map[string]float64
+
+func (r staticDocumentRanker) GetDocumentRanks(context.Context, api.RepoName) (codeinteltypes.RepoPathRanks, error) {
+	return codeinteltypes.RepoPathRanks{Paths: r}, nil
+}
+
+func TestDocumentRankJob(t *testing.T) {
+	repo := types.MinimalRepo{ID: 1, Name: "repo"}
+	fileMatch := func(path string) *result.FileMatch {
+		return &result.FileMatch{File: result.File{Repo: repo, Path: path}}
+	}
+
+	childJob := mockjob.NewMockJob()
+	childJob.RunFunc.SetDefaultHook(func(_ context.Context, _ job.RuntimeClients, s streaming.Sender) (*search.Alert, error) {
+		s.Send(streaming.SearchEvent{Results: result.Matches{fileMatch("test.go"), fileMatch("main.go")}})
+		s.Send(streaming.SearchEvent{Results: result.Matches{fileMatch("lib.go")}})
+		return nil, nil
+	})
+
+	var got []string
+	stream := streaming.StreamFunc(func(ev streaming.SearchEvent) {
+		for _, m := range ev.Results {
+			got = append(got, m.(*result.FileMatch).Path)
+		}
+	})
+
+	j := NewDocumentRankJob(childJob, staticDocumentRanker{"lib.go": 10, "main.go": 3})
+	_, err := j.Run(context.Background(), job.RuntimeClients{}, stream)
+	require.NoError(t, err)
+	require.Equal(t, []string{"lib.go", "main.go", "test.go"}, got)
+}
@@ -0,0 +1,187 @@
+package jobutil
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// NewEnclosingSymbolsJob creates a job that annotates the content matches of
+// each file match with the symbols enclosing them, using the symbols of the
+// file. This lets `select:symbol` operate on results that are not symbol
+// search results, such as structural search matches.
+func NewEnclosingSymbolsJob(child job.Job) job.Job {
+	return &enclosingSymbolsJob{child: child}
+}
+
+type enclosingSymbolsJob struct {
+	child job.Job
+}
+
+func (j *enclosingSymbolsJob) Run(ctx context.Context, clients job.RuntimeClients, stream streaming.Sender) (alert *search.Alert, err error) {
+	_, ctx, stream, finish := job.StartSpan(ctx, stream, j)
+	defer func() { finish(alert, err) }()
+
+	var (
+		mu   sync.Mutex
+		errs error
+	)
+
+	annotatingStream := streaming.StreamFunc(func(event streaming.SearchEvent) {
+		for _, res := range event.Results {
+			fm, ok := res.(*result.FileMatch)
+			if !ok || len(fm.ChunkMatches) == 0 {
+				continue
+			}
+
+			// We send one symbols request per file path.
+			// We should quit early on context deadline exceeded.
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				mu.Lock()
+				errs = errors.Append(errs, ctx.Err())
+				mu.Unlock()
+				break
+			}
+
+			symbols, err := getFileSymbols(ctx, fm)
+			if err != nil {
+				mu.Lock()
+				errs = errors.Append(errs, err)
+				mu.Unlock()
+				continue
+			}
+
+			fm.EnclosingSymbols = enclosingSymbols(symbols, fm.ChunkMatches)
+		}
+
+		stream.Send(event)
+	})
+
+	alert, err = j.child.Run(ctx, clients, annotatingStream)
+	if err != nil {
+		errs = errors.Append(errs, err)
+	}
+	return alert, errs
+}
+
+func (j *enclosingSymbolsJob) Name() string {
+	return "EnclosingSymbolsJob"
+}
+
+func (j *enclosingSymbolsJob) Attributes(job.Verbosity) []attribute.KeyValue {
+	return nil
+}
+
+func (j *enclosingSymbolsJob) Children() []job.Describer {
+	return []job.Describer{j.child}
+}
+
+func (j *enclosingSymbolsJob) MapChildren(fn job.MapFunc) job.Job {
+	cp := *j
+	cp.child = job.Map(j.child, fn)
+	return &cp
+}
+
+// scopeKinds are the symbol kinds, as named in `select:symbol.<kind>`, that
+// can enclose other code.
+var scopeKinds = map[string]struct{}{
+	"module":      {},
+	"namespace":   {},
+	"package":     {},
+	"class":       {},
+	"method":      {},
+	"constructor": {},
+	"enum":        {},
+	"interface":   {},
+	"function":    {},
+	"struct":      {},
+}
+
+// enclosingSymbols returns the symbols enclosing the start of each of the
+// ranges in chunks, in the order they are defined in the file.
+//
+// Symbols only carry the line they are defined on, so the innermost
+// enclosing symbol of a range is approximated by the closest scope symbol
+// defined at or above the range. Its parents, as reported by the symbol
+// itself, are included as well, so that e.g. `select:symbol.class` finds the
+// class of the method containing a match.
+func enclosingSymbols(symbols []*result.SymbolMatch, chunks result.ChunkMatches) []*result.SymbolMatch {
+	scopes := make([]*result.SymbolMatch, 0, len(symbols))
+	byName := make(map[string][]*result.SymbolMatch, len(symbols))
+	for _, s := range symbols {
+		if _, ok := scopeKinds[s.Symbol.SelectKind()]; !ok {
+			continue
+		}
+		scopes = append(scopes, s)
+		byName[s.Symbol.Name] = append(byName[s.Symbol.Name], s)
+	}
+	sort.SliceStable(scopes, func(i, k int) bool {
+		return scopes[i].Symbol.Line < scopes[k].Symbol.Line
+	})
+
+	seen := make(map[*result.SymbolMatch]struct{})
+	var enclosing []*result.SymbolMatch
+	add := func(s *result.SymbolMatch) bool {
+		if _, ok := seen[s]; ok {
+			return false
+		}
+		seen[s] = struct{}{}
+		enclosing = append(enclosing, s)
+		return true
+	}
+
+	for _, chunk := range chunks {
+		for _, rr := range chunk.Ranges {
+			// Symbol lines are 1-based, range lines are 0-based.
+			line := rr.Start.Line + 1
+			i := sort.Search(len(scopes), func(i int) bool {
+				return scopes[i].Symbol.Line > line
+			})
+			if i == 0 {
+				continue // no scope symbol is defined at or above this range
+			}
+
+			// Walk up the parents of the innermost symbol. The parent of a
+			// symbol is always defined above it.
+			for s := scopes[i-1]; s != nil && add(s); {
+				s = parentOf(s, byName[s.Symbol.Parent])
+			}
+		}
+	}
+
+	sort.SliceStable(enclosing, func(i, k int) bool {
+		return enclosing[i].Symbol.Line < enclosing[k].Symbol.Line
+	})
+	return enclosing
+}
+
+// parentOf returns the closest of the candidates defined above s that matches
+// the parent kind of s, if any.
+func parentOf(s *result.SymbolMatch, candidates []*result.SymbolMatch) *result.SymbolMatch {
+	if s.Symbol.Parent == "" {
+		return nil
+	}
+	parentKind := result.Symbol{Kind: s.Symbol.ParentKind}.SelectKind()
+
+	var parent *result.SymbolMatch
+	for _, c := range candidates {
+		if c.Symbol.Line > s.Symbol.Line {
+			continue
+		}
+		if parentKind != "" && c.Symbol.SelectKind() != parentKind {
+			continue
+		}
+		if parent == nil || c.Symbol.Line > parent.Symbol.Line {
+			parent = c
+		}
+	}
+	return parent
+}
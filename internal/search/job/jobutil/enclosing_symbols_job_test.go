@@ -0,0 +1,79 @@
+package jobutil
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hexops/autogold/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/job/mockjob"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+)
+
+func TestEnclosingSymbolsJob(t *testing.T) {
+	// class Server           (line 1)
+	//   def serve            (line 3)
+	//     <match>            (line 5)
+	//   def stop             (line 8)
+	// def main               (line 12)
+	//   <match>              (line 13)
+	symbols := []*result.SymbolMatch{
+		{Symbol: result.Symbol{Name: "main", Kind: "function", Line: 12}},
+		{Symbol: result.Symbol{Name: "Server", Kind: "class", Line: 1}},
+		{Symbol: result.Symbol{Name: "serve", Kind: "method", Line: 3, Parent: "Server", ParentKind: "class"}},
+		{Symbol: result.Symbol{Name: "port", Kind: "variable", Line: 4, Parent: "serve", ParentKind: "method"}},
+		{Symbol: result.Symbol{Name: "stop", Kind: "method", Line: 8, Parent: "Server", ParentKind: "class"}},
+	}
+
+	chunkAt := func(lines ...int) result.ChunkMatches {
+		var chunks result.ChunkMatches
+		for _, line := range lines {
+			chunks = append(chunks, result.ChunkMatch{
+				Ranges: result.Ranges{{
+					Start: result.Location{Line: line - 1},
+					End:   result.Location{Line: line - 1},
+				}},
+			})
+		}
+		return chunks
+	}
+
+	test := func(chunks result.ChunkMatches) string {
+		childJob := mockjob.NewMockJob()
+		childJob.RunFunc.SetDefaultHook(func(_ context.Context, _ job.RuntimeClients, s streaming.Sender) (*search.Alert, error) {
+			s.Send(streaming.SearchEvent{Results: result.Matches{&result.FileMatch{ChunkMatches: chunks}}})
+			return nil, nil
+		})
+
+		MockGetFileSymbols = func(context.Context, *result.FileMatch) ([]*result.SymbolMatch, error) {
+			return symbols, nil
+		}
+		t.Cleanup(func() { MockGetFileSymbols = nil })
+
+		var resultEvent streaming.SearchEvent
+		streamCollector := streaming.StreamFunc(func(ev streaming.SearchEvent) {
+			resultEvent = ev
+		})
+
+		_, err := NewEnclosingSymbolsJob(childJob).Run(context.Background(), job.RuntimeClients{}, streamCollector)
+		require.NoError(t, err)
+		require.Len(t, resultEvent.Results, 1)
+
+		var names []string
+		for _, s := range resultEvent.Results[0].(*result.FileMatch).EnclosingSymbols {
+			names = append(names, s.Symbol.Name)
+		}
+		return strings.Join(names, ", ")
+	}
+
+	autogold.Expect("Server, serve").Equal(t, test(chunkAt(5)))
+	autogold.Expect("main").Equal(t, test(chunkAt(13)))
+	autogold.Expect("Server, serve, main").Equal(t, test(chunkAt(13, 5, 6)))
+	autogold.Expect("Server").Equal(t, test(chunkAt(2)))
+	autogold.Expect("").Equal(t, test(nil))
+}
@@ -14,6 +14,10 @@ import (
 type EnterpriseJobs interface {
 	FileHasOwnerJob(child job.Job, includeOwners, excludeOwners []string) job.Job
 	SelectFileOwnerJob(child job.Job) job.Job
+
+	// DocumentRankJob orders the file matches of child by document rank, if
+	// document ranks are available.
+	DocumentRankJob(child job.Job) job.Job
 }
 
 func NewUnimplementedEnterpriseJobs() EnterpriseJobs {
@@ -30,6 +34,11 @@ func (e *enterpriseJobs) SelectFileOwnerJob(_ job.Job) job.Job {
 	return NewUnimplementedJob("`select:file.owners` searches are not available on this instance")
 }
 
+func (e *enterpriseJobs) DocumentRankJob(child job.Job) job.Job {
+	// Without document ranks, results are returned in the order they are found.
+	return child
+}
+
 func NewUnimplementedJob(msg string) *UnimplementedJob {
 	return &UnimplementedJob{msg: msg}
 }
@@ -0,0 +1,257 @@
+package jobutil
+
+import (
+	"context"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/grafana/regexp"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// maxBlameFilterFiles is the maximum number of files blamed by a single
+// search. Blame is expensive, so once this many files have been blamed
+// further file matches are dropped and the search reports that the limit was
+// hit.
+const maxBlameFilterFiles = 200
+
+// BlameFilter filters lines by the commit which last changed them, as reported
+// by git blame. The zero value accepts every line.
+type BlameFilter struct {
+	// IncludeAuthors and ExcludeAuthors match the name or email of the
+	// author of a line. All patterns are AND'ed together.
+	IncludeAuthors []*regexp.Regexp
+	ExcludeAuthors []*regexp.Regexp
+
+	// Before and After bound the author date of a line, if set.
+	Before *time.Time
+	After  *time.Time
+}
+
+// Matches returns true if a line last changed in the given hunk passes the
+// filter.
+func (f BlameFilter) Matches(hunk *gitserver.Hunk) bool {
+	if f.Before != nil && !hunk.Author.Date.Before(*f.Before) {
+		return false
+	}
+	if f.After != nil && !hunk.Author.Date.After(*f.After) {
+		return false
+	}
+	authorMatches := func(re *regexp.Regexp) bool {
+		return re.MatchString(hunk.Author.Name) || re.MatchString(hunk.Author.Email)
+	}
+	for _, re := range f.IncludeAuthors {
+		if !authorMatches(re) {
+			return false
+		}
+	}
+	for _, re := range f.ExcludeAuthors {
+		if authorMatches(re) {
+			return false
+		}
+	}
+	return true
+}
+
+// NewBlameFilterJob creates a filter job to post-filter content matches by
+// blame (`blame.author:`, `blame.before:` and `blame.after:`). Only the lines
+// spanned by the matches of a file are blamed. Ranges on lines which do not
+// pass the filter are removed, and file matches without any remaining content
+// match are dropped, as are all other results.
+func NewBlameFilterJob(child job.Job, filter BlameFilter) job.Job {
+	return &blameFilterJob{
+		child:  child,
+		filter: filter,
+	}
+}
+
+type blameFilterJob struct {
+	child  job.Job
+	filter BlameFilter
+}
+
+func (j *blameFilterJob) Run(ctx context.Context, clients job.RuntimeClients, stream streaming.Sender) (alert *search.Alert, err error) {
+	_, ctx, stream, finish := job.StartSpan(ctx, stream, j)
+	defer func() { finish(alert, err) }()
+
+	var (
+		mu     sync.Mutex
+		errs   error
+		blamed int
+	)
+
+	filteredStream := streaming.StreamFunc(func(event streaming.SearchEvent) {
+		filtered := event.Results[:0]
+		for _, res := range event.Results {
+			fm, ok := res.(*result.FileMatch)
+			if !ok || len(fm.ChunkMatches) == 0 {
+				continue
+			}
+
+			// We send one blame request per file. We should quit early on
+			// context deadline exceeded.
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				mu.Lock()
+				errs = errors.Append(errs, ctx.Err())
+				mu.Unlock()
+				break
+			}
+
+			mu.Lock()
+			limitHit := blamed >= maxBlameFilterFiles
+			if !limitHit {
+				blamed++
+			}
+			mu.Unlock()
+			if limitHit {
+				event.Stats.IsLimitHit = true
+				continue
+			}
+
+			hunks, err := blameMatchedLines(ctx, clients.Gitserver, fm)
+			if err != nil {
+				mu.Lock()
+				errs = errors.Append(errs, err)
+				mu.Unlock()
+				continue
+			}
+
+			fm.ChunkMatches = j.filterChunkMatches(fm.ChunkMatches, hunks)
+			if len(fm.ChunkMatches) > 0 {
+				filtered = append(filtered, fm)
+			}
+		}
+
+		event.Results = filtered
+		stream.Send(event)
+	})
+
+	alert, err = j.child.Run(ctx, clients, filteredStream)
+	if err != nil {
+		errs = errors.Append(errs, err)
+	}
+	return alert, errs
+}
+
+// filterChunkMatches removes the ranges which start on a line that does not
+// pass the filter. hunks must be sorted by start line.
+func (j *blameFilterJob) filterChunkMatches(chunks result.ChunkMatches, hunks []*gitserver.Hunk) result.ChunkMatches {
+	filtered := chunks[:0]
+	for _, chunk := range chunks {
+		ranges := chunk.Ranges[:0]
+		for _, rr := range chunk.Ranges {
+			hunk := hunkForLine(hunks, rr.Start.Line+1)
+			if hunk != nil && j.filter.Matches(hunk) {
+				ranges = append(ranges, rr)
+			}
+		}
+		if len(ranges) > 0 {
+			chunk.Ranges = ranges
+			filtered = append(filtered, chunk)
+		}
+	}
+	return filtered
+}
+
+func (j *blameFilterJob) Name() string {
+	return "BlameFilterJob"
+}
+
+func (j *blameFilterJob) Attributes(v job.Verbosity) (res []attribute.KeyValue) {
+	switch v {
+	case job.VerbosityMax:
+		fallthrough
+	case job.VerbosityBasic:
+		res = append(res,
+			attribute.StringSlice("includeAuthors", regexpsToStrings(j.filter.IncludeAuthors)),
+			attribute.StringSlice("excludeAuthors", regexpsToStrings(j.filter.ExcludeAuthors)),
+		)
+		if j.filter.Before != nil {
+			res = append(res, attribute.String("before", j.filter.Before.Format(time.RFC3339)))
+		}
+		if j.filter.After != nil {
+			res = append(res, attribute.String("after", j.filter.After.Format(time.RFC3339)))
+		}
+	}
+	return res
+}
+
+func (j *blameFilterJob) Children() []job.Describer {
+	return []job.Describer{j.child}
+}
+
+func (j *blameFilterJob) MapChildren(fn job.MapFunc) job.Job {
+	cp := *j
+	cp.child = job.Map(j.child, fn)
+	return &cp
+}
+
+// blameMatchedLines returns the blame hunks of the lines spanned by the content
+// matches of a file match, sorted by start line.
+func blameMatchedLines(ctx context.Context, client gitserver.Client, fm *result.FileMatch) (_ []*gitserver.Hunk, err error) {
+	startLine, endLine := -1, -1
+	for _, chunk := range fm.ChunkMatches {
+		for _, rr := range chunk.Ranges {
+			if startLine < 0 || rr.Start.Line < startLine {
+				startLine = rr.Start.Line
+			}
+			if rr.Start.Line > endLine {
+				endLine = rr.Start.Line
+			}
+		}
+	}
+
+	hr, err := client.StreamBlameFile(ctx, authz.DefaultSubRepoPermsChecker, fm.Repo.Name, fm.Path, &gitserver.BlameOptions{
+		NewestCommit: fm.CommitID,
+		StartLine:    startLine + 1,
+		EndLine:      endLine + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errors.Append(err, hr.Close()) }()
+
+	var hunks []*gitserver.Hunk
+	for {
+		hunk, err := hr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		hunks = append(hunks, hunk)
+	}
+
+	// Incremental blame returns hunks in the order they are attributed, not
+	// by line.
+	sort.Slice(hunks, func(i, k int) bool { return hunks[i].StartLine < hunks[k].StartLine })
+	return hunks, nil
+}
+
+// hunkForLine returns the hunk containing the given 1-indexed line, or nil.
+func hunkForLine(hunks []*gitserver.Hunk, line int) *gitserver.Hunk {
+	i := sort.Search(len(hunks), func(i int) bool { return hunks[i].EndLine > line })
+	if i < len(hunks) && hunks[i].StartLine <= line {
+		return hunks[i]
+	}
+	return nil
+}
+
+func regexpsToStrings(res []*regexp.Regexp) []string {
+	strs := make([]string, 0, len(res))
+	for _, re := range res {
+		strs = append(strs, re.String())
+	}
+	return strs
+}
@@ -0,0 +1,116 @@
+package jobutil
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/grafana/regexp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/job/mockjob"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+type sliceHunkReader []*gitserver.Hunk
+
+func (r *sliceHunkReader) Read() (*gitserver.Hunk, error) {
+	if len(*r) == 0 {
+		return nil, io.EOF
+	}
+	hunk := (*r)[0]
+	*r = (*r)[1:]
+	return hunk, nil
+}
+
+func (r *sliceHunkReader) Close() error { return nil }
+
+func TestBlameFilterJob(t *testing.T) {
+	old := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	recent := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	hunk := func(start, end int, name string, date time.Time) *gitserver.Hunk {
+		return &gitserver.Hunk{
+			StartLine: start,
+			EndLine:   end,
+			Author:    gitdomain.Signature{Name: name, Email: name + "@example.com", Date: date},
+		}
+	}
+
+	// Lines 1-2 were last changed by alice in 2019, line 3 by bob in 2023.
+	// Hunks are returned out of line order, like incremental blame does.
+	var gotOpts *gitserver.BlameOptions
+	gs := gitserver.NewMockClient()
+	gs.StreamBlameFileFunc.SetDefaultHook(func(_ context.Context, _ authz.SubRepoPermissionChecker, _ api.RepoName, _ string, opts *gitserver.BlameOptions) (gitserver.HunkReader, error) {
+		gotOpts = opts
+		return &sliceHunkReader{hunk(3, 4, "bob", recent), hunk(1, 3, "alice", old)}, nil
+	})
+
+	rangeOnLine := func(line int) result.Range {
+		return result.Range{Start: result.Location{Line: line}, End: result.Location{Line: line, Column: 3}}
+	}
+	fileMatch := func() *result.FileMatch {
+		return &result.FileMatch{
+			File: result.File{Repo: types.MinimalRepo{Name: "repo"}, Path: "main.go", CommitID: "abc"},
+			ChunkMatches: result.ChunkMatches{
+				{Ranges: result.Ranges{rangeOnLine(0), rangeOnLine(1)}},
+				{Ranges: result.Ranges{rangeOnLine(2)}},
+			},
+		}
+	}
+
+	run := func(filter BlameFilter) []result.Ranges {
+		childJob := mockjob.NewMockJob()
+		childJob.RunFunc.SetDefaultHook(func(_ context.Context, _ job.RuntimeClients, s streaming.Sender) (*search.Alert, error) {
+			s.Send(streaming.SearchEvent{Results: result.Matches{
+				fileMatch(),
+				&result.RepoMatch{Name: "repo"},
+			}})
+			return nil, nil
+		})
+
+		var got []result.Ranges
+		stream := streaming.StreamFunc(func(ev streaming.SearchEvent) {
+			for _, m := range ev.Results {
+				for _, cm := range m.(*result.FileMatch).ChunkMatches {
+					got = append(got, cm.Ranges)
+				}
+			}
+		})
+
+		_, err := NewBlameFilterJob(childJob, filter).Run(context.Background(), job.RuntimeClients{Gitserver: gs}, stream)
+		require.NoError(t, err)
+		return got
+	}
+
+	t.Run("author", func(t *testing.T) {
+		got := run(BlameFilter{IncludeAuthors: []*regexp.Regexp{regexp.MustCompile("alice")}})
+		require.Equal(t, []result.Ranges{{rangeOnLine(0), rangeOnLine(1)}}, got)
+		require.Equal(t, &gitserver.BlameOptions{NewestCommit: "abc", StartLine: 1, EndLine: 3}, gotOpts)
+	})
+
+	t.Run("exclude author", func(t *testing.T) {
+		got := run(BlameFilter{ExcludeAuthors: []*regexp.Regexp{regexp.MustCompile("alice")}})
+		require.Equal(t, []result.Ranges{{rangeOnLine(2)}}, got)
+	})
+
+	t.Run("after", func(t *testing.T) {
+		after := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+		got := run(BlameFilter{After: &after})
+		require.Equal(t, []result.Ranges{{rangeOnLine(2)}}, got)
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		before := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+		got := run(BlameFilter{Before: &before})
+		require.Empty(t, got)
+	})
+}
@@ -0,0 +1,140 @@
+package jobutil
+
+import (
+	"context"
+	"sync"
+
+	"github.com/grafana/regexp"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/search/symbol"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// maxFileSymbols bounds the number of symbols fetched for a single file when
+// evaluating file:has.symbol() predicates.
+const maxFileSymbols = 10000
+
+// NewFileHasSymbolJob creates a filter job to post-filter results for the file:has.symbol() predicate.
+//
+// All predicates are AND'ed together i.e. a result will be filtered out and not returned in the result page if any
+// predicate does not pass.
+func NewFileHasSymbolJob(child job.Job, filters []*symbol.Filter) job.Job {
+	return &fileHasSymbolJob{
+		child:   child,
+		filters: filters,
+	}
+}
+
+type fileHasSymbolJob struct {
+	child job.Job
+
+	filters []*symbol.Filter
+}
+
+func (j *fileHasSymbolJob) Run(ctx context.Context, clients job.RuntimeClients, stream streaming.Sender) (alert *search.Alert, err error) {
+	_, ctx, stream, finish := job.StartSpan(ctx, stream, j)
+	defer finish(alert, err)
+
+	var (
+		mu   sync.Mutex
+		errs error
+	)
+
+	filteredStream := streaming.StreamFunc(func(event streaming.SearchEvent) {
+		filtered := event.Results[:0]
+		for _, res := range event.Results {
+			// Filter out any result that is not a file
+			if fm, ok := res.(*result.FileMatch); ok {
+				// We send one symbols request per file path.
+				// We should quit early on context deadline exceeded.
+				if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+					mu.Lock()
+					errs = errors.Append(errs, ctx.Err())
+					mu.Unlock()
+					break
+				}
+
+				symbols, err := getFileSymbols(ctx, fm)
+				if err != nil {
+					mu.Lock()
+					errs = errors.Append(errs, err)
+					mu.Unlock()
+					continue
+				}
+
+				if !symbol.MatchAll(j.filters, symbols) {
+					continue
+				}
+
+				filtered = append(filtered, fm)
+			}
+		}
+
+		event.Results = filtered
+
+		stream.Send(event)
+	})
+
+	alert, err = j.child.Run(ctx, clients, filteredStream)
+	if err != nil {
+		errs = errors.Append(errs, err)
+	}
+	return alert, errs
+}
+
+func (j *fileHasSymbolJob) MapChildren(fn job.MapFunc) job.Job {
+	cp := *j
+	cp.child = job.Map(j.child, fn)
+	return &cp
+}
+
+func (j *fileHasSymbolJob) Name() string {
+	return "FileHasSymbolFilterJob"
+}
+
+func (j *fileHasSymbolJob) Children() []job.Describer {
+	return []job.Describer{j.child}
+}
+
+func (j *fileHasSymbolJob) Attributes(v job.Verbosity) (res []attribute.KeyValue) {
+	switch v {
+	case job.VerbosityMax:
+		fallthrough
+	case job.VerbosityBasic:
+		var include, exclude []string
+		for _, f := range j.filters {
+			if f.Negated {
+				exclude = append(exclude, f.String())
+			} else {
+				include = append(include, f.String())
+			}
+		}
+		res = append(res,
+			attribute.StringSlice("includeSymbols", include),
+			attribute.StringSlice("excludeSymbols", exclude),
+		)
+	}
+	return res
+}
+
+// MockGetFileSymbols mocks the symbols lookup of the file:has.symbol() filter job in tests.
+var MockGetFileSymbols func(ctx context.Context, fm *result.FileMatch) ([]*result.SymbolMatch, error)
+
+func getFileSymbols(ctx context.Context, fm *result.FileMatch) ([]*result.SymbolMatch, error) {
+	if MockGetFileSymbols != nil {
+		return MockGetFileSymbols(ctx, fm)
+	}
+
+	var (
+		emptyString     = ""
+		first           = int32(maxFileSymbols)
+		includePatterns = []string{"^" + regexp.QuoteMeta(fm.Path) + "$"}
+	)
+	return symbol.Compute(ctx, authz.DefaultSubRepoPermsChecker, fm.Repo, fm.CommitID, fm.InputRev, &emptyString, &first, &includePatterns, nil)
+}
@@ -0,0 +1,125 @@
+package jobutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/job/mockjob"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/search/symbol"
+)
+
+func TestFileHasSymbolJob(t *testing.T) {
+	r := func(ms ...result.Match) (res result.Matches) {
+		for _, m := range ms {
+			res = append(res, m)
+		}
+		return res
+	}
+
+	fm := func() *result.FileMatch {
+		return &result.FileMatch{
+			File: result.File{
+				Path:     "path",
+				CommitID: "commitID",
+			},
+		}
+	}
+
+	syms := func(nameAndKinds ...[2]string) (symbols []*result.SymbolMatch) {
+		for _, nk := range nameAndKinds {
+			symbols = append(symbols, &result.SymbolMatch{
+				Symbol: result.Symbol{Name: nk[0], Kind: nk[1]},
+			})
+		}
+		return symbols
+	}
+
+	tests := []struct {
+		name          string
+		caseSensitive bool
+		filters       []query.HasSymbolArgs
+		matches       result.Match
+		symbols       []*result.SymbolMatch
+		outputEvent   streaming.SearchEvent
+	}{{
+		name:        "name matches",
+		filters:     []query.HasSymbolArgs{{Name: "^handle"}},
+		matches:     fm(),
+		symbols:     syms([2]string{"HandleRequest", "function"}),
+		outputEvent: streaming.SearchEvent{Results: r(fm())},
+	}, {
+		name:        "name has no matches",
+		filters:     []query.HasSymbolArgs{{Name: "^handle"}},
+		matches:     fm(),
+		symbols:     syms([2]string{"ServeHTTP", "method"}),
+		outputEvent: streaming.SearchEvent{Results: result.Matches{}},
+	}, {
+		name:        "kind matches",
+		filters:     []query.HasSymbolArgs{{Kind: "function"}},
+		matches:     fm(),
+		symbols:     syms([2]string{"ServeHTTP", "method"}, [2]string{"main", "func"}),
+		outputEvent: streaming.SearchEvent{Results: r(fm())},
+	}, {
+		name:        "name and kind must match the same symbol",
+		filters:     []query.HasSymbolArgs{{Name: "^Handle", Kind: "function"}},
+		matches:     fm(),
+		symbols:     syms([2]string{"Handler", "struct"}, [2]string{"main", "function"}),
+		outputEvent: streaming.SearchEvent{Results: result.Matches{}},
+	}, {
+		name:        "negated filter matches",
+		filters:     []query.HasSymbolArgs{{Kind: "function", Negated: true}},
+		matches:     fm(),
+		symbols:     syms([2]string{"main", "function"}),
+		outputEvent: streaming.SearchEvent{Results: result.Matches{}},
+	}, {
+		name:        "negated filter has no matches",
+		filters:     []query.HasSymbolArgs{{Kind: "function", Negated: true}},
+		matches:     fm(),
+		symbols:     syms([2]string{"Handler", "struct"}),
+		outputEvent: streaming.SearchEvent{Results: r(fm())},
+	}, {
+		name:          "case sensitive has no matches",
+		caseSensitive: true,
+		filters:       []query.HasSymbolArgs{{Name: "^handle"}},
+		matches:       fm(),
+		symbols:       syms([2]string{"HandleRequest", "function"}),
+		outputEvent:   streaming.SearchEvent{Results: result.Matches{}},
+	}, {
+		name:        "not all matches are files",
+		filters:     []query.HasSymbolArgs{{Name: "^handle"}},
+		matches:     &result.CommitMatch{},
+		outputEvent: streaming.SearchEvent{Results: result.Matches{}},
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			childJob := mockjob.NewMockJob()
+			childJob.RunFunc.SetDefaultHook(func(_ context.Context, _ job.RuntimeClients, s streaming.Sender) (*search.Alert, error) {
+				s.Send(streaming.SearchEvent{Results: r(tc.matches)})
+				return nil, nil
+			})
+
+			MockGetFileSymbols = func(context.Context, *result.FileMatch) ([]*result.SymbolMatch, error) {
+				return tc.symbols, nil
+			}
+			t.Cleanup(func() { MockGetFileSymbols = nil })
+
+			var resultEvent streaming.SearchEvent
+			streamCollector := streaming.StreamFunc(func(ev streaming.SearchEvent) {
+				resultEvent = ev
+			})
+
+			j := NewFileHasSymbolJob(childJob, symbol.NewFilters(tc.filters, tc.caseSensitive))
+			alert, err := j.Run(context.Background(), job.RuntimeClients{}, streamCollector)
+			require.Nil(t, alert)
+			require.NoError(t, err)
+			require.Equal(t, tc.outputEvent, resultEvent)
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/search/searcher"
 	"github.com/sourcegraph/sourcegraph/internal/search/smartsearch"
 	"github.com/sourcegraph/sourcegraph/internal/search/structural"
+	"github.com/sourcegraph/sourcegraph/internal/search/symbol"
 	"github.com/sourcegraph/sourcegraph/internal/search/zoekt"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 	"github.com/sourcegraph/sourcegraph/schema"
@@ -181,6 +182,12 @@ func NewBasicJob(inputs *search.Inputs, b query.Basic, enterpriseJobs Enterprise
 		if err != nil {
 			return nil, err
 		}
+		if inputs.Features.Ranking && (job.HasDescendent[*searcher.TextSearchJob](flatJob) || job.HasDescendent[*structural.SearchJob](flatJob)) {
+			// Unlike Zoekt, searcher returns results in no particular order.
+			// Like Zoekt's use of document ranks, this is behind the ranking
+			// feature.
+			flatJob = enterpriseJobs.DocumentRankJob(flatJob)
+		}
 		addJob(flatJob)
 	}
 
@@ -210,6 +217,18 @@ func NewBasicJob(inputs *search.Inputs, b query.Basic, enterpriseJobs Enterprise
 		}
 	}
 
+	{ // Apply blame.author:, blame.before: and blame.after: post-search filter
+		if filter, ok := blameFilter(b); ok {
+			basicJob = NewBlameFilterJob(basicJob, filter)
+		}
+	}
+
+	{ // Apply file:has.symbol() post-search filter
+		if symbolFilters := b.FileHasSymbol(); len(symbolFilters) > 0 {
+			basicJob = NewFileHasSymbolJob(basicJob, symbol.NewFilters(symbolFilters, b.IsCaseSensitive()))
+		}
+	}
+
 	{ // Apply subrepo permissions checks
 		checker := authz.DefaultSubRepoPermsChecker
 		if authz.SubRepoEnabled(checker) {
@@ -223,12 +242,32 @@ func NewBasicJob(inputs *search.Inputs, b query.Basic, enterpriseJobs Enterprise
 			if isSelectOwnersSearch(sp) {
 				// the select owners job is ran separately as it requires state and can return multiple owners from one match.
 				basicJob = enterpriseJobs.SelectFileOwnerJob(basicJob)
+			} else if isSelectCaptureSearch(sp) {
+				// The capture job is ran separately as it requires the pattern
+				// and aggregates values across results.
+				pattern, err := b.SelectCaptureRegexp()
+				if err != nil {
+					return nil, err
+				}
+				basicJob = NewSelectCaptureJob(pattern, basicJob)
 			} else {
+				if sp.Root() == filter.Symbol && computeResultTypes(b, inputs.PatternType).Has(result.TypeStructural) {
+					// Structural search only returns content matches, so we annotate them with their
+					// enclosing symbols for the select job to operate on.
+					basicJob = NewEnclosingSymbolsJob(basicJob)
+				}
 				basicJob = NewSelectJob(sp, basicJob)
 			}
 		}
 	}
 
+	{ // Apply dedupe:content collapsing of identical results across repositories. This
+		// runs after selectors, which would drop the updates reporting more copies of a file.
+		if b.DedupeContent() {
+			basicJob = NewContentDedupeJob(basicJob)
+		}
+	}
+
 	{ // Apply search result sanitization post-filter if enabled
 		if len(inputs.SanitizeSearchPatterns) > 0 {
 			basicJob = NewSanitizeJob(inputs.SanitizeSearchPatterns, basicJob)
@@ -353,6 +392,7 @@ func NewFlatJob(searchInputs *search.Inputs, f query.Flat) (job.Job, error) {
 					UseFullDeadline: useFullDeadline,
 					Features:        *searchInputs.Features,
 					PathRegexps:     getPathRegexpsFromTextPatternInfo(patternInfo),
+					GroupByVersion:  f.ToBasic().GroupByVersion(),
 				}
 
 				addJob(&repoPagerJob{
@@ -546,9 +586,18 @@ func computeFileMatchLimit(b query.Basic, p search.Protocol) int {
 		// This is the int equivalent of count:all.
 		return query.CountAllLimit
 	}
+	if len(b.FileHasSymbol()) > 0 {
+		// This is the int equivalent of count:all.
+		return query.CountAllLimit
+	}
+	if b.DedupeContent() {
+		// Duplicates are hidden, so keep searching until enough distinct
+		// results were sent.
+		return query.CountAllLimit
+	}
 	if v, _ := b.ToParseTree().StringValue(query.FieldSelect); v != "" {
 		sp, _ := filter.SelectPathFromString(v) // Invariant: select already validated
-		if isSelectOwnersSearch(sp) {
+		if isSelectOwnersSearch(sp) || isSelectCaptureSearch(sp) {
 			// This is the int equivalent of count:all.
 			return query.CountAllLimit
 		}
@@ -579,6 +628,10 @@ func isSelectOwnersSearch(sp filter.SelectPath) bool {
 	return sp.Root() == filter.File && len(sp) == 2 && sp[1] == "owners"
 }
 
+func isSelectCaptureSearch(sp filter.SelectPath) bool {
+	return sp.Root() == filter.Content && len(sp) == 2 && sp[1] == filter.Capture
+}
+
 func isContributorSearch(b query.Basic) (include, exclude []string, ok bool) {
 	if includeContributors, excludeContributors := b.FileHasContributor(); len(includeContributors) > 0 || len(excludeContributors) > 0 {
 		return includeContributors, excludeContributors, true
@@ -586,6 +639,18 @@ func isContributorSearch(b query.Basic) (include, exclude []string, ok bool) {
 	return nil, nil, false
 }
 
+func blameFilter(b query.Basic) (BlameFilter, bool) {
+	includeAuthors, excludeAuthors := b.BlameAuthor()
+	filter := BlameFilter{
+		IncludeAuthors: contributorsAsRegexp(includeAuthors, b.IsCaseSensitive()),
+		ExcludeAuthors: contributorsAsRegexp(excludeAuthors, b.IsCaseSensitive()),
+		Before:         b.BlameBefore(),
+		After:          b.BlameAfter(),
+	}
+	ok := len(filter.IncludeAuthors) > 0 || len(filter.ExcludeAuthors) > 0 || filter.Before != nil || filter.After != nil
+	return filter, ok
+}
+
 func contributorsAsRegexp(contributors []string, isCaseSensitive bool) (res []*regexp.Regexp) {
 	for _, pattern := range contributors {
 		if isCaseSensitive {
@@ -768,10 +833,12 @@ func toRepoOptions(b query.Basic, userSettings *schema.Settings) search.RepoOpti
 		NoArchived:          archived == query.No,
 		Visibility:          visibility,
 		HasFileContent:      b.RepoHasFileContent(),
+		HasFileContentExprs: b.RepoHasFileContentExprs(),
 		CommitAfter:         b.RepoContainsCommitAfter(),
 		UseIndex:            b.Index(),
 		HasKVPs:             b.RepoHasKVPs(),
 		HasTopics:           b.RepoHasTopics(),
+		HasSymbol:           b.RepoHasSymbol(),
 	}
 }
 
@@ -854,6 +921,7 @@ func (b *jobBuilder) newZoektSearch(typ search.IndexedRequestType) (job.Job, err
 		Select:         b.selector,
 		Features:       *b.features,
 		KeywordScoring: b.patternType == query.SearchTypeKeyword,
+		GroupByVersion: b.query.GroupByVersion(),
 	}
 
 	switch typ {
@@ -1051,6 +1119,12 @@ func isGlobal(op search.RepoOptions) bool {
 		return false
 	}
 
+	// repo:has.symbol() is handled during the repo resolution step using the
+	// symbols service.
+	if len(op.HasSymbol) > 0 {
+		return false
+	}
+
 	// If a search context is specified, we do not know ahead of time whether
 	// the repos in the context are indexed and we need to go through the repo
 	// resolution process.
@@ -1082,6 +1156,7 @@ func isGlobal(op search.RepoOptions) bool {
 	// - MinusRepoFilters
 	// - CaseSensitiveRepoFilters
 	// - HasFileContent
+	// - HasFileContentExprs
 	// - Visibility
 	// - Limit
 	// - ForkSet
@@ -765,6 +765,66 @@ func TestNewPlanJob(t *testing.T) {
               (repoNamePatterns . [(?i)foo])))
           (REPOSCOMPUTEEXCLUDED
             (repoOpts.hasKVPs[0].key . tag))
+          (PARALLEL
+            NOOP
+            NOOP))))))`),
+		}, {
+			query:      `file:has.symbol(kind:function name:^Handle) ctx.Done()`,
+			protocol:   search.Streaming,
+			searchType: query.SearchTypeLiteral,
+			want: autogold.Expect(`
+(LOG
+  (ALERT
+    (query . )
+    (originalQuery . )
+    (patternType . literal)
+    (TIMEOUT
+      (timeout . 20s)
+      (LIMIT
+        (limit . 500)
+        (FILEHASSYMBOLFILTER
+          (includeSymbols . [name:^Handle kind:function])
+          (excludeSymbols . [])
+          (PARALLEL
+            (ZOEKTGLOBALTEXTSEARCH
+              (query . substr:"ctx.Done()")
+              (type . text))
+            REPOSCOMPUTEEXCLUDED
+            NOOP))))))`),
+		}, {
+			query:      `repo:has.symbol(name:^main$) foo`,
+			protocol:   search.Streaming,
+			searchType: query.SearchTypeLiteral,
+			want: autogold.Expect(`
+(LOG
+  (ALERT
+    (query . )
+    (originalQuery . )
+    (patternType . literal)
+    (TIMEOUT
+      (timeout . 20s)
+      (LIMIT
+        (limit . 500)
+        (PARALLEL
+          (SEQUENTIAL
+            (ensureUnique . false)
+            (REPOPAGER
+              (repoOpts.hasSymbol[0].name . ^main$)
+              (PARTIALREPOS
+                (ZOEKTREPOSUBSETTEXTSEARCH
+                  (query . substr:"foo")
+                  (type . text))))
+            (REPOPAGER
+              (repoOpts.hasSymbol[0].name . ^main$)
+              (PARTIALREPOS
+                (SEARCHERTEXTSEARCH
+                  (indexed . false))))
+            (REPOSEARCH
+              (repoOpts.repoFilters . [foo])
+              (repoOpts.hasSymbol[0].name . ^main$)
+              (repoNamePatterns . [(?i)foo])))
+          (REPOSCOMPUTEEXCLUDED
+            (repoOpts.hasSymbol[0].name . ^main$))
           (PARALLEL
             NOOP
             NOOP))))))`),
@@ -788,6 +848,186 @@ func TestNewPlanJob(t *testing.T) {
             (patternInfo.pattern . (:[_]))
             (patternInfo.isStructural . true)
             (patternInfo.fileMatchLimit . 500)))))))`),
+		}, {
+			query:      `ctx.Done() select:symbol.function`,
+			protocol:   search.Streaming,
+			searchType: query.SearchTypeStructural,
+			want: autogold.Expect(`
+(LOG
+  (ALERT
+    (query . )
+    (originalQuery . )
+    (patternType . structural)
+    (TIMEOUT
+      (timeout . 20s)
+      (LIMIT
+        (limit . 500)
+        (SELECT
+          (select . [symbol function])
+          (ENCLOSINGSYMBOLS
+            (PARALLEL
+              REPOSCOMPUTEEXCLUDED
+              (STRUCTURALSEARCH
+                (patternInfo.pattern . ctx.Done())
+                (patternInfo.isStructural . true)
+                (patternInfo.fileMatchLimit . 500)
+                (patternInfo.select . [symbol function])))))))))`),
+		}, {
+			query:      `repo:foo rev:*refs/tags/v* versions:yes bar`,
+			protocol:   search.Streaming,
+			searchType: query.SearchTypeLiteral,
+			want: autogold.Expect(`
+(LOG
+  (ALERT
+    (query . )
+    (originalQuery . )
+    (patternType . literal)
+    (TIMEOUT
+      (timeout . 20s)
+      (LIMIT
+        (limit . 500)
+        (PARALLEL
+          (SEQUENTIAL
+            (ensureUnique . false)
+            (REPOPAGER
+              (repoOpts.repoFilters . [foo@*refs/tags/v*])
+              (PARTIALREPOS
+                (ZOEKTREPOSUBSETTEXTSEARCH
+                  (query . substr:"bar")
+                  (type . text))))
+            (REPOPAGER
+              (repoOpts.repoFilters . [foo@*refs/tags/v*])
+              (PARTIALREPOS
+                (SEARCHERTEXTSEARCH
+                  (indexed . false)))))
+          (REPOSCOMPUTEEXCLUDED
+            (repoOpts.repoFilters . [foo@*refs/tags/v*]))
+          NOOP)))))`),
+		},
+		{
+			query:      `/version = "(\d+\.\d+)"/ select:content.capture`,
+			protocol:   search.Streaming,
+			searchType: query.SearchTypeStandard,
+			want: autogold.Expect(`
+(LOG
+  (ALERT
+    (query . )
+    (originalQuery . )
+    (patternType . standard)
+    (TIMEOUT
+      (timeout . 20s)
+      (LIMIT
+        (limit . 500)
+        (SELECTCAPTURE
+          (pattern . (?i:version = "(\d+\.\d+)"))
+          (PARALLEL
+            (SEQUENTIAL
+              (ensureUnique . false)
+              (ZOEKTGLOBALTEXTSEARCH
+                (query . regex:"version = \"[0-9]+\\.[0-9]+\"")
+                (type . text))
+              (REPOSEARCH
+                (repoOpts.repoFilters . [version = "(\d+\.\d+)"])
+                (repoNamePatterns . [(?i)version = "(\d+\.\d+)"])))
+            REPOSCOMPUTEEXCLUDED
+            NOOP))))))`),
+		},
+		{
+			query:      `repo:foo dedupe:content bar`,
+			protocol:   search.Streaming,
+			searchType: query.SearchTypeLiteral,
+			want: autogold.Expect(`
+(LOG
+  (ALERT
+    (query . )
+    (originalQuery . )
+    (patternType . literal)
+    (TIMEOUT
+      (timeout . 20s)
+      (LIMIT
+        (limit . 500)
+        (CONTENTDEDUPE
+          (PARALLEL
+            (SEQUENTIAL
+              (ensureUnique . false)
+              (REPOPAGER
+                (repoOpts.repoFilters . [foo])
+                (PARTIALREPOS
+                  (ZOEKTREPOSUBSETTEXTSEARCH
+                    (query . substr:"bar")
+                    (type . text))))
+              (REPOPAGER
+                (repoOpts.repoFilters . [foo])
+                (PARTIALREPOS
+                  (SEARCHERTEXTSEARCH
+                    (indexed . false)))))
+            (REPOSCOMPUTEEXCLUDED
+              (repoOpts.repoFilters . [foo]))
+            NOOP))))))`),
+		},
+		{
+			query:      `repo:foo blame.author:alice bar`,
+			protocol:   search.Streaming,
+			searchType: query.SearchTypeLiteral,
+			want: autogold.Expect(`
+(LOG
+  (ALERT
+    (query . )
+    (originalQuery . )
+    (patternType . literal)
+    (TIMEOUT
+      (timeout . 20s)
+      (LIMIT
+        (limit . 500)
+        (BLAMEFILTER
+          (includeAuthors . [(?i)alice])
+          (excludeAuthors . [])
+          (PARALLEL
+            (SEQUENTIAL
+              (ensureUnique . false)
+              (REPOPAGER
+                (repoOpts.repoFilters . [foo])
+                (PARTIALREPOS
+                  (ZOEKTREPOSUBSETTEXTSEARCH
+                    (query . substr:"bar")
+                    (type . text))))
+              (REPOPAGER
+                (repoOpts.repoFilters . [foo])
+                (PARTIALREPOS
+                  (SEARCHERTEXTSEARCH
+                    (indexed . false)))))
+            (REPOSCOMPUTEEXCLUDED
+              (repoOpts.repoFilters . [foo]))
+            NOOP))))))`),
+		},
+		{
+			query:      `repo:has.file(path:go.mod and not path:vendor/) bar`,
+			protocol:   search.Streaming,
+			searchType: query.SearchTypeLiteral,
+			want: autogold.Expect(`
+(LOG
+  (ALERT
+    (query . )
+    (originalQuery . )
+    (patternType . literal)
+    (TIMEOUT
+      (timeout . 20s)
+      (LIMIT
+        (limit . 500)
+        (PARALLEL
+          (SEQUENTIAL
+            (ensureUnique . false)
+            (ZOEKTGLOBALTEXTSEARCH
+              (query . (and substr:"bar" (type:repo file_regex:"go(?-s:.)mod") (not (type:repo file_regex:"vendor/"))))
+              (type . text)
+              (repoOpts.hasFileContentExprs[0] . (path:go.mod and -path:vendor/)))
+            (REPOSEARCH
+              (repoOpts.repoFilters . [bar])
+              (repoOpts.hasFileContentExprs[0] . (path:go.mod and -path:vendor/))
+              (repoNamePatterns . [(?i)bar])))
+          (REPOSCOMPUTEEXCLUDED
+            (repoOpts.hasFileContentExprs[0] . (path:go.mod and -path:vendor/)))
+          NOOP)))))`),
 		},
 	}
 
@@ -812,6 +1052,35 @@ func TestNewPlanJob(t *testing.T) {
 	}
 }
 
+func TestNewPlanJobGroupByVersion(t *testing.T) {
+	plan, err := query.Pipeline(query.Init(`repo:foo rev:*refs/tags/v* versions:yes bar`, query.SearchTypeLiteral))
+	require.NoError(t, err)
+
+	inputs := &search.Inputs{
+		UserSettings: &schema.Settings{},
+		PatternType:  query.SearchTypeLiteral,
+		Protocol:     search.Streaming,
+		Features:     &search.Features{},
+	}
+	j, err := NewPlanJob(inputs, plan, NewUnimplementedEnterpriseJobs())
+	require.NoError(t, err)
+
+	// Both backends group by version themselves, without collecting all
+	// results.
+	var zoektGrouped, searcherGrouped bool
+	job.MapType(j, func(j *zoektutil.RepoSubsetTextSearchJob) job.Job {
+		zoektGrouped = j.ZoektParams.GroupByVersion
+		require.Equal(t, int32(500), j.ZoektParams.FileMatchLimit)
+		return j
+	})
+	job.MapType(j, func(j *searcher.TextSearchJob) job.Job {
+		searcherGrouped = j.GroupByVersion
+		return j
+	})
+	require.True(t, zoektGrouped)
+	require.True(t, searcherGrouped)
+}
+
 func TestToEvaluateJob(t *testing.T) {
 	test := func(input string, protocol search.Protocol) string {
 		q, _ := query.ParseLiteral(input)
@@ -78,6 +78,11 @@ func newSelectingStream(parent streaming.Sender, s filter.SelectPath) streaming.
 			// if we are only interested in the path (via `select:file`),
 			// we only send the result once.
 			seen := dedup.Seen(current)
+			if seen && result.AddsUp(current) {
+				// Matches which add up are merged by the receiver
+				selected = append(selected, current)
+				continue
+			}
 			fm, isFileMatch := current.(*result.FileMatch)
 			if seen && !isFileMatch {
 				continue
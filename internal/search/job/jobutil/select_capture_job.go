@@ -0,0 +1,102 @@
+package jobutil
+
+import (
+	"context"
+
+	"github.com/grafana/regexp"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+)
+
+// NewSelectCaptureJob creates a job that replaces the content matches of its
+// child with the values of the first capture group of pattern in each
+// repository, along with how often each value was captured
+// (`select:content.capture`).
+//
+// Capture matches are sent as the child finds results: each event of the
+// child is replaced by the values it captured, counted per repository. A value
+// found in several events is sent several times, and consumers add up the
+// counts of capture matches with the same key.
+func NewSelectCaptureJob(pattern *regexp.Regexp, child job.Job) job.Job {
+	return &selectCaptureJob{pattern: pattern, child: child}
+}
+
+type selectCaptureJob struct {
+	pattern *regexp.Regexp
+	child   job.Job
+}
+
+type captureKey struct {
+	Repo  api.RepoID
+	Value string
+}
+
+func (j *selectCaptureJob) Run(ctx context.Context, clients job.RuntimeClients, stream streaming.Sender) (alert *search.Alert, err error) {
+	_, ctx, stream, finish := job.StartSpan(ctx, stream, j)
+	defer func() { finish(alert, err) }()
+
+	capturingStream := streaming.StreamFunc(func(event streaming.SearchEvent) {
+		event.Results = j.captureMatches(event.Results)
+		stream.Send(event)
+	})
+
+	return j.child.Run(ctx, clients, capturingStream)
+}
+
+// captureMatches returns the capture matches of the content matches in
+// matches, with one match per repository and value.
+func (j *selectCaptureJob) captureMatches(matches result.Matches) result.Matches {
+	var (
+		captures result.Matches
+		indexes  = make(map[captureKey]int)
+	)
+	for _, res := range matches {
+		fm, ok := res.(*result.FileMatch)
+		if !ok {
+			continue
+		}
+		for _, chunk := range fm.ChunkMatches {
+			for _, content := range chunk.MatchedContent() {
+				for _, value := range result.CaptureGroupValues(j.pattern, content) {
+					key := captureKey{Repo: fm.Repo.ID, Value: value}
+					if i, ok := indexes[key]; ok {
+						captures[i].(*result.CaptureMatch).Count++
+						continue
+					}
+					indexes[key] = len(captures)
+					captures = append(captures, &result.CaptureMatch{Value: value, Repo: fm.Repo, Count: 1})
+				}
+			}
+		}
+	}
+	return captures
+}
+
+func (j *selectCaptureJob) Name() string {
+	return "SelectCaptureJob"
+}
+
+func (j *selectCaptureJob) Attributes(v job.Verbosity) (res []attribute.KeyValue) {
+	switch v {
+	case job.VerbosityMax:
+		fallthrough
+	case job.VerbosityBasic:
+		res = append(res, attribute.Stringer("pattern", j.pattern))
+	}
+	return res
+}
+
+func (j *selectCaptureJob) Children() []job.Describer {
+	return []job.Describer{j.child}
+}
+
+func (j *selectCaptureJob) MapChildren(fn job.MapFunc) job.Job {
+	cp := *j
+	cp.child = job.Map(j.child, fn)
+	return &cp
+}
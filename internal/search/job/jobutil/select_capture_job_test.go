@@ -0,0 +1,70 @@
+package jobutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/grafana/regexp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/job/mockjob"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestSelectCaptureJob(t *testing.T) {
+	repoA := types.MinimalRepo{ID: 1, Name: "a"}
+	repoB := types.MinimalRepo{ID: 2, Name: "b"}
+
+	fileMatch := func(repo types.MinimalRepo, lines ...string) *result.FileMatch {
+		var chunks result.ChunkMatches
+		for i, line := range lines {
+			chunks = append(chunks, result.ChunkMatch{
+				Content:      line,
+				ContentStart: result.Location{Line: i},
+				Ranges: result.Ranges{{
+					Start: result.Location{Line: i},
+					End:   result.Location{Line: i, Offset: len(line), Column: len(line)},
+				}},
+			})
+		}
+		return &result.FileMatch{File: result.File{Repo: repo}, ChunkMatches: chunks}
+	}
+
+	childJob := mockjob.NewMockJob()
+	childJob.RunFunc.SetDefaultHook(func(_ context.Context, _ job.RuntimeClients, s streaming.Sender) (*search.Alert, error) {
+		s.Send(streaming.SearchEvent{Results: result.Matches{
+			fileMatch(repoA, `version = "1.2"`, `version = "1.3"`),
+			&result.RepoMatch{Name: repoA.Name, ID: repoA.ID},
+		}})
+		s.Send(streaming.SearchEvent{Results: result.Matches{
+			fileMatch(repoA, `VERSION = "1.3"`),
+			fileMatch(repoB, `version = "1.2"`),
+		}})
+		return nil, nil
+	})
+
+	var got []result.Matches
+	stream := streaming.StreamFunc(func(ev streaming.SearchEvent) {
+		got = append(got, ev.Results)
+	})
+
+	pattern := regexp.MustCompile(`(?i:version = "(\d+\.\d+)")`)
+	_, err := NewSelectCaptureJob(pattern, childJob).Run(context.Background(), job.RuntimeClients{}, stream)
+	require.NoError(t, err)
+
+	// Captures are sent with the event of the child they were found in.
+	require.Equal(t, []result.Matches{
+		{
+			&result.CaptureMatch{Value: "1.2", Repo: repoA, Count: 1},
+			&result.CaptureMatch{Value: "1.3", Repo: repoA, Count: 1},
+		},
+		{
+			&result.CaptureMatch{Value: "1.3", Repo: repoA, Count: 1},
+			&result.CaptureMatch{Value: "1.2", Repo: repoB, Count: 1},
+		},
+	}, got)
+}
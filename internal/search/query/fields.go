@@ -27,12 +27,19 @@ const (
 	FieldCommitter = "committer"
 	FieldMessage   = "message"
 
+	// For filtering content matches by git blame:
+	FieldBlameAuthor = "blame.author"
+	FieldBlameBefore = "blame.before"
+	FieldBlameAfter  = "blame.after"
+
 	// Temporary experimental fields:
 	FieldIndex     = "index"
 	FieldCount     = "count" // Searches that specify `count:` will fetch at least that number of results, or the full result set
 	FieldTimeout   = "timeout"
 	FieldCombyRule = "rule"
 	FieldSelect    = "select"
+	FieldVersions  = "versions"
+	FieldDedupe    = "dedupe"
 )
 
 var allFields = map[string]struct{}{
@@ -64,6 +71,9 @@ var allFields = map[string]struct{}{
 	FieldMessage:            empty,
 	"m":                     empty,
 	"msg":                   empty,
+	FieldBlameAuthor:        empty,
+	FieldBlameBefore:        empty,
+	FieldBlameAfter:         empty,
 	FieldIndex:              empty,
 	FieldCount:              empty,
 	FieldTimeout:            empty,
@@ -71,6 +81,8 @@ var allFields = map[string]struct{}{
 	FieldRev:                empty,
 	"revision":              empty,
 	FieldSelect:             empty,
+	FieldVersions:           empty,
+	FieldDedupe:             empty,
 }
 
 var aliases = map[string]string{
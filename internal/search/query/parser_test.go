@@ -289,6 +289,9 @@ func TestScanField(t *testing.T) {
 	autogold.Expect(`{"Field":"","Negated":false,"Advance":0}`).Equal(t, test("-repo"))
 	autogold.Expect(`{"Field":"","Negated":false,"Advance":0}`).Equal(t, test("--repo:"))
 	autogold.Expect(`{"Field":"","Negated":false,"Advance":0}`).Equal(t, test(":foo"))
+	autogold.Expect(`{"Field":"blame.author","Negated":true,"Advance":14}`).Equal(t, test("-blame.author:"))
+	autogold.Expect(`{"Field":"","Negated":false,"Advance":0}`).Equal(t, test("foo.bar:"))
+	autogold.Expect(`{"Field":"","Negated":false,"Advance":0}`).Equal(t, test("-.repo:"))
 }
 
 func parseAndOrGrammar(in string) ([]Node, error) {
@@ -1,12 +1,15 @@
 package query
 
 import (
+	"bytes"
 	"fmt"
 	"strings"
+	"unicode"
 
 	"github.com/grafana/regexp"
 	"github.com/grafana/regexp/syntax"
 
+	"github.com/sourcegraph/sourcegraph/internal/search/filter"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -40,6 +43,7 @@ var DefaultPredicateRegistry = PredicateRegistry{
 		"has.key":               func() Predicate { return &RepoHasKeyPredicate{} },
 		"has.meta":              func() Predicate { return &RepoHasMetaPredicate{} },
 		"has.topic":             func() Predicate { return &RepoHasTopicPredicate{} },
+		"has.symbol":            func() Predicate { return &RepoHasSymbolPredicate{} },
 
 		// Deprecated predicates
 		"contains": func() Predicate { return &RepoContainsPredicate{} },
@@ -49,6 +53,7 @@ var DefaultPredicateRegistry = PredicateRegistry{
 		"has.content":      func() Predicate { return &FileContainsContentPredicate{} },
 		"has.owner":        func() Predicate { return &FileHasOwnerPredicate{} },
 		"has.contributor":  func() Predicate { return &FileHasContributorPredicate{} },
+		"has.symbol":       func() Predicate { return &FileHasSymbolPredicate{} },
 	},
 }
 
@@ -115,10 +120,16 @@ func (EmptyPredicate) Unmarshal(_ string, negated bool) error {
 // RepoContainsFilePredicate represents the `repo:contains.file()` predicate, which filters to
 // repos that contain a path and/or content. NOTE: this predicate still supports the deprecated
 // syntax `repo:contains.file(name.go)` on a best-effort basis.
+//
+// The body may also combine conditions with `and`, `or` and `not`, for example
+// `repo:has.file(path:go.mod and not path:vendor/)`, in which case Expr is set
+// instead of Path and Content.
 type RepoContainsFilePredicate struct {
 	Path    string
 	Content string
 	Negated bool
+
+	Expr *RepoHasFileContentExpr
 }
 
 func (f *RepoContainsFilePredicate) Unmarshal(params string, negated bool) error {
@@ -127,7 +138,8 @@ func (f *RepoContainsFilePredicate) Unmarshal(params string, negated bool) error
 		return err
 	}
 
-	if err := f.parseNodes(nodes); err != nil {
+	expr, err := parseRepoHasFileContentExpr(nodes)
+	if err != nil {
 		// If there's a parsing error, try falling back to the deprecated syntax `repo:contains.file(name.go)`.
 		// Only attempt to fall back if there is a single pattern node, to avoid being too lenient.
 		if len(nodes) != 1 {
@@ -142,67 +154,122 @@ func (f *RepoContainsFilePredicate) Unmarshal(params string, negated bool) error
 		if _, err := syntax.Parse(pattern.Value, syntax.Perl); err != nil {
 			return err
 		}
-		f.Path = pattern.Value
+		expr = RepoHasFileContentExpr{Args: &RepoHasFileContentArgs{Path: pattern.Value}}
 	}
 
-	if f.Path == "" && f.Content == "" {
-		return errors.New("one of path or content must be set")
+	if args := expr.Args; args != nil {
+		// A single condition, such as `-repo:has.file(path:a content:b)`.
+		f.Path = args.Path
+		f.Content = args.Content
+		f.Negated = negated != args.Negated
+		return nil
 	}
 
-	f.Negated = negated
-	return nil
-}
-
-func (f *RepoContainsFilePredicate) parseNodes(nodes []Node) error {
-	for _, node := range nodes {
-		if err := f.parseNode(node); err != nil {
-			return err
-		}
+	if negated {
+		expr = expr.Negate()
 	}
+	f.Expr = &expr
 	return nil
 }
 
-func (f *RepoContainsFilePredicate) parseNode(n Node) error {
-	switch v := n.(type) {
-	case Parameter:
-		if v.Negated {
-			return errors.New("predicates do not currently support negated values")
-		}
-		switch strings.ToLower(v.Field) {
-		case "path":
-			if f.Path != "" {
-				return errors.New("cannot specify path multiple times")
+// parseRepoHasFileContentExpr parses the body of a repo:contains.file()
+// predicate. A `path:` and `content:` next to each other, such as in
+// `path:a content:b`, are one condition on the same file. A negated value,
+// such as `-path:a`, is a condition that no file matches.
+func parseRepoHasFileContentExpr(nodes []Node) (RepoHasFileContentExpr, error) {
+	var (
+		operands      []RepoHasFileContentExpr
+		paths         []string
+		contents      []string
+		negatedLeaves []RepoHasFileContentExpr
+	)
+
+	for _, node := range nodes {
+		switch v := node.(type) {
+		case Parameter:
+			field := strings.ToLower(v.Field)
+			if field != "path" && field != "content" {
+				return RepoHasFileContentExpr{}, errors.Errorf("unsupported option %q", v.Field)
 			}
 			if _, err := syntax.Parse(v.Value, syntax.Perl); err != nil {
-				return errors.Errorf("`contains.file` predicate has invalid `path` argument: %w", err)
+				return RepoHasFileContentExpr{}, errors.Errorf("`contains.file` predicate has invalid `%s` argument: %w", field, err)
 			}
-			f.Path = v.Value
-		case "content":
-			if f.Content != "" {
-				return errors.New("cannot specify content multiple times")
+
+			switch {
+			case v.Negated && field == "path":
+				negatedLeaves = append(negatedLeaves, RepoHasFileContentExpr{Args: &RepoHasFileContentArgs{Path: v.Value, Negated: true}})
+			case v.Negated:
+				negatedLeaves = append(negatedLeaves, RepoHasFileContentExpr{Args: &RepoHasFileContentArgs{Content: v.Value, Negated: true}})
+			case field == "path":
+				paths = append(paths, v.Value)
+			default:
+				contents = append(contents, v.Value)
 			}
-			if _, err := syntax.Parse(v.Value, syntax.Perl); err != nil {
-				return errors.Errorf("`contains.file` predicate has invalid `content` argument: %w", err)
+		case Pattern:
+			return RepoHasFileContentExpr{}, errors.Errorf(`prepend 'file:' or 'content:' to "%s" to search repositories containing files or content respectively.`, v.Value)
+		case Operator:
+			switch v.Kind {
+			case And:
+				operand, err := parseRepoHasFileContentExpr(v.Operands)
+				if err != nil {
+					return RepoHasFileContentExpr{}, err
+				}
+				operands = append(operands, operand)
+			case Or:
+				var or []RepoHasFileContentExpr
+				for _, o := range v.Operands {
+					operand, err := parseRepoHasFileContentExpr([]Node{o})
+					if err != nil {
+						return RepoHasFileContentExpr{}, err
+					}
+					or = append(or, operand)
+				}
+				operands = append(operands, RepoHasFileContentExpr{Or: or})
+			default:
+				return RepoHasFileContentExpr{}, errors.Errorf("unsupported expression %s", v.String())
 			}
-			f.Content = v.Value
 		default:
-			return errors.Errorf("unsupported option %q", v.Field)
-		}
-	case Pattern:
-		return errors.Errorf(`prepend 'file:' or 'content:' to "%s" to search repositories containing files or content respectively.`, v.Value)
-	case Operator:
-		if v.Kind == Or {
-			return errors.New("predicates do not currently support 'or' queries")
+			return RepoHasFileContentExpr{}, errors.Errorf("unsupported node type %T", node)
 		}
-		for _, operand := range v.Operands {
-			if err := f.parseNode(operand); err != nil {
-				return err
+	}
+
+	// Pair up a single path and content as a condition on the same file.
+	// Otherwise, each is a condition of its own. The parser flattens nested
+	// conjunctions, so it is ambiguous which path a content belongs to once
+	// there are several of either.
+	if (len(paths) > 1 && len(contents) > 0) || (len(contents) > 1 && len(paths) > 0) {
+		return RepoHasFileContentExpr{}, errors.New("cannot specify path or content multiple times next to each other, use a separate predicate for each file, such as `repo:has.file(path:a content:b) repo:has.file(path:c)`")
+	}
+	var leaves []RepoHasFileContentExpr
+	if len(paths) <= 1 && len(contents) <= 1 {
+		if len(paths) > 0 || len(contents) > 0 {
+			args := &RepoHasFileContentArgs{}
+			if len(paths) > 0 {
+				args.Path = paths[0]
+			}
+			if len(contents) > 0 {
+				args.Content = contents[0]
 			}
+			leaves = append(leaves, RepoHasFileContentExpr{Args: args})
+		}
+	} else {
+		for _, path := range paths {
+			leaves = append(leaves, RepoHasFileContentExpr{Args: &RepoHasFileContentArgs{Path: path}})
+		}
+		for _, content := range contents {
+			leaves = append(leaves, RepoHasFileContentExpr{Args: &RepoHasFileContentArgs{Content: content}})
 		}
+	}
+	operands = append(append(leaves, negatedLeaves...), operands...)
+
+	switch len(operands) {
+	case 0:
+		return RepoHasFileContentExpr{}, errors.New("one of path or content must be set")
+	case 1:
+		return operands[0], nil
 	default:
-		return errors.Errorf("unsupported node type %T", n)
+		return RepoHasFileContentExpr{And: operands}, nil
 	}
-	return nil
 }
 
 func (f *RepoContainsFilePredicate) Field() string { return FieldRepo }
@@ -483,6 +550,28 @@ func (p *RepoHasTopicPredicate) Unmarshal(params string, negated bool) (err erro
 func (p *RepoHasTopicPredicate) Field() string { return FieldRepo }
 func (p *RepoHasTopicPredicate) Name() string  { return "has.topic" }
 
+/* repo:has.symbol(name:pattern kind:kind) */
+
+// RepoHasSymbolPredicate represents the `repo:has.symbol()` predicate, which
+// filters to repos that define a symbol with a matching name and/or kind.
+type RepoHasSymbolPredicate struct {
+	SymbolName string
+	SymbolKind string
+	Negated    bool
+}
+
+func (p *RepoHasSymbolPredicate) Unmarshal(params string, negated bool) (err error) {
+	p.SymbolName, p.SymbolKind, err = parseHasSymbolParams(p.Field()+":"+p.Name(), params)
+	if err != nil {
+		return err
+	}
+	p.Negated = negated
+	return nil
+}
+
+func (p *RepoHasSymbolPredicate) Field() string { return FieldRepo }
+func (p *RepoHasSymbolPredicate) Name() string  { return "has.symbol" }
+
 // RepoContainsPredicate represents the `repo:contains(file:a content:b)` predicate.
 // DEPRECATED: this syntax is deprecated in favor of `repo:contains.file`.
 type RepoContainsPredicate struct {
@@ -614,3 +703,113 @@ func (f *FileHasContributorPredicate) Unmarshal(params string, negated bool) err
 
 func (f FileHasContributorPredicate) Field() string { return FieldFile }
 func (f FileHasContributorPredicate) Name() string  { return "has.contributor" }
+
+/* file:has.symbol(name:pattern kind:kind) */
+
+// FileHasSymbolPredicate represents the `file:has.symbol()` predicate, which
+// filters to files that define a symbol with a matching name and/or kind.
+type FileHasSymbolPredicate struct {
+	SymbolName string
+	SymbolKind string
+	Negated    bool
+}
+
+func (f *FileHasSymbolPredicate) Unmarshal(params string, negated bool) (err error) {
+	f.SymbolName, f.SymbolKind, err = parseHasSymbolParams(f.Field()+":"+f.Name(), params)
+	if err != nil {
+		return err
+	}
+	f.Negated = negated
+	return nil
+}
+
+func (f FileHasSymbolPredicate) Field() string { return FieldFile }
+func (f FileHasSymbolPredicate) Name() string  { return "has.symbol" }
+
+// parseHasSymbolParams parses the parameters of the has.symbol() predicates,
+// which take the form `name:pattern kind:kind`. Either argument may be
+// omitted, and a bare pattern is interpreted as the symbol name. Values may
+// be quoted with " or ' to contain spaces, as in `name:"foo bar"`.
+func parseHasSymbolParams(predicate, params string) (name, kind string, err error) {
+	args, err := scanHasSymbolParams(params)
+	if err != nil {
+		return "", "", errors.Errorf("the %s() predicate has invalid arguments: %w", predicate, err)
+	}
+	for _, arg := range args {
+		field, value := arg.field, arg.value
+		if field == "" {
+			field = "name"
+		}
+		switch strings.ToLower(field) {
+		case "name":
+			if name != "" {
+				return "", "", errors.Errorf("the %s() predicate cannot specify name multiple times", predicate)
+			}
+			if _, err := syntax.Parse(value, syntax.Perl); err != nil {
+				return "", "", errors.Errorf("the %s() predicate has invalid `name` argument: %w", predicate, err)
+			}
+			name = value
+		case "kind":
+			if kind != "" {
+				return "", "", errors.Errorf("the %s() predicate cannot specify kind multiple times", predicate)
+			}
+			if _, err := filter.SelectPathFromString(filter.Symbol + "." + value); err != nil {
+				return "", "", errors.Errorf("the %s() predicate has invalid `kind` argument %q", predicate, value)
+			}
+			kind = value
+		default:
+			return "", "", errors.Errorf("the %s() predicate has unsupported option %q", predicate, field)
+		}
+	}
+
+	if name == "" && kind == "" {
+		return "", "", errors.Errorf("the %s() predicate requires one of name or kind to be set", predicate)
+	}
+	return name, kind, nil
+}
+
+// hasSymbolParam is an argument of the has.symbol() predicates. field is
+// empty for a bare value.
+type hasSymbolParam struct {
+	field string
+	value string
+}
+
+// scanHasSymbolParams splits the parameters of the has.symbol() predicates
+// into their space separated `field:value` arguments, unquoting the values
+// quoted with " or '.
+func scanHasSymbolParams(params string) ([]hasSymbolParam, error) {
+	var args []hasSymbolParam
+	buf := []byte(params)
+	for {
+		buf = bytes.TrimLeftFunc(buf, unicode.IsSpace)
+		if len(buf) == 0 {
+			return args, nil
+		}
+
+		var arg hasSymbolParam
+		if i := bytes.IndexFunc(buf, func(r rune) bool {
+			return r == ':' || r == '"' || r == '\'' || unicode.IsSpace(r)
+		}); i > 0 && buf[i] == ':' {
+			arg.field, buf = string(buf[:i]), buf[i+1:]
+		}
+
+		if len(buf) > 0 && (buf[0] == '"' || buf[0] == '\'') {
+			value, n, err := ScanDelimited(buf, false, rune(buf[0]))
+			if err != nil {
+				return nil, err
+			}
+			arg.value, buf = value, buf[n:]
+			if len(buf) > 0 && !unicode.IsSpace(rune(buf[0])) {
+				return nil, errors.Errorf("expected a space after the quoted value %q", arg.value)
+			}
+		} else {
+			end := bytes.IndexFunc(buf, unicode.IsSpace)
+			if end < 0 {
+				end = len(buf)
+			}
+			arg.value, buf = string(buf[:end]), buf[end:]
+		}
+		args = append(args, arg)
+	}
+}
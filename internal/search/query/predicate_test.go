@@ -4,6 +4,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/hexops/autogold/v2"
 	"github.com/stretchr/testify/require"
 
 	"github.com/sourcegraph/sourcegraph/lib/pointers"
@@ -25,6 +26,31 @@ func TestRepoContainsFilePredicate(t *testing.T) {
 			{`content and path`, `content:abc path:test.go`, &RepoContainsFilePredicate{Path: "test.go", Content: "abc"}},
 			{`unnamed path`, `test.go`, &RepoContainsFilePredicate{Path: "test.go"}},
 			{`unnamed path regex`, `test(a|b)*.go`, &RepoContainsFilePredicate{Path: "test(a|b)*.go"}},
+			{`negated path`, `-path:test`, &RepoContainsFilePredicate{Path: "test", Negated: true}},
+			{`negated content`, `not content:test`, &RepoContainsFilePredicate{Content: "test", Negated: true}},
+			{`and not`, `path:go.mod and not path:vendor/`, &RepoContainsFilePredicate{Expr: &RepoHasFileContentExpr{And: []RepoHasFileContentExpr{
+				{Args: &RepoHasFileContentArgs{Path: "go.mod"}},
+				{Args: &RepoHasFileContentArgs{Path: "vendor/", Negated: true}},
+			}}}},
+			{`multiple paths`, `path:a path:b`, &RepoContainsFilePredicate{Expr: &RepoHasFileContentExpr{And: []RepoHasFileContentExpr{
+				{Args: &RepoHasFileContentArgs{Path: "a"}},
+				{Args: &RepoHasFileContentArgs{Path: "b"}},
+			}}}},
+			{`multiple contents`, `content:a and content:b`, &RepoContainsFilePredicate{Expr: &RepoHasFileContentExpr{And: []RepoHasFileContentExpr{
+				{Args: &RepoHasFileContentArgs{Content: "a"}},
+				{Args: &RepoHasFileContentArgs{Content: "b"}},
+			}}}},
+			{`or`, `(path:package.json content:react) or path:go.mod`, &RepoContainsFilePredicate{Expr: &RepoHasFileContentExpr{Or: []RepoHasFileContentExpr{
+				{Args: &RepoHasFileContentArgs{Path: "package.json", Content: "react"}},
+				{Args: &RepoHasFileContentArgs{Path: "go.mod"}},
+			}}}},
+			{`nested`, `path:a or (path:b -content:c)`, &RepoContainsFilePredicate{Expr: &RepoHasFileContentExpr{Or: []RepoHasFileContentExpr{
+				{Args: &RepoHasFileContentArgs{Path: "a"}},
+				{And: []RepoHasFileContentExpr{
+					{Args: &RepoHasFileContentArgs{Path: "b"}},
+					{Args: &RepoHasFileContentArgs{Content: "c", Negated: true}},
+				}},
+			}}}},
 		}
 
 		for _, tc := range valid {
@@ -43,8 +69,11 @@ func TestRepoContainsFilePredicate(t *testing.T) {
 
 		invalid := []test{
 			{`empty`, ``, nil},
-			{`negated path`, `-path:test`, nil},
-			{`negated content`, `-content:test`, nil},
+			{`unsupported option`, `path:a or lang:go`, nil},
+			{`negated expression`, `not (path:a or path:b)`, nil},
+			{`multiple paths with content`, `path:a path:b content:c`, nil},
+			{`multiple contents with path`, `path:a content:b content:c`, nil},
+			{`ambiguous parenthesized conditions`, `(path:a content:b) and path:c`, nil},
 			{`catch invalid content regexp`, `path:foo content:([)`, nil},
 			{`unsupported syntax`, `content1 content2`, nil},
 			{`invalid unnamed path`, `([)`, nil},
@@ -60,6 +89,48 @@ func TestRepoContainsFilePredicate(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("Unmarshal negated", func(t *testing.T) {
+		p := &RepoContainsFilePredicate{}
+		err := p.Unmarshal(`path:go.mod and not path:vendor/`, true)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		autogold.Expect("(-path:go.mod or path:vendor/)").Equal(t, p.Expr.String())
+	})
+}
+
+func TestRepoHasFileContentExpr(t *testing.T) {
+	expr := RepoHasFileContentExpr{Or: []RepoHasFileContentExpr{
+		{Args: &RepoHasFileContentArgs{Path: "a"}},
+		{And: []RepoHasFileContentExpr{
+			{Args: &RepoHasFileContentArgs{Path: "b"}},
+			{Args: &RepoHasFileContentArgs{Content: "c", Negated: true}},
+		}},
+	}}
+
+	eval := func(files map[string]bool) (bool, []string) {
+		var evaluated []string
+		ok, _ := expr.Eval(func(args RepoHasFileContentArgs) (bool, error) {
+			evaluated = append(evaluated, args.String())
+			return files[args.Path+args.Content] != args.Negated, nil
+		})
+		return ok, evaluated
+	}
+
+	ok, evaluated := eval(map[string]bool{"a": true})
+	require.True(t, ok)
+	require.Equal(t, []string{"path:a"}, evaluated)
+
+	ok, evaluated = eval(map[string]bool{"b": true})
+	require.True(t, ok)
+	require.Equal(t, []string{"path:a", "path:b", "-content:c"}, evaluated)
+
+	ok, _ = eval(map[string]bool{"b": true, "c": true})
+	require.False(t, ok)
+
+	ok, _ = eval(map[string]bool{})
+	require.False(t, ok)
 }
 
 func TestParseAsPredicate(t *testing.T) {
@@ -366,3 +437,63 @@ func TestFileHasContributorPredicate(t *testing.T) {
 		}
 	})
 }
+
+func TestHasSymbolPredicate(t *testing.T) {
+	t.Run("Unmarshal", func(t *testing.T) {
+		type test struct {
+			name     string
+			params   string
+			expected *FileHasSymbolPredicate
+			error    string
+		}
+
+		cases := []test{
+			{`name`, `name:^Handle`, &FileHasSymbolPredicate{SymbolName: "^Handle"}, ""},
+			{`kind`, `kind:function`, &FileHasSymbolPredicate{SymbolKind: "function"}, ""},
+			{`name and kind`, `kind:function name:^Handle`, &FileHasSymbolPredicate{SymbolName: "^Handle", SymbolKind: "function"}, ""},
+			{`bare pattern`, `^Handle`, &FileHasSymbolPredicate{SymbolName: "^Handle"}, ""},
+			{`quoted name`, `name:"^Handle Request$" kind:function`, &FileHasSymbolPredicate{SymbolName: "^Handle Request$", SymbolKind: "function"}, ""},
+			{`single quoted name`, `kind:function name:'a b'`, &FileHasSymbolPredicate{SymbolName: "a b", SymbolKind: "function"}, ""},
+			{`quoted bare pattern`, `"a:b c"`, &FileHasSymbolPredicate{SymbolName: "a:b c"}, ""},
+			{`escaped quote`, `name:"a\"b"`, &FileHasSymbolPredicate{SymbolName: `a"b`}, ""},
+			{`unterminated quote`, `name:"a b`, &FileHasSymbolPredicate{}, "the file:has.symbol() predicate has invalid arguments: unterminated literal: expected \""},
+			{`text after quote`, `name:"a"b`, &FileHasSymbolPredicate{}, "the file:has.symbol() predicate has invalid arguments: expected a space after the quoted value \"a\""},
+			{`empty`, ``, &FileHasSymbolPredicate{}, "the file:has.symbol() predicate requires one of name or kind to be set"},
+			{`invalid kind`, `kind:funky`, &FileHasSymbolPredicate{}, "the file:has.symbol() predicate has invalid `kind` argument \"funky\""},
+			{`invalid name`, `name:(((`, &FileHasSymbolPredicate{}, "the file:has.symbol() predicate has invalid `name` argument: error parsing regexp: missing closing ): `(((`"},
+			{`duplicate name`, `name:a name:b`, &FileHasSymbolPredicate{}, "the file:has.symbol() predicate cannot specify name multiple times"},
+			{`unsupported option`, `lang:go`, &FileHasSymbolPredicate{}, "the file:has.symbol() predicate has unsupported option \"lang\""},
+		}
+
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				p := &FileHasSymbolPredicate{}
+				err := p.Unmarshal(tc.params, false)
+				if err != nil {
+					if tc.error == "" {
+						t.Fatalf("unexpected error: %s", err)
+					} else if tc.error != err.Error() {
+						t.Fatalf("expected error %s, got %s", tc.error, err.Error())
+					}
+				} else if tc.error != "" {
+					t.Fatalf("expected error %s, got none", tc.error)
+				}
+
+				if !reflect.DeepEqual(tc.expected, p) {
+					t.Fatalf("expected %#v, got %#v", tc.expected, p)
+				}
+			})
+		}
+	})
+
+	t.Run("repo predicate is negatable", func(t *testing.T) {
+		p := &RepoHasSymbolPredicate{}
+		if err := p.Unmarshal(`name:^main$ kind:function`, true); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		expected := &RepoHasSymbolPredicate{SymbolName: "^main$", SymbolKind: "function", Negated: true}
+		if !reflect.DeepEqual(expected, p) {
+			t.Fatalf("expected %#v, got %#v", expected, p)
+		}
+	})
+}
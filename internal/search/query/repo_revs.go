@@ -2,12 +2,14 @@ package query
 
 import (
 	"strings"
+	"time"
 
 	"github.com/grafana/regexp"
 )
 
 // RevisionSpecifier represents either a revspec or a ref glob. At most one
-// field is set. The default branch is represented by all fields being empty.
+// field is set, except for Before which may be combined with RevSpec. The
+// default branch is represented by all fields being empty.
 type RevisionSpecifier struct {
 	// RevSpec is a revision range specifier suitable for passing to git. See
 	// the manpage gitrevisions(7).
@@ -20,8 +22,16 @@ type RevisionSpecifier struct {
 	// ExcludeRefGlob is a glob for references to exclude. See the
 	// documentation for "--exclude" in git-log.
 	ExcludeRefGlob string
+
+	// Before is a date in the format YYYY-MM-DD. If set, the revision
+	// resolves to the last commit before the start of that day (UTC) on
+	// RevSpec, or on the default branch if RevSpec is empty.
+	Before string
 }
 
+// revisionDateLayout is the layout of the date in a `{date}` revision.
+const revisionDateLayout = "2006-01-02"
+
 func (r1 RevisionSpecifier) String() string {
 	if r1.ExcludeRefGlob != "" {
 		return "*!" + r1.ExcludeRefGlob
@@ -29,6 +39,12 @@ func (r1 RevisionSpecifier) String() string {
 	if r1.RefGlob != "" {
 		return "*" + r1.RefGlob
 	}
+	if r1.Before != "" {
+		if r1.RevSpec == "" {
+			return "{" + r1.Before + "}"
+		}
+		return r1.RevSpec + "@{" + r1.Before + "}"
+	}
 	return r1.RevSpec
 }
 
@@ -44,13 +60,23 @@ func (r1 RevisionSpecifier) Less(r2 RevisionSpecifier) bool {
 	if r1.RefGlob != r2.RefGlob {
 		return r1.RefGlob < r2.RefGlob
 	}
-	return r1.ExcludeRefGlob < r2.ExcludeRefGlob
+	if r1.ExcludeRefGlob != r2.ExcludeRefGlob {
+		return r1.ExcludeRefGlob < r2.ExcludeRefGlob
+	}
+	return r1.Before < r2.Before
 }
 
 func (r1 RevisionSpecifier) HasRefGlob() bool {
 	return r1.RefGlob != "" || r1.ExcludeRefGlob != ""
 }
 
+// BeforeTime returns the instant Before refers to, i.e. the start of that day
+// in UTC. It returns the zero time if Before is not set.
+func (r1 RevisionSpecifier) BeforeTime() time.Time {
+	t, _ := time.Parse(revisionDateLayout, r1.Before)
+	return t
+}
+
 type ParsedRepoFilter struct {
 	Repo      string
 	RepoRegex *regexp.Regexp // A case-insensitive regex matching the Repo pattern
@@ -90,6 +116,9 @@ func (p ParsedRepoFilter) String() string {
 //   - 'foo@*bar' refers to the 'foo' repo and all refs matching the glob 'bar/*',
 //     because git interprets the ref glob 'bar' as being 'bar/*' (see `man git-log`
 //     section on the --glob flag)
+//   - 'foo@{2023-01-01}' refers to the 'foo' repo at the last commit on the
+//     default branch before 2023-01-01, and 'foo@bar@{2023-01-01}' to the last
+//     commit on 'bar' before that date.
 func ParseRepositoryRevisions(repoAndOptionalRev string) (ParsedRepoFilter, error) {
 	var repo string
 	var revs []RevisionSpecifier
@@ -132,6 +161,34 @@ func parseRev(spec string) RevisionSpecifier {
 		return RevisionSpecifier{ExcludeRefGlob: spec[2:]}
 	} else if strings.HasPrefix(spec, "*") {
 		return RevisionSpecifier{RefGlob: spec[1:]}
+	} else if rev, before, ok := parseRevBefore(spec); ok {
+		return RevisionSpecifier{RevSpec: rev, Before: before}
 	}
 	return RevisionSpecifier{RevSpec: spec}
 }
+
+// parseRevBefore parses revisions of the form `{date}` and `rev@{date}`. Any
+// other use of braces, such as the reflog syntax `rev@{1}`, is left to git.
+func parseRevBefore(spec string) (rev, before string, ok bool) {
+	if !strings.HasSuffix(spec, "}") {
+		return "", "", false
+	}
+	i := strings.LastIndex(spec, "{")
+	if i == -1 {
+		return "", "", false
+	}
+	rev, before = spec[:i], spec[i+1:len(spec)-1]
+	if rev != "" {
+		if !strings.HasSuffix(rev, "@") {
+			return "", "", false
+		}
+		rev = strings.TrimSuffix(rev, "@")
+		if rev == "" {
+			return "", "", false
+		}
+	}
+	if _, err := time.Parse(revisionDateLayout, before); err != nil {
+		return "", "", false
+	}
+	return rev, before, true
+}
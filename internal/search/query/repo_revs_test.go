@@ -35,8 +35,15 @@ func TestParseRepositoryRevisions(t *testing.T) {
 				{RefGlob: "glob3"},
 			},
 		},
-		"@rev1":            {repo: "", revs: []RevisionSpecifier{{RevSpec: "rev1"}}},
-		"repo?*@rev1:rev2": {err: &syntax.Error{Code: "invalid nested repetition operator", Expr: "?*"}},
+		"repo@{2023-01-01}": {repo: "repo", revs: []RevisionSpecifier{{Before: "2023-01-01"}}},
+		"repo@rev@{2023-01-01}:rev2": {
+			repo: "repo",
+			revs: []RevisionSpecifier{{RevSpec: "rev", Before: "2023-01-01"}, {RevSpec: "rev2"}},
+		},
+		"repo@rev@{1}":         {repo: "repo", revs: []RevisionSpecifier{{RevSpec: "rev@{1}"}}},
+		"repo@rev{2023-01-01}": {repo: "repo", revs: []RevisionSpecifier{{RevSpec: "rev{2023-01-01}"}}},
+		"@rev1":                {repo: "", revs: []RevisionSpecifier{{RevSpec: "rev1"}}},
+		"repo?*@rev1:rev2":     {err: &syntax.Error{Code: "invalid nested repetition operator", Expr: "?*"}},
 	}
 	for input, want := range tests {
 		t.Run(input, func(t *testing.T) {
@@ -61,3 +68,37 @@ func TestParseRepositoryRevisions(t *testing.T) {
 		})
 	}
 }
+
+func TestRevisionSpecifier_String(t *testing.T) {
+	tests := []struct {
+		rev  RevisionSpecifier
+		want string
+	}{
+		{rev: RevisionSpecifier{}, want: ""},
+		{rev: RevisionSpecifier{RevSpec: "main"}, want: "main"},
+		{rev: RevisionSpecifier{RefGlob: "refs/heads/*"}, want: "*refs/heads/*"},
+		{rev: RevisionSpecifier{ExcludeRefGlob: "refs/heads/x"}, want: "*!refs/heads/x"},
+		{rev: RevisionSpecifier{Before: "2023-01-01"}, want: "{2023-01-01}"},
+		{rev: RevisionSpecifier{RevSpec: "main", Before: "2023-01-01"}, want: "main@{2023-01-01}"},
+	}
+	for _, test := range tests {
+		t.Run(test.want, func(t *testing.T) {
+			got := test.rev.String()
+			if got != test.want {
+				t.Fatalf("got %q, want %q", got, test.want)
+			}
+
+			// The rendered revision must parse back to the same specifier.
+			if got == "" {
+				return
+			}
+			parsed, err := ParseRepositoryRevisions("repo@" + got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff([]RevisionSpecifier{test.rev}, parsed.Revs); diff != "" {
+				t.Fatalf("round trip (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
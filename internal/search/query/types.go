@@ -9,6 +9,7 @@ import (
 	"github.com/grafana/regexp"
 
 	"github.com/sourcegraph/sourcegraph/internal/search/limits"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 type ExpectedOperand struct {
@@ -325,6 +326,28 @@ func (b Basic) IsEmptyPattern() bool {
 	return false
 }
 
+// SelectCaptureRegexp returns the regular expression whose first capture
+// group is selected by `select:content.capture`. The query must have a single
+// regular expression pattern with at least one capture group.
+func (b Basic) SelectCaptureRegexp() (*regexp.Regexp, error) {
+	p, ok := b.Pattern.(Pattern)
+	if !ok || p.Negated || !p.Annotation.Labels.IsSet(Regexp) {
+		return nil, errors.New("select:content.capture requires a single regular expression pattern, for example /version = \"(\\d+\\.\\d+)\"/")
+	}
+	value := p.Value
+	if !b.IsCaseSensitive() {
+		value = "(?i:" + value + ")"
+	}
+	re, err := regexp.Compile(value)
+	if err != nil {
+		return nil, err
+	}
+	if re.NumSubexp() == 0 {
+		return nil, errors.New("select:content.capture requires the pattern to have a capture group")
+	}
+	return re, nil
+}
+
 type Parameters []Parameter
 
 // IncludeExcludeValues partitions multiple values of a field into positive
@@ -358,6 +381,99 @@ type RepoHasFileContentArgs struct {
 	Negated bool
 }
 
+func (a RepoHasFileContentArgs) String() string {
+	var parts []string
+	if a.Path != "" {
+		parts = append(parts, "path:"+a.Path)
+	}
+	if a.Content != "" {
+		parts = append(parts, "content:"+a.Content)
+	}
+	s := strings.Join(parts, " ")
+	switch {
+	case a.Negated && len(parts) > 1:
+		return "-(" + s + ")"
+	case a.Negated:
+		return "-" + s
+	default:
+		return s
+	}
+}
+
+// RepoHasFileContentExpr is a boolean combination of RepoHasFileContentArgs, as
+// written in the body of a repo:has.file() predicate, for example
+// `repo:has.file(path:go.mod or (path:package.json -content:react))`. Exactly
+// one of Args, And and Or is set.
+type RepoHasFileContentExpr struct {
+	Args *RepoHasFileContentArgs
+	And  []RepoHasFileContentExpr
+	Or   []RepoHasFileContentExpr
+}
+
+// Negate returns the negation of the expression, with negations pushed down to
+// the arguments.
+func (e RepoHasFileContentExpr) Negate() RepoHasFileContentExpr {
+	negateAll := func(exprs []RepoHasFileContentExpr) []RepoHasFileContentExpr {
+		res := make([]RepoHasFileContentExpr, 0, len(exprs))
+		for _, expr := range exprs {
+			res = append(res, expr.Negate())
+		}
+		return res
+	}
+
+	switch {
+	case e.Args != nil:
+		args := *e.Args
+		args.Negated = !args.Negated
+		return RepoHasFileContentExpr{Args: &args}
+	case len(e.And) > 0:
+		return RepoHasFileContentExpr{Or: negateAll(e.And)}
+	default:
+		return RepoHasFileContentExpr{And: negateAll(e.Or)}
+	}
+}
+
+// Eval evaluates the expression, where holds reports whether the condition of
+// a single argument, including its negation, holds. Evaluation stops as soon
+// as the result is known.
+func (e RepoHasFileContentExpr) Eval(holds func(RepoHasFileContentArgs) (bool, error)) (bool, error) {
+	if e.Args != nil {
+		return holds(*e.Args)
+	}
+	for _, operand := range e.And {
+		ok, err := operand.Eval(holds)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	for _, operand := range e.Or {
+		ok, err := operand.Eval(holds)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+	return len(e.And) > 0, nil
+}
+
+func (e RepoHasFileContentExpr) String() string {
+	join := func(exprs []RepoHasFileContentExpr, op string) string {
+		parts := make([]string, 0, len(exprs))
+		for _, expr := range exprs {
+			parts = append(parts, expr.String())
+		}
+		return "(" + strings.Join(parts, " "+op+" ") + ")"
+	}
+
+	switch {
+	case e.Args != nil:
+		return e.Args.String()
+	case len(e.And) > 0:
+		return join(e.And, "and")
+	default:
+		return join(e.Or, "or")
+	}
+}
+
 func (p Parameters) RepoHasFileContent() (res []RepoHasFileContentArgs) {
 	nodes := toNodes(p)
 	VisitField(nodes, FieldRepoHasFile, func(v string, negated bool, _ Annotation) {
@@ -382,6 +498,10 @@ func (p Parameters) RepoHasFileContent() (res []RepoHasFileContentArgs) {
 	})
 
 	VisitTypedPredicate(nodes, func(pred *RepoContainsFilePredicate) {
+		if pred.Expr != nil {
+			// Returned by RepoHasFileContentExprs instead.
+			return
+		}
 		res = append(res, RepoHasFileContentArgs{
 			Path:    pred.Path,
 			Content: pred.Content,
@@ -400,6 +520,18 @@ func (p Parameters) RepoHasFileContent() (res []RepoHasFileContentArgs) {
 	return res
 }
 
+// RepoHasFileContentExprs returns the repo:has.file() predicates whose body is
+// a boolean combination of conditions. Simple predicates are returned by
+// RepoHasFileContent.
+func (p Parameters) RepoHasFileContentExprs() (res []RepoHasFileContentExpr) {
+	VisitTypedPredicate(toNodes(p), func(pred *RepoContainsFilePredicate) {
+		if pred.Expr != nil {
+			res = append(res, *pred.Expr)
+		}
+	})
+	return res
+}
+
 func (p Parameters) FileContainsContent() (include []string) {
 	VisitTypedPredicate(toNodes(p), func(pred *FileContainsContentPredicate) {
 		include = append(include, pred.Pattern)
@@ -505,6 +637,36 @@ func (p Parameters) FileHasContributor() (include []string, exclude []string) {
 	return include, exclude
 }
 
+// HasSymbolArgs represents the args of the file:has.symbol() and
+// repo:has.symbol() predicates.
+type HasSymbolArgs struct {
+	Name    string
+	Kind    string
+	Negated bool
+}
+
+func (p Parameters) FileHasSymbol() (res []HasSymbolArgs) {
+	VisitTypedPredicate(toNodes(p), func(pred *FileHasSymbolPredicate) {
+		res = append(res, HasSymbolArgs{
+			Name:    pred.SymbolName,
+			Kind:    pred.SymbolKind,
+			Negated: pred.Negated,
+		})
+	})
+	return res
+}
+
+func (p Parameters) RepoHasSymbol() (res []HasSymbolArgs) {
+	VisitTypedPredicate(toNodes(p), func(pred *RepoHasSymbolPredicate) {
+		res = append(res, HasSymbolArgs{
+			Name:    pred.SymbolName,
+			Kind:    pred.SymbolKind,
+			Negated: pred.Negated,
+		})
+	})
+	return res
+}
+
 // Exists returns whether a parameter exists in the query (whether negated or not).
 func (p Parameters) Exists(field string) bool {
 	found := false
@@ -579,6 +741,54 @@ func (p Parameters) IsCaseSensitive() bool {
 	return p.boolValue(FieldCase)
 }
 
+// GroupByVersion returns whether identical file matches across the searched
+// revisions should be grouped into one result (`versions:yes`).
+func (p Parameters) GroupByVersion() bool {
+	return p.boolValue(FieldVersions)
+}
+
+// DedupeModeContent is the value of `dedupe:content`.
+const DedupeModeContent = "content"
+
+// DedupeContent returns whether file matches with identical content at the
+// same path across repositories, such as in forks and mirrors, should be
+// collapsed into one result (`dedupe:content`).
+func (p Parameters) DedupeContent() bool {
+	var result bool
+	VisitField(toNodes(p), FieldDedupe, func(value string, _ bool, _ Annotation) {
+		result = strings.EqualFold(value, DedupeModeContent)
+	})
+	return result
+}
+
+// BlameAuthor returns the author patterns of `blame.author:` parameters, which
+// filter content matches by the author of the matched lines.
+func (p Parameters) BlameAuthor() (include, exclude []string) {
+	return p.IncludeExcludeValues(FieldBlameAuthor)
+}
+
+// BlameBefore returns the time of a `blame.before:` parameter, which filters
+// content matches to lines last changed before it.
+func (p Parameters) BlameBefore() *time.Time {
+	return p.gitDateValue(FieldBlameBefore)
+}
+
+// BlameAfter returns the time of a `blame.after:` parameter, which filters
+// content matches to lines last changed after it.
+func (p Parameters) BlameAfter() *time.Time {
+	return p.gitDateValue(FieldBlameAfter)
+}
+
+func (p Parameters) gitDateValue(field string) *time.Time {
+	var result *time.Time
+	VisitField(toNodes(p), field, func(value string, _ bool, _ Annotation) {
+		if t, err := ParseGitDate(value, time.Now); err == nil {
+			result = &t
+		}
+	})
+	return result
+}
+
 func (p Parameters) yesNoOnlyValue(field string) *YesNoOnly {
 	var res *YesNoOnly
 	VisitField(toNodes(p), field, func(value string, _ bool, _ Annotation) {
@@ -222,6 +222,13 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 		return err
 	}
 
+	isDedupeMode := func() error {
+		if !strings.EqualFold(value, DedupeModeContent) {
+			return errors.Errorf("invalid value %q for field %q. Valid values are: %s", value, field, DedupeModeContent)
+		}
+		return nil
+	}
+
 	isValidGitDate := func() error {
 		_, err := ParseGitDate(value, time.Now)
 		return err
@@ -241,7 +248,8 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 		FieldDefault:
 		// Search patterns are not validated here, as it depends on the search type.
 	case
-		FieldCase:
+		FieldCase,
+		FieldVersions:
 		return satisfies(isSingular, isBoolean, isNotNegated)
 	case
 		FieldRepo:
@@ -278,6 +286,13 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 		FieldCommitter,
 		FieldMessage:
 		return satisfies(isValidRegexp)
+	case
+		FieldBlameAuthor:
+		return satisfies(isValidRegexp)
+	case
+		FieldBlameBefore,
+		FieldBlameAfter:
+		return satisfies(isSingular, isNotNegated, isValidGitDate)
 	case
 		FieldIndex,
 		FieldFork,
@@ -298,6 +313,9 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 	case
 		FieldSelect:
 		return satisfies(isSingular, isNotNegated, isValidSelect)
+	case
+		FieldDedupe:
+		return satisfies(isSingular, isNotNegated, isDedupeMode)
 	default:
 		return isUnrecognizedField()
 	}
@@ -382,6 +400,24 @@ func validateTypeStructural(nodes []Node) error {
 	return nil
 }
 
+// validateSelectCapture validates that a query with `select:content.capture`
+// has a pattern to select capture groups from.
+func validateSelectCapture(nodes []Node) error {
+	var selector string
+	VisitField(nodes, FieldSelect, func(value string, _ bool, _ Annotation) {
+		selector = value
+	})
+	if selector != filter.Content+"."+filter.Capture {
+		return nil
+	}
+	parameters, pattern, err := PartitionSearchPattern(nodes)
+	if err != nil {
+		return err
+	}
+	_, err = Basic{Parameters: parameters, Pattern: pattern}.SelectCaptureRegexp()
+	return err
+}
+
 func validateRefGlobs(nodes []Node) error {
 	if !ContainsRefGlobs(nodes) {
 		return nil
@@ -500,6 +536,7 @@ func validate(nodes []Node) error {
 		validateCommitParameters,
 		validateTypeStructural,
 		validateRefGlobs,
+		validateSelectCapture,
 	)
 }
 
@@ -111,6 +111,31 @@ func TestValidation(t *testing.T) {
 			input: "type:symbol select:symbol.timelime",
 			want:  `invalid field "timelime" on select path "symbol.timelime"`,
 		},
+		{
+			input:      `version select:content.capture`,
+			want:       `select:content.capture requires a single regular expression pattern, for example /version = "(\d+\.\d+)"/`,
+			searchType: SearchTypeStandard,
+		},
+		{
+			input: `version\s*=\s*\d+ select:content.capture`,
+			want:  "select:content.capture requires the pattern to have a capture group",
+		},
+		{
+			input: "foo dedupe:repo",
+			want:  `invalid value "repo" for field "dedupe". Valid values are: content`,
+		},
+		{
+			input: "foo -dedupe:content",
+			want:  `field "dedupe" does not support negation`,
+		},
+		{
+			input: "foo blame.before:yesterday blame.before:today",
+			want:  `field "blame.before" may not be used more than once`,
+		},
+		{
+			input: "foo -blame.after:yesterday",
+			want:  `field "blame.after" does not support negation`,
+		},
 		{
 			input:      "nice try type:repo",
 			want:       "this structural search query specifies `type:` and is not supported. Structural search syntax only applies to searching file contents",
@@ -34,6 +34,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/search/searchcontexts"
 	"github.com/sourcegraph/sourcegraph/internal/search/searcher"
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/search/symbol"
 	searchzoekt "github.com/sourcegraph/sourcegraph/internal/search/zoekt"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 	"github.com/sourcegraph/sourcegraph/internal/types"
@@ -271,6 +272,13 @@ func (r *Resolver) Resolve(ctx context.Context, op search.RepoOptions) (_ Resolv
 	}
 	tr.AddEvent("finished contains filtering")
 
+	tr.AddEvent("starting symbol filtering")
+	filteredRepoRevs, err = r.filterRepoHasSymbol(ctx, filteredRepoRevs, op)
+	if err != nil {
+		return Resolved{}, errors.Wrap(err, "filter has symbol")
+	}
+	tr.AddEvent("finished symbol filtering")
+
 	if len(missingRepoRevs) > 0 {
 		err = errors.Append(err, &MissingRepoRevsError{Missing: missingRepoRevs})
 	}
@@ -391,6 +399,28 @@ func (r *Resolver) normalizeRefs(ctx context.Context, repoRevSpecs []RepoRevSpec
 	return filteredResults, missing, nil
 }
 
+// resolveRevisionBefore resolves a revision with a date to the last commit
+// before that date on rev.RevSpec, or on the default branch if it is empty.
+func (r *Resolver) resolveRevisionBefore(ctx context.Context, repo api.RepoName, rev query.RevisionSpecifier) (api.CommitID, error) {
+	revSpec := rev.RevSpec
+	if revSpec == "" {
+		revSpec = "HEAD"
+	}
+	commits, err := r.gitserver.Commits(ctx, authz.DefaultSubRepoPermsChecker, repo, gitserver.CommitsOptions{
+		Range:            revSpec,
+		Before:           rev.BeforeTime().Format(time.RFC3339),
+		N:                1,
+		NoEnsureRevision: true,
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(commits) == 0 {
+		return "", &gitdomain.RevisionNotFoundError{Repo: repo, Spec: rev.String()}
+	}
+	return commits[0].ID, nil
+}
+
 func (r *Resolver) normalizeRepoRefs(
 	ctx context.Context,
 	repo types.MinimalRepo,
@@ -405,6 +435,16 @@ func (r *Resolver) normalizeRepoRefs(
 			globs = append(globs, gitdomain.RefGlob{Include: rev.RefGlob})
 		case rev.ExcludeRefGlob != "":
 			globs = append(globs, gitdomain.RefGlob{Exclude: rev.ExcludeRefGlob})
+		case rev.Before != "":
+			commitID, err := r.resolveRevisionBefore(ctx, repo.Name, rev)
+			if err != nil {
+				if errors.Is(err, context.DeadlineExceeded) || errors.HasType(err, &gitdomain.BadCommitError{}) {
+					return nil, err
+				}
+				reportMissing(RepoRevSpecs{Repo: repo, Revs: []query.RevisionSpecifier{rev}})
+				continue
+			}
+			revs = append(revs, string(commitID))
 		case rev.RevSpec == "" || rev.RevSpec == "HEAD":
 			// NOTE: HEAD is the only case here that we don't resolve to a
 			// commit ID. We should consider building []gitdomain.Ref here
@@ -515,8 +555,104 @@ func (r *Resolver) filterHasCommitAfter(
 	return filteredRepoRevs, nil
 }
 
+// maxRepoSymbols bounds the number of symbols fetched for a single repo
+// revision when evaluating a repo:has.symbol() predicate.
+const maxRepoSymbols = 1000
+
+// filterRepoHasSymbol filters the revisions on each of a set of RepositoryRevisions to
+// only those that define symbols matching the `repo:has.symbol()` predicates in
+// RepoOptions.HasSymbol. Symbols are looked up with Zoekt if the revision is indexed,
+// and with the symbols service otherwise.
+func (r *Resolver) filterRepoHasSymbol(
+	ctx context.Context,
+	repoRevs []*search.RepositoryRevisions,
+	op search.RepoOptions,
+) (
+	_ []*search.RepositoryRevisions,
+	err error,
+) {
+	// Early return if there are no filters
+	if len(op.HasSymbol) == 0 {
+		return repoRevs, nil
+	}
+
+	tr, ctx := trace.New(ctx, "Resolve.FilterHasSymbol")
+	tr.SetAttributes(attribute.Int("inputRevCount", len(repoRevs)))
+	defer tr.FinishWithErr(&err)
+
+	filters := symbol.NewFilters(op.HasSymbol, op.CaseSensitiveRepoFilters)
+
+	p := pool.New().WithContext(ctx).WithMaxGoroutines(16)
+
+	for _, repoRev := range repoRevs {
+		repoRev := repoRev
+
+		allRevs := repoRev.Revs
+
+		var mu sync.Mutex
+		repoRev.Revs = make([]string, 0, len(allRevs))
+
+		for _, rev := range allRevs {
+			rev := rev
+			p.Go(func(ctx context.Context) error {
+				commitID, err := r.gitserver.ResolveRevision(ctx, repoRev.Repo.Name, rev, gitserver.ResolveRevisionOptions{NoEnsureRevision: true})
+				if err != nil {
+					if errors.HasType(err, &gitdomain.RevisionNotFoundError{}) || gitdomain.IsRepoNotExist(err) {
+						// If the revision does not exist or the repo does not exist,
+						// it certainly does not define any symbols.
+						// Ignore the error, but filter this repo out.
+						return nil
+					}
+					return err
+				}
+
+				for _, f := range filters {
+					// Pass the kind to the symbol search rather than filtering
+					// its results, so that the symbols of other kinds don't
+					// use up the first maxRepoSymbols symbols.
+					var kinds []string
+					if f.Kind != "" {
+						kinds = []string{f.Kind}
+					}
+					first := int32(maxRepoSymbols)
+					symbols, err := symbol.Compute(ctx, authz.DefaultSubRepoPermsChecker, repoRev.Repo, commitID, &rev, &f.Name, &first, nil, kinds)
+					if err != nil {
+						return err
+					}
+
+					if f.Match(symbols) == f.Negated {
+						// One of the conditions has failed, so we can return early
+						return nil
+					}
+				}
+
+				mu.Lock()
+				repoRev.Revs = append(repoRev.Revs, rev)
+				mu.Unlock()
+				return nil
+			})
+		}
+	}
+
+	if err := p.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Filter out any repo revs with empty revs
+	filteredRepoRevs := repoRevs[:0]
+	for _, repoRev := range repoRevs {
+		if len(repoRev.Revs) > 0 {
+			filteredRepoRevs = append(filteredRepoRevs, repoRev)
+		}
+	}
+
+	tr.SetAttributes(attribute.Int("filteredRevCount", len(filteredRepoRevs)))
+	return filteredRepoRevs, nil
+}
+
 // filterRepoHasFileContent filters a page of repos to only those that match the
-// given contains predicates in RepoOptions.HasFileContent.
+// given contains predicates in RepoOptions.HasFileContent and
+// RepoOptions.HasFileContentExprs.
 // Brief overview of the method:
 // 1) We partition the set of repos into indexed and unindexed
 // 2) We kick off a single zoekt search that handles all the indexed revs
@@ -540,10 +676,18 @@ func (r *Resolver) filterRepoHasFileContent(
 	}()
 
 	// Early return if there are no filters
-	if len(op.HasFileContent) == 0 {
+	if len(op.HasFileContent) == 0 && len(op.HasFileContentExprs) == 0 {
 		return repoRevs, nil, 0, nil
 	}
 
+	// A repo rev must satisfy all the predicates. Simple predicates are
+	// treated as expressions of a single condition.
+	exprs := make([]query.RepoHasFileContentExpr, 0, len(op.HasFileContent)+len(op.HasFileContentExprs))
+	for i := range op.HasFileContent {
+		exprs = append(exprs, query.RepoHasFileContentExpr{Args: &op.HasFileContent[i]})
+	}
+	exprs = append(exprs, op.HasFileContentExprs...)
+
 	indexed, unindexed, err := searchzoekt.PartitionRepos(
 		ctx,
 		r.logger,
@@ -613,8 +757,8 @@ func (r *Resolver) filterRepoHasFileContent(
 				rev string
 			}
 			var revsMatchingAllPredicates Set[repoAndRev]
-			for i, opt := range op.HasFileContent {
-				q := searchzoekt.QueryForFileContentArgs(opt, op.CaseSensitiveRepoFilters)
+			for i, expr := range exprs {
+				q := searchzoekt.QueryForFileContentExpr(expr, op.CaseSensitiveRepoFilters)
 				q = zoektquery.NewAnd(&zoektquery.BranchesRepos{List: indexed.BranchRepos()}, q)
 
 				repos, err := r.zoekt.List(ctx, q, &zoekt.ListOptions{Minimal: true})
@@ -676,14 +820,21 @@ func (r *Resolver) filterRepoHasFileContent(
 				repo, rev := repoRevs.Repo, rev
 
 				p.Go(func(ctx context.Context) error {
-					for _, arg := range op.HasFileContent {
+					holds := func(arg query.RepoHasFileContentArgs) (bool, error) {
 						hasMatches, err := checkHasMatches(ctx, arg, repo, rev)
 						if err != nil {
-							return err
+							return false, err
 						}
-
 						wantMatches := !arg.Negated
-						if wantMatches != hasMatches {
+						return wantMatches == hasMatches, nil
+					}
+
+					for _, expr := range exprs {
+						ok, err := expr.Eval(holds)
+						if err != nil {
+							return err
+						}
+						if !ok {
 							// One of the conditions has failed, so we can return early
 							return nil
 						}
@@ -986,7 +1137,7 @@ func (r *RepoRevSpecs) RevSpecs() []string {
 		case rev.RefGlob != "":
 		case rev.ExcludeRefGlob != "":
 		default:
-			res = append(res, rev.RevSpec)
+			res = append(res, rev.String())
 		}
 	}
 	return res
@@ -77,6 +77,18 @@ func TestRevisionValidation(t *testing.T) {
 			Name: "refs/heads/revBas",
 		}}, nil
 	})
+	mockGitserver.CommitsFunc.SetDefaultHook(func(_ context.Context, _ authz.SubRepoPermissionChecker, _ api.RepoName, opt gitserver.CommitsOptions) ([]*gitdomain.Commit, error) {
+		if opt.Before != "2023-01-01T00:00:00Z" {
+			return nil, nil
+		}
+		switch opt.Range {
+		case "HEAD":
+			return []*gitdomain.Commit{{ID: "c0ffee"}}, nil
+		case "revBar":
+			return []*gitdomain.Commit{{ID: "deadbeef"}}, nil
+		}
+		return nil, &gitdomain.RevisionNotFoundError{Repo: "repoFoo", Spec: opt.Range}
+	})
 
 	tests := []struct {
 		repoFilters  []string
@@ -135,6 +147,28 @@ func TestRevisionValidation(t *testing.T) {
 			}},
 			wantErr: nil,
 		},
+		{
+			repoFilters: []string{"repoFoo@{2023-01-01}:revBar@{2023-01-01}"},
+			wantRepoRevs: []*search.RepositoryRevisions{{
+				Repo: types.MinimalRepo{Name: "repoFoo"},
+				Revs: []string{"c0ffee", "deadbeef"},
+			}},
+		},
+		{
+			repoFilters: []string{"repoFoo@revBar:{2000-01-01}"},
+			wantRepoRevs: []*search.RepositoryRevisions{{
+				Repo: types.MinimalRepo{Name: "repoFoo"},
+				Revs: []string{"revBar"},
+			}},
+			wantErr: &MissingRepoRevsError{
+				Missing: []RepoRevSpecs{{
+					Repo: types.MinimalRepo{Name: "repoFoo"},
+					Revs: []query.RevisionSpecifier{{
+						Before: "2000-01-01",
+					}},
+				}},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -533,6 +567,7 @@ func TestRepoHasFileContent(t *testing.T) {
 	cases := []struct {
 		name          string
 		filters       []query.RepoHasFileContentArgs
+		exprs         []query.RepoHasFileContentExpr
 		matchingRepos map[uint32]*zoekt.MinimalRepoListEntry
 		expected      []*search.RepositoryRevisions
 	}{{
@@ -606,6 +641,41 @@ func TestRepoHasFileContent(t *testing.T) {
 		expected: []*search.RepositoryRevisions{
 			mkHead(repoC),
 		},
+	}, {
+		name: "unindexed paths or",
+		exprs: []query.RepoHasFileContentExpr{{Or: []query.RepoHasFileContentExpr{
+			{Args: &query.RepoHasFileContentArgs{Path: "pathC"}},
+			{Args: &query.RepoHasFileContentArgs{Path: "pathD"}},
+		}}},
+		matchingRepos: nil,
+		expected: []*search.RepositoryRevisions{
+			mkHead(repoC),
+			mkHead(repoD),
+		},
+	}, {
+		name: "unindexed paths or and not content",
+		exprs: []query.RepoHasFileContentExpr{{And: []query.RepoHasFileContentExpr{
+			{Or: []query.RepoHasFileContentExpr{
+				{Args: &query.RepoHasFileContentArgs{Path: "pathC"}},
+				{Args: &query.RepoHasFileContentArgs{Path: "pathD"}},
+			}},
+			{Args: &query.RepoHasFileContentArgs{Content: "lineD", Negated: true}},
+		}}},
+		matchingRepos: nil,
+		expected: []*search.RepositoryRevisions{
+			mkHead(repoC),
+		},
+	}, {
+		name:    "path and expression",
+		filters: []query.RepoHasFileContentArgs{{Path: "pathC"}},
+		exprs: []query.RepoHasFileContentExpr{{Or: []query.RepoHasFileContentExpr{
+			{Args: &query.RepoHasFileContentArgs{Path: "pathD"}},
+			{Args: &query.RepoHasFileContentArgs{Content: "line1"}},
+		}}},
+		matchingRepos: nil,
+		expected: []*search.RepositoryRevisions{
+			mkHead(repoC),
+		},
 	}}
 
 	for _, tc := range cases {
@@ -623,14 +693,15 @@ func TestRepoHasFileContent(t *testing.T) {
 				},
 			}, nil)
 
-			mockZoekt.ListFunc.PushReturn(&zoekt.RepoList{
+			mockZoekt.ListFunc.SetDefaultReturn(&zoekt.RepoList{
 				Minimal: tc.matchingRepos,
 			}, nil)
 
 			res := NewResolver(logtest.Scoped(t), db, mockGitserver, endpoint.Static("test"), mockZoekt)
 			resolved, err := res.Resolve(context.Background(), search.RepoOptions{
-				RepoFilters:    toParsedRepoFilters(".*"),
-				HasFileContent: tc.filters,
+				RepoFilters:         toParsedRepoFilters(".*"),
+				HasFileContent:      tc.filters,
+				HasFileContentExprs: tc.exprs,
 			})
 			require.NoError(t, err)
 
@@ -741,3 +812,17 @@ func TestRepoHasCommitAfter(t *testing.T) {
 		})
 	}
 }
+
+func TestRepoRevSpecs_RevSpecs(t *testing.T) {
+	r := RepoRevSpecs{
+		Repo: types.MinimalRepo{Name: "foo"},
+		Revs: []query.RevisionSpecifier{
+			{RevSpec: "main"},
+			{Before: "2023-01-01"},
+			{RevSpec: "dev", Before: "2023-01-01"},
+			{RefGlob: "refs/heads/*"},
+			{ExcludeRefGlob: "refs/heads/old*"},
+		},
+	}
+	require.Equal(t, []string{"main", "{2023-01-01}", "dev@{2023-01-01}"}, r.RevSpecs())
+}
@@ -0,0 +1,71 @@
+package result
+
+import (
+	"github.com/grafana/regexp"
+
+	"github.com/sourcegraph/sourcegraph/internal/search/filter"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// CaptureMatch is a value of a regular expression capture group in the
+// content matches of a repository, as selected by `select:content.capture`.
+//
+// Capture matches are streamed as values are found, so a value may be sent
+// several times for the same repository. The counts of capture matches with
+// the same key add up.
+type CaptureMatch struct {
+	Value string
+	Repo  types.MinimalRepo
+
+	// Count is the number of times Value was captured in the repository
+	// since the last capture match with the same key was sent.
+	Count int
+}
+
+func (cm *CaptureMatch) RepoName() types.MinimalRepo {
+	return cm.Repo
+}
+
+func (cm *CaptureMatch) ResultCount() int {
+	return 1
+}
+
+func (cm *CaptureMatch) Limit(limit int) int {
+	return limit - 1
+}
+
+func (cm *CaptureMatch) Select(path filter.SelectPath) Match {
+	if path.Root() == filter.Repository {
+		return &RepoMatch{Name: cm.Repo.Name, ID: cm.Repo.ID}
+	}
+	return nil
+}
+
+// AppendMatches adds the count of other, which has the same key, to cm.
+func (cm *CaptureMatch) AppendMatches(other *CaptureMatch) {
+	cm.Count += other.Count
+}
+
+func (cm *CaptureMatch) Key() Key {
+	return Key{
+		TypeRank: rankCaptureMatch,
+		Repo:     cm.Repo.Name,
+		Capture:  cm.Value,
+	}
+}
+
+func (cm *CaptureMatch) searchResultMarker() {}
+
+// CaptureGroupValues returns the values of the first capture group of each
+// match of pattern in content. A match whose first group did not participate
+// is skipped.
+func CaptureGroupValues(pattern *regexp.Regexp, content string) []string {
+	var values []string
+	for _, submatches := range pattern.FindAllStringSubmatchIndex(content, -1) {
+		if len(submatches) < 4 || submatches[2] == -1 || submatches[3] == -1 {
+			continue
+		}
+		values = append(values, content[submatches[2]:submatches[3]])
+	}
+	return values
+}
@@ -0,0 +1,15 @@
+package result
+
+import (
+	"testing"
+
+	"github.com/grafana/regexp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCaptureGroupValues(t *testing.T) {
+	// Matches of the second alternative don't capture the first group.
+	pattern := regexp.MustCompile(`(a+)|(b+)`)
+	require.Equal(t, []string{"a", "aa"}, CaptureGroupValues(pattern, "a b aa bb"))
+	require.Empty(t, CaptureGroupValues(pattern, "c"))
+}
@@ -23,6 +23,8 @@ func (d *Deduper) Add(m Match) {
 			prevMatch.AppendMatches(m.(*FileMatch))
 		case *CommitMatch:
 			prevMatch.AppendMatches(m.(*CommitMatch))
+		case *CaptureMatch:
+			prevMatch.AppendMatches(m.(*CaptureMatch))
 		}
 		return
 	}
@@ -39,3 +41,11 @@ func (d *Deduper) Seen(m Match) bool {
 func (d *Deduper) Results() Matches {
 	return d.results
 }
+
+// AddsUp reports whether m adds to the matches with the same key sent before
+// it rather than repeating them, such as the count of a capture match. A
+// stream which drops matches with a key it has seen must still send these on.
+func AddsUp(m Match) bool {
+	_, ok := m.(*CaptureMatch)
+	return ok
+}
@@ -54,6 +54,13 @@ func TestDeduper(t *testing.T) {
 		}
 	}
 
+	withDuplicates := func(fm *FileMatch, repos ...string) *FileMatch {
+		for _, repo := range repos {
+			fm.DuplicateRepos = append(fm.DuplicateRepos, types.MinimalRepo{Name: api.RepoName(repo)})
+		}
+		return fm
+	}
+
 	hm := func(s string) ChunkMatch {
 		return ChunkMatch{
 			Content: s,
@@ -90,6 +97,28 @@ func TestDeduper(t *testing.T) {
 				file("a", "b", "c", ChunkMatches{hm("a"), hm("b"), hm("c"), hm("d")}),
 			},
 		},
+		{
+			name: "merge duplicate repos",
+			input: []Match{
+				withDuplicates(file("a", "b", "c", ChunkMatches{hm("a")}), "d"),
+				withDuplicates(file("a", "b", "c", nil), "e", "f"),
+			},
+			expected: []Match{
+				withDuplicates(file("a", "b", "c", ChunkMatches{hm("a")}), "d", "e", "f"),
+			},
+		},
+		{
+			name: "merge capture counts",
+			input: []Match{
+				&CaptureMatch{Value: "1.2", Repo: types.MinimalRepo{Name: "a"}, Count: 2},
+				&CaptureMatch{Value: "1.3", Repo: types.MinimalRepo{Name: "a"}, Count: 1},
+				&CaptureMatch{Value: "1.2", Repo: types.MinimalRepo{Name: "a"}, Count: 3},
+			},
+			expected: []Match{
+				&CaptureMatch{Value: "1.2", Repo: types.MinimalRepo{Name: "a"}, Count: 5},
+				&CaptureMatch{Value: "1.3", Repo: types.MinimalRepo{Name: "a"}, Count: 1},
+			},
+		},
 		{
 			name: "diff and commit are not equal",
 			input: []Match{
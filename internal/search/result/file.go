@@ -47,6 +47,31 @@ type FileMatch struct {
 	Symbols      []*SymbolMatch `json:"-"`
 	PathMatches  []Range
 
+	// EnclosingSymbols are the symbols that enclose the ChunkMatches of
+	// this file match, such as the function or class containing a
+	// structural match. It is only populated for content results when a
+	// query selects symbols, and is surfaced as Symbols by Select.
+	EnclosingSymbols []*SymbolMatch `json:"-"`
+
+	// Versions are the ranges of searched revisions containing the same
+	// contents of this file, in version order, when identical results across
+	// revisions are grouped into one (`versions:yes`). It is empty otherwise.
+	Versions []VersionRange `json:"-"`
+
+	// ContentChecksum identifies the contents of the file. It is the
+	// checksum Zoekt indexes for each file, which searcher computes the same
+	// way for unindexed files. It is empty if the contents were not read.
+	ContentChecksum []byte `json:"-"`
+
+	// DuplicateRepos are the other repositories containing this file at the
+	// same path with identical content, such as forks and mirrors, when
+	// matches are deduplicated across repositories (`dedupe:content`).
+	//
+	// Copies are reported as they are found, so a file match may be sent
+	// again with only the duplicate repos found since. The duplicate repos
+	// of file matches with the same key add up.
+	DuplicateRepos []types.MinimalRepo `json:"-"`
+
 	LimitHit bool
 
 	// Debug is optionally set with a debug message explaining the result.
@@ -87,11 +112,16 @@ func (fm *FileMatch) Select(selectPath filter.SelectPath) Match {
 	case filter.File:
 		fm.ChunkMatches = nil
 		fm.Symbols = nil
+		fm.EnclosingSymbols = nil
 		if len(selectPath) > 1 && selectPath[1] == "directory" {
 			fm.Path = path.Clean(path.Dir(fm.Path)) + "/" // Add trailing slash for clarity.
 		}
 		return fm
 	case filter.Symbol:
+		if len(fm.Symbols) == 0 && len(fm.EnclosingSymbols) > 0 {
+			fm.Symbols = fm.EnclosingSymbols
+		}
+		fm.EnclosingSymbols = nil
 		if len(fm.Symbols) > 0 {
 			fm.ChunkMatches = nil // Only return symbol match if symbols exist
 			if len(selectPath) > 1 {
@@ -108,6 +138,7 @@ func (fm *FileMatch) Select(selectPath filter.SelectPath) Match {
 		// Only return file match if line matches exist
 		if len(fm.ChunkMatches) > 0 {
 			fm.Symbols = nil
+			fm.EnclosingSymbols = nil
 			fm.PathMatches = nil
 			return fm
 		}
@@ -124,6 +155,8 @@ func (fm *FileMatch) AppendMatches(src *FileMatch) {
 	// TODO merge hunk matches smartly
 	fm.ChunkMatches = append(fm.ChunkMatches, src.ChunkMatches...)
 	fm.Symbols = append(fm.Symbols, src.Symbols...)
+	fm.EnclosingSymbols = append(fm.EnclosingSymbols, src.EnclosingSymbols...)
+	fm.DuplicateRepos = append(fm.DuplicateRepos, src.DuplicateRepos...)
 	fm.LimitHit = fm.LimitHit || src.LimitHit
 }
 
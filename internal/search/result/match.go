@@ -35,17 +35,19 @@ var (
 	_ Match = (*CommitMatch)(nil)
 	_ Match = (*CommitDiffMatch)(nil)
 	_ Match = (*OwnerMatch)(nil)
+	_ Match = (*CaptureMatch)(nil)
 )
 
 // Match ranks are used for sorting the different match types.
 // Match types with lower ranks will be sorted before match types
 // with higher ranks.
 const (
-	rankFileMatch   = 0
-	rankCommitMatch = 1
-	rankDiffMatch   = 2
-	rankRepoMatch   = 3
-	rankOwnerMatch  = 4
+	rankFileMatch    = 0
+	rankCommitMatch  = 1
+	rankDiffMatch    = 2
+	rankRepoMatch    = 3
+	rankOwnerMatch   = 4
+	rankCaptureMatch = 5
 )
 
 // Key is a sorting or deduplicating key for a Match. It contains all the
@@ -80,6 +82,10 @@ type Key struct {
 	// Empty if this is not a Key for an OwnerMatch.
 	OwnerMetadata string
 
+	// Capture is the captured value of a CaptureMatch.
+	// Empty if this is not a Key for a CaptureMatch.
+	Capture string
+
 	// TypeRank is the sorting rank of the type this key belongs to.
 	TypeRank int
 }
@@ -110,6 +116,10 @@ func (k Key) Less(other Key) bool {
 		return k.OwnerMetadata < other.OwnerMetadata
 	}
 
+	if k.Capture != other.Capture {
+		return k.Capture < other.Capture
+	}
+
 	return k.TypeRank < other.TypeRank
 }
 
@@ -38,6 +38,33 @@ func TestSelect(t *testing.T) {
 			autogold.Expect("var c:variable").Equal(t, test("symbol.variable"))
 		})
 
+		t.Run("enclosing symbols", func(t *testing.T) {
+			test := func(input string) string {
+				data := &FileMatch{
+					ChunkMatches: []ChunkMatch{{}},
+					EnclosingSymbols: []*SymbolMatch{
+						{Symbol: Symbol{Name: "Handler", Kind: "class"}},
+						{Symbol: Symbol{Name: "Handler.serve()", Kind: "method"}},
+					},
+				}
+				selected := data.Select(filter.SelectPath(strings.Split(input, ".")))
+				if selected == nil {
+					return "<nil>"
+				}
+				fm := selected.(*FileMatch)
+				require.Empty(t, fm.ChunkMatches)
+				var values []string
+				for _, s := range fm.Symbols {
+					values = append(values, s.Symbol.Name+":"+s.Symbol.Kind)
+				}
+				return strings.Join(values, ", ")
+			}
+
+			autogold.Expect("Handler:class, Handler.serve():method").Equal(t, test("symbol"))
+			autogold.Expect("Handler.serve():method").Equal(t, test("symbol.method"))
+			autogold.Expect("<nil>").Equal(t, test("symbol.function"))
+		})
+
 		t.Run("path match", func(t *testing.T) {
 			fm := &FileMatch{
 				PathMatches:  []Range{{}},
@@ -3,6 +3,7 @@ package result
 import (
 	"fmt"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -237,6 +238,28 @@ func pick(symbols []*SymbolMatch, satisfy func(*SymbolMatch) bool) []*SymbolMatc
 
 func SelectSymbolKind(symbols []*SymbolMatch, field string) []*SymbolMatch {
 	return pick(symbols, func(s *SymbolMatch) bool {
-		return field == toSelectKind[strings.ToLower(s.Symbol.Kind)]
+		return field == s.Symbol.SelectKind()
 	})
 }
+
+// SelectKind returns the kind of the symbol as it is named in
+// `select:symbol.<kind>`, or the empty string if the kind is not recognized.
+func (s Symbol) SelectKind() string {
+	return toSelectKind[strings.ToLower(s.Kind)]
+}
+
+// KindsOfSelectKinds returns the lowercased internal symbol kinds (cf.
+// ctagsKind) of the symbols whose SelectKind is one of selectKinds, sorted.
+func KindsOfSelectKinds(selectKinds []string) []string {
+	var kinds []string
+	for kind, selectKind := range toSelectKind {
+		for _, k := range selectKinds {
+			if k == selectKind {
+				kinds = append(kinds, kind)
+				break
+			}
+		}
+	}
+	sort.Strings(kinds)
+	return kinds
+}
@@ -53,3 +53,12 @@ func TestSymbolURL(t *testing.T) {
 		})
 	}
 }
+
+func TestKindsOfSelectKinds(t *testing.T) {
+	require.Equal(t, []string{"command", "func", "function", "macro", "procedure", "singletonmethod", "subprogram", "subroutine"}, KindsOfSelectKinds([]string{"function"}))
+	for _, kind := range KindsOfSelectKinds([]string{"function", "class"}) {
+		selectKind := Symbol{Kind: kind}.SelectKind()
+		require.Contains(t, []string{"function", "class"}, selectKind, kind)
+	}
+	require.Empty(t, KindsOfSelectKinds([]string{"unknown"}))
+}
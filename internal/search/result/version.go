@@ -0,0 +1,66 @@
+package result
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver"
+)
+
+// VersionRange is a contiguous range of the searched revisions of a
+// repository, in version order, that contain the same file contents. First
+// and Last are equal if the range is a single revision.
+type VersionRange struct {
+	First string
+	Last  string
+}
+
+// VersionRanges returns the contiguous ranges of revs, which must be in
+// version order (see SortVersions), for which contains returns true.
+func VersionRanges(revs []string, contains func(rev string) bool) []VersionRange {
+	var ranges []VersionRange
+	inRange := false
+	for _, rev := range revs {
+		if !contains(rev) {
+			inRange = false
+			continue
+		}
+		if inRange {
+			ranges[len(ranges)-1].Last = rev
+			continue
+		}
+		ranges = append(ranges, VersionRange{First: rev, Last: rev})
+		inRange = true
+	}
+	return ranges
+}
+
+// SortVersions sorts revisions in ascending version order. Revisions that
+// are semantic versions, such as the tag refs/tags/v1.2.0, are ordered by
+// version and before any other revisions, which are ordered by name.
+func SortVersions(revs []string) {
+	parsed := make(map[string]*semver.Version, len(revs))
+	for _, rev := range revs {
+		name := strings.TrimPrefix(strings.TrimPrefix(rev, "refs/tags/"), "refs/heads/")
+		if v, err := semver.NewVersion(name); err == nil {
+			parsed[rev] = v
+		}
+	}
+
+	sort.SliceStable(revs, func(i, k int) bool {
+		vi, vk := parsed[revs[i]], parsed[revs[k]]
+		switch {
+		case vi != nil && vk != nil:
+			if vi.Equal(vk) {
+				return revs[i] < revs[k]
+			}
+			return vi.LessThan(vk)
+		case vi != nil:
+			return true
+		case vk != nil:
+			return false
+		default:
+			return revs[i] < revs[k]
+		}
+	})
+}
@@ -0,0 +1,33 @@
+package result
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortVersions(t *testing.T) {
+	revs := []string{"main", "refs/tags/v1.10.0", "refs/tags/v1.2.0", "refs/tags/v1.2.0-rc1", "develop", "v0.9"}
+	SortVersions(revs)
+	require.Equal(t, []string{"v0.9", "refs/tags/v1.2.0-rc1", "refs/tags/v1.2.0", "refs/tags/v1.10.0", "develop", "main"}, revs)
+}
+
+func TestVersionRanges(t *testing.T) {
+	revs := []string{"v1.0.0", "v1.1.0", "v1.2.0", "v1.3.0", "v1.4.0", "v1.5.0"}
+	contains := func(revs ...string) func(string) bool {
+		set := make(map[string]bool, len(revs))
+		for _, rev := range revs {
+			set[rev] = true
+		}
+		return func(rev string) bool { return set[rev] }
+	}
+
+	require.Equal(t, []VersionRange{
+		{First: "v1.0.0", Last: "v1.2.0"},
+		{First: "v1.4.0", Last: "v1.4.0"},
+	}, VersionRanges(revs, contains("v1.0.0", "v1.1.0", "v1.2.0", "v1.4.0")))
+	require.Equal(t, []VersionRange{
+		{First: "v1.0.0", Last: "v1.5.0"},
+	}, VersionRanges(revs, contains(revs...)))
+	require.Empty(t, VersionRanges(revs, contains()))
+}
@@ -491,7 +491,7 @@ func ParseRepoOpts(contextQuery string) ([]RepoOpts, error) {
 		for _, r := range repoFilters {
 			for _, rev := range r.Revs {
 				if !rev.HasRefGlob() {
-					rq.RevSpecs = append(rq.RevSpecs, rev.RevSpec)
+					rq.RevSpecs = append(rq.RevSpecs, rev.String())
 				}
 			}
 			rq.IncludePatterns = append(rq.IncludePatterns, r.Repo)
@@ -706,6 +706,19 @@ func TestParseRepoOpts(t *testing.T) {
 				},
 			},
 		},
+		{
+			in: "r:foo@{2023-01-01}:main@{2023-01-01}",
+			out: []RepoOpts{
+				{
+					ReposListOptions: database.ReposListOptions{
+						IncludePatterns: []string{"foo"},
+						NoForks:         true,
+						NoArchived:      true,
+					},
+					RevSpecs: []string{"{2023-01-01}", "main@{2023-01-01}"},
+				},
+			},
+		},
 	} {
 		t.Run(tc.in, func(t *testing.T) {
 			have, err := ParseRepoOpts(tc.in)
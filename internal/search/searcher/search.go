@@ -50,6 +50,11 @@ type TextSearchJob struct {
 	// to true if the user requests a specific timeout or maximum result size.
 	UseFullDeadline bool
 
+	// GroupByVersion searches each distinct file of a repository once across
+	// the searched revisions, and sends its matches with the ranges of
+	// revisions containing it (`versions:yes`).
+	GroupByVersion bool
+
 	Features search.Features
 }
 
@@ -92,44 +97,89 @@ func (s *TextSearchJob) Run(ctx context.Context, clients job.RuntimeClients, str
 	textSearchLimiter.SetLimit(len(eps) * 32)
 
 	g, ctx := errgroup.WithContext(ctx)
+
+	// searchRevs searches each of revs in repo concurrently, skipping the
+	// revisions and files versions says are searched in other revisions.
+	searchRevs := func(repo types.MinimalRepo, revs []string, versions *repoVersions) error {
+		for _, rev := range revs {
+			rev := rev // capture rev
+			info, revStream := s.PatternInfo, stream
+			if versions != nil {
+				var ok bool
+				if info, ok = versions.patternInfo(rev, info); !ok {
+					continue
+				}
+				revStream = versions.stream(rev, stream)
+			}
+
+			limitCtx, limitDone, err := textSearchLimiter.Acquire(ctx)
+			if err != nil {
+				return err
+			}
+
+			g.Go(func() error {
+				ctx, done := limitCtx, limitDone
+				defer done()
+
+				repoLimitHit, err := s.searchFilesInRepo(ctx, clients.SearcherURLs, clients.SearcherGRPCConnectionCache, repo, repo.Name, rev, s.Indexed, info, fetchTimeout, revStream)
+				if err != nil {
+					tr.SetAttributes(
+						repo.Name.Attr(),
+						trace.Error(err),
+						attribute.Bool("timeout", errcode.IsTimeout(err)),
+						attribute.Bool("temporary", errcode.IsTemporary(err)))
+					clients.Logger.Warn("searchFilesInRepo failed", log.Error(err), log.String("repo", string(repo.Name)))
+				}
+				// non-diff search reports timeout through err, so pass false for timedOut
+				status, limitHit, err := search.HandleRepoSearchResult(repo.ID, []string{rev}, repoLimitHit, false, err)
+				stream.Send(streaming.SearchEvent{
+					Stats: streaming.Stats{
+						Status:     status,
+						IsLimitHit: limitHit,
+					},
+				})
+				return err
+			})
+		}
+		return nil
+	}
+
 	g.Go(func() error {
 		for _, repoAllRevs := range s.Repos {
-			repo := repoAllRevs.Repo // capture repo
-			if len(repoAllRevs.Revs) == 0 {
+			repo, revs := repoAllRevs.Repo, repoAllRevs.Revs // capture repo and revs
+			if len(revs) == 0 {
 				continue
 			}
 
-			for _, rev := range repoAllRevs.Revs {
-				rev := rev // capture rev
-				limitCtx, limitDone, err := textSearchLimiter.Acquire(ctx)
-				if err != nil {
+			if !s.GroupByVersion {
+				if err := searchRevs(repo, revs, nil); err != nil {
 					return err
 				}
+				continue
+			}
 
-				g.Go(func() error {
-					ctx, done := limitCtx, limitDone
-					defer done()
-
-					repoLimitHit, err := s.searchFilesInRepo(ctx, clients.SearcherURLs, clients.SearcherGRPCConnectionCache, repo, repo.Name, rev, s.Indexed, s.PatternInfo, fetchTimeout, stream)
-					if err != nil {
-						tr.SetAttributes(
-							repo.Name.Attr(),
-							trace.Error(err),
-							attribute.Bool("timeout", errcode.IsTimeout(err)),
-							attribute.Bool("temporary", errcode.IsTemporary(err)))
-						clients.Logger.Warn("searchFilesInRepo failed", log.Error(err), log.String("repo", string(repo.Name)))
-					}
-					// non-diff search reports timeout through err, so pass false for timedOut
-					status, limitHit, err := search.HandleRepoSearchResult(repo.ID, []string{rev}, repoLimitHit, false, err)
-					stream.Send(streaming.SearchEvent{
-						Stats: streaming.Stats{
-							Status:     status,
-							IsLimitHit: limitHit,
-						},
-					})
-					return err
-				})
+			// Resolving the versions of a repository lists the files of all
+			// its revisions, so it runs concurrently with the searches of the
+			// other repositories, under the same limit. The slot is released
+			// before the revisions are searched, which need slots of their
+			// own.
+			limitCtx, limitDone, err := textSearchLimiter.Acquire(ctx)
+			if err != nil {
+				return err
 			}
+
+			g.Go(func() error {
+				versions, err := resolveRepoVersions(limitCtx, clients.Gitserver, repo.Name, revs)
+				limitDone()
+				if err != nil {
+					if ctx.Err() != nil {
+						return ctx.Err()
+					}
+					// Search every revision without grouping instead.
+					clients.Logger.Warn("resolving revisions to group by version failed", log.Error(err), log.String("repo", string(repo.Name)))
+				}
+				return searchRevs(repo, revs, versions)
+			})
 		}
 
 		return nil
@@ -149,6 +199,7 @@ func (s *TextSearchJob) Attributes(v job.Verbosity) (res []attribute.KeyValue) {
 			attribute.Bool("useFullDeadline", s.UseFullDeadline),
 			attribute.Stringer("patternInfo", s.PatternInfo),
 			attribute.Int("numRepos", len(s.Repos)),
+			attribute.Bool("groupByVersion", s.GroupByVersion),
 			trace.Stringers("pathRegexps", s.PathRegexps),
 		)
 		fallthrough
@@ -284,9 +335,10 @@ func convertProtoMatch(repo types.MinimalRepo, commit api.CommitID, rev *string,
 			CommitID: commit,
 			InputRev: rev,
 		},
-		ChunkMatches: chunkMatches,
-		PathMatches:  pathMatches,
-		LimitHit:     fm.LimitHit,
+		ChunkMatches:    chunkMatches,
+		PathMatches:     pathMatches,
+		LimitHit:        fm.LimitHit,
+		ContentChecksum: fm.ContentChecksum,
 	}
 }
 
@@ -350,9 +402,10 @@ func convertMatches(repo types.MinimalRepo, commit api.CommitID, rev *string, se
 				CommitID: commit,
 				InputRev: rev,
 			},
-			ChunkMatches: chunkMatches,
-			PathMatches:  pathMatches,
-			LimitHit:     fm.LimitHit,
+			ChunkMatches:    chunkMatches,
+			PathMatches:     pathMatches,
+			LimitHit:        fm.LimitHit,
+			ContentChecksum: fm.ContentChecksum,
 		})
 	}
 	return matches
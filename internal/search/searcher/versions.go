@@ -0,0 +1,186 @@
+package searcher
+
+import (
+	"context"
+	"strings"
+
+	"github.com/grafana/regexp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+)
+
+// maxVersionSearchPaths is the most paths the search of a revision is
+// restricted to when grouping by version. A revision with more new files is
+// searched in full, and matches in files searched in an earlier revision are
+// dropped.
+const maxVersionSearchPaths = 1000
+
+// repoVersions plans the search of the revisions of a repository when
+// identical files across revisions are grouped (`versions:yes`). The blobs of
+// every revision are resolved up front, so each distinct file is only
+// searched in the first revision, in version order, that contains it, and its
+// matches are sent right away with all the revisions containing it.
+type repoVersions struct {
+	// revs are the searched revisions in version order.
+	revs []string
+
+	// ranges are, for each path, the contiguous ranges of revs in which the
+	// path has the same blob.
+	ranges map[string][]blobRange
+
+	// newPaths are, for each revision, the paths whose blob is first found in
+	// that revision.
+	newPaths map[string][]string
+}
+
+// blobRange is a contiguous range of revisions, as indexes into
+// repoVersions.revs, in which a path has the same blob.
+type blobRange struct {
+	oid         gitdomain.OID
+	first, last int
+}
+
+// resolveRepoVersions lists the files of each revision of a repository to
+// plan which of them to search.
+func resolveRepoVersions(ctx context.Context, gs gitserver.Client, repo api.RepoName, revs []string) (*repoVersions, error) {
+	rv := &repoVersions{
+		revs:     append([]string(nil), revs...),
+		ranges:   make(map[string][]blobRange),
+		newPaths: make(map[string][]string, len(revs)),
+	}
+	result.SortVersions(rv.revs)
+
+	type pathBlob struct {
+		path string
+		oid  gitdomain.OID
+	}
+	seen := make(map[pathBlob]struct{})
+
+	for i, rev := range rv.revs {
+		commit, err := gs.ResolveRevision(ctx, repo, rev, gitserver.ResolveRevisionOptions{NoEnsureRevision: true})
+		if err != nil {
+			return nil, err
+		}
+		files, err := gs.ReadDir(ctx, authz.DefaultSubRepoPermsChecker, repo, commit, "", true)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, fi := range files {
+			oi, ok := fi.Sys().(gitdomain.ObjectInfo)
+			if !ok || fi.IsDir() {
+				continue
+			}
+			path, oid := fi.Name(), oi.OID()
+			rv.addBlob(i, path, oid)
+
+			if _, ok := seen[pathBlob{path, oid}]; !ok {
+				seen[pathBlob{path, oid}] = struct{}{}
+				rv.newPaths[rev] = append(rv.newPaths[rev], path)
+			}
+		}
+	}
+
+	return rv, nil
+}
+
+func (rv *repoVersions) addBlob(i int, path string, oid gitdomain.OID) {
+	ranges := rv.ranges[path]
+	if n := len(ranges); n > 0 && ranges[n-1].oid == oid && ranges[n-1].last == i-1 {
+		ranges[n-1].last = i
+		return
+	}
+	rv.ranges[path] = append(ranges, blobRange{oid: oid, first: i, last: i})
+}
+
+// patternInfo returns the pattern to search rev with, restricted to the files
+// first found in rev. ok is false if rev has no such files and does not need
+// to be searched.
+func (rv *repoVersions) patternInfo(rev string, info *search.TextPatternInfo) (_ *search.TextPatternInfo, ok bool) {
+	paths := rv.newPaths[rev]
+	if len(paths) == 0 {
+		return nil, false
+	}
+	if len(paths) > maxVersionSearchPaths {
+		return info, true
+	}
+
+	quoted := make([]string, 0, len(paths))
+	for _, path := range paths {
+		quoted = append(quoted, regexp.QuoteMeta(path))
+	}
+
+	cp := *info
+	cp.IncludePatterns = append(append([]string(nil), info.IncludePatterns...), "^(?:"+strings.Join(quoted, "|")+")$")
+	return &cp, true
+}
+
+// stream returns a stream that annotates the file matches found in rev with
+// the ranges of revisions containing the same blob, dropping matches in blobs
+// that are searched in an earlier revision.
+func (rv *repoVersions) stream(rev string, s streaming.Sender) streaming.Sender {
+	i := -1
+	for k, r := range rv.revs {
+		if r == rev {
+			i = k
+			break
+		}
+	}
+
+	return streaming.StreamFunc(func(event streaming.SearchEvent) {
+		matches := event.Results[:0]
+		for _, m := range event.Results {
+			fm, ok := m.(*result.FileMatch)
+			if !ok {
+				matches = append(matches, m)
+				continue
+			}
+			versions, ok := rv.versions(i, fm.Path)
+			if !ok {
+				continue
+			}
+			fm.Versions = versions
+			matches = append(matches, fm)
+		}
+		event.Results = matches
+		s.Send(event)
+	})
+}
+
+// versions returns the ranges of revisions in which path has the same blob as
+// in revision i. ok is false if the blob is first found in an earlier
+// revision.
+func (rv *repoVersions) versions(i int, path string) (_ []result.VersionRange, ok bool) {
+	ranges := rv.ranges[path]
+
+	var oid gitdomain.OID
+	found := false
+	for _, r := range ranges {
+		if r.first <= i && i <= r.last {
+			oid, found = r.oid, true
+			break
+		}
+	}
+	if !found {
+		// Not a file we listed, so we cannot tell which revisions contain it.
+		return nil, true
+	}
+
+	var versions []result.VersionRange
+	for _, r := range ranges {
+		if r.oid != oid {
+			continue
+		}
+		if len(versions) == 0 && r.first != i {
+			return nil, false
+		}
+		versions = append(versions, result.VersionRange{First: rv.revs[r.first], Last: rv.revs[r.last]})
+	}
+	return versions, true
+}
@@ -0,0 +1,163 @@
+package searcher
+
+import (
+	"context"
+	"io/fs"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/endpoint"
+	"github.com/sourcegraph/sourcegraph/internal/fileutil"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+type testObjectInfo gitdomain.OID
+
+func (oid testObjectInfo) OID() gitdomain.OID { return gitdomain.OID(oid) }
+
+func TestRepoVersions(t *testing.T) {
+	// vuln.go has the same content in v1.2.0, v1.10.0 and v1.11.0, but was
+	// changed in v1.9.0. other.go is the same in all revisions.
+	trees := map[api.CommitID]map[string]gitdomain.OID{
+		"commit-v1.2.0":  {"vuln.go": {1}, "other.go": {3}},
+		"commit-v1.9.0":  {"vuln.go": {2}, "other.go": {3}},
+		"commit-v1.10.0": {"vuln.go": {1}, "other.go": {3}},
+		"commit-v1.11.0": {"vuln.go": {1}, "other.go": {3}},
+	}
+	gs := gitserver.NewMockClient()
+	gs.ResolveRevisionFunc.SetDefaultHook(func(_ context.Context, _ api.RepoName, rev string, _ gitserver.ResolveRevisionOptions) (api.CommitID, error) {
+		return api.CommitID("commit-" + rev), nil
+	})
+	gs.ReadDirFunc.SetDefaultHook(func(_ context.Context, _ authz.SubRepoPermissionChecker, _ api.RepoName, commit api.CommitID, _ string, _ bool) ([]fs.FileInfo, error) {
+		var files []fs.FileInfo
+		for path, oid := range trees[commit] {
+			files = append(files, &fileutil.FileInfo{Name_: path, Sys_: testObjectInfo(oid)})
+		}
+		return files, nil
+	})
+
+	rv, err := resolveRepoVersions(context.Background(), gs, "repo", []string{"v1.10.0", "v1.2.0", "v1.9.0", "v1.11.0"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"v1.2.0", "v1.9.0", "v1.10.0", "v1.11.0"}, rv.revs)
+
+	t.Run("patternInfo", func(t *testing.T) {
+		info := &search.TextPatternInfo{Pattern: "foo", IncludePatterns: []string{`\.go$`}}
+
+		// Only the files changed in v1.9.0 are searched.
+		got, ok := rv.patternInfo("v1.9.0", info)
+		require.True(t, ok)
+		require.Equal(t, []string{`\.go$`, `^(?:vuln\.go)$`}, got.IncludePatterns)
+		require.Equal(t, []string{`\.go$`}, info.IncludePatterns)
+
+		// Nothing is new in v1.10.0 and v1.11.0.
+		_, ok = rv.patternInfo("v1.10.0", info)
+		require.False(t, ok)
+		_, ok = rv.patternInfo("v1.11.0", info)
+		require.False(t, ok)
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		repo := types.MinimalRepo{ID: 1, Name: "repo"}
+		fileMatch := func(path string) *result.FileMatch {
+			return &result.FileMatch{File: result.File{Repo: repo, Path: path}}
+		}
+
+		send := func(rev string, matches ...result.Match) map[string][]result.VersionRange {
+			got := make(map[string][]result.VersionRange)
+			s := rv.stream(rev, streaming.StreamFunc(func(event streaming.SearchEvent) {
+				for _, m := range event.Results {
+					if fm, ok := m.(*result.FileMatch); ok {
+						got[fm.Path] = fm.Versions
+					}
+				}
+			}))
+			s.Send(streaming.SearchEvent{Results: matches})
+			return got
+		}
+
+		require.Equal(t, map[string][]result.VersionRange{
+			"vuln.go": {
+				{First: "v1.2.0", Last: "v1.2.0"},
+				{First: "v1.10.0", Last: "v1.11.0"},
+			},
+			"other.go": {
+				{First: "v1.2.0", Last: "v1.11.0"},
+			},
+		}, send("v1.2.0", fileMatch("vuln.go"), fileMatch("other.go")))
+
+		// other.go was already searched in v1.2.0.
+		require.Equal(t, map[string][]result.VersionRange{
+			"vuln.go": {
+				{First: "v1.9.0", Last: "v1.9.0"},
+			},
+		}, send("v1.9.0", fileMatch("vuln.go"), fileMatch("other.go")))
+	})
+}
+
+func TestTextSearchJobGroupByVersion(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// The versions of slow are only resolved once fast has been searched, so
+	// the search only finishes if fast doesn't wait for slow.
+	fastSearched := make(chan struct{})
+	gs := gitserver.NewMockClient()
+	gs.ResolveRevisionFunc.SetDefaultHook(func(ctx context.Context, repo api.RepoName, rev string, _ gitserver.ResolveRevisionOptions) (api.CommitID, error) {
+		if repo == "slow" {
+			select {
+			case <-fastSearched:
+			case <-ctx.Done():
+				return "", ctx.Err()
+			}
+		}
+		return api.CommitID("commit-" + rev), nil
+	})
+	gs.ReadDirFunc.SetDefaultHook(func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, api.CommitID, string, bool) ([]fs.FileInfo, error) {
+		return []fs.FileInfo{&fileutil.FileInfo{Name_: "main.go", Sys_: testObjectInfo(gitdomain.OID{1})}}, nil
+	})
+
+	var mu sync.Mutex
+	var searched []string
+	MockSearchFilesInRepo = func(_ context.Context, repo types.MinimalRepo, _ api.RepoName, rev string, _ *search.TextPatternInfo, _ time.Duration, _ streaming.Sender) (bool, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		searched = append(searched, string(repo.Name)+"@"+rev)
+		if repo.Name == "fast" {
+			close(fastSearched)
+		}
+		return false, nil
+	}
+	t.Cleanup(func() { MockSearchFilesInRepo = nil })
+
+	j := &TextSearchJob{
+		PatternInfo: &search.TextPatternInfo{Pattern: "foo"},
+		Repos: []*search.RepositoryRevisions{
+			{Repo: types.MinimalRepo{ID: 1, Name: "slow"}, Revs: []string{"v1.0.0", "v2.0.0"}},
+			{Repo: types.MinimalRepo{ID: 2, Name: "fast"}, Revs: []string{"v1.0.0"}},
+		},
+		GroupByVersion: true,
+	}
+	clients := job.RuntimeClients{
+		Logger:       logtest.Scoped(t),
+		SearcherURLs: endpoint.Static("searcher"),
+		Gitserver:    gs,
+	}
+	_, err := j.Run(ctx, clients, streaming.NewAggregatingStream())
+	require.NoError(t, err)
+
+	// main.go is the same in both versions of slow, so only the first one is
+	// searched.
+	require.Equal(t, []string{"fast@v1.0.0", "slow@v1.0.0"}, searched)
+}
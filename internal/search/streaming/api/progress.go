@@ -41,6 +41,7 @@ type ProgressStats struct {
 	BackendsMissing     int
 	ExcludedArchived    int
 	ExcludedForks       int
+	DuplicateMatches    int
 
 	Timedout []api.RepoID
 	Missing  []api.RepoID
@@ -212,6 +213,21 @@ func excludedArchiveHandler(resultsResolver ProgressStats) (Skipped, bool) {
 	}, true
 }
 
+func duplicateContentHandler(resultsResolver ProgressStats) (Skipped, bool) {
+	duplicates := resultsResolver.DuplicateMatches
+	if duplicates == 0 {
+		return Skipped{}, false
+	}
+
+	amount := number(duplicates)
+	return Skipped{
+		Reason:   DuplicateContent,
+		Title:    fmt.Sprintf("%s %s", amount, plural("duplicate", "duplicates", duplicates)),
+		Message:  "We collapse files with the same contents at the same path as a file already shown, such as copies in forks and mirrors. Show them by removing `dedupe:content` from your query.",
+		Severity: SeverityInfo,
+	}, true
+}
+
 // TODO implement all skipped reasons
 var skippedHandlers = []func(stats ProgressStats) (Skipped, bool){
 	repositoryMissingHandler,
@@ -223,6 +239,7 @@ var skippedHandlers = []func(stats ProgressStats) (Skipped, bool){
 	backendsMissingHandler,
 	excludedForkHandler,
 	excludedArchiveHandler,
+	duplicateContentHandler,
 	displayLimitHandler,
 }
 
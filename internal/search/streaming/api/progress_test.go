@@ -49,6 +49,7 @@ func TestSearchProgress(t *testing.T) {
 			BackendsMissing:     1,
 			ExcludedArchived:    1,
 			ExcludedForks:       5,
+			DuplicateMatches:    2,
 			Timedout:            []api.RepoID{1},
 			Missing:             []api.RepoID{2, 3},
 			Cloning:             []api.RepoID{4},
@@ -85,6 +85,9 @@ const (
 	// ExcludedArchive is when we did not search a repository because it is
 	// archived.
 	ExcludedArchive SkippedReason = "excluded-archive"
+	// DuplicateContent is when we did not send file matches because a file
+	// with the same contents was already sent (`dedupe:content`).
+	DuplicateContent SkippedReason = "duplicate-content"
 )
 
 // SkippedSeverity is an enum for Skipped.Severity.
@@ -62,6 +62,7 @@ func (p *ProgressAggregator) currentStats() api.ProgressStats {
 		BackendsMissing:     p.Stats.BackendsMissing,
 		ExcludedArchived:    p.Stats.ExcludedArchived,
 		ExcludedForks:       p.Stats.ExcludedForks,
+		DuplicateMatches:    p.Stats.DuplicateMatches,
 		Timedout:            getRepos(p.Stats, searchshared.RepoStatusTimedout),
 		Missing:             getRepos(p.Stats, searchshared.RepoStatusMissing),
 		Cloning:             getRepos(p.Stats, searchshared.RepoStatusCloning),
@@ -26,7 +26,9 @@ type EventContentMatch struct {
 	RepoStars       int              `json:"repoStars,omitempty"`
 	RepoLastFetched *time.Time       `json:"repoLastFetched,omitempty"`
 	Branches        []string         `json:"branches,omitempty"`
+	Versions        []VersionRange   `json:"versions,omitempty"`
 	Commit          string           `json:"commit,omitempty"`
+	Duplicates      []string         `json:"duplicates,omitempty"`
 	Hunks           []DecoratedHunk  `json:"hunks"`
 	LineMatches     []EventLineMatch `json:"lineMatches,omitempty"`
 	ChunkMatches    []ChunkMatch     `json:"chunkMatches,omitempty"`
@@ -43,19 +45,28 @@ type EventPathMatch struct {
 	// Type is always PathMatchType. Included here for marshalling.
 	Type MatchType `json:"type"`
 
-	Path            string     `json:"path"`
-	PathMatches     []Range    `json:"pathMatches,omitempty"`
-	RepositoryID    int32      `json:"repositoryID"`
-	Repository      string     `json:"repository"`
-	RepoStars       int        `json:"repoStars,omitempty"`
-	RepoLastFetched *time.Time `json:"repoLastFetched,omitempty"`
-	Branches        []string   `json:"branches,omitempty"`
-	Commit          string     `json:"commit,omitempty"`
-	Debug           string     `json:"debug,omitempty"`
+	Path            string         `json:"path"`
+	PathMatches     []Range        `json:"pathMatches,omitempty"`
+	RepositoryID    int32          `json:"repositoryID"`
+	Repository      string         `json:"repository"`
+	RepoStars       int            `json:"repoStars,omitempty"`
+	RepoLastFetched *time.Time     `json:"repoLastFetched,omitempty"`
+	Branches        []string       `json:"branches,omitempty"`
+	Versions        []VersionRange `json:"versions,omitempty"`
+	Commit          string         `json:"commit,omitempty"`
+	Duplicates      []string       `json:"duplicates,omitempty"`
+	Debug           string         `json:"debug,omitempty"`
 }
 
 func (e *EventPathMatch) eventMatch() {}
 
+// VersionRange is a contiguous range of searched revisions, in version order,
+// containing the same contents of a file (`versions:yes`).
+type VersionRange struct {
+	First string `json:"first"`
+	Last  string `json:"last"`
+}
+
 type DecoratedHunk struct {
 	Content   DecoratedContent `json:"content"`
 	LineStart int              `json:"lineStart"`
@@ -118,13 +129,15 @@ type EventSymbolMatch struct {
 	// Type is always SymbolMatchType. Included here for marshalling.
 	Type MatchType `json:"type"`
 
-	Path            string     `json:"path"`
-	RepositoryID    int32      `json:"repositoryID"`
-	Repository      string     `json:"repository"`
-	RepoStars       int        `json:"repoStars,omitempty"`
-	RepoLastFetched *time.Time `json:"repoLastFetched,omitempty"`
-	Branches        []string   `json:"branches,omitempty"`
-	Commit          string     `json:"commit,omitempty"`
+	Path            string         `json:"path"`
+	RepositoryID    int32          `json:"repositoryID"`
+	Repository      string         `json:"repository"`
+	RepoStars       int            `json:"repoStars,omitempty"`
+	RepoLastFetched *time.Time     `json:"repoLastFetched,omitempty"`
+	Branches        []string       `json:"branches,omitempty"`
+	Versions        []VersionRange `json:"versions,omitempty"`
+	Commit          string         `json:"commit,omitempty"`
+	Duplicates      []string       `json:"duplicates,omitempty"`
 
 	Symbols []Symbol `json:"symbols"`
 }
@@ -200,6 +213,20 @@ type EventTeamMatch struct {
 
 func (e *EventTeamMatch) eventMatch() {}
 
+// EventCaptureMatch is a distinct value of a regular expression capture group
+// in a repository, as selected by `select:content.capture`.
+type EventCaptureMatch struct {
+	// Type is always CaptureMatchType. Included here for marshalling.
+	Type MatchType `json:"type"`
+
+	Value        string `json:"value"`
+	Count        int    `json:"count"`
+	Repository   string `json:"repository"`
+	RepositoryID int32  `json:"repositoryID"`
+}
+
+func (e *EventCaptureMatch) eventMatch() {}
+
 // EventFilter is a suggestion for a search filter. Currently has a 1-1
 // correspondance with the SearchFilter graphql type.
 type EventFilter struct {
@@ -247,6 +274,7 @@ const (
 	PathMatchType
 	PersonMatchType
 	TeamMatchType
+	CaptureMatchType
 )
 
 func (t MatchType) MarshalJSON() ([]byte, error) {
@@ -265,6 +293,8 @@ func (t MatchType) MarshalJSON() ([]byte, error) {
 		return []byte(`"person"`), nil
 	case TeamMatchType:
 		return []byte(`"team"`), nil
+	case CaptureMatchType:
+		return []byte(`"capture"`), nil
 	default:
 		return nil, errors.Errorf("unknown MatchType: %d", t)
 	}
@@ -285,6 +315,8 @@ func (t *MatchType) UnmarshalJSON(b []byte) error {
 		*t = PersonMatchType
 	} else if bytes.Equal(b, []byte(`"team"`)) {
 		*t = TeamMatchType
+	} else if bytes.Equal(b, []byte(`"capture"`)) {
+		*t = CaptureMatchType
 	} else {
 		return errors.Errorf("unknown MatchType: %s", b)
 	}
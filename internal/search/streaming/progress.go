@@ -33,6 +33,11 @@ type Stats struct {
 	// ExcludedArchived is the count of excluded archived repos because the
 	// search query doesn't apply to them, but that we want to know about.
 	ExcludedArchived int
+
+	// DuplicateMatches is the count of file matches that were not sent
+	// because a file with the same contents at the same path was already
+	// sent (`dedupe:content`).
+	DuplicateMatches int
 }
 
 // Update updates c with the other data, deduping as necessary. It modifies c but
@@ -58,6 +63,7 @@ func (c *Stats) Update(other *Stats) {
 	c.BackendsMissing += other.BackendsMissing
 	c.ExcludedForks += other.ExcludedForks
 	c.ExcludedArchived += other.ExcludedArchived
+	c.DuplicateMatches += other.DuplicateMatches
 }
 
 // Zero returns true if stats is empty. IE calling Update will result in no
@@ -72,7 +78,8 @@ func (c *Stats) Zero() bool {
 		c.Status.Len() > 0 ||
 		c.BackendsMissing > 0 ||
 		c.ExcludedForks > 0 ||
-		c.ExcludedArchived > 0)
+		c.ExcludedArchived > 0 ||
+		c.DuplicateMatches > 0)
 }
 
 func (c *Stats) String() string {
@@ -91,6 +98,7 @@ func (c *Stats) String() string {
 		{"backendsMissing", c.BackendsMissing},
 		{"excludedForks", c.ExcludedForks},
 		{"excludedArchived", c.ExcludedArchived},
+		{"duplicateMatches", c.DuplicateMatches},
 	}
 	for _, p := range nums {
 		if p.n != 0 {
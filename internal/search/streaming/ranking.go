@@ -0,0 +1,183 @@
+package streaming
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/types"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+// DocumentRanker returns the ranks of the documents in a repository, as
+// computed by the code intelligence ranking service.
+type DocumentRanker interface {
+	GetDocumentRanks(ctx context.Context, repoName api.RepoName) (types.RepoPathRanks, error)
+}
+
+// NewRankingStream returns a stream that reorders the file matches sent to it
+// by the rank of their document, higher ranks first. This gives results from
+// backends which do not rank, such as searcher, the same order as Zoekt.
+//
+// Up to maxQueueDepth file matches are held back to be reordered. Once the
+// queue is full, the highest ranked match is sent for every match added. A
+// negative maxQueueDepth means the queue is unbounded. Other results and
+// stats are passed through as they arrive. When there will be no more events
+// sent on the ranking stream, Done() must be called to flush the queue.
+//
+// The ranks of a repository are fetched once, in the background, when the
+// first file match of the repository is sent. Until they arrive, the file
+// matches of the repository wait outside the queue, so Send never blocks on
+// the ranking service.
+func NewRankingStream(ctx context.Context, ranker DocumentRanker, maxQueueDepth int, parent Sender) *rankingStream {
+	return &rankingStream{
+		ctx:           ctx,
+		ranker:        ranker,
+		maxQueueDepth: maxQueueDepth,
+		parent:        parent,
+		repos:         make(map[api.RepoID]*repoRanks),
+	}
+}
+
+type rankingStream struct {
+	ctx           context.Context
+	ranker        DocumentRanker
+	maxQueueDepth int
+	parent        Sender
+
+	// fetches tracks the rank lookups still in flight.
+	fetches sync.WaitGroup
+
+	mu    sync.Mutex
+	repos map[api.RepoID]*repoRanks
+	queue rankedMatches
+	seq   int
+}
+
+// repoRanks are the document ranks of a repository. Until fetched is set,
+// pending holds the file matches of the repository waiting for them.
+type repoRanks struct {
+	fetched bool
+	paths   map[string]float64
+	pending []rankedMatch
+}
+
+func (s *rankingStream) Send(event SearchEvent) {
+	results := event.Results[:0]
+
+	s.mu.Lock()
+	for _, match := range event.Results {
+		fm, ok := match.(*result.FileMatch)
+		if !ok {
+			results = append(results, match)
+			continue
+		}
+
+		rm := rankedMatch{match: fm, seq: s.seq}
+		s.seq++
+
+		ranks, ok := s.repos[fm.Repo.ID]
+		if !ok {
+			ranks = &repoRanks{}
+			s.repos[fm.Repo.ID] = ranks
+			s.fetches.Add(1)
+			go s.fetchRanks(fm.Repo.Name, ranks)
+		}
+		if !ranks.fetched {
+			ranks.pending = append(ranks.pending, rm)
+			continue
+		}
+
+		rm.rank = ranks.paths[fm.Path]
+		results = s.push(results, rm)
+	}
+	s.mu.Unlock()
+
+	event.Results = results
+	s.parent.Send(event)
+}
+
+// fetchRanks fetches the document ranks of a repository and queues the file
+// matches which were waiting for them. Documents which have not been ranked,
+// including all documents of a repository whose ranks cannot be fetched, have
+// rank 0.
+func (s *rankingStream) fetchRanks(repoName api.RepoName, ranks *repoRanks) {
+	defer s.fetches.Done()
+
+	// Ranks are best effort, so we don't fail the search if we can't fetch
+	// them.
+	repoPathRanks, _ := s.ranker.GetDocumentRanks(s.ctx, repoName)
+
+	var results result.Matches
+	s.mu.Lock()
+	ranks.fetched = true
+	ranks.paths = repoPathRanks.Paths
+	for _, rm := range ranks.pending {
+		rm.rank = ranks.paths[rm.match.Path]
+		results = s.push(results, rm)
+	}
+	ranks.pending = nil
+	s.mu.Unlock()
+
+	if len(results) > 0 {
+		s.parent.Send(SearchEvent{Results: results})
+	}
+}
+
+// push adds a file match to the queue, appending the highest ranked match to
+// results if the queue is full. s.mu must be held.
+func (s *rankingStream) push(results result.Matches, rm rankedMatch) result.Matches {
+	heap.Push(&s.queue, rm)
+	if s.maxQueueDepth >= 0 && s.queue.Len() > s.maxQueueDepth {
+		results = append(results, heap.Pop(&s.queue).(rankedMatch).match)
+	}
+	return results
+}
+
+// Done waits for the rank lookups in flight and sends all the queued file
+// matches in rank order.
+func (s *rankingStream) Done() {
+	s.fetches.Wait()
+
+	s.mu.Lock()
+	results := make(result.Matches, 0, s.queue.Len())
+	for s.queue.Len() > 0 {
+		results = append(results, heap.Pop(&s.queue).(rankedMatch).match)
+	}
+	s.mu.Unlock()
+
+	if len(results) > 0 {
+		s.parent.Send(SearchEvent{Results: results})
+	}
+}
+
+type rankedMatch struct {
+	match *result.FileMatch
+	rank  float64
+	seq   int // the order the match was received in, to break ties
+}
+
+// rankedMatches is a max-heap of file matches by rank.
+type rankedMatches []rankedMatch
+
+func (q rankedMatches) Len() int { return len(q) }
+
+func (q rankedMatches) Less(i, j int) bool {
+	if q[i].rank != q[j].rank {
+		return q[i].rank > q[j].rank
+	}
+	return q[i].seq < q[j].seq
+}
+
+func (q rankedMatches) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *rankedMatches) Push(x any) { *q = append(*q, x.(rankedMatch)) }
+
+func (q *rankedMatches) Pop() any {
+	old := *q
+	n := len(old)
+	x := old[n-1]
+	*q = old[:n-1]
+	return x
+}
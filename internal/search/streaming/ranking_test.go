@@ -0,0 +1,118 @@
+package streaming
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/types"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	internaltypes "github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type fakeDocumentRanker map[api.RepoName]map[string]float64
+
+func (r fakeDocumentRanker) GetDocumentRanks(_ context.Context, repoName api.RepoName) (types.RepoPathRanks, error) {
+	paths, ok := r[repoName]
+	if !ok {
+		return types.RepoPathRanks{}, errors.New("no ranks")
+	}
+	return types.RepoPathRanks{Paths: paths}, nil
+}
+
+func TestRankingStream(t *testing.T) {
+	repoA := internaltypes.MinimalRepo{ID: 1, Name: "a"}
+	repoB := internaltypes.MinimalRepo{ID: 2, Name: "b"}
+	ranker := fakeDocumentRanker{
+		"a": {"low.go": 1, "mid.go": 5, "high.go": 10},
+	}
+
+	fileMatch := func(repo internaltypes.MinimalRepo, path string) *result.FileMatch {
+		return &result.FileMatch{File: result.File{Repo: repo, Path: path}}
+	}
+
+	run := func(maxQueueDepth int) []string {
+		// Matches are sent from the rank lookups as well as from Send.
+		var mu sync.Mutex
+		var got []string
+		s := NewRankingStream(context.Background(), ranker, maxQueueDepth, StreamFunc(func(event SearchEvent) {
+			mu.Lock()
+			defer mu.Unlock()
+			for _, m := range event.Results {
+				switch v := m.(type) {
+				case *result.FileMatch:
+					got = append(got, string(v.Repo.Name)+"/"+v.Path)
+				case *result.RepoMatch:
+					got = append(got, string(v.Name))
+				}
+			}
+		}))
+
+		// Wait for the ranks after every event so that the order matches
+		// are queued in doesn't depend on the order of the lookups.
+		s.Send(SearchEvent{Results: result.Matches{
+			fileMatch(repoA, "low.go"),
+			&result.RepoMatch{Name: repoA.Name, ID: repoA.ID},
+		}})
+		s.fetches.Wait()
+		s.Send(SearchEvent{Results: result.Matches{
+			fileMatch(repoB, "unranked.go"),
+		}})
+		s.fetches.Wait()
+		s.Send(SearchEvent{Results: result.Matches{
+			fileMatch(repoA, "high.go"),
+			fileMatch(repoA, "mid.go"),
+		}})
+		s.Done()
+		return got
+	}
+
+	t.Run("unbounded", func(t *testing.T) {
+		require.Equal(t, []string{"a", "a/high.go", "a/mid.go", "a/low.go", "b/unranked.go"}, run(-1))
+	})
+
+	t.Run("bounded", func(t *testing.T) {
+		// Once a match is queued, the best one is sent for every new match.
+		require.Equal(t, []string{"a", "a/low.go", "a/high.go", "a/mid.go", "b/unranked.go"}, run(1))
+	})
+
+	t.Run("no queue", func(t *testing.T) {
+		require.Equal(t, []string{"a", "a/low.go", "b/unranked.go", "a/high.go", "a/mid.go"}, run(0))
+	})
+
+	t.Run("does not block on ranks", func(t *testing.T) {
+		release := make(chan struct{})
+		blockingRanker := blockingDocumentRanker{ranker: ranker, release: release}
+
+		var got []string
+		s := NewRankingStream(context.Background(), blockingRanker, 0, StreamFunc(func(event SearchEvent) {
+			for _, m := range event.Results {
+				got = append(got, m.(*result.FileMatch).Path)
+			}
+		}))
+
+		// The matches wait for the ranks of their repository without
+		// blocking the sender.
+		s.Send(SearchEvent{Results: result.Matches{fileMatch(repoA, "low.go")}})
+		s.Send(SearchEvent{Results: result.Matches{fileMatch(repoA, "high.go")}})
+		require.Empty(t, got)
+
+		close(release)
+		s.Done()
+		require.Equal(t, []string{"low.go", "high.go"}, got)
+	})
+}
+
+type blockingDocumentRanker struct {
+	ranker  DocumentRanker
+	release chan struct{}
+}
+
+func (r blockingDocumentRanker) GetDocumentRanks(ctx context.Context, repoName api.RepoName) (types.RepoPathRanks, error) {
+	<-r.release
+	return r.ranker.GetDocumentRanks(ctx, repoName)
+}
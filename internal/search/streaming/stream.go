@@ -87,8 +87,9 @@ func (c *resultCountingStream) Count() int {
 }
 
 // NewDedupingStream ensures only unique results are sent on the stream. Any
-// result that has already been seen is discard. Note: using this function
-// requires storing the result set of seen result.
+// result that has already been seen is discard, unless it adds up with the
+// result seen before (see result.AddsUp). Note: using this function requires
+// storing the result set of seen result.
 func NewDedupingStream(s Sender) *dedupingStream {
 	return &dedupingStream{
 		parent:  s,
@@ -106,8 +107,11 @@ func (d *dedupingStream) Send(event SearchEvent) {
 	d.Mutex.Lock()
 	results := event.Results[:0]
 	for _, match := range event.Results {
-		seen := d.deduper.Seen(match)
-		if seen {
+		if d.deduper.Seen(match) {
+			// Matches which add up are merged by the receiver
+			if result.AddsUp(match) {
+				results = append(results, match)
+			}
 			continue
 		}
 		d.deduper.Add(match)
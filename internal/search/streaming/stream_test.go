@@ -10,6 +10,7 @@ import (
 	"go.uber.org/atomic"
 
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
 )
 
 func BenchmarkBatchingStream(b *testing.B) {
@@ -148,3 +149,21 @@ func TestDedupingStream(t *testing.T) {
 
 	require.Equal(t, 1, len(sent))
 }
+
+func TestDedupingStreamCaptures(t *testing.T) {
+	var sent []result.Match
+	s := NewDedupingStream(StreamFunc(func(e SearchEvent) {
+		sent = append(sent, e.Results...)
+	}))
+
+	// The counts of capture matches with the same value add up, so a capture
+	// found in several events is sent every time.
+	repo := types.MinimalRepo{ID: 1, Name: "foo"}
+	s.Send(SearchEvent{Results: result.Matches{&result.CaptureMatch{Value: "1.2", Repo: repo, Count: 2}}})
+	s.Send(SearchEvent{Results: result.Matches{&result.CaptureMatch{Value: "1.2", Repo: repo, Count: 3}}})
+
+	require.Equal(t, []result.Match{
+		&result.CaptureMatch{Value: "1.2", Repo: repo, Count: 2},
+		&result.CaptureMatch{Value: "1.2", Repo: repo, Count: 3},
+	}, sent)
+}
@@ -0,0 +1,76 @@
+package symbol
+
+import (
+	"strings"
+
+	"github.com/grafana/regexp"
+
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+// Filter is the compiled form of the arguments to the file:has.symbol() and
+// repo:has.symbol() predicates. An empty Name or Kind matches any symbol name
+// or kind respectively.
+type Filter struct {
+	query.HasSymbolArgs
+
+	name *regexp.Regexp
+}
+
+// NewFilters compiles the given has.symbol() predicate arguments. It assumes
+// the arguments have already been validated by the query parser.
+func NewFilters(args []query.HasSymbolArgs, caseSensitive bool) []*Filter {
+	filters := make([]*Filter, 0, len(args))
+	for _, arg := range args {
+		f := &Filter{HasSymbolArgs: arg}
+		if arg.Name != "" {
+			if caseSensitive {
+				f.name = regexp.MustCompile(arg.Name)
+			} else {
+				f.name = regexp.MustCompile(`(?i)` + arg.Name)
+			}
+		}
+		filters = append(filters, f)
+	}
+	return filters
+}
+
+// String returns the filter in the syntax of the has.symbol() predicate
+// arguments.
+func (f *Filter) String() string {
+	var parts []string
+	if f.Name != "" {
+		parts = append(parts, "name:"+f.Name)
+	}
+	if f.Kind != "" {
+		parts = append(parts, "kind:"+f.Kind)
+	}
+	return strings.Join(parts, " ")
+}
+
+// Match returns true if any of the symbols satisfies the name and kind
+// constraints of the filter. It does not take negation into account.
+func (f *Filter) Match(symbols []*result.SymbolMatch) bool {
+	for _, s := range symbols {
+		if f.Kind != "" && f.Kind != s.Symbol.SelectKind() {
+			continue
+		}
+		if f.name != nil && !f.name.MatchString(s.Symbol.Name) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// MatchAll returns true if the symbols pass every filter. Filters are AND'ed
+// together, and a negated filter passes only if no symbol matches it.
+func MatchAll(filters []*Filter, symbols []*result.SymbolMatch) bool {
+	for _, f := range filters {
+		if f.Match(symbols) == f.Negated {
+			return false
+		}
+	}
+	return true
+}
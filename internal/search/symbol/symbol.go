@@ -70,7 +70,12 @@ func FilterZoektResults(ctx context.Context, checker authz.SubRepoPermissionChec
 	return filtered, nil
 }
 
-func searchZoekt(ctx context.Context, repoName types.MinimalRepo, commitID api.CommitID, inputRev *string, branch string, queryString *string, first *int32, includePatterns *[]string) (res []*result.SymbolMatch, err error) {
+// searchZoekt searches the symbols of a repository indexed by Zoekt. Zoekt
+// can't query symbols by kind, so symbols which are not of one of
+// includeKinds are dropped from its results. To still find the first symbols
+// of these kinds, the search is repeated with a larger limit while Zoekt
+// returns fewer of them than asked for but had more symbols to return.
+func searchZoekt(ctx context.Context, repoName types.MinimalRepo, commitID api.CommitID, inputRev *string, branch string, queryString *string, first *int32, includePatterns *[]string, includeKinds []string) (res []*result.SymbolMatch, err error) {
 	var raw string
 	if queryString != nil {
 		raw = *queryString
@@ -115,16 +120,53 @@ func searchZoekt(ctx context.Context, repoName types.MinimalRepo, commitID api.C
 
 	final := zoektquery.Simplify(zoektquery.NewAnd(ands...))
 	match := limitOrDefault(first) + 1
-	resp, err := search.Indexed().Search(ctx, final, &zoekt.SearchOptions{
-		Trace:              policy.ShouldTrace(ctx),
-		MaxWallTime:        3 * time.Second,
-		ShardMaxMatchCount: match * 25,
-		TotalMaxMatchCount: match * 25,
-		MaxDocDisplayCount: match,
-		ChunkMatches:       true,
-	})
-	if err != nil {
-		return nil, err
+	for limit, round := match, 0; ; limit, round = limit*zoektKindSearchGrowth, round+1 {
+		resp, err := search.Indexed().Search(ctx, final, &zoekt.SearchOptions{
+			Trace:              policy.ShouldTrace(ctx),
+			MaxWallTime:        3 * time.Second,
+			ShardMaxMatchCount: limit * 25,
+			TotalMaxMatchCount: limit * 25,
+			MaxDocDisplayCount: limit,
+			ChunkMatches:       true,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		res = zoektSymbolMatches(resp, repoName, commitID, inputRev, includeKinds)
+		if len(includeKinds) == 0 || len(res) >= match || round == zoektKindSearchMaxRetries || !zoektResultsTruncated(resp, limit) {
+			return res, nil
+		}
+	}
+}
+
+// zoektKindSearchGrowth is the factor by which searchZoekt grows its limit
+// for each of up to zoektKindSearchMaxRetries repeated searches.
+const (
+	zoektKindSearchGrowth     = 4
+	zoektKindSearchMaxRetries = 3
+)
+
+// zoektResultsTruncated reports whether Zoekt stopped before returning all
+// the matches of a search because of limit.
+func zoektResultsTruncated(resp *zoekt.SearchResult, limit int) bool {
+	return len(resp.Files) >= limit || resp.Stats.MatchCount >= limit*25 || resp.Stats.FilesSkipped > 0 || resp.Stats.ShardsSkipped > 0
+}
+
+// zoektSymbolMatches returns the symbols of one of includeKinds, or all the
+// symbols if includeKinds is empty, in the results of a Zoekt symbol search.
+func zoektSymbolMatches(resp *zoekt.SearchResult, repoName types.MinimalRepo, commitID api.CommitID, inputRev *string, includeKinds []string) (res []*result.SymbolMatch) {
+	isKindMatch := func(kind string) bool {
+		if len(includeKinds) == 0 {
+			return true
+		}
+		selectKind := result.Symbol{Kind: kind}.SelectKind()
+		for _, k := range includeKinds {
+			if k == selectKind {
+				return true
+			}
+		}
+		return false
 	}
 
 	for _, file := range resp.Files {
@@ -141,7 +183,7 @@ func searchZoekt(ctx context.Context, repoName types.MinimalRepo, commitID api.C
 			}
 
 			for _, m := range l.LineFragments {
-				if m.SymbolInfo == nil {
+				if m.SymbolInfo == nil || !isKindMatch(m.SymbolInfo.Kind) {
 					continue
 				}
 
@@ -167,7 +209,7 @@ func searchZoekt(ctx context.Context, repoName types.MinimalRepo, commitID api.C
 
 			for i, r := range cm.Ranges {
 				si := cm.SymbolInfo[i]
-				if si == nil {
+				if si == nil || !isKindMatch(si.Kind) {
 					continue
 				}
 
@@ -189,11 +231,14 @@ func searchZoekt(ctx context.Context, repoName types.MinimalRepo, commitID api.C
 	return
 }
 
-func Compute(ctx context.Context, checker authz.SubRepoPermissionChecker, repoName types.MinimalRepo, commitID api.CommitID, inputRev *string, query *string, first *int32, includePatterns *[]string) (res []*result.SymbolMatch, err error) {
+// Compute returns the symbols of a repository at commitID. If includeKinds is
+// not empty, only the symbols of one of these kinds (as named in
+// select:symbol.<kind>) are returned.
+func Compute(ctx context.Context, checker authz.SubRepoPermissionChecker, repoName types.MinimalRepo, commitID api.CommitID, inputRev *string, query *string, first *int32, includePatterns *[]string, includeKinds []string) (res []*result.SymbolMatch, err error) {
 	// TODO(keegancsmith) we should be able to use indexedSearchRequest here
 	// and remove indexedSymbolsBranch.
 	if branch := indexedSymbolsBranch(ctx, &repoName, string(commitID)); branch != "" {
-		results, err := searchZoekt(ctx, repoName, commitID, inputRev, branch, query, first, includePatterns)
+		results, err := searchZoekt(ctx, repoName, commitID, inputRev, branch, query, first, includePatterns, includeKinds)
 		if err != nil {
 			return nil, errors.Wrap(err, "zoekt symbol search")
 		}
@@ -223,6 +268,7 @@ func Compute(ctx context.Context, checker authz.SubRepoPermissionChecker, repoNa
 		First:           limitOrDefault(first) + 1, // add 1 so we can determine PageInfo.hasNextPage
 		Repo:            repoName.Name,
 		IncludePatterns: includePatternsSlice,
+		IncludeKinds:    includeKinds,
 		Timeout:         serverTimeout,
 	}
 	if query != nil {
@@ -260,7 +306,7 @@ func GetMatchAtLineCharacter(ctx context.Context, checker authz.SubRepoPermissio
 	first := int32(999999)
 	emptyString := ""
 	includePatterns := []string{regexp.QuoteMeta(filePath)}
-	symbolMatches, err := Compute(ctx, checker, repo, commitID, &emptyString, &emptyString, &first, &includePatterns)
+	symbolMatches, err := Compute(ctx, checker, repo, commitID, &emptyString, &emptyString, &first, &includePatterns, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2,8 +2,12 @@ package symbol
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
+	"github.com/sourcegraph/zoekt"
+	zoektquery "github.com/sourcegraph/zoekt/query"
+
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -21,6 +25,55 @@ func TestSearchZoektDoesntPanicWithNilQuery(t *testing.T) {
 		search.IndexedMock = nil
 	})
 
-	_, err := searchZoekt(context.Background(), types.MinimalRepo{ID: 1}, "commitID", nil, "branch", nil, nil, nil)
+	_, err := searchZoekt(context.Background(), types.MinimalRepo{ID: 1}, "commitID", nil, "branch", nil, nil, nil, nil)
 	assert.ErrorIs(t, err, expectedErr)
 }
+
+func TestSearchZoektKinds(t *testing.T) {
+	// The first 8 files only define functions, so the classes are only found
+	// once the limit has grown past them.
+	var files []zoekt.FileMatch
+	for i := 0; i < 10; i++ {
+		kind := "function"
+		if i >= 8 {
+			kind = "class"
+		}
+		files = append(files, zoekt.FileMatch{
+			FileName: fmt.Sprintf("file%d.go", i),
+			ChunkMatches: []zoekt.ChunkMatch{{
+				Ranges:     []zoekt.Range{{Start: zoekt.Location{LineNumber: 1, Column: 1}}},
+				SymbolInfo: []*zoekt.Symbol{{Sym: fmt.Sprintf("sym%d", i), Kind: kind}},
+			}},
+		})
+	}
+
+	mockStreamer := NewMockStreamer()
+	mockStreamer.SearchFunc.SetDefaultHook(func(_ context.Context, _ zoektquery.Q, opts *zoekt.SearchOptions) (*zoekt.SearchResult, error) {
+		n := opts.MaxDocDisplayCount
+		if n > len(files) {
+			n = len(files)
+		}
+		return &zoekt.SearchResult{Files: files[:n]}, nil
+	})
+	search.IndexedMock = mockStreamer
+	t.Cleanup(func() {
+		search.IndexedMock = nil
+	})
+
+	first := int32(1)
+	res, err := searchZoekt(context.Background(), types.MinimalRepo{ID: 1}, "commitID", nil, "branch", nil, &first, nil, []string{"class"})
+	assert.NoError(t, err)
+
+	var syms []string
+	for _, r := range res {
+		syms = append(syms, r.Symbol.Name)
+	}
+	assert.Equal(t, []string{"sym8", "sym9"}, syms)
+	assert.Len(t, mockStreamer.SearchFunc.History(), 3)
+
+	// Without kinds, the first search is enough.
+	res, err = searchZoekt(context.Background(), types.MinimalRepo{ID: 1}, "commitID", nil, "branch", nil, &first, nil, nil)
+	assert.NoError(t, err)
+	assert.Len(t, res, 2)
+	assert.Len(t, mockStreamer.SearchFunc.History(), 4)
+}
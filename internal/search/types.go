@@ -108,6 +108,11 @@ type SymbolsParameters struct {
 	// need to match to get included in the result
 	ExcludePattern string
 
+	// IncludeKinds is an optional list of symbol kinds, as used by the
+	// select:symbol.<kind> filter (see result.Symbol.SelectKind), of which
+	// symbols need to be one to get included in the result.
+	IncludeKinds []string
+
 	// First indicates that only the first n symbols should be returned.
 	First int
 
@@ -181,6 +186,11 @@ type ZoektParameters struct {
 
 	// EXPERIMENTAL: If true, use keyword-style scoring instead of Zoekt's default scoring formula.
 	KeywordScoring bool
+
+	// GroupByVersion sends a file that is identical across the searched
+	// revisions of a repository once, with the ranges of revisions
+	// containing it (`versions:yes`).
+	GroupByVersion bool
 }
 
 // ToSearchOptions converts the parameters to options for the Zoekt search API.
@@ -443,10 +453,12 @@ type RepoOptions struct {
 	Cursors     []*types.Cursor
 
 	// Whether we should depend on Zoekt for resolving repositories
-	UseIndex       query.YesNoOnly
-	HasFileContent []query.RepoHasFileContentArgs
-	HasKVPs        []query.RepoKVPFilter
-	HasTopics      []query.RepoHasTopicPredicate
+	UseIndex            query.YesNoOnly
+	HasFileContent      []query.RepoHasFileContentArgs
+	HasFileContentExprs []query.RepoHasFileContentExpr
+	HasKVPs             []query.RepoKVPFilter
+	HasTopics           []query.RepoHasTopicPredicate
+	HasSymbol           []query.HasSymbolArgs
 
 	// ForkSet indicates whether `fork:` was set explicitly in the query,
 	// or whether the values were set from defaults.
@@ -515,6 +527,9 @@ func (op *RepoOptions) Attributes() []attribute.KeyValue {
 			add(trace.Scoped(fmt.Sprintf("hasFileContent[%d]", i), nondefault...)...)
 		}
 	}
+	for i, expr := range op.HasFileContentExprs {
+		add(attribute.String(fmt.Sprintf("hasFileContentExprs[%d]", i), expr.String()))
+	}
 	if len(op.HasKVPs) > 0 {
 		for i, arg := range op.HasKVPs {
 			nondefault := []attribute.KeyValue{}
@@ -542,6 +557,21 @@ func (op *RepoOptions) Attributes() []attribute.KeyValue {
 			add(trace.Scoped(fmt.Sprintf("hasTopics[%d]", i), nondefault...)...)
 		}
 	}
+	if len(op.HasSymbol) > 0 {
+		for i, arg := range op.HasSymbol {
+			nondefault := []attribute.KeyValue{}
+			if arg.Name != "" {
+				nondefault = append(nondefault, attribute.String("name", arg.Name))
+			}
+			if arg.Kind != "" {
+				nondefault = append(nondefault, attribute.String("kind", arg.Kind))
+			}
+			if arg.Negated {
+				nondefault = append(nondefault, attribute.Bool("negated", arg.Negated))
+			}
+			add(trace.Scoped(fmt.Sprintf("hasSymbol[%d]", i), nondefault...)...)
+		}
+	}
 	if op.ForkSet {
 		add(attribute.Bool("forkSet", op.ForkSet))
 	}
@@ -605,6 +635,9 @@ func (op *RepoOptions) String() string {
 			}
 		}
 	}
+	for i, expr := range op.HasFileContentExprs {
+		fmt.Fprintf(&b, "HasFileContentExprs[%d]: %s\n", i, expr)
+	}
 	if len(op.HasKVPs) > 0 {
 		for i, arg := range op.HasKVPs {
 			if arg.Key != "" {
@@ -628,6 +661,19 @@ func (op *RepoOptions) String() string {
 			}
 		}
 	}
+	if len(op.HasSymbol) > 0 {
+		for i, arg := range op.HasSymbol {
+			if arg.Name != "" {
+				fmt.Fprintf(&b, "HasSymbol[%d].name: %s\n", i, arg.Name)
+			}
+			if arg.Kind != "" {
+				fmt.Fprintf(&b, "HasSymbol[%d].kind: %s\n", i, arg.Kind)
+			}
+			if arg.Negated {
+				fmt.Fprintf(&b, "HasSymbol[%d].negated: %t\n", i, arg.Negated)
+			}
+		}
+	}
 
 	if op.CaseSensitiveRepoFilters {
 		fmt.Fprintf(&b, "CaseSensitiveRepoFilters: %t\n", op.CaseSensitiveRepoFilters)
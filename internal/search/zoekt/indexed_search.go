@@ -206,6 +206,25 @@ func (rb *IndexedRepoRevs) getRepoInputRev(file *zoekt.FileMatch) (repo types.Mi
 	return repoRev.Repo, inputRevs
 }
 
+// versionRanges returns the contiguous ranges of the searched revisions of a
+// repository, in version order, that are among inputRevs.
+func (rb *IndexedRepoRevs) versionRanges(id api.RepoID, inputRevs []string) []result.VersionRange {
+	found := make(map[string]bool, len(inputRevs))
+	for _, rev := range inputRevs {
+		found[rev] = true
+	}
+
+	var revs []string
+	if repoRev, ok := rb.RepoRevs[id]; ok {
+		revs = append(revs, repoRev.Revs...)
+	} else {
+		revs = append(revs, inputRevs...)
+	}
+	result.SortVersions(revs)
+
+	return result.VersionRanges(revs, func(rev string) bool { return found[rev] })
+}
+
 func PartitionRepos(
 	ctx context.Context,
 	logger log.Logger,
@@ -299,7 +318,7 @@ func DoZoektSearchGlobal(ctx context.Context, client zoekt.Streamer, params *sea
 				Name: api.RepoName(file.Repository),
 			}
 			return repo, []string{""}
-		}, params.Typ, params.Select, c)
+		}, nil, params.Typ, params.Select, c)
 	}))
 }
 
@@ -334,10 +353,15 @@ func zoektSearch(ctx context.Context, repos *IndexedRepoRevs, q zoektquery.Q, pa
 		defer cancel()
 	}
 
+	var versionRanges versionRangesFunc
+	if zoektParams.GroupByVersion {
+		versionRanges = repos.versionRanges
+	}
+
 	foundResults := atomic.Bool{}
 	err := client.StreamSearch(ctx, finalQuery, searchOpts, backend.ZoektStreamFunc(func(event *zoekt.SearchResult) {
 		foundResults.CompareAndSwap(false, event.FileCount != 0 || event.MatchCount != 0)
-		sendMatches(event, pathRegexps, repos.getRepoInputRev, typ, zoektParams.Select, c)
+		sendMatches(event, pathRegexps, repos.getRepoInputRev, versionRanges, typ, zoektParams.Select, c)
 	}))
 	if err != nil {
 		return err
@@ -357,7 +381,12 @@ func zoektSearch(ctx context.Context, repos *IndexedRepoRevs, q zoektquery.Q, pa
 	return nil
 }
 
-func sendMatches(event *zoekt.SearchResult, pathRegexps []*regexp.Regexp, getRepoInputRev repoRevFunc, typ search.IndexedRequestType, selector filter.SelectPath, c streaming.Sender) {
+// sendMatches converts the files matched by Zoekt into file matches, one for
+// each revision a file was found in. If versionRanges is non-nil, a file is
+// instead sent once with the ranges of revisions containing it: Zoekt indexes
+// a file that is identical across revisions once, so its match lists all of
+// them.
+func sendMatches(event *zoekt.SearchResult, pathRegexps []*regexp.Regexp, getRepoInputRev repoRevFunc, versionRanges versionRangesFunc, typ search.IndexedRequestType, selector filter.SelectPath, c streaming.Sender) {
 	files := event.Files
 	stats := streaming.Stats{
 		// In the case of Zoekt the only time we get non-zero Crashes in
@@ -405,6 +434,15 @@ func sendMatches(event *zoekt.SearchResult, pathRegexps []*regexp.Regexp, getRep
 
 		pathMatches := zoektFileMatchToPathMatchRanges(&file, pathRegexps)
 
+		var versions []result.VersionRange
+		if versionRanges != nil {
+			versions = versionRanges(repo.ID, inputRevs)
+			if len(versions) > 0 {
+				// Link to the latest revision containing the file.
+				inputRevs = []string{versions[len(versions)-1].Last}
+			}
+		}
+
 		for _, inputRev := range inputRevs {
 			inputRev := inputRev // copy so we can take the pointer
 
@@ -413,9 +451,11 @@ func sendMatches(event *zoekt.SearchResult, pathRegexps []*regexp.Regexp, getRep
 				symbols = zoektFileMatchToSymbolResults(repo, inputRev, &file)
 			}
 			fm := result.FileMatch{
-				ChunkMatches: hms,
-				Symbols:      symbols,
-				PathMatches:  pathMatches,
+				ChunkMatches:    hms,
+				Symbols:         symbols,
+				PathMatches:     pathMatches,
+				Versions:        versions,
+				ContentChecksum: file.Checksum,
 				File: result.File{
 					InputRev: &inputRev,
 					CommitID: api.CommitID(file.Version),
@@ -1001,3 +1001,33 @@ func TestGetRepoRevsFromBranchRepos_SingleRepo(t *testing.T) {
 		})
 	}
 }
+
+func TestSendMatchesGroupByVersion(t *testing.T) {
+	repo := types.MinimalRepo{ID: 1, Name: "repo"}
+	repos := &IndexedRepoRevs{RepoRevs: map[api.RepoID]*search.RepositoryRevisions{
+		repo.ID: {Repo: repo, Revs: []string{"v1.10.0", "v1.2.0", "v1.9.0", "v1.11.0"}},
+	}}
+
+	// Zoekt returns a file that is identical in several revisions once.
+	event := &zoekt.SearchResult{Files: []zoekt.FileMatch{{
+		FileName:     "vuln.go",
+		Repository:   string(repo.Name),
+		RepositoryID: uint32(repo.ID),
+		Branches:     []string{"v1.2.0", "v1.10.0", "v1.11.0"},
+	}}}
+
+	var got []*result.FileMatch
+	stream := streaming.StreamFunc(func(event streaming.SearchEvent) {
+		for _, m := range event.Results {
+			got = append(got, m.(*result.FileMatch))
+		}
+	})
+	sendMatches(event, nil, repos.getRepoInputRev, repos.versionRanges, search.TextRequest, filter.SelectPath{}, stream)
+
+	require.Len(t, got, 1)
+	require.Equal(t, "v1.11.0", *got[0].InputRev)
+	require.Equal(t, []result.VersionRange{
+		{First: "v1.2.0", Last: "v1.2.0"},
+		{First: "v1.10.0", Last: "v1.11.0"},
+	}, got[0].Versions)
+}
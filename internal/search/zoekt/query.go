@@ -64,6 +64,9 @@ func QueryToZoektQuery(b query.Basic, resultTypes result.Types, feat *search.Fea
 	for _, filter := range b.RepoHasFileContent() {
 		repoHasFilters = append(repoHasFilters, QueryForFileContentArgs(filter, isCaseSensitive))
 	}
+	for _, expr := range b.RepoHasFileContentExprs() {
+		repoHasFilters = append(repoHasFilters, QueryForFileContentExpr(expr, isCaseSensitive))
+	}
 	if len(repoHasFilters) > 0 {
 		and = append(and, zoekt.NewAnd(repoHasFilters...))
 	}
@@ -113,6 +116,27 @@ func QueryForFileContentArgs(opt query.RepoHasFileContentArgs, caseSensitive boo
 	return q
 }
 
+// QueryForFileContentExpr returns a query matching repositories which satisfy
+// the boolean combination of file conditions in expr.
+func QueryForFileContentExpr(expr query.RepoHasFileContentExpr, caseSensitive bool) zoekt.Q {
+	fold := func(exprs []query.RepoHasFileContentExpr) []zoekt.Q {
+		qs := make([]zoekt.Q, 0, len(exprs))
+		for _, e := range exprs {
+			qs = append(qs, QueryForFileContentExpr(e, caseSensitive))
+		}
+		return qs
+	}
+
+	switch {
+	case expr.Args != nil:
+		return QueryForFileContentArgs(*expr.Args, caseSensitive)
+	case len(expr.And) > 0:
+		return zoekt.Simplify(zoekt.NewAnd(fold(expr.And)...))
+	default:
+		return zoekt.Simplify(zoekt.NewOr(fold(expr.Or)...))
+	}
+}
+
 func toZoektPattern(
 	expression query.Node, isCaseSensitive, patternMatchesContent, patternMatchesPath bool, typ search.IndexedRequestType) (zoekt.Q, error) {
 	var fold func(node query.Node) (zoekt.Q, error)
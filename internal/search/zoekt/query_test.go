@@ -150,6 +150,29 @@ func Test_toZoektPattern(t *testing.T) {
 		Equal(t, test(`type:symbol (foo and not bar)`, query.SearchTypeLiteral, search.SymbolRequest))
 }
 
+func TestQueryForFileContentExpr(t *testing.T) {
+	test := func(input string) string {
+		p, err := query.Pipeline(query.Init(input, query.SearchTypeLiteral))
+		if err != nil {
+			return err.Error()
+		}
+		exprs := p[0].RepoHasFileContentExprs()
+		if len(exprs) != 1 {
+			return "expected one expression"
+		}
+		return QueryForFileContentExpr(exprs[0], false).String()
+	}
+
+	autogold.Expect(`(and (type:repo file_regex:"go\\.mod") (not (type:repo file_regex:"vendor/")))`).
+		Equal(t, test(`repo:has.file(path:go\.mod and not path:vendor/)`))
+
+	autogold.Expect(`(or (type:repo (and file_regex:"package\\.json" regex:"react")) (type:repo file_regex:"go\\.mod"))`).
+		Equal(t, test(`repo:has.file((path:package\.json content:react) or path:go\.mod)`))
+
+	autogold.Expect(`(or (not (type:repo file_regex:"go\\.mod")) (type:repo file_regex:"vendor/"))`).
+		Equal(t, test(`-repo:has.file(path:go\.mod and not path:vendor/)`))
+}
+
 func queryEqual(a, b zoekt.Q) bool {
 	sortChildren := func(q zoekt.Q) zoekt.Q {
 		switch s := q.(type) {
@@ -6,6 +6,8 @@ import (
 	"github.com/sourcegraph/zoekt"
 	zoektquery "github.com/sourcegraph/zoekt/query"
 
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 )
 
@@ -47,3 +49,7 @@ func parseRe(pattern string, filenameOnly bool, contentOnly bool, queryIsCaseSen
 // repoRevFunc is a function which maps repository names returned from Zoekt
 // into the Sourcegraph's resolved repository revisions for the search.
 type repoRevFunc func(file *zoekt.FileMatch) (repo types.MinimalRepo, revs []string)
+
+// versionRangesFunc maps the revisions a file was found in to the contiguous
+// ranges of the searched revisions of its repository (`versions:yes`).
+type versionRangesFunc func(repo api.RepoID, revs []string) []result.VersionRange
@@ -19,6 +19,7 @@ func (x *SearchRequest) FromInternal(p *search.SymbolsParameters) {
 		IsCaseSensitive: p.IsCaseSensitive,
 		IncludePatterns: p.IncludePatterns,
 		ExcludePattern:  p.ExcludePattern,
+		IncludeKinds:    p.IncludeKinds,
 
 		First:   int32(p.First),
 		Timeout: durationpb.New(p.Timeout),
@@ -34,6 +35,7 @@ func (x *SearchRequest) ToInternal() search.SymbolsParameters {
 		IsCaseSensitive: x.GetIsCaseSensitive(),
 		IncludePatterns: x.GetIncludePatterns(),
 		ExcludePattern:  x.GetExcludePattern(),
+		IncludeKinds:    x.GetIncludeKinds(),
 		First:           int(x.GetFirst()),
 		Timeout:         x.GetTimeout().AsDuration(),
 	}
@@ -93,6 +93,21 @@ func (e *ExternalService) RedactedConfig(ctx context.Context) (string, error) {
 		// Nothing to redact
 	case *schema.RubyPackagesConnection:
 		es.redactString(c.Repository, "repository")
+	case *schema.NuGetPackagesConnection:
+		err = es.redactURL(c.Repository, "repository")
+		if err != nil {
+			return "", err
+		}
+	case *schema.HexPackagesConnection:
+		err = es.redactURL(c.Repository, "repository")
+		if err != nil {
+			return "", err
+		}
+	case *schema.PubPackagesConnection:
+		err = es.redactURL(c.Repository, "repository")
+		if err != nil {
+			return "", err
+		}
 	case *schema.JVMPackagesConnection:
 		es.redactString(c.Maven.Credentials, "maven", "credentials")
 	case *schema.PagureConnection:
@@ -227,6 +242,21 @@ func (e *ExternalService) UnredactConfig(ctx context.Context, old *ExternalServi
 	case *schema.RubyPackagesConnection:
 		o := oldCfg.(*schema.RubyPackagesConnection)
 		es.unredactString(c.Repository, o.Repository, "repository")
+	case *schema.NuGetPackagesConnection:
+		err = es.unredactURL(c.Repository, oldCfg.(*schema.NuGetPackagesConnection).Repository, "repository")
+		if err != nil {
+			return err
+		}
+	case *schema.HexPackagesConnection:
+		err = es.unredactURL(c.Repository, oldCfg.(*schema.HexPackagesConnection).Repository, "repository")
+		if err != nil {
+			return err
+		}
+	case *schema.PubPackagesConnection:
+		err = es.unredactURL(c.Repository, oldCfg.(*schema.PubPackagesConnection).Repository, "repository")
+		if err != nil {
+			return err
+		}
 	case *schema.JVMPackagesConnection:
 		o := oldCfg.(*schema.JVMPackagesConnection)
 		// credentials didn't change check if repositories did
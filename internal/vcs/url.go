@@ -33,7 +33,7 @@ import (
 // And if that cannot be found, it assumes rawurl is a local path.
 // If none of these rules apply, Parse returns an error.
 //
-// Code copied and modified from github.com/whilp/git-urls to support perforce scheme.
+// Code copied and modified from github.com/whilp/git-urls to support perforce and subversion schemes.
 func ParseURL(rawurl string) (u *URL, err error) {
 	parsers := []func(string) (*URL, error){
 		parseFile,
@@ -67,6 +67,8 @@ var schemes = map[string]struct{}{
 	"rsync":    {},
 	"file":     {},
 	"perforce": {},
+	"svn":      {},
+	"svn+ssh":  {},
 }
 
 func parseScheme(rawurl string) (*URL, error) {
@@ -0,0 +1,7 @@
+DELETE FROM package_repo_filters WHERE scheme IN ('scip-dotnet', 'hex', 'scip-dart');
+
+ALTER TABLE package_repo_filters
+    DROP CONSTRAINT IF EXISTS package_repo_filters_is_pkgrepo_scheme,
+    ADD CONSTRAINT package_repo_filters_is_pkgrepo_scheme CHECK (
+        scheme = ANY('{"semanticdb","npm","go","python","rust-analyzer","scip-ruby"}')
+    );
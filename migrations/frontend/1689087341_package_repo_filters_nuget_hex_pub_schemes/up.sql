@@ -0,0 +1,5 @@
+ALTER TABLE package_repo_filters
+    DROP CONSTRAINT IF EXISTS package_repo_filters_is_pkgrepo_scheme,
+    ADD CONSTRAINT package_repo_filters_is_pkgrepo_scheme CHECK (
+        scheme = ANY('{"semanticdb","npm","go","python","rust-analyzer","scip-ruby","scip-dotnet","hex","scip-dart"}')
+    );
@@ -0,0 +1,3 @@
+ALTER TABLE vulnerabilities DROP COLUMN IF EXISTS import_source;
+
+DROP TABLE IF EXISTS vulnerability_import_sources;
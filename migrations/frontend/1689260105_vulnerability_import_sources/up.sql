@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS vulnerability_import_sources (
+    id                  SERIAL PRIMARY KEY,
+    name                TEXT NOT NULL,
+    url                 TEXT NOT NULL,
+    checksum            TEXT,
+    attempted_at        TIMESTAMP WITH TIME ZONE,
+    imported_at         TIMESTAMP WITH TIME ZONE,
+    num_vulnerabilities INTEGER NOT NULL DEFAULT 0,
+    num_inserted        INTEGER NOT NULL DEFAULT 0,
+    num_updated         INTEGER NOT NULL DEFAULT 0,
+    failure_message     TEXT
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS vulnerability_import_sources_name ON vulnerability_import_sources(name);
+
+COMMENT ON TABLE vulnerability_import_sources IS 'Tracks the imports of the OSV advisory bundles configured in the codeIntelSentinel.advisorySources site configuration.';
+COMMENT ON COLUMN vulnerability_import_sources.checksum IS 'The SHA-256 checksum of the last imported bundle. A bundle with the same checksum is not re-imported.';
+COMMENT ON COLUMN vulnerability_import_sources.attempted_at IS 'The last time the bundle was read, whether or not it had changed.';
+COMMENT ON COLUMN vulnerability_import_sources.imported_at IS 'The last time the vulnerabilities of a changed bundle were written.';
+
+ALTER TABLE vulnerabilities ADD COLUMN IF NOT EXISTS import_source TEXT;
+
+COMMENT ON COLUMN vulnerabilities.import_source IS 'The name of the advisory source that last wrote this vulnerability, or null if it was downloaded from a public advisory database.';
@@ -0,0 +1 @@
+ALTER TABLE vulnerability_matches DROP COLUMN IF EXISTS reachability;
@@ -0,0 +1,3 @@
+ALTER TABLE vulnerability_matches ADD COLUMN IF NOT EXISTS reachability TEXT;
+
+COMMENT ON COLUMN vulnerability_matches.reachability IS 'Whether the upload references the affected symbols of the vulnerability: one of `reachable`, `unreachable`, or `unknown`. Null until the match is classified.';
@@ -0,0 +1,3 @@
+ALTER TABLE package_repo_versions ADD COLUMN IF NOT EXISTS license TEXT;
+
+COMMENT ON COLUMN package_repo_versions.license IS 'The SPDX license expression declared by the package version, as reported by the package host. Null when unknown.';
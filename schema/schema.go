@@ -8,10 +8,29 @@ import (
 	"fmt"
 )
 
+type AWSCodeCommitCloneOptions struct {
+	// BlobSizeLimit description: Only fetch blobs up to this size in bytes, with an optional k, m or g suffix (git fetch --filter=blob:limit=<size>). Use 0 to fetch no blobs at all. Blobs which were not fetched are fetched from the code host when they are first read.
+	BlobSizeLimit string `json:"blobSizeLimit,omitempty"`
+	// DiskQuotaBytes description: Maximum size in bytes of a repository on disk. Clones of larger repositories are cancelled and refused, and larger repositories are no longer fetched until the quota is raised. No quota if 0.
+	DiskQuotaBytes int `json:"diskQuotaBytes,omitempty"`
+	// FetchLFS description: Fetch the Git LFS objects of the repositories from the LFS server of the code host when clients such as searcher read files tracked by LFS, and store them on gitserver, so that the content of the files is searched instead of their LFS pointer files. Only supported for HTTP(S) clone URLs.
+	FetchLFS bool `json:"fetchLFS,omitempty"`
+	// LfsMaxObjectSizeBytes description: Maximum size in bytes of the Git LFS objects which are fetched. Larger files are returned as LFS pointer files. Defaults to 100 MiB if 0.
+	LfsMaxObjectSizeBytes int `json:"lfsMaxObjectSizeBytes,omitempty"`
+	// Pattern description: Regular expression which the names of the repositories these options apply to must match. Matches all repositories of this code host connection if empty.
+	Pattern string `json:"pattern,omitempty"`
+	// Refspecs description: Only fetch these refspecs, instead of all branches, tags, pull requests and merge requests.
+	Refspecs []string `json:"refspecs,omitempty"`
+	// ShallowSince description: Only fetch the history after this date (git fetch --shallow-since=<date>).
+	ShallowSince string `json:"shallowSince,omitempty"`
+}
+
 // AWSCodeCommitConnection description: Configuration for a connection to AWS CodeCommit.
 type AWSCodeCommitConnection struct {
 	// AccessKeyID description: The AWS access key ID to use when listing and updating repositories from AWS CodeCommit. Must have the AWSCodeCommitReadOnly IAM policy.
 	AccessKeyID string `json:"accessKeyID"`
+	// CloneOptions description: EXPERIMENTAL: Options to clone and fetch less than a full mirror of very large repositories, such as monorepos with large binary files or a long history, and to limit their size on disk. The options of the first entry whose pattern matches the name of a repository are used. Changing the options applies to the next fetch, but blobs and history which were not fetched are only fetched again after the repository is re-cloned.
+	CloneOptions []*AWSCodeCommitCloneOptions `json:"cloneOptions,omitempty"`
 	// Exclude description: A list of repositories to never mirror from AWS CodeCommit.
 	//
 	// Supports excluding by name ({"name": "git-codecommit.us-west-1.amazonaws.com/repo-name"}) or by ARN ({"id": "arn:aws:codecommit:us-west-1:999999999999:name"}).
@@ -217,9 +236,27 @@ type AzureDevOpsAuthProvider struct {
 	Order         int     `json:"order,omitempty"`
 	Type          string  `json:"type"`
 }
+type AzureDevOpsCloneOptions struct {
+	// BlobSizeLimit description: Only fetch blobs up to this size in bytes, with an optional k, m or g suffix (git fetch --filter=blob:limit=<size>). Use 0 to fetch no blobs at all. Blobs which were not fetched are fetched from the code host when they are first read.
+	BlobSizeLimit string `json:"blobSizeLimit,omitempty"`
+	// DiskQuotaBytes description: Maximum size in bytes of a repository on disk. Clones of larger repositories are cancelled and refused, and larger repositories are no longer fetched until the quota is raised. No quota if 0.
+	DiskQuotaBytes int `json:"diskQuotaBytes,omitempty"`
+	// FetchLFS description: Fetch the Git LFS objects of the repositories from the LFS server of the code host when clients such as searcher read files tracked by LFS, and store them on gitserver, so that the content of the files is searched instead of their LFS pointer files. Only supported for HTTP(S) clone URLs.
+	FetchLFS bool `json:"fetchLFS,omitempty"`
+	// LfsMaxObjectSizeBytes description: Maximum size in bytes of the Git LFS objects which are fetched. Larger files are returned as LFS pointer files. Defaults to 100 MiB if 0.
+	LfsMaxObjectSizeBytes int `json:"lfsMaxObjectSizeBytes,omitempty"`
+	// Pattern description: Regular expression which the names of the repositories these options apply to must match. Matches all repositories of this code host connection if empty.
+	Pattern string `json:"pattern,omitempty"`
+	// Refspecs description: Only fetch these refspecs, instead of all branches, tags, pull requests and merge requests.
+	Refspecs []string `json:"refspecs,omitempty"`
+	// ShallowSince description: Only fetch the history after this date (git fetch --shallow-since=<date>).
+	ShallowSince string `json:"shallowSince,omitempty"`
+}
 
 // AzureDevOpsConnection description: Configuration for a connection to Azure DevOps.
 type AzureDevOpsConnection struct {
+	// CloneOptions description: EXPERIMENTAL: Options to clone and fetch less than a full mirror of very large repositories, such as monorepos with large binary files or a long history, and to limit their size on disk. The options of the first entry whose pattern matches the name of a repository are used. Changing the options applies to the next fetch, but blobs and history which were not fetched are only fetched again after the repository is re-cloned.
+	CloneOptions []*AzureDevOpsCloneOptions `json:"cloneOptions,omitempty"`
 	// EnforcePermissions description: A flag to enforce Azure DevOps repository access permissions
 	EnforcePermissions bool `json:"enforcePermissions,omitempty"`
 	// Exclude description: A list of repositories to never mirror from Azure DevOps Services.
@@ -298,6 +335,22 @@ type BitbucketCloudAuthorization struct {
 	// IdentityProvider description: The identity provider to use for user information. If not set, the `url` field is used.
 	IdentityProvider string `json:"identityProvider,omitempty"`
 }
+type BitbucketCloudCloneOptions struct {
+	// BlobSizeLimit description: Only fetch blobs up to this size in bytes, with an optional k, m or g suffix (git fetch --filter=blob:limit=<size>). Use 0 to fetch no blobs at all. Blobs which were not fetched are fetched from the code host when they are first read.
+	BlobSizeLimit string `json:"blobSizeLimit,omitempty"`
+	// DiskQuotaBytes description: Maximum size in bytes of a repository on disk. Clones of larger repositories are cancelled and refused, and larger repositories are no longer fetched until the quota is raised. No quota if 0.
+	DiskQuotaBytes int `json:"diskQuotaBytes,omitempty"`
+	// FetchLFS description: Fetch the Git LFS objects of the repositories from the LFS server of the code host when clients such as searcher read files tracked by LFS, and store them on gitserver, so that the content of the files is searched instead of their LFS pointer files. Only supported for HTTP(S) clone URLs.
+	FetchLFS bool `json:"fetchLFS,omitempty"`
+	// LfsMaxObjectSizeBytes description: Maximum size in bytes of the Git LFS objects which are fetched. Larger files are returned as LFS pointer files. Defaults to 100 MiB if 0.
+	LfsMaxObjectSizeBytes int `json:"lfsMaxObjectSizeBytes,omitempty"`
+	// Pattern description: Regular expression which the names of the repositories these options apply to must match. Matches all repositories of this code host connection if empty.
+	Pattern string `json:"pattern,omitempty"`
+	// Refspecs description: Only fetch these refspecs, instead of all branches, tags, pull requests and merge requests.
+	Refspecs []string `json:"refspecs,omitempty"`
+	// ShallowSince description: Only fetch the history after this date (git fetch --shallow-since=<date>).
+	ShallowSince string `json:"shallowSince,omitempty"`
+}
 
 // BitbucketCloudConnection description: Configuration for a connection to Bitbucket Cloud.
 type BitbucketCloudConnection struct {
@@ -307,6 +360,8 @@ type BitbucketCloudConnection struct {
 	AppPassword string `json:"appPassword"`
 	// Authorization description: If non-null, enforces Bitbucket Cloud repository permissions. This requires that there is an item in the [site configuration json](https://docs.sourcegraph.com/admin/config/site_config#auth-providers) `auth.providers` field, of type "bitbucketcloud" with the same `url` field as specified in this `BitbucketCloudConnection`.
 	Authorization *BitbucketCloudAuthorization `json:"authorization,omitempty"`
+	// CloneOptions description: EXPERIMENTAL: Options to clone and fetch less than a full mirror of very large repositories, such as monorepos with large binary files or a long history, and to limit their size on disk. The options of the first entry whose pattern matches the name of a repository are used. Changing the options applies to the next fetch, but blobs and history which were not fetched are only fetched again after the repository is re-cloned.
+	CloneOptions []*BitbucketCloudCloneOptions `json:"cloneOptions,omitempty"`
 	// Exclude description: A list of repositories to never mirror from Bitbucket Cloud. Takes precedence over "teams" configuration.
 	//
 	// Supports excluding by name ({"name": "myorg/myrepo"}) or by UUID ({"uuid": "{fceb73c7-cef6-4abe-956d-e471281126bd}"}).
@@ -352,6 +407,22 @@ type BitbucketServerAuthorization struct {
 	// Oauth description: OAuth configuration specified when creating the Bitbucket Server / Bitbucket Data Center Application Link with incoming authentication. Two Legged OAuth with 'ExecuteAs=admin' must be enabled as well as user impersonation.
 	Oauth BitbucketServerOAuth `json:"oauth"`
 }
+type BitbucketServerCloneOptions struct {
+	// BlobSizeLimit description: Only fetch blobs up to this size in bytes, with an optional k, m or g suffix (git fetch --filter=blob:limit=<size>). Use 0 to fetch no blobs at all. Blobs which were not fetched are fetched from the code host when they are first read.
+	BlobSizeLimit string `json:"blobSizeLimit,omitempty"`
+	// DiskQuotaBytes description: Maximum size in bytes of a repository on disk. Clones of larger repositories are cancelled and refused, and larger repositories are no longer fetched until the quota is raised. No quota if 0.
+	DiskQuotaBytes int `json:"diskQuotaBytes,omitempty"`
+	// FetchLFS description: Fetch the Git LFS objects of the repositories from the LFS server of the code host when clients such as searcher read files tracked by LFS, and store them on gitserver, so that the content of the files is searched instead of their LFS pointer files. Only supported for HTTP(S) clone URLs.
+	FetchLFS bool `json:"fetchLFS,omitempty"`
+	// LfsMaxObjectSizeBytes description: Maximum size in bytes of the Git LFS objects which are fetched. Larger files are returned as LFS pointer files. Defaults to 100 MiB if 0.
+	LfsMaxObjectSizeBytes int `json:"lfsMaxObjectSizeBytes,omitempty"`
+	// Pattern description: Regular expression which the names of the repositories these options apply to must match. Matches all repositories of this code host connection if empty.
+	Pattern string `json:"pattern,omitempty"`
+	// Refspecs description: Only fetch these refspecs, instead of all branches, tags, pull requests and merge requests.
+	Refspecs []string `json:"refspecs,omitempty"`
+	// ShallowSince description: Only fetch the history after this date (git fetch --shallow-since=<date>).
+	ShallowSince string `json:"shallowSince,omitempty"`
+}
 
 // BitbucketServerConnection description: Configuration for a connection to Bitbucket Server / Bitbucket Data Center.
 type BitbucketServerConnection struct {
@@ -359,6 +430,8 @@ type BitbucketServerConnection struct {
 	Authorization *BitbucketServerAuthorization `json:"authorization,omitempty"`
 	// Certificate description: TLS certificate of the Bitbucket Server / Bitbucket Data Center instance. This is only necessary if the certificate is self-signed or signed by an internal CA. To get the certificate run `openssl s_client -connect HOST:443 -showcerts < /dev/null 2> /dev/null | openssl x509 -outform PEM`. To escape the value into a JSON string, you may want to use a tool like https://json-escape-text.now.sh.
 	Certificate string `json:"certificate,omitempty"`
+	// CloneOptions description: EXPERIMENTAL: Options to clone and fetch less than a full mirror of very large repositories, such as monorepos with large binary files or a long history, and to limit their size on disk. The options of the first entry whose pattern matches the name of a repository are used. Changing the options applies to the next fetch, but blobs and history which were not fetched are only fetched again after the repository is re-cloned.
+	CloneOptions []*BitbucketServerCloneOptions `json:"cloneOptions,omitempty"`
 	// Exclude description: A list of repositories to never mirror from this Bitbucket Server / Bitbucket Data Center instance. Takes precedence over "repos" and "repositoryQuery".
 	//
 	// Supports excluding by name ({"name": "projectKey/repositorySlug"}) or by ID ({"id": 42}).
@@ -845,8 +918,12 @@ type ExperimentalFeatures struct {
 	EventLogging string `json:"eventLogging,omitempty"`
 	// GitServerPinnedRepos description: List of repositories pinned to specific gitserver instances. The specified repositories will remain at their pinned servers on scaling the cluster. If the specified pinned server differs from the current server that stores the repository, then it must be re-cloned to the specified server.
 	GitServerPinnedRepos map[string]string `json:"gitServerPinnedRepos,omitempty"`
+	// GitServerReplicationFactor description: The number of gitserver instances which store a copy of each repository. Reads fail over to the other copies when a gitserver instance is unavailable. Pinned repositories are only stored on their pinned instance.
+	GitServerReplicationFactor int `json:"gitServerReplicationFactor,omitempty"`
 	// GoPackages description: Allow adding Go package host connections
 	GoPackages string `json:"goPackages,omitempty"`
+	// HexPackages description: Allow adding Hex package code host connections
+	HexPackages string `json:"hexPackages,omitempty"`
 	// InsightsAlternateLoadingStrategy description: Use an in-memory strategy of loading Code Insights. Should only be used for benchmarking on large instances, not for customer use currently.
 	InsightsAlternateLoadingStrategy bool `json:"insightsAlternateLoadingStrategy,omitempty"`
 	// InsightsBackfillerV2 description: DEPRECATED: Setting any value to this flag has no effect.
@@ -855,8 +932,12 @@ type ExperimentalFeatures struct {
 	InsightsDataRetention *bool `json:"insightsDataRetention,omitempty"`
 	// JvmPackages description: Allow adding JVM package host connections
 	JvmPackages string `json:"jvmPackages,omitempty"`
+	// Mercurial description: Allow adding Mercurial code host connections
+	Mercurial string `json:"mercurial,omitempty"`
 	// NpmPackages description: Allow adding npm package code host connections
 	NpmPackages string `json:"npmPackages,omitempty"`
+	// NugetPackages description: Allow adding NuGet package code host connections
+	NugetPackages string `json:"nugetPackages,omitempty"`
 	// Pagure description: Allow adding Pagure code host connections
 	Pagure string `json:"pagure,omitempty"`
 	// PasswordPolicy description: DEPRECATED: this is now a standard feature see: auth.passwordPolicy
@@ -865,6 +946,8 @@ type ExperimentalFeatures struct {
 	Perforce string `json:"perforce,omitempty"`
 	// PerforceChangelistMapping description: Allow mapping of Perforce changelists to their commit SHAs in the DB
 	PerforceChangelistMapping string `json:"perforceChangelistMapping,omitempty"`
+	// PubPackages description: Allow adding Pub package code host connections
+	PubPackages string `json:"pubPackages,omitempty"`
 	// PythonPackages description: Allow adding Python package code host connections
 	PythonPackages string `json:"pythonPackages,omitempty"`
 	// Ranking description: Experimental search result ranking options.
@@ -886,6 +969,8 @@ type ExperimentalFeatures struct {
 	// StructuralSearch description: Enables structural search.
 	StructuralSearch   string              `json:"structuralSearch,omitempty"`
 	SubRepoPermissions *SubRepoPermissions `json:"subRepoPermissions,omitempty"`
+	// Subversion description: Allow adding Subversion code host connections
+	Subversion string `json:"subversion,omitempty"`
 	// TlsExternal description: Global TLS/SSL settings for Sourcegraph to use when communicating with code hosts.
 	TlsExternal *TlsExternal   `json:"tls.external,omitempty"`
 	Additional  map[string]any `json:"-"` // additionalProperties not explicitly defined in the schema
@@ -930,16 +1015,21 @@ func (v *ExperimentalFeatures) UnmarshalJSON(data []byte) error {
 	delete(m, "enableStorm")
 	delete(m, "eventLogging")
 	delete(m, "gitServerPinnedRepos")
+	delete(m, "gitServerReplicationFactor")
 	delete(m, "goPackages")
+	delete(m, "hexPackages")
 	delete(m, "insightsAlternateLoadingStrategy")
 	delete(m, "insightsBackfillerV2")
 	delete(m, "insightsDataRetention")
 	delete(m, "jvmPackages")
+	delete(m, "mercurial")
 	delete(m, "npmPackages")
+	delete(m, "nugetPackages")
 	delete(m, "pagure")
 	delete(m, "passwordPolicy")
 	delete(m, "perforce")
 	delete(m, "perforceChangelistMapping")
+	delete(m, "pubPackages")
 	delete(m, "pythonPackages")
 	delete(m, "ranking")
 	delete(m, "rateLimitAnonymous")
@@ -951,6 +1041,7 @@ func (v *ExperimentalFeatures) UnmarshalJSON(data []byte) error {
 	delete(m, "search.sanitization")
 	delete(m, "structuralSearch")
 	delete(m, "subRepoPermissions")
+	delete(m, "subversion")
 	delete(m, "tls.external")
 	if len(m) > 0 {
 		v.Additional = make(map[string]any, len(m))
@@ -1031,11 +1122,29 @@ type GerritAuthorization struct {
 	// IdentityProvider description: The identity provider to use for user information. If not set, the `url` field is used.
 	IdentityProvider string `json:"identityProvider,omitempty"`
 }
+type GerritCloneOptions struct {
+	// BlobSizeLimit description: Only fetch blobs up to this size in bytes, with an optional k, m or g suffix (git fetch --filter=blob:limit=<size>). Use 0 to fetch no blobs at all. Blobs which were not fetched are fetched from the code host when they are first read.
+	BlobSizeLimit string `json:"blobSizeLimit,omitempty"`
+	// DiskQuotaBytes description: Maximum size in bytes of a repository on disk. Clones of larger repositories are cancelled and refused, and larger repositories are no longer fetched until the quota is raised. No quota if 0.
+	DiskQuotaBytes int `json:"diskQuotaBytes,omitempty"`
+	// FetchLFS description: Fetch the Git LFS objects of the repositories from the LFS server of the code host when clients such as searcher read files tracked by LFS, and store them on gitserver, so that the content of the files is searched instead of their LFS pointer files. Only supported for HTTP(S) clone URLs.
+	FetchLFS bool `json:"fetchLFS,omitempty"`
+	// LfsMaxObjectSizeBytes description: Maximum size in bytes of the Git LFS objects which are fetched. Larger files are returned as LFS pointer files. Defaults to 100 MiB if 0.
+	LfsMaxObjectSizeBytes int `json:"lfsMaxObjectSizeBytes,omitempty"`
+	// Pattern description: Regular expression which the names of the repositories these options apply to must match. Matches all repositories of this code host connection if empty.
+	Pattern string `json:"pattern,omitempty"`
+	// Refspecs description: Only fetch these refspecs, instead of all branches, tags, pull requests and merge requests.
+	Refspecs []string `json:"refspecs,omitempty"`
+	// ShallowSince description: Only fetch the history after this date (git fetch --shallow-since=<date>).
+	ShallowSince string `json:"shallowSince,omitempty"`
+}
 
 // GerritConnection description: Configuration for a connection to Gerrit.
 type GerritConnection struct {
 	// Authorization description: If non-null, enforces Gerrit repository permissions. This requires that there is an item in the [site configuration json](https://docs.sourcegraph.com/admin/config/site_config#auth-providers) `auth.providers` field, of type "gerrit" with the same `url` field as specified in this `GerritConnection`.
 	Authorization *GerritAuthorization `json:"authorization,omitempty"`
+	// CloneOptions description: EXPERIMENTAL: Options to clone and fetch less than a full mirror of very large repositories, such as monorepos with large binary files or a long history, and to limit their size on disk. The options of the first entry whose pattern matches the name of a repository are used. Changing the options applies to the next fetch, but blobs and history which were not fetched are only fetched again after the repository is re-cloned.
+	CloneOptions []*GerritCloneOptions `json:"cloneOptions,omitempty"`
 	// Password description: The password associated with the Gerrit username used for authentication.
 	Password string `json:"password"`
 	// Projects description: An array of project strings specifying which Gerrit projects to mirror on Sourcegraph. If empty, all projects will be mirrored.
@@ -1122,6 +1231,22 @@ type GitHubAuthorization struct {
 	// GroupsCacheTTL description: Experimental: If set, configures hours cached permissions from teams and organizations should be kept for. Setting a negative value disables syncing from teams and organizations, and falls back to the default behaviour of syncing all permisisons directly from user-repository affiliations instead. [Learn more](https://docs.sourcegraph.com/admin/external_service/github#teams-and-organizations-permissions-caching).
 	GroupsCacheTTL float64 `json:"groupsCacheTTL,omitempty"`
 }
+type GitHubCloneOptions struct {
+	// BlobSizeLimit description: Only fetch blobs up to this size in bytes, with an optional k, m or g suffix (git fetch --filter=blob:limit=<size>). Use 0 to fetch no blobs at all. Blobs which were not fetched are fetched from the code host when they are first read.
+	BlobSizeLimit string `json:"blobSizeLimit,omitempty"`
+	// DiskQuotaBytes description: Maximum size in bytes of a repository on disk. Clones of larger repositories are cancelled and refused, and larger repositories are no longer fetched until the quota is raised. No quota if 0.
+	DiskQuotaBytes int `json:"diskQuotaBytes,omitempty"`
+	// FetchLFS description: Fetch the Git LFS objects of the repositories from the LFS server of the code host when clients such as searcher read files tracked by LFS, and store them on gitserver, so that the content of the files is searched instead of their LFS pointer files. Only supported for HTTP(S) clone URLs.
+	FetchLFS bool `json:"fetchLFS,omitempty"`
+	// LfsMaxObjectSizeBytes description: Maximum size in bytes of the Git LFS objects which are fetched. Larger files are returned as LFS pointer files. Defaults to 100 MiB if 0.
+	LfsMaxObjectSizeBytes int `json:"lfsMaxObjectSizeBytes,omitempty"`
+	// Pattern description: Regular expression which the names of the repositories these options apply to must match. Matches all repositories of this code host connection if empty.
+	Pattern string `json:"pattern,omitempty"`
+	// Refspecs description: Only fetch these refspecs, instead of all branches, tags, pull requests and merge requests.
+	Refspecs []string `json:"refspecs,omitempty"`
+	// ShallowSince description: Only fetch the history after this date (git fetch --shallow-since=<date>).
+	ShallowSince string `json:"shallowSince,omitempty"`
+}
 
 // GitHubConnection description: Configuration for a connection to GitHub or GitHub Enterprise.
 type GitHubConnection struct {
@@ -1129,6 +1254,8 @@ type GitHubConnection struct {
 	Authorization *GitHubAuthorization `json:"authorization,omitempty"`
 	// Certificate description: TLS certificate of the GitHub Enterprise instance. This is only necessary if the certificate is self-signed or signed by an internal CA. To get the certificate run `openssl s_client -connect HOST:443 -showcerts < /dev/null 2> /dev/null | openssl x509 -outform PEM`. To escape the value into a JSON string, you may want to use a tool like https://json-escape-text.now.sh.
 	Certificate string `json:"certificate,omitempty"`
+	// CloneOptions description: EXPERIMENTAL: Options to clone and fetch less than a full mirror of very large repositories, such as monorepos with large binary files or a long history, and to limit their size on disk. The options of the first entry whose pattern matches the name of a repository are used. Changing the options applies to the next fetch, but blobs and history which were not fetched are only fetched again after the repository is re-cloned.
+	CloneOptions []*GitHubCloneOptions `json:"cloneOptions,omitempty"`
 	// CloudDefault description: Only used to override the cloud_default column from a config file specified by EXTSVC_CONFIG_FILE
 	CloudDefault bool `json:"cloudDefault,omitempty"`
 	// CloudGlobal description: When set to true, this external service will be chosen as our 'Global' GitHub service. Only valid on Sourcegraph.com. Only one service can have this flag set.
@@ -1232,6 +1359,22 @@ type GitLabAuthorization struct {
 	// IdentityProvider description: The source of identity to use when computing permissions. This defines how to compute the GitLab identity to use for a given Sourcegraph user.
 	IdentityProvider IdentityProvider `json:"identityProvider"`
 }
+type GitLabCloneOptions struct {
+	// BlobSizeLimit description: Only fetch blobs up to this size in bytes, with an optional k, m or g suffix (git fetch --filter=blob:limit=<size>). Use 0 to fetch no blobs at all. Blobs which were not fetched are fetched from the code host when they are first read.
+	BlobSizeLimit string `json:"blobSizeLimit,omitempty"`
+	// DiskQuotaBytes description: Maximum size in bytes of a repository on disk. Clones of larger repositories are cancelled and refused, and larger repositories are no longer fetched until the quota is raised. No quota if 0.
+	DiskQuotaBytes int `json:"diskQuotaBytes,omitempty"`
+	// FetchLFS description: Fetch the Git LFS objects of the repositories from the LFS server of the code host when clients such as searcher read files tracked by LFS, and store them on gitserver, so that the content of the files is searched instead of their LFS pointer files. Only supported for HTTP(S) clone URLs.
+	FetchLFS bool `json:"fetchLFS,omitempty"`
+	// LfsMaxObjectSizeBytes description: Maximum size in bytes of the Git LFS objects which are fetched. Larger files are returned as LFS pointer files. Defaults to 100 MiB if 0.
+	LfsMaxObjectSizeBytes int `json:"lfsMaxObjectSizeBytes,omitempty"`
+	// Pattern description: Regular expression which the names of the repositories these options apply to must match. Matches all repositories of this code host connection if empty.
+	Pattern string `json:"pattern,omitempty"`
+	// Refspecs description: Only fetch these refspecs, instead of all branches, tags, pull requests and merge requests.
+	Refspecs []string `json:"refspecs,omitempty"`
+	// ShallowSince description: Only fetch the history after this date (git fetch --shallow-since=<date>).
+	ShallowSince string `json:"shallowSince,omitempty"`
+}
 
 // GitLabConnection description: Configuration for a connection to GitLab (GitLab.com or GitLab self-managed).
 type GitLabConnection struct {
@@ -1239,6 +1382,8 @@ type GitLabConnection struct {
 	Authorization *GitLabAuthorization `json:"authorization,omitempty"`
 	// Certificate description: TLS certificate of the GitLab instance. This is only necessary if the certificate is self-signed or signed by an internal CA. To get the certificate run `openssl s_client -connect HOST:443 -showcerts < /dev/null 2> /dev/null | openssl x509 -outform PEM`. To escape the value into a JSON string, you may want to use a tool like https://json-escape-text.now.sh.
 	Certificate string `json:"certificate,omitempty"`
+	// CloneOptions description: EXPERIMENTAL: Options to clone and fetch less than a full mirror of very large repositories, such as monorepos with large binary files or a long history, and to limit their size on disk. The options of the first entry whose pattern matches the name of a repository are used. Changing the options applies to the next fetch, but blobs and history which were not fetched are only fetched again after the repository is re-cloned.
+	CloneOptions []*GitLabCloneOptions `json:"cloneOptions,omitempty"`
 	// CloudDefault description: Only used to override the cloud_default column from a config file specified by EXTSVC_CONFIG_FILE
 	CloudDefault bool `json:"cloudDefault,omitempty"`
 	// CloudGlobal description: When set to true, this external service will be chosen as our 'Global' GitLab service. Only valid on Sourcegraph.com. Only one service can have this flag set.
@@ -1326,9 +1471,27 @@ type Github struct {
 	// WebhookSecret description: The release webhook secret.
 	WebhookSecret string `json:"webhookSecret"`
 }
+type GitoliteCloneOptions struct {
+	// BlobSizeLimit description: Only fetch blobs up to this size in bytes, with an optional k, m or g suffix (git fetch --filter=blob:limit=<size>). Use 0 to fetch no blobs at all. Blobs which were not fetched are fetched from the code host when they are first read.
+	BlobSizeLimit string `json:"blobSizeLimit,omitempty"`
+	// DiskQuotaBytes description: Maximum size in bytes of a repository on disk. Clones of larger repositories are cancelled and refused, and larger repositories are no longer fetched until the quota is raised. No quota if 0.
+	DiskQuotaBytes int `json:"diskQuotaBytes,omitempty"`
+	// FetchLFS description: Fetch the Git LFS objects of the repositories from the LFS server of the code host when clients such as searcher read files tracked by LFS, and store them on gitserver, so that the content of the files is searched instead of their LFS pointer files. Only supported for HTTP(S) clone URLs.
+	FetchLFS bool `json:"fetchLFS,omitempty"`
+	// LfsMaxObjectSizeBytes description: Maximum size in bytes of the Git LFS objects which are fetched. Larger files are returned as LFS pointer files. Defaults to 100 MiB if 0.
+	LfsMaxObjectSizeBytes int `json:"lfsMaxObjectSizeBytes,omitempty"`
+	// Pattern description: Regular expression which the names of the repositories these options apply to must match. Matches all repositories of this code host connection if empty.
+	Pattern string `json:"pattern,omitempty"`
+	// Refspecs description: Only fetch these refspecs, instead of all branches, tags, pull requests and merge requests.
+	Refspecs []string `json:"refspecs,omitempty"`
+	// ShallowSince description: Only fetch the history after this date (git fetch --shallow-since=<date>).
+	ShallowSince string `json:"shallowSince,omitempty"`
+}
 
 // GitoliteConnection description: Configuration for a connection to Gitolite.
 type GitoliteConnection struct {
+	// CloneOptions description: EXPERIMENTAL: Options to clone and fetch less than a full mirror of very large repositories, such as monorepos with large binary files or a long history, and to limit their size on disk. The options of the first entry whose pattern matches the name of a repository are used. Changing the options applies to the next fetch, but blobs and history which were not fetched are only fetched again after the repository is re-cloned.
+	CloneOptions []*GitoliteCloneOptions `json:"cloneOptions,omitempty"`
 	// Exclude description: A list of repositories to never mirror from this Gitolite instance. Supports excluding by exact name ({"name": "foo"}).
 	Exclude []*ExcludedGitoliteRepo `json:"exclude,omitempty"`
 	// Host description: Gitolite host that stores the repositories (e.g., git@gitolite.example.com, ssh://git@gitolite.example.com:2222/).
@@ -1377,6 +1540,24 @@ type Header struct {
 	Value     string `json:"value"`
 }
 
+// HexPackagesConnection description: Configuration for a connection to Hex packages
+type HexPackagesConnection struct {
+	// Dependencies description: An array of strings specifying Hex packages to mirror in Sourcegraph.
+	Dependencies []string `json:"dependencies,omitempty"`
+	// RateLimit description: Rate limit applied when making background API requests to the configured Hex repository APIs.
+	RateLimit *HexRateLimit `json:"rateLimit,omitempty"`
+	// Repository description: The URL of the Hex repository which package tarballs are downloaded from. It can include the username and password of a private repository.
+	Repository string `json:"repository,omitempty"`
+}
+
+// HexRateLimit description: Rate limit applied when making background API requests to the configured Hex repository APIs.
+type HexRateLimit struct {
+	// Enabled description: true if rate limiting is enabled.
+	Enabled bool `json:"enabled"`
+	// RequestsPerHour description: Requests per hour permitted. This is an average, calculated per second. Internally, the burst limit is set to 100, which implies that for a requests per hour limit as low as 1, users will continue to be able to send a maximum of 100 requests immediately, provided that the complexity cost of each request is 1.
+	RequestsPerHour float64 `json:"requestsPerHour"`
+}
+
 // IdentityProvider description: The source of identity to use when computing permissions. This defines how to compute the GitLab identity to use for a given Sourcegraph user.
 type IdentityProvider struct {
 	Oauth    *OAuthIdentity
@@ -1465,6 +1646,26 @@ type MavenRateLimit struct {
 	// RequestsPerHour description: Requests per hour permitted. This is an average, calculated per second. Internally, the burst limit is set to 100, which implies that for a requests per hour limit as low as 1, users will continue to be able to send a maximum of 100 requests immediately, provided that the complexity cost of each request is 1.
 	RequestsPerHour float64 `json:"requestsPerHour"`
 }
+
+// MercurialConnection description: Configuration for a connection to Mercurial repositories.
+type MercurialConnection struct {
+	// Exclude description: A list of repository paths to never mirror, even if they are discovered.
+	Exclude []string `json:"exclude,omitempty"`
+	// Password description: The password used to authenticate to the Mercurial server.
+	Password string `json:"password,omitempty"`
+	// Repos description: The paths of the repositories to mirror, relative to the URL. If not set, all the repositories listed by the hgweb server or found in the directory are mirrored.
+	Repos []string `json:"repos,omitempty"`
+	// RepositoryPathPattern description: The pattern used to generate the corresponding Sourcegraph repository name for a Mercurial repository. In the pattern, the variable "{host}" is replaced with the host of the Mercurial server, and "{repo}" with the path of the repository.
+	//
+	// For example, if your Mercurial server is https://hg.example.com and your Sourcegraph URL is https://src.example.com, then a repositoryPathPattern of "hg/{repo}" would mean that the Mercurial repository "project-a" is available on Sourcegraph at https://src.example.com/hg/project-a.
+	//
+	// It is important that the Sourcegraph repository name generated with this pattern be unique to this Mercurial server. If different Mercurial servers generate repository names that collide, Sourcegraph's behavior is undefined.
+	RepositoryPathPattern string `json:"repositoryPathPattern,omitempty"`
+	// Url description: The root URL of the Mercurial repositories. If it is an hgweb server (http or https) or a directory (file), the repositories it serves are discovered when "repos" is not set. The schemes http, https, ssh and file are supported.
+	Url string `json:"url"`
+	// Username description: The username used to authenticate to the Mercurial server.
+	Username string `json:"username,omitempty"`
+}
 type Mount struct {
 	// Mountpoint description: The path in the container to mount the path on the local machine to.
 	Mountpoint string `json:"mountpoint"`
@@ -1620,6 +1821,24 @@ type NpmRateLimit struct {
 	// RequestsPerHour description: Requests per hour permitted. This is an average, calculated per second. Internally, the burst limit is set to 100, which implies that for a requests per hour limit as low as 1, users will continue to be able to send a maximum of 100 requests immediately, provided that the complexity cost of each request is 1.
 	RequestsPerHour float64 `json:"requestsPerHour"`
 }
+
+// NuGetPackagesConnection description: Configuration for a connection to NuGet packages
+type NuGetPackagesConnection struct {
+	// Dependencies description: An array of strings specifying NuGet packages to mirror in Sourcegraph.
+	Dependencies []string `json:"dependencies,omitempty"`
+	// RateLimit description: Rate limit applied when making background API requests to the configured NuGet repository APIs.
+	RateLimit *NuGetRateLimit `json:"rateLimit,omitempty"`
+	// Repository description: The URL of the service index of the NuGet V3 feed. It can include the username and password of a private feed.
+	Repository string `json:"repository,omitempty"`
+}
+
+// NuGetRateLimit description: Rate limit applied when making background API requests to the configured NuGet repository APIs.
+type NuGetRateLimit struct {
+	// Enabled description: true if rate limiting is enabled.
+	Enabled bool `json:"enabled"`
+	// RequestsPerHour description: Requests per hour permitted. This is an average, calculated per second. Internally, the burst limit is set to 100, which implies that for a requests per hour limit as low as 1, users will continue to be able to send a maximum of 100 requests immediately, provided that the complexity cost of each request is 1.
+	RequestsPerHour float64 `json:"requestsPerHour"`
+}
 type OAuthIdentity struct {
 	Type string `json:"type"`
 }
@@ -1712,9 +1931,27 @@ type OrganizationInvitations struct {
 	// The legacy invitation will be deprecated in the future and creating an organization invitation will fail with an error if this setting is not present.
 	SigningKey string `json:"signingKey"`
 }
+type OtherCloneOptions struct {
+	// BlobSizeLimit description: Only fetch blobs up to this size in bytes, with an optional k, m or g suffix (git fetch --filter=blob:limit=<size>). Use 0 to fetch no blobs at all. Blobs which were not fetched are fetched from the code host when they are first read.
+	BlobSizeLimit string `json:"blobSizeLimit,omitempty"`
+	// DiskQuotaBytes description: Maximum size in bytes of a repository on disk. Clones of larger repositories are cancelled and refused, and larger repositories are no longer fetched until the quota is raised. No quota if 0.
+	DiskQuotaBytes int `json:"diskQuotaBytes,omitempty"`
+	// FetchLFS description: Fetch the Git LFS objects of the repositories from the LFS server of the code host when clients such as searcher read files tracked by LFS, and store them on gitserver, so that the content of the files is searched instead of their LFS pointer files. Only supported for HTTP(S) clone URLs.
+	FetchLFS bool `json:"fetchLFS,omitempty"`
+	// LfsMaxObjectSizeBytes description: Maximum size in bytes of the Git LFS objects which are fetched. Larger files are returned as LFS pointer files. Defaults to 100 MiB if 0.
+	LfsMaxObjectSizeBytes int `json:"lfsMaxObjectSizeBytes,omitempty"`
+	// Pattern description: Regular expression which the names of the repositories these options apply to must match. Matches all repositories of this code host connection if empty.
+	Pattern string `json:"pattern,omitempty"`
+	// Refspecs description: Only fetch these refspecs, instead of all branches, tags, pull requests and merge requests.
+	Refspecs []string `json:"refspecs,omitempty"`
+	// ShallowSince description: Only fetch the history after this date (git fetch --shallow-since=<date>).
+	ShallowSince string `json:"shallowSince,omitempty"`
+}
 
 // OtherExternalServiceConnection description: Configuration for a Connection to Git repositories for which an external service integration isn't yet available.
 type OtherExternalServiceConnection struct {
+	// CloneOptions description: EXPERIMENTAL: Options to clone and fetch less than a full mirror of very large repositories, such as monorepos with large binary files or a long history, and to limit their size on disk. The options of the first entry whose pattern matches the name of a repository are used. Changing the options applies to the next fetch, but blobs and history which were not fetched are only fetched again after the repository is re-cloned.
+	CloneOptions []*OtherCloneOptions `json:"cloneOptions,omitempty"`
 	// Exclude description: A list of repositories to never mirror by name after applying repositoryPathPattern. Supports excluding by exact name ({"name": "myrepo"}) or regular expression ({"pattern": ".*secret.*"}).
 	Exclude []*ExcludedOtherRepo `json:"exclude,omitempty"`
 	Repos   []string             `json:"repos"`
@@ -1850,6 +2087,24 @@ type PhabricatorConnection struct {
 	Url string `json:"url,omitempty"`
 }
 
+// PubPackagesConnection description: Configuration for a connection to Pub packages
+type PubPackagesConnection struct {
+	// Dependencies description: An array of strings specifying Pub packages to mirror in Sourcegraph.
+	Dependencies []string `json:"dependencies,omitempty"`
+	// RateLimit description: Rate limit applied when making background API requests to the configured Pub repository APIs.
+	RateLimit *PubRateLimit `json:"rateLimit,omitempty"`
+	// Repository description: The URL of the hosted Pub repository which packages are downloaded from. It can include the username and password of a private repository.
+	Repository string `json:"repository,omitempty"`
+}
+
+// PubRateLimit description: Rate limit applied when making background API requests to the configured Pub repository APIs.
+type PubRateLimit struct {
+	// Enabled description: true if rate limiting is enabled.
+	Enabled bool `json:"enabled"`
+	// RequestsPerHour description: Requests per hour permitted. This is an average, calculated per second. Internally, the burst limit is set to 100, which implies that for a requests per hour limit as low as 1, users will continue to be able to send a maximum of 100 requests immediately, provided that the complexity cost of each request is 1.
+	RequestsPerHour float64 `json:"requestsPerHour"`
+}
+
 // PythonPackagesConnection description: Configuration for a connection to Python simple repository APIs compatible with PEP 503
 type PythonPackagesConnection struct {
 	// Dependencies description: An array of strings specifying Python packages to mirror in Sourcegraph.
@@ -2464,6 +2719,10 @@ type SiteConfiguration struct {
 	CodeIntelRankingDocumentReferenceCountsGraphKey string `json:"codeIntelRanking.documentReferenceCountsGraphKey,omitempty"`
 	// CodeIntelRankingStaleResultsAge description: The interval at which to run the reduce job that computes document reference counts. Default is 24hrs.
 	CodeIntelRankingStaleResultsAge int `json:"codeIntelRanking.staleResultsAge,omitempty"`
+	// CodeIntelSentinelAdvisorySources description: Bundles of vulnerability advisories in the Open Source Vulnerability (OSV) format imported by the vulnerability scanner, for instances that cannot download the public advisory databases. Sources are imported periodically and on demand by a site admin, and a bundle is only re-imported when its contents change.
+	CodeIntelSentinelAdvisorySources []*VulnerabilityAdvisorySource `json:"codeIntelSentinel.advisorySources,omitempty"`
+	// CodeIntelSentinelPublicAdvisoryDatabasesEnabled description: Whether the vulnerability scanner downloads the GitHub Advisory Database from github.com. Disable on instances without internet access, and import advisories with codeIntelSentinel.advisorySources instead.
+	CodeIntelSentinelPublicAdvisoryDatabasesEnabled *bool `json:"codeIntelSentinel.publicAdvisoryDatabasesEnabled,omitempty"`
 	// CodyEnabled description: Enable or disable Cody instance-wide. When Cody is disabled, all Cody endpoints and GraphQL queries will return errors, Cody will not show up in the site-admin sidebar, and Cody in the global navbar will only show a call-to-action for site-admins to enable Cody.
 	CodyEnabled *bool `json:"cody.enabled,omitempty"`
 	// CodyRestrictUsersFeatureFlag description: Restrict Cody to only be enabled for users that have a feature flag labeled "cody" set to true. You must create a feature flag with this ID after enabling this setting: https://docs.sourcegraph.com/dev/how-to/use_feature_flags#create-a-feature-flag. This setting only has an effect if cody.enabled is true.
@@ -2720,6 +2979,8 @@ func (v *SiteConfiguration) UnmarshalJSON(data []byte) error {
 	delete(m, "codeIntelRanking.documentReferenceCountsEnabled")
 	delete(m, "codeIntelRanking.documentReferenceCountsGraphKey")
 	delete(m, "codeIntelRanking.staleResultsAge")
+	delete(m, "codeIntelSentinel.advisorySources")
+	delete(m, "codeIntelSentinel.publicAdvisoryDatabasesEnabled")
 	delete(m, "cody.enabled")
 	delete(m, "cody.restrictUsersFeatureFlag")
 	delete(m, "completions")
@@ -2858,6 +3119,26 @@ type SubRepoPermissions struct {
 	UserCacheTTLSeconds int `json:"userCacheTTLSeconds,omitempty"`
 }
 
+// SubversionConnection description: Configuration for a connection to a Subversion server.
+type SubversionConnection struct {
+	// Layout description: The layout of the repositories. "standard" imports the trunk/, branches/ and tags/ directories as Git branches and tags (git svn --stdlayout). "none" imports the whole repository as a single branch.
+	Layout string `json:"layout,omitempty"`
+	// Password description: The password used to authenticate to the Subversion server.
+	Password string `json:"password,omitempty"`
+	// Repos description: The paths of the repositories to mirror, relative to the URL of the Subversion server.
+	Repos []string `json:"repos"`
+	// RepositoryPathPattern description: The pattern used to generate the corresponding Sourcegraph repository name for a Subversion repository. In the pattern, the variable "{host}" is replaced with the host of the Subversion server, and "{repo}" with the path of the repository.
+	//
+	// For example, if your Subversion server is https://svn.example.com/repos and your Sourcegraph URL is https://src.example.com, then a repositoryPathPattern of "svn/{repo}" would mean that the Subversion repository "project-a" is available on Sourcegraph at https://src.example.com/svn/project-a.
+	//
+	// It is important that the Sourcegraph repository name generated with this pattern be unique to this Subversion server. If different Subversion servers generate repository names that collide, Sourcegraph's behavior is undefined.
+	RepositoryPathPattern string `json:"repositoryPathPattern,omitempty"`
+	// Url description: The root URL of the Subversion server that contains the repositories. The schemes http, https, svn, svn+ssh and file are supported.
+	Url string `json:"url"`
+	// Username description: The username used to authenticate to the Subversion server.
+	Username string `json:"username,omitempty"`
+}
+
 // SymbolConfiguration description: Configure symbol generation
 type SymbolConfiguration struct {
 	// Engine description: Manually specify overrides for symbol generation engine per language
@@ -2921,6 +3202,12 @@ type UpdateIntervalRule struct {
 type UsernameIdentity struct {
 	Type string `json:"type"`
 }
+type VulnerabilityAdvisorySource struct {
+	// Name description: A unique name identifying the source. Imported vulnerabilities record the name of the source that last wrote them.
+	Name string `json:"name"`
+	// Url description: The location of the bundle: a path or file:// URL readable by the frontend and worker services (e.g. a mounted volume), an http(s):// URL such as an internal mirror, or a blobstore://<bucket>/<key> URL naming an object of the instance's built-in blobstore. A bundle is a zip archive of OSV JSON files, a directory of OSV JSON files, or a single JSON file containing one advisory or an array of advisories.
+	Url string `json:"url"`
+}
 
 // WebhookLogging description: Configuration for logging incoming webhooks.
 type WebhookLogging struct {
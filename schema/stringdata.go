@@ -70,6 +70,15 @@ var RustPackagesSchemaJSON string
 //go:embed ruby-packages.schema.json
 var RubyPackagesSchemaJSON string
 
+//go:embed nuget-packages.schema.json
+var NuGetPackagesSchemaJSON string
+
+//go:embed hex-packages.schema.json
+var HexPackagesSchemaJSON string
+
+//go:embed pub-packages.schema.json
+var PubPackagesSchemaJSON string
+
 // OtherExternalServiceSchemaJSON is the content of the file "other_external_service.schema.json".
 //
 //go:embed other_external_service.schema.json
@@ -80,11 +89,21 @@ var OtherExternalServiceSchemaJSON string
 //go:embed localgit.schema.json
 var LocalGitExternalServiceSchemaJSON string
 
+// MercurialSchemaJSON is the content of the file "mercurial.schema.json".
+//
+//go:embed mercurial.schema.json
+var MercurialSchemaJSON string
+
 // PerforceSchemaJSON is the content of the file "perforce.schema.json".
 //
 //go:embed perforce.schema.json
 var PerforceSchemaJSON string
 
+// SubversionSchemaJSON is the content of the file "subversion.schema.json".
+//
+//go:embed subversion.schema.json
+var SubversionSchemaJSON string
+
 // PhabricatorSchemaJSON is the content of the file "phabricator.schema.json".
 //
 //go:embed phabricator.schema.json